@@ -4,9 +4,13 @@ import (
 	"github.com/gin-gonic/gin"
 
 	"softpharos/cmd/buildingAPI"
+	"softpharos/internal/auth"
 )
 
 func MapUrls(router *gin.Engine) {
+	// Los access tokens revocados en logout dejan de ser aceptados
+	auth.SetRevocationList(buildingAPI.BuildRevocationList())
+
 	v1 := router.Group("")
 	{
 		// Health check
@@ -17,18 +21,28 @@ func MapUrls(router *gin.Engine) {
 			})
 		})
 
-		// Registrar rutas de autenticación (sin middleware)
+		// Registrar rutas de autenticación (sin middleware, salvo logout)
 		buildingAPI.RegisterAuthRoutes(v1)
+	}
 
+	// Rutas protegidas: requieren un JWT válido
+	protected := router.Group("")
+	protected.Use(auth.AuthMiddleware())
+	{
 		// Registrar rutas de cada dominio
-		buildingAPI.RegisterProjectRoutes(v1)
-		buildingAPI.RegisterRoleRoutes(v1)
-		buildingAPI.RegisterUserRoutes(v1)
-		buildingAPI.RegisterMilestoneRoutes(v1)
-		buildingAPI.RegisterCommentRoutes(v1)
-		buildingAPI.RegisterDeliverableRoutes(v1)
-		buildingAPI.RegisterFeedbackRoutes(v1)
-		buildingAPI.RegisterProjectMemberRoutes(v1)
-		buildingAPI.RegisterReactionRoutes(v1)
+		buildingAPI.RegisterProjectRoutes(protected)
+		buildingAPI.RegisterRoleRoutes(protected)
+		buildingAPI.RegisterUserRoutes(protected)
+		buildingAPI.RegisterMilestoneRoutes(protected)
+		buildingAPI.RegisterCommentRoutes(protected)
+		buildingAPI.RegisterDeliverableRoutes(protected)
+		buildingAPI.RegisterFeedbackRoutes(protected)
+		buildingAPI.RegisterProjectMemberRoutes(protected)
+		buildingAPI.RegisterReactionRoutes(protected)
+		buildingAPI.RegisterSignupRuleRoutes(protected)
+		buildingAPI.RegisterInvitationRoutes(protected)
+		buildingAPI.RegisterTermRoutes(protected)
+		buildingAPI.RegisterCourseRoutes(protected)
+		buildingAPI.RegisterTimelineRoutes(protected)
 	}
 }
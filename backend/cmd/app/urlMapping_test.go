@@ -0,0 +1,219 @@
+package app
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"slices"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+
+	"softpharos/internal/auth"
+	"softpharos/internal/core/repository"
+	"softpharos/internal/infra/databases"
+)
+
+func setupRouter(t *testing.T) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+
+	client, _, sqlDB := repository.SetupMockDB(t)
+	t.Cleanup(func() { sqlDB.Close() })
+	databases.InitializeDatabase(client)
+
+	router := gin.New()
+	MapUrls(router)
+	// Estos tests cubren el enrutamiento; la revocación se prueba en internal/auth
+	auth.SetRevocationList(nil)
+	t.Cleanup(func() { auth.SetRevocationList(nil) })
+	return router
+}
+
+func TestMapUrls_PublicRoutes(t *testing.T) {
+	router := setupRouter(t)
+
+	req, _ := http.NewRequest("GET", "/health", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	req, _ = http.NewRequest("POST", "/auth/google", bytes.NewBufferString("{}"))
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	assert.NotEqual(t, http.StatusUnauthorized, w.Code)
+}
+
+func TestMapUrls_RequiresAuthentication(t *testing.T) {
+	router := setupRouter(t)
+
+	for _, path := range []string{"/projects", "/roles", "/users", "/milestones", "/comments", "/deliverables", "/feedbacks", "/project-members", "/reactions", "/signup-rules", "/invitations/project/1", "/me/projects", "/me/invitations", "/terms", "/courses", "/course-sections/1/members", "/projects/1/timeline"} {
+		t.Run(path, func(t *testing.T) {
+			req, _ := http.NewRequest("GET", path, nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, http.StatusUnauthorized, w.Code)
+			assert.Contains(t, w.Body.String(), `"code":"UNAUTHORIZED"`)
+		})
+	}
+}
+
+func TestMapUrls_RolePermissions(t *testing.T) {
+	os.Setenv("JWT_SECRET", "test-secret")
+	defer os.Unsetenv("JWT_SECRET")
+
+	router := setupRouter(t)
+
+	everyone := []string{auth.RoleAdmin, auth.RoleProfessor, auth.RoleStudent}
+	adminOnly := []string{auth.RoleAdmin}
+	adminStudent := []string{auth.RoleAdmin, auth.RoleStudent}
+	adminProfessor := []string{auth.RoleAdmin, auth.RoleProfessor}
+
+	tests := []struct {
+		method  string
+		path    string
+		allowed []string
+	}{
+		{"GET", "/projects", everyone},
+		{"GET", "/projects/1", everyone},
+		{"GET", "/projects/owner/1", everyone},
+		{"POST", "/projects", adminStudent},
+		{"PUT", "/projects/1", adminStudent},
+		{"POST", "/projects/1/transfer-ownership", adminStudent},
+		{"POST", "/projects/1/archive", adminStudent},
+		{"POST", "/projects/1/unarchive", adminStudent},
+		{"GET", "/me/projects", everyone},
+		{"DELETE", "/projects/1", adminStudent},
+		{"GET", "/projects/trash", adminStudent},
+		{"POST", "/projects/1/restore", adminStudent},
+
+		{"GET", "/roles", everyone},
+		{"GET", "/roles/1", everyone},
+		{"GET", "/roles/name/admin", everyone},
+		{"POST", "/roles", adminOnly},
+		{"PUT", "/roles/1", adminOnly},
+		{"DELETE", "/roles/1", adminOnly},
+
+		{"GET", "/users", everyone},
+		{"GET", "/users/1", everyone},
+		{"GET", "/users/email/test@unal.edu.co", everyone},
+		{"POST", "/users", adminOnly},
+		{"PUT", "/users/1", adminOnly},
+		{"PUT", "/users/1/role", adminOnly},
+		{"DELETE", "/users/1", adminOnly},
+
+		{"GET", "/milestones", everyone},
+		{"GET", "/milestones/1", everyone},
+		{"GET", "/milestones/project/1", everyone},
+		{"POST", "/milestones", adminStudent},
+		{"PUT", "/milestones/1", adminStudent},
+		{"DELETE", "/milestones/1", adminStudent},
+		{"GET", "/milestones/project/1/trash", adminStudent},
+		{"POST", "/milestones/1/restore", adminStudent},
+		{"GET", "/milestones/project/1/overdue", everyone},
+		{"POST", "/milestones/1/start", adminStudent},
+		{"POST", "/milestones/1/submit", adminStudent},
+		{"POST", "/milestones/1/review", adminProfessor},
+
+		{"GET", "/comments", everyone},
+		{"GET", "/comments/1", everyone},
+		{"GET", "/comments/milestone/1", everyone},
+		{"POST", "/comments", everyone},
+		{"PUT", "/comments/1", everyone},
+		{"DELETE", "/comments/1", everyone},
+		{"GET", "/comments/milestone/1/trash", everyone},
+		{"POST", "/comments/1/restore", everyone},
+
+		{"GET", "/deliverables", everyone},
+		{"GET", "/deliverables/1", everyone},
+		{"GET", "/deliverables/milestone/1", everyone},
+		{"POST", "/deliverables", adminStudent},
+		{"PUT", "/deliverables/1", adminStudent},
+		{"DELETE", "/deliverables/1", adminStudent},
+		{"GET", "/deliverables/milestone/1/trash", adminStudent},
+		{"POST", "/deliverables/1/restore", adminStudent},
+
+		{"GET", "/feedbacks", everyone},
+		{"GET", "/feedbacks/1", everyone},
+		{"GET", "/feedbacks/milestone/1", everyone},
+		{"POST", "/feedbacks", adminProfessor},
+		{"PUT", "/feedbacks/1", adminProfessor},
+		{"DELETE", "/feedbacks/1", adminProfessor},
+		{"GET", "/feedbacks/milestone/1/trash", adminProfessor},
+		{"POST", "/feedbacks/1/restore", adminProfessor},
+
+		{"GET", "/project-members", everyone},
+		{"GET", "/project-members/1", everyone},
+		{"GET", "/project-members/project/1", everyone},
+		{"POST", "/project-members", adminStudent},
+		{"PUT", "/project-members/1", adminStudent},
+		{"DELETE", "/project-members/1", adminStudent},
+
+		{"GET", "/reactions", everyone},
+		{"GET", "/reactions/1", everyone},
+		{"GET", "/reactions/milestone/1", everyone},
+		{"GET", "/reactions/types", everyone},
+		{"GET", "/milestones/1/reactions", everyone},
+		{"PUT", "/milestones/1/reactions/like", everyone},
+		{"POST", "/reactions", everyone},
+		{"PUT", "/reactions/1", everyone},
+		{"DELETE", "/reactions/1", everyone},
+
+		{"GET", "/signup-rules", adminOnly},
+		{"GET", "/signup-rules/1", adminOnly},
+		{"POST", "/signup-rules", adminOnly},
+		{"PUT", "/signup-rules/1", adminOnly},
+		{"DELETE", "/signup-rules/1", adminOnly},
+
+		{"GET", "/invitations/project/1", everyone},
+		{"POST", "/invitations/project/1", adminStudent},
+		{"POST", "/invitations/accept", everyone},
+		{"POST", "/invitations/decline", everyone},
+		{"GET", "/me/invitations", everyone},
+		{"POST", "/me/invitations/1/accept", everyone},
+		{"POST", "/me/invitations/1/decline", everyone},
+
+		{"GET", "/terms", everyone},
+		{"GET", "/terms/1", everyone},
+		{"POST", "/terms", adminOnly},
+		{"PUT", "/terms/1", adminOnly},
+		{"DELETE", "/terms/1", adminOnly},
+
+		{"GET", "/courses", everyone},
+		{"GET", "/courses/1", everyone},
+		{"POST", "/courses", adminOnly},
+		{"PUT", "/courses/1", adminOnly},
+		{"DELETE", "/courses/1", adminOnly},
+		{"GET", "/courses/1/sections", everyone},
+		{"POST", "/courses/1/sections", adminOnly},
+		{"GET", "/courses/1/projects", everyone},
+		{"GET", "/courses/1/overdue-milestones", everyone},
+		{"GET", "/projects/1/timeline", everyone},
+		{"DELETE", "/course-sections/1", adminOnly},
+		{"GET", "/course-sections/1/members", everyone},
+		{"POST", "/course-sections/1/members", adminProfessor},
+		{"DELETE", "/course-sections/1/members/2", adminProfessor},
+	}
+
+	for _, tt := range tests {
+		for _, role := range everyone {
+			t.Run(tt.method+" "+tt.path+" como "+role, func(t *testing.T) {
+				token, _ := auth.GenerateJWT(1, "test@unal.edu.co", 1, role)
+
+				req, _ := http.NewRequest(tt.method, tt.path, bytes.NewBufferString("{}"))
+				req.Header.Set("Authorization", "Bearer "+token)
+				req.Header.Set("Content-Type", "application/json")
+				w := httptest.NewRecorder()
+				router.ServeHTTP(w, req)
+
+				if slices.Contains(tt.allowed, role) {
+					assert.NotEqual(t, http.StatusForbidden, w.Code)
+				} else {
+					assert.Equal(t, http.StatusForbidden, w.Code)
+				}
+			})
+		}
+	}
+}
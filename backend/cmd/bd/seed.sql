@@ -2,7 +2,7 @@
 -- SEED DATA - Datos Iniciales Esenciales
 -- ============================================
 -- Este script contiene datos base necesarios para el funcionamiento de la aplicación.
--- Se ejecuta después de init.sql y es idempotente (puede ejecutarse múltiples veces).
+-- Se ejecuta después de aplicar las migraciones y es idempotente (puede ejecutarse múltiples veces).
 
 -- ============================================
 -- 1. ROLES
@@ -0,0 +1,22 @@
+package buildingAPI
+
+import (
+	"softpharos/internal/core/ports/services"
+	courseRepo "softpharos/internal/core/repository/course"
+	milestoneRepo "softpharos/internal/core/repository/milestone"
+	projectRepo "softpharos/internal/core/repository/project"
+	projectMemberRepo "softpharos/internal/core/repository/project_member"
+	"softpharos/internal/core/services/access"
+	"softpharos/internal/infra/databases"
+)
+
+func BuildAccessService() services.AccessService {
+	dbClient := databases.GetInstance()
+
+	return access.New(
+		projectRepo.New(dbClient),
+		projectMemberRepo.New(dbClient),
+		milestoneRepo.New(dbClient),
+		courseRepo.NewMemberRepository(dbClient),
+	)
+}
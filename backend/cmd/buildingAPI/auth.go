@@ -3,23 +3,38 @@ package buildingAPI
 import (
 	"github.com/gin-gonic/gin"
 
+	"softpharos/internal/auth"
 	authController "softpharos/internal/controllers/auth"
+	"softpharos/internal/core/ports/services"
+	refreshTokenRepo "softpharos/internal/core/repository/refresh_token"
+	revokedTokenRepo "softpharos/internal/core/repository/revoked_token"
 	roleRepo "softpharos/internal/core/repository/role"
+	"softpharos/internal/core/repository/unit_of_work"
 	userRepo "softpharos/internal/core/repository/user"
 	authService "softpharos/internal/core/services/auth"
 	"softpharos/internal/infra/databases"
 )
 
 func RegisterAuthRoutes(router *gin.RouterGroup) {
-	client := databases.GetInstance()
-
-	userRepository := userRepo.New(client)
-	roleRepository := roleRepo.New(client)
-	service := authService.New(userRepository, roleRepository)
-	controller := authController.New(service)
+	controller := authController.New(BuildAuthService())
 
 	authGroup := router.Group("/auth")
 	{
 		authGroup.POST("/google", controller.GoogleLogin)
+		authGroup.POST("/refresh", controller.Refresh)
+		authGroup.POST("/logout", auth.AuthMiddleware(), controller.Logout)
 	}
 }
+
+// BuildAuthService construye el servicio de autenticación con el proveedor de
+// identidad configurado
+func BuildAuthService() services.AuthService {
+	client := databases.GetInstance()
+
+	return authService.New(BuildIdentityProvider(), BuildSignupRuleService(), userRepo.New(client), roleRepo.New(client), refreshTokenRepo.New(client), revokedTokenRepo.New(client), unit_of_work.New(client))
+}
+
+// BuildRevocationList construye la lista de access tokens revocados que consulta auth.ValidateJWT
+func BuildRevocationList() auth.RevocationList {
+	return revokedTokenRepo.New(databases.GetInstance())
+}
@@ -2,6 +2,7 @@ package buildingAPI
 
 import (
 	"github.com/gin-gonic/gin"
+	"softpharos/internal/auth"
 	commentController "softpharos/internal/controllers/comment"
 	commentRepo "softpharos/internal/core/repository/comment"
 	"softpharos/internal/core/services/comment"
@@ -11,7 +12,7 @@ import (
 func BuildCommentController() *commentController.Controller {
 	dbClient := databases.GetInstance()
 	repo := commentRepo.New(dbClient)
-	service := comment.New(repo)
+	service := comment.New(repo, BuildAccessService())
 	ctrl := commentController.New(service)
 
 	return ctrl
@@ -22,11 +23,13 @@ func RegisterCommentRoutes(router *gin.RouterGroup) {
 
 	comments := router.Group("/comments")
 	{
-		comments.GET("", commentCtrl.GetAllComments)
-		comments.GET("/:id", commentCtrl.GetCommentByID)
-		comments.GET("/milestone/:milestoneId", commentCtrl.GetCommentsByMilestoneID)
-		comments.POST("", commentCtrl.CreateComment)
-		comments.PUT("/:id", commentCtrl.UpdateComment)
-		comments.DELETE("/:id", commentCtrl.DeleteComment)
+		comments.GET("", auth.RequirePermission(auth.ResourceComments, auth.ActionRead), commentCtrl.GetAllComments)
+		comments.GET("/:id", auth.RequirePermission(auth.ResourceComments, auth.ActionRead), commentCtrl.GetCommentByID)
+		comments.GET("/milestone/:milestoneId", auth.RequirePermission(auth.ResourceComments, auth.ActionRead), commentCtrl.GetCommentsByMilestoneID)
+		comments.GET("/milestone/:milestoneId/trash", auth.RequirePermission(auth.ResourceComments, auth.ActionDelete), commentCtrl.GetTrashedCommentsByMilestoneID)
+		comments.POST("", auth.RequirePermission(auth.ResourceComments, auth.ActionCreate), commentCtrl.CreateComment)
+		comments.PUT("/:id", auth.RequirePermission(auth.ResourceComments, auth.ActionUpdate), commentCtrl.UpdateComment)
+		comments.DELETE("/:id", auth.RequirePermission(auth.ResourceComments, auth.ActionDelete), commentCtrl.DeleteComment)
+		comments.POST("/:id/restore", auth.RequirePermission(auth.ResourceComments, auth.ActionDelete), commentCtrl.RestoreComment)
 	}
 }
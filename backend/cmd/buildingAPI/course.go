@@ -0,0 +1,50 @@
+package buildingAPI
+
+import (
+	"github.com/gin-gonic/gin"
+	"softpharos/internal/auth"
+	courseController "softpharos/internal/controllers/course"
+	courseRepo "softpharos/internal/core/repository/course"
+	milestoneRepo "softpharos/internal/core/repository/milestone"
+	projectRepo "softpharos/internal/core/repository/project"
+	"softpharos/internal/core/services/course"
+	"softpharos/internal/infra/databases"
+)
+
+func BuildCourseController() *courseController.Controller {
+	dbClient := databases.GetInstance()
+	service := course.New(
+		courseRepo.New(dbClient),
+		courseRepo.NewMemberRepository(dbClient),
+		projectRepo.New(dbClient),
+		milestoneRepo.New(dbClient),
+		BuildAccessService(),
+	)
+
+	return courseController.New(service)
+}
+
+func RegisterCourseRoutes(router *gin.RouterGroup) {
+	courseCtrl := BuildCourseController()
+
+	courses := router.Group("/courses")
+	{
+		courses.GET("", auth.RequirePermission(auth.ResourceCourses, auth.ActionRead), courseCtrl.GetAllCourses)
+		courses.GET("/:id", auth.RequirePermission(auth.ResourceCourses, auth.ActionRead), courseCtrl.GetCourseByID)
+		courses.POST("", auth.RequirePermission(auth.ResourceCourses, auth.ActionCreate), courseCtrl.CreateCourse)
+		courses.PUT("/:id", auth.RequirePermission(auth.ResourceCourses, auth.ActionUpdate), courseCtrl.UpdateCourse)
+		courses.DELETE("/:id", auth.RequirePermission(auth.ResourceCourses, auth.ActionDelete), courseCtrl.DeleteCourse)
+		courses.GET("/:id/sections", auth.RequirePermission(auth.ResourceCourses, auth.ActionRead), courseCtrl.GetCourseSections)
+		courses.POST("/:id/sections", auth.RequirePermission(auth.ResourceCourses, auth.ActionCreate), courseCtrl.CreateCourseSection)
+		courses.GET("/:id/projects", auth.RequirePermission(auth.ResourceCourses, auth.ActionRead), courseCtrl.GetCourseProjects)
+		courses.GET("/:id/overdue-milestones", auth.RequirePermission(auth.ResourceCourses, auth.ActionRead), courseCtrl.GetOverdueMilestones)
+	}
+
+	sections := router.Group("/course-sections")
+	{
+		sections.DELETE("/:id", auth.RequirePermission(auth.ResourceCourses, auth.ActionDelete), courseCtrl.DeleteCourseSection)
+		sections.GET("/:id/members", auth.RequirePermission(auth.ResourceCourseMembers, auth.ActionRead), courseCtrl.GetSectionMembers)
+		sections.POST("/:id/members", auth.RequirePermission(auth.ResourceCourseMembers, auth.ActionCreate), courseCtrl.AddSectionMember)
+		sections.DELETE("/:id/members/:userId", auth.RequirePermission(auth.ResourceCourseMembers, auth.ActionDelete), courseCtrl.RemoveSectionMember)
+	}
+}
@@ -2,6 +2,7 @@ package buildingAPI
 
 import (
 	"github.com/gin-gonic/gin"
+	"softpharos/internal/auth"
 	deliverableController "softpharos/internal/controllers/deliverable"
 	deliverableRepo "softpharos/internal/core/repository/deliverable"
 	"softpharos/internal/core/services/deliverable"
@@ -11,7 +12,7 @@ import (
 func BuildDeliverableController() *deliverableController.Controller {
 	dbClient := databases.GetInstance()
 	repo := deliverableRepo.New(dbClient)
-	service := deliverable.New(repo)
+	service := deliverable.New(repo, BuildAccessService())
 	ctrl := deliverableController.New(service)
 
 	return ctrl
@@ -22,11 +23,13 @@ func RegisterDeliverableRoutes(router *gin.RouterGroup) {
 
 	deliverables := router.Group("/deliverables")
 	{
-		deliverables.GET("", deliverableCtrl.GetAllDeliverables)
-		deliverables.GET("/:id", deliverableCtrl.GetDeliverableByID)
-		deliverables.GET("/milestone/:milestoneId", deliverableCtrl.GetDeliverablesByMilestoneID)
-		deliverables.POST("", deliverableCtrl.CreateDeliverable)
-		deliverables.PUT("/:id", deliverableCtrl.UpdateDeliverable)
-		deliverables.DELETE("/:id", deliverableCtrl.DeleteDeliverable)
+		deliverables.GET("", auth.RequirePermission(auth.ResourceDeliverables, auth.ActionRead), deliverableCtrl.GetAllDeliverables)
+		deliverables.GET("/:id", auth.RequirePermission(auth.ResourceDeliverables, auth.ActionRead), deliverableCtrl.GetDeliverableByID)
+		deliverables.GET("/milestone/:milestoneId", auth.RequirePermission(auth.ResourceDeliverables, auth.ActionRead), deliverableCtrl.GetDeliverablesByMilestoneID)
+		deliverables.GET("/milestone/:milestoneId/trash", auth.RequirePermission(auth.ResourceDeliverables, auth.ActionDelete), deliverableCtrl.GetTrashedDeliverablesByMilestoneID)
+		deliverables.POST("", auth.RequirePermission(auth.ResourceDeliverables, auth.ActionCreate), deliverableCtrl.CreateDeliverable)
+		deliverables.PUT("/:id", auth.RequirePermission(auth.ResourceDeliverables, auth.ActionUpdate), deliverableCtrl.UpdateDeliverable)
+		deliverables.DELETE("/:id", auth.RequirePermission(auth.ResourceDeliverables, auth.ActionDelete), deliverableCtrl.DeleteDeliverable)
+		deliverables.POST("/:id/restore", auth.RequirePermission(auth.ResourceDeliverables, auth.ActionDelete), deliverableCtrl.RestoreDeliverable)
 	}
 }
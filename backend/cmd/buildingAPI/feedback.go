@@ -2,6 +2,7 @@ package buildingAPI
 
 import (
 	"github.com/gin-gonic/gin"
+	"softpharos/internal/auth"
 	feedbackController "softpharos/internal/controllers/feedback"
 	feedbackRepo "softpharos/internal/core/repository/feedback"
 	"softpharos/internal/core/services/feedback"
@@ -11,7 +12,7 @@ import (
 func BuildFeedbackController() *feedbackController.Controller {
 	dbClient := databases.GetInstance()
 	repo := feedbackRepo.New(dbClient)
-	service := feedback.New(repo)
+	service := feedback.New(repo, BuildAccessService())
 	ctrl := feedbackController.New(service)
 
 	return ctrl
@@ -22,11 +23,13 @@ func RegisterFeedbackRoutes(router *gin.RouterGroup) {
 
 	feedbacks := router.Group("/feedbacks")
 	{
-		feedbacks.GET("", feedbackCtrl.GetAllFeedbacks)
-		feedbacks.GET("/:id", feedbackCtrl.GetFeedbackByID)
-		feedbacks.GET("/milestone/:milestoneId", feedbackCtrl.GetFeedbacksByMilestoneID)
-		feedbacks.POST("", feedbackCtrl.CreateFeedback)
-		feedbacks.PUT("/:id", feedbackCtrl.UpdateFeedback)
-		feedbacks.DELETE("/:id", feedbackCtrl.DeleteFeedback)
+		feedbacks.GET("", auth.RequirePermission(auth.ResourceFeedbacks, auth.ActionRead), feedbackCtrl.GetAllFeedbacks)
+		feedbacks.GET("/:id", auth.RequirePermission(auth.ResourceFeedbacks, auth.ActionRead), feedbackCtrl.GetFeedbackByID)
+		feedbacks.GET("/milestone/:milestoneId", auth.RequirePermission(auth.ResourceFeedbacks, auth.ActionRead), feedbackCtrl.GetFeedbacksByMilestoneID)
+		feedbacks.GET("/milestone/:milestoneId/trash", auth.RequirePermission(auth.ResourceFeedbacks, auth.ActionDelete), feedbackCtrl.GetTrashedFeedbacksByMilestoneID)
+		feedbacks.POST("", auth.RequirePermission(auth.ResourceFeedbacks, auth.ActionCreate), feedbackCtrl.CreateFeedback)
+		feedbacks.PUT("/:id", auth.RequirePermission(auth.ResourceFeedbacks, auth.ActionUpdate), feedbackCtrl.UpdateFeedback)
+		feedbacks.DELETE("/:id", auth.RequirePermission(auth.ResourceFeedbacks, auth.ActionDelete), feedbackCtrl.DeleteFeedback)
+		feedbacks.POST("/:id/restore", auth.RequirePermission(auth.ResourceFeedbacks, auth.ActionDelete), feedbackCtrl.RestoreFeedback)
 	}
 }
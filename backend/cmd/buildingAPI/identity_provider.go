@@ -0,0 +1,47 @@
+package buildingAPI
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"strings"
+
+	"softpharos/internal/core/ports/providers"
+	"softpharos/internal/infra/oidc"
+)
+
+// BuildIdentityProvider elige el proveedor de identidad según IDENTITY_PROVIDER:
+//   - google (por defecto): ID tokens de Google Sign-In
+//   - oidc: cualquier proveedor OIDC, validando contra OIDC_JWKS_URL
+//   - dev: tokens firmados localmente con DEV_IDENTITY_SECRET, para desarrollo y CI
+func BuildIdentityProvider() providers.IdentityProvider {
+	switch name := os.Getenv("IDENTITY_PROVIDER"); name {
+	case "", "google":
+		clientID := os.Getenv("GOOGLE_CLIENT_ID")
+		if clientID == "" {
+			log.Println("⚠️  GOOGLE_CLIENT_ID no está configurado, se rechazarán los ID tokens de Google")
+		}
+		return oidc.NewGoogleProvider(clientID)
+	case "oidc":
+		config := oidc.JWKSConfig{
+			JWKSURL:  strings.TrimSpace(os.Getenv("OIDC_JWKS_URL")),
+			Issuers:  oidc.ParseIssuers(os.Getenv("OIDC_ISSUER")),
+			Audience: strings.TrimSpace(os.Getenv("OIDC_AUDIENCE")),
+		}
+		if err := config.Validate(); err != nil {
+			panic(err)
+		}
+		return oidc.NewJWKSProvider(config)
+	case "dev":
+		if os.Getenv("ENV") == "production" {
+			panic(fmt.Errorf("IDENTITY_PROVIDER=dev no está permitido en producción"))
+		}
+		secret := os.Getenv("DEV_IDENTITY_SECRET")
+		if secret == "" {
+			panic(fmt.Errorf("DEV_IDENTITY_SECRET no está configurado"))
+		}
+		return oidc.NewDevProvider(secret)
+	default:
+		panic(fmt.Errorf("IDENTITY_PROVIDER %q no soportado", name))
+	}
+}
@@ -0,0 +1,46 @@
+package buildingAPI
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"softpharos/internal/auth"
+	invitationController "softpharos/internal/controllers/invitation"
+	invitationRepo "softpharos/internal/core/repository/invitation"
+	projectMemberRepo "softpharos/internal/core/repository/project_member"
+	"softpharos/internal/core/repository/unit_of_work"
+	userRepo "softpharos/internal/core/repository/user"
+	invitationService "softpharos/internal/core/services/invitation"
+	"softpharos/internal/infra/databases"
+)
+
+func BuildInvitationController() *invitationController.Controller {
+	client := databases.GetInstance()
+	service := invitationService.New(
+		invitationRepo.New(client),
+		userRepo.New(client),
+		projectMemberRepo.New(client),
+		BuildAccessService(),
+		unit_of_work.New(client),
+	)
+
+	return invitationController.New(service)
+}
+
+func RegisterInvitationRoutes(router *gin.RouterGroup) {
+	ctrl := BuildInvitationController()
+
+	invitations := router.Group("/invitations")
+	{
+		invitations.GET("/project/:projectId", auth.RequirePermission(auth.ResourceInvitations, auth.ActionRead), ctrl.GetInvitationsByProjectID)
+		invitations.POST("/project/:projectId", auth.RequirePermission(auth.ResourceInvitations, auth.ActionCreate), ctrl.CreateInvitation)
+		invitations.POST("/accept", auth.RequirePermission(auth.ResourceInvitations, auth.ActionUpdate), ctrl.AcceptInvitation)
+		invitations.POST("/decline", auth.RequirePermission(auth.ResourceInvitations, auth.ActionUpdate), ctrl.DeclineInvitation)
+	}
+
+	me := router.Group("/me/invitations")
+	{
+		me.GET("", auth.RequirePermission(auth.ResourceInvitations, auth.ActionRead), ctrl.GetMyInvitations)
+		me.POST("/:id/accept", auth.RequirePermission(auth.ResourceInvitations, auth.ActionUpdate), ctrl.AcceptMyInvitation)
+		me.POST("/:id/decline", auth.RequirePermission(auth.ResourceInvitations, auth.ActionUpdate), ctrl.DeclineMyInvitation)
+	}
+}
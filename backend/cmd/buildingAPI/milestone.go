@@ -2,8 +2,10 @@ package buildingAPI
 
 import (
 	"github.com/gin-gonic/gin"
+	"softpharos/internal/auth"
 	milestoneController "softpharos/internal/controllers/milestone"
 	milestoneRepo "softpharos/internal/core/repository/milestone"
+	termRepo "softpharos/internal/core/repository/term"
 	"softpharos/internal/core/services/milestone"
 	"softpharos/internal/infra/databases"
 )
@@ -11,7 +13,7 @@ import (
 func BuildMilestoneController() *milestoneController.Controller {
 	dbClient := databases.GetInstance()
 	repo := milestoneRepo.New(dbClient)
-	service := milestone.New(repo)
+	service := milestone.New(repo, termRepo.New(dbClient), BuildAccessService())
 	ctrl := milestoneController.New(service)
 
 	return ctrl
@@ -22,11 +24,18 @@ func RegisterMilestoneRoutes(router *gin.RouterGroup) {
 
 	milestones := router.Group("/milestones")
 	{
-		milestones.GET("", milestoneCtrl.GetAllMilestones)
-		milestones.GET("/:id", milestoneCtrl.GetMilestoneByID)
-		milestones.GET("/project/:projectId", milestoneCtrl.GetMilestonesByProjectID)
-		milestones.POST("", milestoneCtrl.CreateMilestone)
-		milestones.PUT("/:id", milestoneCtrl.UpdateMilestone)
-		milestones.DELETE("/:id", milestoneCtrl.DeleteMilestone)
+		milestones.GET("", auth.RequirePermission(auth.ResourceMilestones, auth.ActionRead), milestoneCtrl.GetAllMilestones)
+		milestones.GET("/:id", auth.RequirePermission(auth.ResourceMilestones, auth.ActionRead), milestoneCtrl.GetMilestoneByID)
+		milestones.GET("/project/:projectId", auth.RequirePermission(auth.ResourceMilestones, auth.ActionRead), milestoneCtrl.GetMilestonesByProjectID)
+		milestones.GET("/project/:projectId/overdue", auth.RequirePermission(auth.ResourceMilestones, auth.ActionRead), milestoneCtrl.GetOverdueMilestonesByProjectID)
+		milestones.GET("/project/:projectId/trash", auth.RequirePermission(auth.ResourceMilestones, auth.ActionDelete), milestoneCtrl.GetTrashedMilestonesByProjectID)
+		milestones.POST("", auth.RequirePermission(auth.ResourceMilestones, auth.ActionCreate), milestoneCtrl.CreateMilestone)
+		milestones.PUT("/:id", auth.RequirePermission(auth.ResourceMilestones, auth.ActionUpdate), milestoneCtrl.UpdateMilestone)
+		milestones.DELETE("/:id", auth.RequirePermission(auth.ResourceMilestones, auth.ActionDelete), milestoneCtrl.DeleteMilestone)
+		milestones.POST("/:id/restore", auth.RequirePermission(auth.ResourceMilestones, auth.ActionDelete), milestoneCtrl.RestoreMilestone)
+		milestones.POST("/:id/start", auth.RequirePermission(auth.ResourceMilestones, auth.ActionUpdate), milestoneCtrl.StartMilestone)
+		milestones.POST("/:id/submit", auth.RequirePermission(auth.ResourceMilestones, auth.ActionUpdate), milestoneCtrl.SubmitMilestone)
+		// revisar un hito es parte de dar retroalimentación, así que lo hacen profesores
+		milestones.POST("/:id/review", auth.RequirePermission(auth.ResourceFeedbacks, auth.ActionCreate), milestoneCtrl.ReviewMilestone)
 	}
 }
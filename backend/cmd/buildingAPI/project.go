@@ -2,8 +2,11 @@ package buildingAPI
 
 import (
 	"github.com/gin-gonic/gin"
+	"softpharos/internal/auth"
 	projectController "softpharos/internal/controllers/project"
 	project2 "softpharos/internal/core/repository/project"
+	projectMemberRepo "softpharos/internal/core/repository/project_member"
+	"softpharos/internal/core/repository/unit_of_work"
 	"softpharos/internal/core/services/project"
 	"softpharos/internal/infra/databases"
 )
@@ -11,7 +14,7 @@ import (
 func BuildProjectController() *projectController.Controller {
 	dbClient := databases.GetInstance()
 	projectRepo := project2.New(dbClient)
-	projectService := project.New(projectRepo)
+	projectService := project.New(projectRepo, projectMemberRepo.New(dbClient), BuildAccessService(), unit_of_work.New(dbClient))
 	projectCtrl := projectController.New(projectService)
 
 	return projectCtrl
@@ -22,11 +25,21 @@ func RegisterProjectRoutes(router *gin.RouterGroup) {
 
 	projects := router.Group("/projects")
 	{
-		projects.GET("", projectCtrl.GetAllProjects)
-		projects.GET("/:id", projectCtrl.GetProjectByID)
-		projects.GET("/owner/:owner", projectCtrl.GetProjectsByOwner)
-		projects.POST("", projectCtrl.CreateProject)
-		projects.PUT("/:id", projectCtrl.UpdateProject)
-		projects.DELETE("/:id", projectCtrl.DeleteProject)
+		projects.GET("", auth.RequirePermission(auth.ResourceProjects, auth.ActionRead), projectCtrl.GetAllProjects)
+		projects.GET("/trash", auth.RequirePermission(auth.ResourceProjects, auth.ActionDelete), projectCtrl.GetTrashedProjects)
+		projects.GET("/:id", auth.RequirePermission(auth.ResourceProjects, auth.ActionRead), projectCtrl.GetProjectByID)
+		projects.GET("/owner/:ownerId", auth.RequirePermission(auth.ResourceProjects, auth.ActionRead), projectCtrl.GetProjectsByOwner)
+		projects.POST("", auth.RequirePermission(auth.ResourceProjects, auth.ActionCreate), projectCtrl.CreateProject)
+		projects.PUT("/:id", auth.RequirePermission(auth.ResourceProjects, auth.ActionUpdate), projectCtrl.UpdateProject)
+		projects.POST("/:id/transfer-ownership", auth.RequirePermission(auth.ResourceProjects, auth.ActionUpdate), projectCtrl.TransferOwnership)
+		projects.POST("/:id/archive", auth.RequirePermission(auth.ResourceProjects, auth.ActionUpdate), projectCtrl.ArchiveProject)
+		projects.POST("/:id/unarchive", auth.RequirePermission(auth.ResourceProjects, auth.ActionUpdate), projectCtrl.UnarchiveProject)
+		projects.DELETE("/:id", auth.RequirePermission(auth.ResourceProjects, auth.ActionDelete), projectCtrl.DeleteProject)
+		projects.POST("/:id/restore", auth.RequirePermission(auth.ResourceProjects, auth.ActionDelete), projectCtrl.RestoreProject)
+	}
+
+	me := router.Group("/me")
+	{
+		me.GET("/projects", auth.RequirePermission(auth.ResourceProjects, auth.ActionRead), projectCtrl.GetMyProjects)
 	}
 }
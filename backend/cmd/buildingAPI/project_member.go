@@ -2,6 +2,7 @@ package buildingAPI
 
 import (
 	"github.com/gin-gonic/gin"
+	"softpharos/internal/auth"
 	projectMemberController "softpharos/internal/controllers/project_member"
 	projectMemberRepo "softpharos/internal/core/repository/project_member"
 	"softpharos/internal/core/services/project_member"
@@ -11,7 +12,7 @@ import (
 func BuildProjectMemberController() *projectMemberController.Controller {
 	dbClient := databases.GetInstance()
 	repo := projectMemberRepo.New(dbClient)
-	service := project_member.New(repo)
+	service := project_member.New(repo, BuildAccessService())
 	ctrl := projectMemberController.New(service)
 
 	return ctrl
@@ -22,11 +23,11 @@ func RegisterProjectMemberRoutes(router *gin.RouterGroup) {
 
 	projectMembers := router.Group("/project-members")
 	{
-		projectMembers.GET("", projectMemberCtrl.GetAllProjectMembers)
-		projectMembers.GET("/:id", projectMemberCtrl.GetProjectMemberByID)
-		projectMembers.GET("/project/:projectId", projectMemberCtrl.GetProjectMembersByProjectID)
-		projectMembers.POST("", projectMemberCtrl.CreateProjectMember)
-		projectMembers.PUT("/:id", projectMemberCtrl.UpdateProjectMember)
-		projectMembers.DELETE("/:id", projectMemberCtrl.DeleteProjectMember)
+		projectMembers.GET("", auth.RequirePermission(auth.ResourceProjectMembers, auth.ActionRead), projectMemberCtrl.GetAllProjectMembers)
+		projectMembers.GET("/:id", auth.RequirePermission(auth.ResourceProjectMembers, auth.ActionRead), projectMemberCtrl.GetProjectMemberByID)
+		projectMembers.GET("/project/:projectId", auth.RequirePermission(auth.ResourceProjectMembers, auth.ActionRead), projectMemberCtrl.GetProjectMembersByProjectID)
+		projectMembers.POST("", auth.RequirePermission(auth.ResourceProjectMembers, auth.ActionCreate), projectMemberCtrl.CreateProjectMember)
+		projectMembers.PUT("/:id", auth.RequirePermission(auth.ResourceProjectMembers, auth.ActionUpdate), projectMemberCtrl.UpdateProjectMember)
+		projectMembers.DELETE("/:id", auth.RequirePermission(auth.ResourceProjectMembers, auth.ActionDelete), projectMemberCtrl.DeleteProjectMember)
 	}
 }
@@ -0,0 +1,44 @@
+package buildingAPI
+
+import (
+	"context"
+	"log"
+	"time"
+)
+
+// purgeInterval es cada cuánto se revisan la papelera y los tokens expirados
+const purgeInterval = 24 * time.Hour
+
+// StartPurgeJob purga la papelera y los tokens expirados al arrancar y luego
+// una vez al día hasta que ctx se cancele
+func StartPurgeJob(ctx context.Context) {
+	trashService := BuildTrashService()
+	authService := BuildAuthService()
+
+	go func() {
+		ticker := time.NewTicker(purgeInterval)
+		defer ticker.Stop()
+
+		for {
+			purged, err := trashService.PurgeExpired(ctx)
+			if err != nil {
+				log.Printf("⚠️  Error al purgar la papelera: %v", err)
+			} else if purged > 0 {
+				log.Printf("🧹 Papelera purgada: %d registros eliminados definitivamente", purged)
+			}
+
+			purged, err = authService.PurgeExpiredTokens(ctx)
+			if err != nil {
+				log.Printf("⚠️  Error al purgar los tokens expirados: %v", err)
+			} else if purged > 0 {
+				log.Printf("🧹 Tokens expirados purgados: %d registros eliminados", purged)
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
@@ -1,20 +1,36 @@
 package buildingAPI
 
 import (
+	"os"
+	"strings"
+
 	"github.com/gin-gonic/gin"
+	"softpharos/internal/auth"
 	reactionController "softpharos/internal/controllers/reaction"
+	"softpharos/internal/core/ports/services"
+	milestoneRepo "softpharos/internal/core/repository/milestone"
 	reactionRepo "softpharos/internal/core/repository/reaction"
 	"softpharos/internal/core/services/reaction"
 	"softpharos/internal/infra/databases"
 )
 
-func BuildReactionController() *reactionController.Controller {
+// BuildReactionService construye el servicio de reacciones. REACTION_TYPES
+// define el catálogo de tipos separados por coma (reaction.DefaultTypes si está vacío).
+func BuildReactionService() services.ReactionService {
 	dbClient := databases.GetInstance()
-	repo := reactionRepo.New(dbClient)
-	service := reaction.New(repo)
-	ctrl := reactionController.New(service)
 
-	return ctrl
+	var types []string
+	for _, t := range strings.Split(os.Getenv("REACTION_TYPES"), ",") {
+		if t = strings.TrimSpace(t); t != "" {
+			types = append(types, t)
+		}
+	}
+
+	return reaction.New(reactionRepo.New(dbClient), milestoneRepo.New(dbClient), BuildAccessService(), types)
+}
+
+func BuildReactionController() *reactionController.Controller {
+	return reactionController.New(BuildReactionService())
 }
 
 func RegisterReactionRoutes(router *gin.RouterGroup) {
@@ -22,11 +38,18 @@ func RegisterReactionRoutes(router *gin.RouterGroup) {
 
 	reactions := router.Group("/reactions")
 	{
-		reactions.GET("", reactionCtrl.GetAllReactions)
-		reactions.GET("/:id", reactionCtrl.GetReactionByID)
-		reactions.GET("/milestone/:milestoneId", reactionCtrl.GetReactionsByMilestoneID)
-		reactions.POST("", reactionCtrl.CreateReaction)
-		reactions.PUT("/:id", reactionCtrl.UpdateReaction)
-		reactions.DELETE("/:id", reactionCtrl.DeleteReaction)
+		reactions.GET("", auth.RequirePermission(auth.ResourceReactions, auth.ActionRead), reactionCtrl.GetAllReactions)
+		reactions.GET("/types", auth.RequirePermission(auth.ResourceReactions, auth.ActionRead), reactionCtrl.GetReactionTypes)
+		reactions.GET("/:id", auth.RequirePermission(auth.ResourceReactions, auth.ActionRead), reactionCtrl.GetReactionByID)
+		reactions.GET("/milestone/:milestoneId", auth.RequirePermission(auth.ResourceReactions, auth.ActionRead), reactionCtrl.GetReactionsByMilestoneID)
+		reactions.POST("", auth.RequirePermission(auth.ResourceReactions, auth.ActionCreate), reactionCtrl.CreateReaction)
+		reactions.PUT("/:id", auth.RequirePermission(auth.ResourceReactions, auth.ActionUpdate), reactionCtrl.UpdateReaction)
+		reactions.DELETE("/:id", auth.RequirePermission(auth.ResourceReactions, auth.ActionDelete), reactionCtrl.DeleteReaction)
+	}
+
+	milestones := router.Group("/milestones")
+	{
+		milestones.GET("/:id/reactions", auth.RequirePermission(auth.ResourceReactions, auth.ActionRead), reactionCtrl.GetReactionSummary)
+		milestones.PUT("/:id/reactions/:type", auth.RequirePermission(auth.ResourceReactions, auth.ActionUpdate), reactionCtrl.SetReaction)
 	}
 }
@@ -2,8 +2,10 @@ package buildingAPI
 
 import (
 	"github.com/gin-gonic/gin"
+	"softpharos/internal/auth"
 	roleController "softpharos/internal/controllers/role"
 	roleRepo "softpharos/internal/core/repository/role"
+	"softpharos/internal/core/repository/unit_of_work"
 	"softpharos/internal/core/services/role"
 	"softpharos/internal/infra/databases"
 )
@@ -11,7 +13,7 @@ import (
 func BuildRoleController() *roleController.Controller {
 	dbClient := databases.GetInstance()
 	roleRepository := roleRepo.New(dbClient)
-	roleService := role.New(roleRepository)
+	roleService := role.New(roleRepository, unit_of_work.New(dbClient))
 	roleCtrl := roleController.New(roleService)
 
 	return roleCtrl
@@ -22,8 +24,11 @@ func RegisterRoleRoutes(router *gin.RouterGroup) {
 
 	roles := router.Group("/roles")
 	{
-		roles.GET("", roleCtrl.GetAllRoles)
-		roles.GET("/:id", roleCtrl.GetRoleByID)
-		roles.GET("/name/:name", roleCtrl.GetRoleByName)
+		roles.GET("", auth.RequirePermission(auth.ResourceRoles, auth.ActionRead), roleCtrl.GetAllRoles)
+		roles.GET("/:id", auth.RequirePermission(auth.ResourceRoles, auth.ActionRead), roleCtrl.GetRoleByID)
+		roles.GET("/name/:name", auth.RequirePermission(auth.ResourceRoles, auth.ActionRead), roleCtrl.GetRoleByName)
+		roles.POST("", auth.RequirePermission(auth.ResourceRoles, auth.ActionCreate), roleCtrl.CreateRole)
+		roles.PUT("/:id", auth.RequirePermission(auth.ResourceRoles, auth.ActionUpdate), roleCtrl.UpdateRole)
+		roles.DELETE("/:id", auth.RequirePermission(auth.ResourceRoles, auth.ActionDelete), roleCtrl.DeleteRole)
 	}
 }
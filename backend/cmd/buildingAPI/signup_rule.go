@@ -0,0 +1,52 @@
+package buildingAPI
+
+import (
+	"os"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"softpharos/internal/auth"
+	signupRuleController "softpharos/internal/controllers/signup_rule"
+	"softpharos/internal/core/ports/services"
+	roleRepo "softpharos/internal/core/repository/role"
+	signupRuleRepo "softpharos/internal/core/repository/signup_rule"
+	"softpharos/internal/core/services/signup_rule"
+	"softpharos/internal/infra/databases"
+)
+
+// BuildSignupRuleService construye el servicio de reglas de registro. Los
+// dominios permitidos se leen de ALLOWED_DOMAINS (separados por coma) o, si
+// no está definido, de GOOGLE_ALLOWED_DOMAIN.
+func BuildSignupRuleService() services.SignupRuleService {
+	dbClient := databases.GetInstance()
+
+	allowed := os.Getenv("ALLOWED_DOMAINS")
+	if allowed == "" {
+		allowed = os.Getenv("GOOGLE_ALLOWED_DOMAIN")
+	}
+
+	var domains []string
+	if allowed != "" {
+		domains = strings.Split(allowed, ",")
+	}
+
+	return signup_rule.New(signupRuleRepo.New(dbClient), roleRepo.New(dbClient), domains)
+}
+
+func BuildSignupRuleController() *signupRuleController.Controller {
+	return signupRuleController.New(BuildSignupRuleService())
+}
+
+func RegisterSignupRuleRoutes(router *gin.RouterGroup) {
+	ctrl := BuildSignupRuleController()
+
+	rules := router.Group("/signup-rules")
+	{
+		rules.GET("", auth.RequirePermission(auth.ResourceSignupRules, auth.ActionRead), ctrl.GetAllSignupRules)
+		rules.GET("/:id", auth.RequirePermission(auth.ResourceSignupRules, auth.ActionRead), ctrl.GetSignupRuleByID)
+		rules.POST("", auth.RequirePermission(auth.ResourceSignupRules, auth.ActionCreate), ctrl.CreateSignupRule)
+		rules.PUT("/:id", auth.RequirePermission(auth.ResourceSignupRules, auth.ActionUpdate), ctrl.UpdateSignupRule)
+		rules.DELETE("/:id", auth.RequirePermission(auth.ResourceSignupRules, auth.ActionDelete), ctrl.DeleteSignupRule)
+	}
+}
@@ -0,0 +1,30 @@
+package buildingAPI
+
+import (
+	"github.com/gin-gonic/gin"
+	"softpharos/internal/auth"
+	termController "softpharos/internal/controllers/term"
+	termRepo "softpharos/internal/core/repository/term"
+	"softpharos/internal/core/services/term"
+	"softpharos/internal/infra/databases"
+)
+
+func BuildTermController() *termController.Controller {
+	dbClient := databases.GetInstance()
+	service := term.New(termRepo.New(dbClient))
+
+	return termController.New(service)
+}
+
+func RegisterTermRoutes(router *gin.RouterGroup) {
+	termCtrl := BuildTermController()
+
+	terms := router.Group("/terms")
+	{
+		terms.GET("", auth.RequirePermission(auth.ResourceTerms, auth.ActionRead), termCtrl.GetAllTerms)
+		terms.GET("/:id", auth.RequirePermission(auth.ResourceTerms, auth.ActionRead), termCtrl.GetTermByID)
+		terms.POST("", auth.RequirePermission(auth.ResourceTerms, auth.ActionCreate), termCtrl.CreateTerm)
+		terms.PUT("/:id", auth.RequirePermission(auth.ResourceTerms, auth.ActionUpdate), termCtrl.UpdateTerm)
+		terms.DELETE("/:id", auth.RequirePermission(auth.ResourceTerms, auth.ActionDelete), termCtrl.DeleteTerm)
+	}
+}
@@ -0,0 +1,27 @@
+package buildingAPI
+
+import (
+	"github.com/gin-gonic/gin"
+	"softpharos/internal/auth"
+	timelineController "softpharos/internal/controllers/timeline"
+	projectRepo "softpharos/internal/core/repository/project"
+	timelineRepo "softpharos/internal/core/repository/timeline"
+	"softpharos/internal/core/services/timeline"
+	"softpharos/internal/infra/databases"
+)
+
+func BuildTimelineController() *timelineController.Controller {
+	dbClient := databases.GetInstance()
+	service := timeline.New(projectRepo.New(dbClient), timelineRepo.New(dbClient))
+
+	return timelineController.New(service)
+}
+
+func RegisterTimelineRoutes(router *gin.RouterGroup) {
+	timelineCtrl := BuildTimelineController()
+
+	projects := router.Group("/projects")
+	{
+		projects.GET("/:id/timeline", auth.RequirePermission(auth.ResourceProjects, auth.ActionRead), timelineCtrl.GetProjectTimeline)
+	}
+}
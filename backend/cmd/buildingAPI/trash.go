@@ -0,0 +1,23 @@
+package buildingAPI
+
+import (
+	"os"
+	"strconv"
+	"time"
+
+	"softpharos/internal/core/ports/services"
+	trashRepo "softpharos/internal/core/repository/trash"
+	"softpharos/internal/core/services/trash"
+	"softpharos/internal/infra/databases"
+)
+
+// BuildTrashService construye el servicio de la papelera. TRASH_RETENTION_DAYS
+// define cuántos días se conserva lo borrado (30 por defecto).
+func BuildTrashService() services.TrashService {
+	retention := trash.DefaultRetention
+	if days, err := strconv.Atoi(os.Getenv("TRASH_RETENTION_DAYS")); err == nil && days > 0 {
+		retention = time.Duration(days) * 24 * time.Hour
+	}
+
+	return trash.New(trashRepo.New(databases.GetInstance()), retention)
+}
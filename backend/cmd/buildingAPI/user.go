@@ -2,7 +2,10 @@ package buildingAPI
 
 import (
 	"github.com/gin-gonic/gin"
+	"softpharos/internal/auth"
 	userController "softpharos/internal/controllers/user"
+	roleRepo "softpharos/internal/core/repository/role"
+	"softpharos/internal/core/repository/unit_of_work"
 	userRepo "softpharos/internal/core/repository/user"
 	"softpharos/internal/core/services/user"
 	"softpharos/internal/infra/databases"
@@ -11,7 +14,7 @@ import (
 func BuildUserController() *userController.Controller {
 	dbClient := databases.GetInstance()
 	repo := userRepo.New(dbClient)
-	service := user.New(repo)
+	service := user.New(repo, roleRepo.New(dbClient), unit_of_work.New(dbClient))
 	ctrl := userController.New(service)
 
 	return ctrl
@@ -22,11 +25,12 @@ func RegisterUserRoutes(router *gin.RouterGroup) {
 
 	users := router.Group("/users")
 	{
-		users.GET("", userCtrl.GetAllUsers)
-		users.GET("/:id", userCtrl.GetUserByID)
-		users.GET("/email/:email", userCtrl.GetUserByEmail)
-		users.POST("", userCtrl.CreateUser)
-		users.PUT("/:id", userCtrl.UpdateUser)
-		users.DELETE("/:id", userCtrl.DeleteUser)
+		users.GET("", auth.RequirePermission(auth.ResourceUsers, auth.ActionRead), userCtrl.GetAllUsers)
+		users.GET("/:id", auth.RequirePermission(auth.ResourceUsers, auth.ActionRead), userCtrl.GetUserByID)
+		users.GET("/email/:email", auth.RequirePermission(auth.ResourceUsers, auth.ActionRead), userCtrl.GetUserByEmail)
+		users.POST("", auth.RequirePermission(auth.ResourceUsers, auth.ActionCreate), userCtrl.CreateUser)
+		users.PUT("/:id", auth.RequirePermission(auth.ResourceUsers, auth.ActionUpdate), userCtrl.UpdateUser)
+		users.PUT("/:id/role", auth.RequirePermission(auth.ResourceUsers, auth.ActionUpdate), userCtrl.ChangeUserRole)
+		users.DELETE("/:id", auth.RequirePermission(auth.ResourceUsers, auth.ActionDelete), userCtrl.DeleteUser)
 	}
 }
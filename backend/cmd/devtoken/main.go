@@ -0,0 +1,55 @@
+// devtoken firma un ID token para el proveedor de identidad de desarrollo
+// (IDENTITY_PROVIDER=dev). Permite iniciar sesión sin acceso a Google:
+//
+//	go run ./cmd/devtoken -email estudiante@unal.edu.co -name "Estudiante"
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"softpharos/internal/core/domain/identity"
+	"softpharos/internal/core/domain/signup_rule"
+	"softpharos/internal/infra/oidc"
+)
+
+func main() {
+	email := flag.String("email", "dev@unal.edu.co", "email del usuario")
+	name := flag.String("name", "Usuario de desarrollo", "nombre del usuario")
+	subject := flag.String("sub", "", "identificador del usuario en el proveedor (por defecto dev-<email>)")
+	hostedDomain := flag.String("hd", "", "dominio alojado de la cuenta (por defecto el dominio del email; \"-\" para una cuenta personal)")
+	ttl := flag.Duration("ttl", time.Hour, "vigencia del token")
+	flag.Parse()
+
+	secret := os.Getenv("DEV_IDENTITY_SECRET")
+	if secret == "" {
+		log.Fatal("❌ DEV_IDENTITY_SECRET no está configurado")
+	}
+
+	if *subject == "" {
+		*subject = "dev-" + *email
+	}
+
+	switch *hostedDomain {
+	case "":
+		*hostedDomain = signup_rule.EmailDomain(*email)
+	case "-":
+		*hostedDomain = ""
+	}
+
+	token, err := oidc.SignDevToken(secret, &identity.ProviderIdentity{
+		Subject:       *subject,
+		Email:         *email,
+		EmailVerified: true,
+		Name:          *name,
+		HostedDomain:  *hostedDomain,
+	}, *ttl)
+	if err != nil {
+		log.Fatalf("❌ Error al firmar el token: %v", err)
+	}
+
+	fmt.Println(token)
+}
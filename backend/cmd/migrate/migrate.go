@@ -0,0 +1,96 @@
+// Package migrate implementa el subcomando "migrate" del binario:
+//
+//	go run . migrate up          aplica las migraciones pendientes
+//	go run . migrate down [n]    revierte las últimas n migraciones (1 por defecto)
+//	go run . migrate status      lista las migraciones y su estado
+package migrate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"softpharos/internal/infra/databases/migrations"
+)
+
+// Migrator es lo que necesita el subcomando; lo implementa migrations.Migrator
+type Migrator interface {
+	Up(ctx context.Context) ([]migrations.Migration, error)
+	Down(ctx context.Context, steps int) ([]migrations.Migration, error)
+	Status(ctx context.Context) ([]migrations.Status, error)
+}
+
+var ErrUsage = errors.New("uso: migrate up | migrate down [n] | migrate status")
+
+// Run ejecuta el subcomando indicado en args y escribe el resultado en out
+func Run(ctx context.Context, migrator Migrator, args []string, out io.Writer) error {
+	if len(args) == 0 {
+		return ErrUsage
+	}
+
+	switch args[0] {
+	case "up":
+		if len(args) > 1 {
+			return ErrUsage
+		}
+		applied, err := migrator.Up(ctx)
+		printMigrations(out, "✅ Aplicada", applied)
+		if err != nil {
+			return err
+		}
+		if len(applied) == 0 {
+			fmt.Fprintln(out, "El esquema ya está al día")
+		}
+		return nil
+
+	case "down":
+		steps := 1
+		if len(args) > 2 {
+			return ErrUsage
+		}
+		if len(args) == 2 {
+			n, err := strconv.Atoi(args[1])
+			if err != nil || n < 1 {
+				return fmt.Errorf("%w: n debe ser un entero positivo", ErrUsage)
+			}
+			steps = n
+		}
+		reverted, err := migrator.Down(ctx, steps)
+		printMigrations(out, "↩️  Revertida", reverted)
+		if err != nil {
+			return err
+		}
+		if len(reverted) == 0 {
+			fmt.Fprintln(out, "No hay migraciones aplicadas")
+		}
+		return nil
+
+	case "status":
+		if len(args) > 1 {
+			return ErrUsage
+		}
+		statuses, err := migrator.Status(ctx)
+		if err != nil {
+			return err
+		}
+		for _, status := range statuses {
+			state := "pendiente"
+			if status.AppliedAt != nil {
+				state = "aplicada " + status.AppliedAt.Format(time.RFC3339)
+			}
+			fmt.Fprintf(out, "%04d_%s\t%s\n", status.Version, status.Name, state)
+		}
+		return nil
+	}
+
+	return ErrUsage
+}
+
+func printMigrations(out io.Writer, prefix string, list []migrations.Migration) {
+	for _, migration := range list {
+		fmt.Fprintf(out, "%s %04d_%s\n", prefix, migration.Version, migration.Name)
+	}
+}
@@ -0,0 +1,100 @@
+package migrate
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"softpharos/internal/infra/databases/migrations"
+)
+
+type fakeMigrator struct {
+	steps int
+	err   error
+}
+
+func (f *fakeMigrator) Up(ctx context.Context) ([]migrations.Migration, error) {
+	return []migrations.Migration{{Version: 1, Name: "init"}}, f.err
+}
+
+func (f *fakeMigrator) Down(ctx context.Context, steps int) ([]migrations.Migration, error) {
+	f.steps = steps
+	return []migrations.Migration{{Version: 1, Name: "init"}}, f.err
+}
+
+func (f *fakeMigrator) Status(ctx context.Context) ([]migrations.Status, error) {
+	appliedAt := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	return []migrations.Status{
+		{Migration: migrations.Migration{Version: 1, Name: "init"}, AppliedAt: &appliedAt},
+		{Migration: migrations.Migration{Version: 2, Name: "indices"}},
+	}, f.err
+}
+
+func TestRun(t *testing.T) {
+	tests := []struct {
+		name          string
+		args          []string
+		migratorErr   error
+		expectedOut   string
+		expectedSteps int
+		expectedErr   error
+	}{
+		{
+			name:        "aplica las migraciones pendientes",
+			args:        []string{"up"},
+			expectedOut: "✅ Aplicada 0001_init\n",
+		},
+		{
+			name:          "revierte una migración por defecto",
+			args:          []string{"down"},
+			expectedOut:   "↩️  Revertida 0001_init\n",
+			expectedSteps: 1,
+		},
+		{
+			name:          "revierte n migraciones",
+			args:          []string{"down", "3"},
+			expectedOut:   "↩️  Revertida 0001_init\n",
+			expectedSteps: 3,
+		},
+		{
+			name:        "muestra el estado de cada migración",
+			args:        []string{"status"},
+			expectedOut: "0001_init\taplicada 2025-01-02T03:04:05Z\n0002_indices\tpendiente\n",
+		},
+		{name: "rechaza la ausencia de subcomando", args: nil, expectedErr: ErrUsage},
+		{name: "rechaza subcomandos desconocidos", args: []string{"redo"}, expectedErr: ErrUsage},
+		{name: "rechaza n inválido", args: []string{"down", "0"}, expectedErr: ErrUsage},
+		{
+			name:        "propaga el error del migrador",
+			args:        []string{"up"},
+			migratorErr: errors.New("syntax error"),
+			expectedOut: "✅ Aplicada 0001_init\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			migrator := &fakeMigrator{err: tt.migratorErr}
+			var out bytes.Buffer
+
+			err := Run(context.Background(), migrator, tt.args, &out)
+
+			switch {
+			case tt.expectedErr != nil:
+				assert.ErrorIs(t, err, tt.expectedErr)
+			case tt.migratorErr != nil:
+				assert.ErrorIs(t, err, tt.migratorErr)
+			default:
+				assert.NoError(t, err)
+			}
+			if tt.expectedOut != "" {
+				assert.Equal(t, tt.expectedOut, out.String())
+			}
+			assert.Equal(t, tt.expectedSteps, migrator.steps)
+		})
+	}
+}
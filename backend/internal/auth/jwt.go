@@ -1,6 +1,9 @@
 package auth
 
 import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
 	"fmt"
 	"os"
 	"time"
@@ -8,30 +11,56 @@ import (
 	"github.com/golang-jwt/jwt/v5"
 )
 
+// AccessTokenTTL es la vigencia de los access tokens; la sesión se extiende
+// con el refresh token
+const AccessTokenTTL = 15 * time.Minute
+
+// RevocationList indica si un access token fue revocado antes de expirar,
+// ya sea individualmente (logout) o junto con todos los del usuario
+// (cambio de rol)
+type RevocationList interface {
+	IsRevoked(ctx context.Context, jti string, userID int, issuedAt time.Time) (bool, error)
+}
+
+var revocationList RevocationList
+
+// SetRevocationList define la lista que consulta ValidateJWT. Con nil no se
+// verifica la revocación.
+func SetRevocationList(list RevocationList) {
+	revocationList = list
+}
+
 type Claims struct {
 	UserID int    `json:"user_id"`
 	Email  string `json:"email"`
 	RoleID int    `json:"role_id"`
+	Role   string `json:"role"`
 	jwt.RegisteredClaims
 }
 
-func GenerateJWT(userID int, email string, roleID int) (string, error) {
+func GenerateJWT(userID int, email string, roleID int, roleName string) (string, error) {
 	secret := os.Getenv("JWT_SECRET")
 	if secret == "" {
 		panic(fmt.Errorf("JWT_SECRET no está configurado"))
 	}
 
-	// Expiración en 24 horas
-	expirationTime := time.Now().Add(24 * time.Hour)
+	jti, err := newTokenID()
+	if err != nil {
+		return "", err
+	}
+
+	expirationTime := time.Now().Add(AccessTokenTTL)
 
 	claims := &Claims{
 		UserID: userID,
 		Email:  email,
 		RoleID: roleID,
+		Role:   roleName,
 		RegisteredClaims: jwt.RegisteredClaims{
 			ExpiresAt: jwt.NewNumericDate(expirationTime),
 			IssuedAt:  jwt.NewNumericDate(time.Now()),
 			Issuer:    "softpharos",
+			ID:        jti,
 		},
 	}
 
@@ -44,7 +73,9 @@ func GenerateJWT(userID int, email string, roleID int) (string, error) {
 	return tokenString, nil
 }
 
-func ValidateJWT(tokenString string) (*Claims, error) {
+// ValidateJWT verifica la firma y la vigencia del token y consulta la lista
+// de revocación con el contexto de la petición
+func ValidateJWT(ctx context.Context, tokenString string) (*Claims, error) {
 	secret := os.Getenv("JWT_SECRET")
 	if secret == "" {
 		return nil, fmt.Errorf("JWT_SECRET no está configurado")
@@ -61,9 +92,32 @@ func ValidateJWT(tokenString string) (*Claims, error) {
 		return nil, fmt.Errorf("error al validar el token: %w", err)
 	}
 
-	if claims, ok := token.Claims.(*Claims); ok && token.Valid {
-		return claims, nil
+	claims, ok := token.Claims.(*Claims)
+	if !ok || !token.Valid {
+		return nil, fmt.Errorf("token inválido")
 	}
 
-	return nil, fmt.Errorf("token inválido")
+	if revocationList != nil {
+		var issuedAt time.Time
+		if claims.IssuedAt != nil {
+			issuedAt = claims.IssuedAt.Time
+		}
+		revoked, err := revocationList.IsRevoked(ctx, claims.ID, claims.UserID, issuedAt)
+		if err != nil {
+			return nil, fmt.Errorf("error al verificar la revocación del token: %w", err)
+		}
+		if revoked {
+			return nil, fmt.Errorf("token revocado")
+		}
+	}
+
+	return claims, nil
+}
+
+func newTokenID() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("error al generar el identificador del token: %w", err)
+	}
+	return hex.EncodeToString(b), nil
 }
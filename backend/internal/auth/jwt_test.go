@@ -1,6 +1,8 @@
 package auth
 
 import (
+	"context"
+	"errors"
 	"os"
 	"testing"
 	"time"
@@ -12,7 +14,7 @@ func TestGenerateJWT_Success(t *testing.T) {
 	os.Setenv("JWT_SECRET", "test-secret-key")
 	defer os.Unsetenv("JWT_SECRET")
 
-	token, err := GenerateJWT(1, "test@unal.edu.co", 3)
+	token, err := GenerateJWT(1, "test@unal.edu.co", 3, "student")
 
 	assert.NoError(t, err)
 	assert.NotEmpty(t, token)
@@ -22,7 +24,7 @@ func TestGenerateJWT_NoSecret(t *testing.T) {
 	os.Unsetenv("JWT_SECRET")
 
 	assert.Panics(t, func() {
-		GenerateJWT(1, "test@unal.edu.co", 3)
+		GenerateJWT(1, "test@unal.edu.co", 3, "student")
 	})
 }
 
@@ -30,22 +32,23 @@ func TestValidateJWT_Success(t *testing.T) {
 	os.Setenv("JWT_SECRET", "test-secret-key")
 	defer os.Unsetenv("JWT_SECRET")
 
-	token, _ := GenerateJWT(1, "test@unal.edu.co", 3)
+	token, _ := GenerateJWT(1, "test@unal.edu.co", 3, "student")
 
-	claims, err := ValidateJWT(token)
+	claims, err := ValidateJWT(context.Background(), token)
 
 	assert.NoError(t, err)
 	assert.NotNil(t, claims)
 	assert.Equal(t, 1, claims.UserID)
 	assert.Equal(t, "test@unal.edu.co", claims.Email)
 	assert.Equal(t, 3, claims.RoleID)
+	assert.Equal(t, "student", claims.Role)
 }
 
 func TestValidateJWT_InvalidToken(t *testing.T) {
 	os.Setenv("JWT_SECRET", "test-secret-key")
 	defer os.Unsetenv("JWT_SECRET")
 
-	claims, err := ValidateJWT("invalid-token")
+	claims, err := ValidateJWT(context.Background(), "invalid-token")
 
 	assert.Error(t, err)
 	assert.Nil(t, claims)
@@ -62,7 +65,7 @@ func TestValidateJWT_ExpiredToken(t *testing.T) {
 func TestValidateJWT_NoSecret(t *testing.T) {
 	os.Unsetenv("JWT_SECRET")
 
-	claims, err := ValidateJWT("some-token")
+	claims, err := ValidateJWT(context.Background(), "some-token")
 
 	assert.Error(t, err)
 	assert.Nil(t, claims)
@@ -85,17 +88,115 @@ func TestJWTExpirationTime(t *testing.T) {
 	os.Setenv("JWT_SECRET", "test-secret-key")
 	defer os.Unsetenv("JWT_SECRET")
 
-	token, err := GenerateJWT(1, "test@unal.edu.co", 3)
+	token, err := GenerateJWT(1, "test@unal.edu.co", 3, "student")
 	assert.NoError(t, err)
 
-	claims, err := ValidateJWT(token)
+	claims, err := ValidateJWT(context.Background(), token)
 	assert.NoError(t, err)
 
-	// Verificar que el token expira en ~24 horas
+	// Verificar que el access token es de corta duración
 	expirationTime := claims.ExpiresAt.Time
-	expectedExpiration := time.Now().Add(24 * time.Hour)
+	expectedExpiration := time.Now().Add(AccessTokenTTL)
 
 	// Permitir 1 minuto de diferencia debido a tiempo de ejecución del test
 	diff := expirationTime.Sub(expectedExpiration)
 	assert.Less(t, diff.Abs(), time.Minute)
 }
+
+func TestGenerateJWT_UniqueTokenID(t *testing.T) {
+	os.Setenv("JWT_SECRET", "test-secret-key")
+	defer os.Unsetenv("JWT_SECRET")
+
+	first, _ := GenerateJWT(1, "test@unal.edu.co", 3, "student")
+	second, _ := GenerateJWT(1, "test@unal.edu.co", 3, "student")
+
+	firstClaims, err := ValidateJWT(context.Background(), first)
+	assert.NoError(t, err)
+	secondClaims, err := ValidateJWT(context.Background(), second)
+	assert.NoError(t, err)
+
+	assert.NotEmpty(t, firstClaims.ID)
+	assert.NotEqual(t, firstClaims.ID, secondClaims.ID)
+}
+
+type stubRevocationList struct {
+	revoked       map[string]bool
+	revokedBefore map[int]time.Time
+	err           error
+}
+
+func (s stubRevocationList) IsRevoked(ctx context.Context, jti string, userID int, issuedAt time.Time) (bool, error) {
+	return s.revoked[jti] || issuedAt.Before(s.revokedBefore[userID]), s.err
+}
+
+func TestValidateJWT_RevocationList(t *testing.T) {
+	os.Setenv("JWT_SECRET", "test-secret-key")
+	defer os.Unsetenv("JWT_SECRET")
+	defer SetRevocationList(nil)
+
+	token, _ := GenerateJWT(1, "test@unal.edu.co", 3, "student")
+	claims, _ := ValidateJWT(context.Background(), token)
+
+	tests := []struct {
+		name        string
+		list        RevocationList
+		expectError bool
+	}{
+		{name: "acepta token no revocado", list: stubRevocationList{revoked: map[string]bool{}}, expectError: false},
+		{name: "rechaza token revocado", list: stubRevocationList{revoked: map[string]bool{claims.ID: true}}, expectError: true},
+		{name: "rechaza token emitido antes de revocar al usuario", list: stubRevocationList{revokedBefore: map[int]time.Time{1: time.Now().Add(time.Minute)}}, expectError: true},
+		{name: "acepta token emitido después de revocar al usuario", list: stubRevocationList{revokedBefore: map[int]time.Time{1: time.Now().Add(-time.Hour)}}, expectError: false},
+		{name: "rechaza cuando la lista no responde", list: stubRevocationList{err: errors.New("database error")}, expectError: true},
+		{name: "sin lista no verifica revocación", list: nil, expectError: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			SetRevocationList(tt.list)
+
+			_, err := ValidateJWT(context.Background(), token)
+
+			if tt.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+// ctxRevocationList responde con el error del contexto recibido, para
+// comprobar que ValidateJWT consulta con el contexto de la petición
+type ctxRevocationList struct{}
+
+func (ctxRevocationList) IsRevoked(ctx context.Context, jti string, userID int, issuedAt time.Time) (bool, error) {
+	return false, ctx.Err()
+}
+
+func TestValidateJWT_RevocationUsesRequestContext(t *testing.T) {
+	os.Setenv("JWT_SECRET", "test-secret-key")
+	defer os.Unsetenv("JWT_SECRET")
+	defer SetRevocationList(nil)
+
+	SetRevocationList(ctxRevocationList{})
+	token, _ := GenerateJWT(1, "test@unal.edu.co", 3, "student")
+
+	_, err := ValidateJWT(context.Background(), token)
+	assert.NoError(t, err)
+
+	canceled, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = ValidateJWT(canceled, token)
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+func TestRefreshToken(t *testing.T) {
+	first, err := GenerateRefreshToken()
+	assert.NoError(t, err)
+	second, err := GenerateRefreshToken()
+	assert.NoError(t, err)
+
+	assert.NotEqual(t, first, second)
+	assert.Equal(t, HashRefreshToken(first), HashRefreshToken(first))
+	assert.NotEqual(t, first, HashRefreshToken(first))
+}
@@ -1,10 +1,20 @@
 package auth
 
 import (
-	"net/http"
 	"strings"
 
 	"github.com/gin-gonic/gin"
+
+	"softpharos/internal/controllers"
+	"softpharos/internal/core/domain/identity"
+)
+
+// Claves con las que AuthMiddleware guarda los claims en el contexto de gin
+const (
+	ContextUserIDKey = "user_id"
+	ContextEmailKey  = "email"
+	ContextRoleIDKey = "role_id"
+	ContextRoleKey   = "role"
 )
 
 func AuthMiddleware() gin.HandlerFunc {
@@ -12,9 +22,7 @@ func AuthMiddleware() gin.HandlerFunc {
 		// Obtener el token del header Authorization
 		authHeader := c.GetHeader("Authorization")
 		if authHeader == "" {
-			c.JSON(http.StatusUnauthorized, gin.H{
-				"error": "Authorization header requerido",
-			})
+			controllers.Response.Unauthorized(c, "Authorization header requerido")
 			c.Abort()
 			return
 		}
@@ -22,9 +30,7 @@ func AuthMiddleware() gin.HandlerFunc {
 		// Verificar formato "Bearer {token}"
 		parts := strings.Split(authHeader, " ")
 		if len(parts) != 2 || parts[0] != "Bearer" {
-			c.JSON(http.StatusUnauthorized, gin.H{
-				"error": "Formato de Authorization inválido.",
-			})
+			controllers.Response.Unauthorized(c, "Formato de Authorization inválido.")
 			c.Abort()
 			return
 		}
@@ -32,19 +38,63 @@ func AuthMiddleware() gin.HandlerFunc {
 		tokenString := parts[1]
 
 		// Validar el token
-		claims, err := ValidateJWT(tokenString)
+		claims, err := ValidateJWT(c.Request.Context(), tokenString)
 		if err != nil {
-			c.JSON(http.StatusUnauthorized, gin.H{
-				"error": "Token inválido o expirado",
-			})
+			controllers.Response.Unauthorized(c, "Token inválido o expirado")
 			c.Abort()
 			return
 		}
 
 		// Guardar claims en el contexto para uso posterior
-		c.Set("user_id", claims.UserID)
-		c.Set("email", claims.Email)
-		c.Set("role_id", claims.RoleID)
+		c.Set(ContextUserIDKey, claims.UserID)
+		c.Set(ContextEmailKey, claims.Email)
+		c.Set(ContextRoleIDKey, claims.RoleID)
+		c.Set(ContextRoleKey, claims.Role)
+
+		// Propagar la identidad al context.Context que reciben los services
+		id := &identity.Identity{
+			UserID:  claims.UserID,
+			Email:   claims.Email,
+			RoleID:  claims.RoleID,
+			Role:    claims.Role,
+			TokenID: claims.ID,
+		}
+		if claims.ExpiresAt != nil {
+			id.TokenExpiresAt = claims.ExpiresAt.Time
+		}
+		c.Request = c.Request.WithContext(identity.NewContext(c.Request.Context(), id))
+
+		c.Next()
+	}
+}
+
+// RequireRole permite el acceso solo a los roles indicados.
+// Debe registrarse después de AuthMiddleware.
+func RequireRole(roles ...string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		role := c.GetString(ContextRoleKey)
+		for _, allowed := range roles {
+			if role == allowed {
+				c.Next()
+				return
+			}
+		}
+
+		controllers.Response.Forbidden(c, "No tienes permisos para realizar esta acción")
+		c.Abort()
+	}
+}
+
+// RequirePermission consulta DefaultPolicy para decidir si el rol del
+// usuario autenticado puede ejecutar la acción sobre el recurso.
+// Debe registrarse después de AuthMiddleware.
+func RequirePermission(resource Resource, action Action) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !DefaultPolicy.Allows(c.GetString(ContextRoleKey), resource, action) {
+			controllers.Response.Forbidden(c, "No tienes permisos para realizar esta acción")
+			c.Abort()
+			return
+		}
 
 		c.Next()
 	}
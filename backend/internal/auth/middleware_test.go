@@ -5,9 +5,12 @@ import (
 	"net/http/httptest"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
+
+	"softpharos/internal/core/domain/identity"
 )
 
 func setupTestRouter() *gin.Engine {
@@ -34,7 +37,7 @@ func TestAuthMiddleware_Success(t *testing.T) {
 		})
 	})
 
-	token, _ := GenerateJWT(1, "test@example.com", 3)
+	token, _ := GenerateJWT(1, "test@example.com", 3, "student")
 
 	req, _ := http.NewRequest("GET", "/protected", nil)
 	req.Header.Set("Authorization", "Bearer "+token)
@@ -59,6 +62,7 @@ func TestAuthMiddleware_NoAuthHeader(t *testing.T) {
 
 	assert.Equal(t, http.StatusUnauthorized, w.Code)
 	assert.Contains(t, w.Body.String(), "Authorization header requerido")
+	assert.Contains(t, w.Body.String(), `"code":"UNAUTHORIZED"`)
 }
 
 func TestAuthMiddleware_InvalidFormat(t *testing.T) {
@@ -96,6 +100,7 @@ func TestAuthMiddleware_InvalidToken(t *testing.T) {
 
 	assert.Equal(t, http.StatusUnauthorized, w.Code)
 	assert.Contains(t, w.Body.String(), "Token inválido")
+	assert.Contains(t, w.Body.String(), `"code":"UNAUTHORIZED"`)
 }
 
 func TestAuthMiddleware_OnlyBearer(t *testing.T) {
@@ -113,3 +118,51 @@ func TestAuthMiddleware_OnlyBearer(t *testing.T) {
 
 	assert.Equal(t, http.StatusUnauthorized, w.Code)
 }
+
+func TestAuthMiddleware_AbortsChain(t *testing.T) {
+	router := setupTestRouter()
+
+	handlerCalled := false
+	router.GET("/protected", AuthMiddleware(), func(c *gin.Context) {
+		handlerCalled = true
+		c.JSON(http.StatusOK, gin.H{"message": "success"})
+	})
+
+	req, _ := http.NewRequest("GET", "/protected", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+	assert.False(t, handlerCalled)
+	assert.Contains(t, w.Body.String(), `"success":false`)
+}
+
+func TestAuthMiddleware_PropagatesIdentity(t *testing.T) {
+	os.Setenv("JWT_SECRET", "test-secret")
+	defer os.Unsetenv("JWT_SECRET")
+
+	router := setupTestRouter()
+
+	var got *identity.Identity
+	router.GET("/protected", AuthMiddleware(), func(c *gin.Context) {
+		got, _ = identity.FromContext(c.Request.Context())
+		c.Status(http.StatusOK)
+	})
+
+	token, _ := GenerateJWT(7, "test@example.com", 2, "professor")
+
+	req, _ := http.NewRequest("GET", "/protected", nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, 7, got.UserID)
+	assert.Equal(t, "test@example.com", got.Email)
+	assert.Equal(t, 2, got.RoleID)
+	assert.Equal(t, "professor", got.Role)
+	assert.NotEmpty(t, got.TokenID)
+	assert.WithinDuration(t, time.Now().Add(AccessTokenTTL), got.TokenExpiresAt, time.Minute)
+}
@@ -0,0 +1,107 @@
+package auth
+
+import "softpharos/internal/core/domain/role"
+
+const (
+	RoleAdmin     = role.Admin
+	RoleProfessor = role.Professor
+	RoleStudent   = role.Student
+)
+
+type Resource string
+
+const (
+	ResourceProjects       Resource = "projects"
+	ResourceRoles          Resource = "roles"
+	ResourceUsers          Resource = "users"
+	ResourceMilestones     Resource = "milestones"
+	ResourceComments       Resource = "comments"
+	ResourceDeliverables   Resource = "deliverables"
+	ResourceFeedbacks      Resource = "feedbacks"
+	ResourceProjectMembers Resource = "project_members"
+	ResourceReactions      Resource = "reactions"
+	ResourceSignupRules    Resource = "signup_rules"
+	ResourceInvitations    Resource = "invitations"
+	ResourceTerms          Resource = "terms"
+	ResourceCourses        Resource = "courses"
+	ResourceCourseMembers  Resource = "course_members"
+)
+
+type Action string
+
+const (
+	ActionRead   Action = "read"
+	ActionCreate Action = "create"
+	ActionUpdate Action = "update"
+	ActionDelete Action = "delete"
+)
+
+var (
+	readOnly = []Action{ActionRead}
+	// respond permite aceptar o rechazar invitaciones propias sin poder enviarlas
+	respond   = []Action{ActionRead, ActionUpdate}
+	allAction = []Action{ActionRead, ActionCreate, ActionUpdate, ActionDelete}
+)
+
+// Policy asocia cada rol con las acciones permitidas sobre cada recurso
+type Policy map[string]map[Resource][]Action
+
+// DefaultPolicy es la tabla de permisos que aplica la API
+var DefaultPolicy = Policy{
+	RoleAdmin: {
+		ResourceProjects:       allAction,
+		ResourceRoles:          allAction,
+		ResourceUsers:          allAction,
+		ResourceMilestones:     allAction,
+		ResourceComments:       allAction,
+		ResourceDeliverables:   allAction,
+		ResourceFeedbacks:      allAction,
+		ResourceProjectMembers: allAction,
+		ResourceReactions:      allAction,
+		ResourceSignupRules:    allAction,
+		ResourceInvitations:    allAction,
+		ResourceTerms:          allAction,
+		ResourceCourses:        allAction,
+		ResourceCourseMembers:  allAction,
+	},
+	RoleProfessor: {
+		ResourceProjects:       readOnly,
+		ResourceRoles:          readOnly,
+		ResourceUsers:          readOnly,
+		ResourceMilestones:     readOnly,
+		ResourceComments:       allAction,
+		ResourceDeliverables:   readOnly,
+		ResourceFeedbacks:      allAction,
+		ResourceProjectMembers: readOnly,
+		ResourceReactions:      allAction,
+		ResourceInvitations:    respond,
+		ResourceTerms:          readOnly,
+		ResourceCourses:        readOnly,
+		ResourceCourseMembers:  allAction, // el servicio exige ser profesor de la sección
+	},
+	RoleStudent: {
+		ResourceProjects:       allAction,
+		ResourceRoles:          readOnly,
+		ResourceUsers:          readOnly,
+		ResourceMilestones:     allAction,
+		ResourceComments:       allAction,
+		ResourceDeliverables:   allAction,
+		ResourceFeedbacks:      readOnly,
+		ResourceProjectMembers: allAction,
+		ResourceReactions:      allAction,
+		ResourceInvitations:    allAction,
+		ResourceTerms:          readOnly,
+		ResourceCourses:        readOnly,
+		ResourceCourseMembers:  readOnly,
+	},
+}
+
+// Allows indica si el rol puede ejecutar la acción sobre el recurso
+func (p Policy) Allows(role string, resource Resource, action Action) bool {
+	for _, allowed := range p[role][resource] {
+		if allowed == action {
+			return true
+		}
+	}
+	return false
+}
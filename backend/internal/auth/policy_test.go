@@ -0,0 +1,138 @@
+package auth
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+
+	"softpharos/internal/core/domain/role"
+)
+
+// Los servicios solo asignan los roles de role.System; la política debe
+// definir permisos exactamente para esos roles
+func TestDefaultPolicyCoversSystemRoles(t *testing.T) {
+	var roles []string
+	for name := range DefaultPolicy {
+		roles = append(roles, name)
+	}
+
+	assert.ElementsMatch(t, role.System, roles)
+}
+
+func TestPolicyAllows(t *testing.T) {
+	tests := []struct {
+		name     string
+		role     string
+		resource Resource
+		action   Action
+		expected bool
+	}{
+		{name: "admin puede eliminar usuarios", role: RoleAdmin, resource: ResourceUsers, action: ActionDelete, expected: true},
+		{name: "professor puede crear feedback", role: RoleProfessor, resource: ResourceFeedbacks, action: ActionCreate, expected: true},
+		{name: "professor no puede crear proyectos", role: RoleProfessor, resource: ResourceProjects, action: ActionCreate, expected: false},
+		{name: "student puede crear proyectos", role: RoleStudent, resource: ResourceProjects, action: ActionCreate, expected: true},
+		{name: "student no puede crear feedback", role: RoleStudent, resource: ResourceFeedbacks, action: ActionCreate, expected: false},
+		{name: "student no puede eliminar usuarios", role: RoleStudent, resource: ResourceUsers, action: ActionDelete, expected: false},
+		{name: "student puede leer roles", role: RoleStudent, resource: ResourceRoles, action: ActionRead, expected: true},
+		{name: "admin puede crear reglas de registro", role: RoleAdmin, resource: ResourceSignupRules, action: ActionCreate, expected: true},
+		{name: "professor no puede leer reglas de registro", role: RoleProfessor, resource: ResourceSignupRules, action: ActionRead, expected: false},
+		{name: "student puede invitar a proyectos", role: RoleStudent, resource: ResourceInvitations, action: ActionCreate, expected: true},
+		{name: "professor puede responder invitaciones", role: RoleProfessor, resource: ResourceInvitations, action: ActionUpdate, expected: true},
+		{name: "professor no puede enviar invitaciones", role: RoleProfessor, resource: ResourceInvitations, action: ActionCreate, expected: false},
+		{name: "professor puede inscribir en secciones", role: RoleProfessor, resource: ResourceCourseMembers, action: ActionCreate, expected: true},
+		{name: "professor no puede crear asignaturas", role: RoleProfessor, resource: ResourceCourses, action: ActionCreate, expected: false},
+		{name: "student puede consultar periodos", role: RoleStudent, resource: ResourceTerms, action: ActionRead, expected: true},
+		{name: "student no puede inscribir en secciones", role: RoleStudent, resource: ResourceCourseMembers, action: ActionCreate, expected: false},
+		{name: "rol desconocido no tiene permisos", role: "guest", resource: ResourceProjects, action: ActionRead, expected: false},
+		{name: "rol vacío no tiene permisos", role: "", resource: ResourceProjects, action: ActionRead, expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, DefaultPolicy.Allows(tt.role, tt.resource, tt.action))
+		})
+	}
+}
+
+func TestRequirePermission(t *testing.T) {
+	os.Setenv("JWT_SECRET", "test-secret")
+	defer os.Unsetenv("JWT_SECRET")
+
+	tests := []struct {
+		name               string
+		role               string
+		expectedStatusCode int
+	}{
+		{name: "permite a professor crear feedback", role: RoleProfessor, expectedStatusCode: http.StatusOK},
+		{name: "permite a admin crear feedback", role: RoleAdmin, expectedStatusCode: http.StatusOK},
+		{name: "rechaza a student crear feedback", role: RoleStudent, expectedStatusCode: http.StatusForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router := setupTestRouter()
+			router.POST("/feedbacks", AuthMiddleware(), RequirePermission(ResourceFeedbacks, ActionCreate), func(c *gin.Context) {
+				c.JSON(http.StatusOK, gin.H{"message": "success"})
+			})
+
+			token, _ := GenerateJWT(1, "test@example.com", 1, tt.role)
+
+			req, _ := http.NewRequest("POST", "/feedbacks", nil)
+			req.Header.Set("Authorization", "Bearer "+token)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatusCode, w.Code)
+			if tt.expectedStatusCode == http.StatusForbidden {
+				assert.Contains(t, w.Body.String(), `"code":"FORBIDDEN"`)
+			}
+		})
+	}
+}
+
+func TestRequirePermission_WithoutAuthMiddleware(t *testing.T) {
+	router := setupTestRouter()
+	router.GET("/projects", RequirePermission(ResourceProjects, ActionRead), func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{"message": "success"})
+	})
+
+	req, _ := http.NewRequest("GET", "/projects", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusForbidden, w.Code)
+}
+
+func TestRequireRole(t *testing.T) {
+	tests := []struct {
+		name               string
+		role               string
+		expectedStatusCode int
+	}{
+		{name: "permite rol incluido", role: RoleAdmin, expectedStatusCode: http.StatusOK},
+		{name: "permite segundo rol incluido", role: RoleProfessor, expectedStatusCode: http.StatusOK},
+		{name: "rechaza rol no incluido", role: RoleStudent, expectedStatusCode: http.StatusForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router := setupTestRouter()
+			router.GET("/admin", func(c *gin.Context) {
+				c.Set(ContextRoleKey, tt.role)
+				c.Next()
+			}, RequireRole(RoleAdmin, RoleProfessor), func(c *gin.Context) {
+				c.JSON(http.StatusOK, gin.H{"message": "success"})
+			})
+
+			req, _ := http.NewRequest("GET", "/admin", nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatusCode, w.Code)
+		})
+	}
+}
@@ -0,0 +1,45 @@
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"time"
+)
+
+// RefreshTokenTTL es la vigencia de un refresh token; cada uso lo rota
+const RefreshTokenTTL = 7 * 24 * time.Hour
+
+// GenerateRefreshToken genera un refresh token opaco. Solo se persiste su hash.
+func GenerateRefreshToken() (string, error) {
+	return generateOpaqueToken("el refresh token")
+}
+
+func HashRefreshToken(token string) string {
+	return hashOpaqueToken(token)
+}
+
+// GenerateInvitationToken genera el token que se entrega al invitar a un
+// proyecto. Como el refresh token, solo se persiste su hash.
+func GenerateInvitationToken() (string, error) {
+	return generateOpaqueToken("el token de invitación")
+}
+
+func HashInvitationToken(token string) string {
+	return hashOpaqueToken(token)
+}
+
+func generateOpaqueToken(name string) (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("error al generar %s: %w", name, err)
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+func hashOpaqueToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
@@ -26,13 +26,47 @@ func (c *Controller) GoogleLogin(ctx *gin.Context) {
 		return
 	}
 
-	user, accessToken, err := c.authService.AuthenticateWithGoogle(ctx.Request.Context(), req.IDToken)
+	user, tokens, err := c.authService.Authenticate(ctx.Request.Context(), req.IDToken)
 	if err != nil {
-		ctx.JSON(http.StatusUnauthorized, gin.H{
-			"error": "Token de Google inválido: " + err.Error(),
-		})
+		controllers.Response.FromError(ctx, err)
 		return
 	}
 
-	controllers.Response.Success(ctx, http.StatusOK, ToUserInfo(user, accessToken))
+	controllers.Response.Success(ctx, http.StatusOK, ToUserInfo(user, tokens))
+}
+
+func (c *Controller) Refresh(ctx *gin.Context) {
+	var req RefreshRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		controllers.Response.BadRequest(ctx, "refreshToken es requerido")
+		return
+	}
+
+	user, tokens, err := c.authService.RefreshSession(ctx.Request.Context(), req.RefreshToken)
+	if err != nil {
+		controllers.Response.FromError(ctx, err)
+		return
+	}
+
+	controllers.Response.Success(ctx, http.StatusOK, ToUserInfo(user, tokens))
+}
+
+func (c *Controller) Logout(ctx *gin.Context) {
+	// El refresh token es opcional: sin él solo se revoca el access token
+	var req LogoutRequest
+	if ctx.Request.ContentLength > 0 {
+		if err := ctx.ShouldBindJSON(&req); err != nil {
+			controllers.Response.BadRequest(ctx, "Datos inválidos: "+err.Error())
+			return
+		}
+	}
+
+	if err := c.authService.Logout(ctx.Request.Context(), req.RefreshToken); err != nil {
+		controllers.Response.FromError(ctx, err)
+		return
+	}
+
+	controllers.Response.Success(ctx, http.StatusOK, gin.H{
+		"message": "Sesión cerrada exitosamente",
+	})
 }
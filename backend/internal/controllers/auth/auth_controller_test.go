@@ -13,7 +13,10 @@ import (
 	"go.uber.org/mock/gomock"
 
 	"softpharos/internal/core/domain/role"
+	"softpharos/internal/core/domain/session"
 	"softpharos/internal/core/domain/user"
+	"softpharos/internal/core/errs"
+	"softpharos/internal/core/ports/services"
 	mockService "softpharos/mocks/core/ports/services"
 )
 
@@ -44,7 +47,7 @@ func TestGoogleLogin(t *testing.T) {
 			},
 			mockSetup: func(m *mockService.MockAuthService) {
 				m.EXPECT().
-					AuthenticateWithGoogle(gomock.Any(), "valid-google-token").
+					Authenticate(gomock.Any(), "valid-google-token").
 					Return(&user.User{
 						ID:         1,
 						Name:       &name,
@@ -57,7 +60,7 @@ func TestGoogleLogin(t *testing.T) {
 							Name:        roleName,
 							Description: &roleDesc,
 						},
-					}, "jwt-access-token-123", nil)
+					}, &session.Tokens{AccessToken: "jwt-access-token-123", RefreshToken: "refresh-123"}, nil)
 			},
 			expectedStatusCode: http.StatusOK,
 		},
@@ -76,11 +79,23 @@ func TestGoogleLogin(t *testing.T) {
 			},
 			mockSetup: func(m *mockService.MockAuthService) {
 				m.EXPECT().
-					AuthenticateWithGoogle(gomock.Any(), "invalid-token").
-					Return(nil, "", errors.New("invalid token"))
+					Authenticate(gomock.Any(), "invalid-token").
+					Return(nil, nil, services.ErrInvalidIDToken)
 			},
 			expectedStatusCode: http.StatusUnauthorized,
 		},
+		{
+			name: "retorna 403 cuando el dominio no está permitido",
+			requestBody: GoogleLoginRequest{
+				IDToken: "other-domain-token",
+			},
+			mockSetup: func(m *mockService.MockAuthService) {
+				m.EXPECT().
+					Authenticate(gomock.Any(), "other-domain-token").
+					Return(nil, nil, services.ErrDomainNotAllowed)
+			},
+			expectedStatusCode: http.StatusForbidden,
+		},
 		{
 			name: "retorna error cuando el servicio falla",
 			requestBody: GoogleLoginRequest{
@@ -88,10 +103,10 @@ func TestGoogleLogin(t *testing.T) {
 			},
 			mockSetup: func(m *mockService.MockAuthService) {
 				m.EXPECT().
-					AuthenticateWithGoogle(gomock.Any(), "valid-token").
-					Return(nil, "", errors.New("service error"))
+					Authenticate(gomock.Any(), "valid-token").
+					Return(nil, nil, errors.New("service error"))
 			},
-			expectedStatusCode: http.StatusUnauthorized,
+			expectedStatusCode: http.StatusInternalServerError,
 		},
 	}
 
@@ -126,12 +141,133 @@ func TestGoogleLogin(t *testing.T) {
 
 				data := response["data"].(map[string]interface{})
 				assert.NotEmpty(t, data["accessToken"])
+				assert.NotEmpty(t, data["refreshToken"])
 				assert.NotNil(t, data["user"])
 			}
 		})
 	}
 }
 
+func TestRefresh(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tests := []struct {
+		name               string
+		requestBody        string
+		mockSetup          func(*mockService.MockAuthService)
+		expectedStatusCode int
+	}{
+		{
+			name:        "renueva la sesión con un refresh token válido",
+			requestBody: `{"refreshToken":"refresh-123"}`,
+			mockSetup: func(m *mockService.MockAuthService) {
+				m.EXPECT().
+					RefreshSession(gomock.Any(), "refresh-123").
+					Return(&user.User{ID: 1, Email: "test@unal.edu.co"}, &session.Tokens{AccessToken: "new-access", RefreshToken: "new-refresh"}, nil)
+			},
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name:               "retorna error cuando falta el refresh token",
+			requestBody:        `{}`,
+			mockSetup:          func(m *mockService.MockAuthService) {},
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:        "retorna 401 cuando el refresh token es inválido",
+			requestBody: `{"refreshToken":"used-token"}`,
+			mockSetup: func(m *mockService.MockAuthService) {
+				m.EXPECT().
+					RefreshSession(gomock.Any(), "used-token").
+					Return(nil, nil, services.ErrInvalidRefreshToken)
+			},
+			expectedStatusCode: http.StatusUnauthorized,
+		},
+		{
+			name:        "retorna error cuando el servicio falla",
+			requestBody: `{"refreshToken":"refresh-123"}`,
+			mockSetup: func(m *mockService.MockAuthService) {
+				m.EXPECT().
+					RefreshSession(gomock.Any(), "refresh-123").
+					Return(nil, nil, errors.New("database error"))
+			},
+			expectedStatusCode: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockSvc := mockService.NewMockAuthService(ctrl)
+			tt.mockSetup(mockSvc)
+
+			router := setupRouter()
+			router.POST("/auth/refresh", New(mockSvc).Refresh)
+
+			req, _ := http.NewRequest("POST", "/auth/refresh", bytes.NewBufferString(tt.requestBody))
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatusCode, w.Code)
+		})
+	}
+}
+
+func TestLogout(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tests := []struct {
+		name               string
+		requestBody        string
+		mockSetup          func(*mockService.MockAuthService)
+		expectedStatusCode int
+	}{
+		{
+			name:        "cierra sesión revocando el refresh token",
+			requestBody: `{"refreshToken":"refresh-123"}`,
+			mockSetup: func(m *mockService.MockAuthService) {
+				m.EXPECT().Logout(gomock.Any(), "refresh-123").Return(nil)
+			},
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name:        "cierra sesión sin body",
+			requestBody: "",
+			mockSetup: func(m *mockService.MockAuthService) {
+				m.EXPECT().Logout(gomock.Any(), "").Return(nil)
+			},
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name:        "retorna 401 sin usuario autenticado",
+			requestBody: "",
+			mockSetup: func(m *mockService.MockAuthService) {
+				m.EXPECT().Logout(gomock.Any(), "").Return(errs.Unauthorized("usuario no autenticado"))
+			},
+			expectedStatusCode: http.StatusUnauthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockSvc := mockService.NewMockAuthService(ctrl)
+			tt.mockSetup(mockSvc)
+
+			router := setupRouter()
+			router.POST("/auth/logout", New(mockSvc).Logout)
+
+			req, _ := http.NewRequest("POST", "/auth/logout", bytes.NewBufferString(tt.requestBody))
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatusCode, w.Code)
+		})
+	}
+}
+
 func TestNewController(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -6,9 +6,19 @@ type GoogleLoginRequest struct {
 	IDToken string `json:"idToken" binding:"required"`
 }
 
+type RefreshRequest struct {
+	RefreshToken string `json:"refreshToken" binding:"required"`
+}
+
+type LogoutRequest struct {
+	RefreshToken string `json:"refreshToken"`
+}
+
 type AuthResponse struct {
-	AccessToken string   `json:"accessToken"`
-	User        UserInfo `json:"user"`
+	AccessToken  string    `json:"accessToken"`
+	RefreshToken string    `json:"refreshToken"`
+	ExpiresAt    time.Time `json:"expiresAt"`
+	User         UserInfo  `json:"user"`
 }
 
 type UserInfo struct {
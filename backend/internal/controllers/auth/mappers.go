@@ -2,11 +2,12 @@ package auth
 
 import (
 	"softpharos/internal/core/domain/role"
+	"softpharos/internal/core/domain/session"
 	"softpharos/internal/core/domain/user"
 )
 
-func ToUserInfo(u *user.User, accessToken string) *AuthResponse {
-	if u == nil {
+func ToUserInfo(u *user.User, tokens *session.Tokens) *AuthResponse {
+	if u == nil || tokens == nil {
 		return nil
 	}
 
@@ -16,7 +17,9 @@ func ToUserInfo(u *user.User, accessToken string) *AuthResponse {
 	}
 
 	return &AuthResponse{
-		AccessToken: accessToken,
+		AccessToken:  tokens.AccessToken,
+		RefreshToken: tokens.RefreshToken,
+		ExpiresAt:    tokens.ExpiresAt,
 		User: UserInfo{
 			ID:         u.ID,
 			Name:       u.Name,
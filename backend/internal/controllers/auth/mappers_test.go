@@ -7,6 +7,7 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"softpharos/internal/core/domain/role"
+	"softpharos/internal/core/domain/session"
 	"softpharos/internal/core/domain/user"
 )
 
@@ -16,12 +17,13 @@ func TestToUserInfo(t *testing.T) {
 	roleName := "student"
 	roleDesc := "Student role"
 	now := time.Now()
+	expiresAt := now.Add(15 * time.Minute)
 
 	tests := []struct {
-		name        string
-		user        *user.User
-		accessToken string
-		expected    *AuthResponse
+		name     string
+		user     *user.User
+		tokens   *session.Tokens
+		expected *AuthResponse
 	}{
 		{
 			name: "convierte usuario con rol a UserInfo",
@@ -39,9 +41,11 @@ func TestToUserInfo(t *testing.T) {
 				},
 				CreatedAt: now,
 			},
-			accessToken: "jwt-token-123",
+			tokens: &session.Tokens{AccessToken: "jwt-token-123", RefreshToken: "refresh-123", ExpiresAt: expiresAt},
 			expected: &AuthResponse{
-				AccessToken: "jwt-token-123",
+				AccessToken:  "jwt-token-123",
+				RefreshToken: "refresh-123",
+				ExpiresAt:    expiresAt,
 				User: UserInfo{
 					ID:         1,
 					Name:       &name,
@@ -64,9 +68,11 @@ func TestToUserInfo(t *testing.T) {
 				Role:       nil,
 				CreatedAt:  now,
 			},
-			accessToken: "jwt-token-456",
+			tokens: &session.Tokens{AccessToken: "jwt-token-456", RefreshToken: "refresh-456", ExpiresAt: expiresAt},
 			expected: &AuthResponse{
-				AccessToken: "jwt-token-456",
+				AccessToken:  "jwt-token-456",
+				RefreshToken: "refresh-456",
+				ExpiresAt:    expiresAt,
 				User: UserInfo{
 					ID:        2,
 					Name:      &name,
@@ -78,16 +84,22 @@ func TestToUserInfo(t *testing.T) {
 			},
 		},
 		{
-			name:        "retorna nil para usuario nil",
-			user:        nil,
-			accessToken: "jwt-token",
-			expected:    nil,
+			name:     "retorna nil para usuario nil",
+			user:     nil,
+			tokens:   &session.Tokens{AccessToken: "jwt-token"},
+			expected: nil,
+		},
+		{
+			name:     "retorna nil sin tokens",
+			user:     &user.User{ID: 3},
+			tokens:   nil,
+			expected: nil,
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result := ToUserInfo(tt.user, tt.accessToken)
+			result := ToUserInfo(tt.user, tt.tokens)
 			assert.Equal(t, tt.expected, result)
 		})
 	}
@@ -21,13 +21,19 @@ func New(commentService services.CommentService) *Controller {
 }
 
 func (c *Controller) GetAllComments(ctx *gin.Context) {
-	comments, err := c.commentService.GetAllComments(ctx.Request.Context())
+	params, err := controllers.ParseListQuery(ctx, listSpec)
 	if err != nil {
-		controllers.Response.InternalError(ctx, err.Error())
+		controllers.Response.BadRequest(ctx, err.Error())
 		return
 	}
 
-	controllers.Response.Success(ctx, http.StatusOK, ToCommentListResponse(comments))
+	page, err := c.commentService.GetAllComments(ctx.Request.Context(), params)
+	if err != nil {
+		controllers.Response.FromError(ctx, err)
+		return
+	}
+
+	controllers.Response.Paginated(ctx, ToCommentListResponse(page.Items), controllers.ToPagination(page))
 }
 
 func (c *Controller) GetCommentByID(ctx *gin.Context) {
@@ -40,7 +46,7 @@ func (c *Controller) GetCommentByID(ctx *gin.Context) {
 
 	comment, err := c.commentService.GetCommentByID(ctx.Request.Context(), id)
 	if err != nil {
-		controllers.Response.NotFound(ctx, "Comentario no encontrado")
+		controllers.Response.FromError(ctx, err)
 		return
 	}
 
@@ -57,7 +63,7 @@ func (c *Controller) GetCommentsByMilestoneID(ctx *gin.Context) {
 
 	comments, err := c.commentService.GetCommentsByMilestoneID(ctx.Request.Context(), milestoneID)
 	if err != nil {
-		controllers.Response.InternalError(ctx, err.Error())
+		controllers.Response.FromError(ctx, err)
 		return
 	}
 
@@ -71,9 +77,15 @@ func (c *Controller) CreateComment(ctx *gin.Context) {
 		return
 	}
 
-	comment := ToCommentDomain(&req)
+	userID, ok := controllers.AuthenticatedUserID(ctx)
+	if !ok {
+		controllers.Response.Unauthorized(ctx, "Usuario no autenticado")
+		return
+	}
+
+	comment := ToCommentDomain(&req, userID)
 	if err := c.commentService.CreateComment(ctx.Request.Context(), comment); err != nil {
-		controllers.Response.InternalError(ctx, err.Error())
+		controllers.Response.FromError(ctx, err)
 		return
 	}
 
@@ -96,7 +108,7 @@ func (c *Controller) UpdateComment(ctx *gin.Context) {
 
 	existingComment, err := c.commentService.GetCommentByID(ctx.Request.Context(), id)
 	if err != nil {
-		controllers.Response.NotFound(ctx, "Comentario no encontrado")
+		controllers.Response.FromError(ctx, err)
 		return
 	}
 
@@ -105,7 +117,7 @@ func (c *Controller) UpdateComment(ctx *gin.Context) {
 	}
 
 	if err := c.commentService.UpdateComment(ctx.Request.Context(), existingComment); err != nil {
-		controllers.Response.InternalError(ctx, err.Error())
+		controllers.Response.FromError(ctx, err)
 		return
 	}
 
@@ -121,7 +133,7 @@ func (c *Controller) DeleteComment(ctx *gin.Context) {
 	}
 
 	if err := c.commentService.DeleteComment(ctx.Request.Context(), id); err != nil {
-		controllers.Response.InternalError(ctx, err.Error())
+		controllers.Response.FromError(ctx, err)
 		return
 	}
 
@@ -129,3 +141,38 @@ func (c *Controller) DeleteComment(ctx *gin.Context) {
 		"message": "Comentario eliminado exitosamente",
 	})
 }
+
+func (c *Controller) GetTrashedCommentsByMilestoneID(ctx *gin.Context) {
+	milestoneIDParam := ctx.Param("milestoneId")
+	milestoneID, err := strconv.Atoi(milestoneIDParam)
+	if err != nil {
+		controllers.Response.InvalidID(ctx, "El ID del milestone debe ser un número válido")
+		return
+	}
+
+	comments, err := c.commentService.GetTrashedCommentsByMilestoneID(ctx.Request.Context(), milestoneID)
+	if err != nil {
+		controllers.Response.FromError(ctx, err)
+		return
+	}
+
+	controllers.Response.Success(ctx, http.StatusOK, ToCommentListResponse(comments))
+}
+
+func (c *Controller) RestoreComment(ctx *gin.Context) {
+	idParam := ctx.Param("id")
+	id, err := strconv.Atoi(idParam)
+	if err != nil {
+		controllers.Response.InvalidID(ctx, "El ID debe ser un número válido")
+		return
+	}
+
+	if err := c.commentService.RestoreComment(ctx.Request.Context(), id); err != nil {
+		controllers.Response.FromError(ctx, err)
+		return
+	}
+
+	controllers.Response.Success(ctx, http.StatusOK, gin.H{
+		"message": "Comentario restaurado exitosamente",
+	})
+}
@@ -13,7 +13,10 @@ import (
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
 
+	"softpharos/internal/controllers/testutil"
 	"softpharos/internal/core/domain/comment"
+	"softpharos/internal/core/domain/query"
+	"softpharos/internal/core/errs"
 	mockService "softpharos/mocks/core/ports/services"
 )
 
@@ -39,11 +42,11 @@ func TestGetAllComments(t *testing.T) {
 			name: "retorna todos los comentarios exitosamente",
 			mockSetup: func(m *mockService.MockCommentService) {
 				m.EXPECT().
-					GetAllComments(gomock.Any()).
-					Return([]comment.Comment{
+					GetAllComments(gomock.Any(), gomock.Any()).
+					Return(&query.Page[comment.Comment]{Items: []comment.Comment{
 						{ID: 1, MilestoneID: 1, UserID: 1, Content: &content1, CreatedAt: now},
 						{ID: 2, MilestoneID: 1, UserID: 2, Content: &content2, CreatedAt: now},
-					}, nil)
+					}}, nil)
 			},
 			expectedStatusCode: http.StatusOK,
 		},
@@ -51,7 +54,7 @@ func TestGetAllComments(t *testing.T) {
 			name: "retorna error cuando el service falla",
 			mockSetup: func(m *mockService.MockCommentService) {
 				m.EXPECT().
-					GetAllComments(gomock.Any()).
+					GetAllComments(gomock.Any(), gomock.Any()).
 					Return(nil, errors.New("service error"))
 			},
 			expectedStatusCode: http.StatusInternalServerError,
@@ -117,7 +120,7 @@ func TestGetCommentByID(t *testing.T) {
 			mockSetup: func(m *mockService.MockCommentService) {
 				m.EXPECT().
 					GetCommentByID(gomock.Any(), 999).
-					Return(nil, errors.New("not found"))
+					Return(nil, errs.NotFound("no encontrado"))
 			},
 			expectedStatusCode: http.StatusNotFound,
 		},
@@ -220,7 +223,6 @@ func TestCreateComment(t *testing.T) {
 			name: "crea comentario exitosamente",
 			requestBody: CreateCommentRequest{
 				MilestoneID: 1,
-				UserID:      1,
 				Content:     &content,
 			},
 			mockSetup: func(m *mockService.MockCommentService) {
@@ -240,7 +242,6 @@ func TestCreateComment(t *testing.T) {
 			name: "retorna error cuando el service falla",
 			requestBody: CreateCommentRequest{
 				MilestoneID: 1,
-				UserID:      1,
 				Content:     &content,
 			},
 			mockSetup: func(m *mockService.MockCommentService) {
@@ -250,6 +251,16 @@ func TestCreateComment(t *testing.T) {
 			},
 			expectedStatusCode: http.StatusInternalServerError,
 		},
+		{
+			name:        "ignora el user_id enviado por el cliente y usa el usuario autenticado",
+			requestBody: map[string]interface{}{"milestone_id": 1, "user_id": 99, "content": "Suplantación"},
+			mockSetup: func(m *mockService.MockCommentService) {
+				m.EXPECT().
+					CreateComment(gomock.Any(), gomock.Cond(func(x *comment.Comment) bool { return x.UserID == 1 })).
+					Return(nil)
+			},
+			expectedStatusCode: http.StatusCreated,
+		},
 	}
 
 	for _, tt := range tests {
@@ -259,7 +270,7 @@ func TestCreateComment(t *testing.T) {
 
 			controller := New(mockSvc)
 			router := setupRouter()
-			router.POST("/comments", controller.CreateComment)
+			router.POST("/comments", testutil.AuthenticatedAs(1), controller.CreateComment)
 
 			var body []byte
 			if str, ok := tt.requestBody.(string); ok {
@@ -278,6 +289,24 @@ func TestCreateComment(t *testing.T) {
 	}
 }
 
+func TestCreateComment_Unauthenticated(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockSvc := mockService.NewMockCommentService(ctrl)
+	controller := New(mockSvc)
+	router := setupRouter()
+	router.POST("/comments", controller.CreateComment)
+
+	body, _ := json.Marshal(map[string]interface{}{"milestone_id": 1, "user_id": 99, "content": "Suplantación"})
+	req, _ := http.NewRequest("POST", "/comments", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+}
+
 func TestUpdateComment(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -331,7 +360,7 @@ func TestUpdateComment(t *testing.T) {
 			mockSetup: func(m *mockService.MockCommentService) {
 				m.EXPECT().
 					GetCommentByID(gomock.Any(), 999).
-					Return(nil, errors.New("not found"))
+					Return(nil, errs.NotFound("no encontrado"))
 			},
 			expectedStatusCode: http.StatusNotFound,
 		},
@@ -411,6 +440,16 @@ func TestDeleteComment(t *testing.T) {
 			mockSetup:          func(m *mockService.MockCommentService) {},
 			expectedStatusCode: http.StatusBadRequest,
 		},
+		{
+			name:      "retorna 403 cuando el usuario no es el autor",
+			commentID: "1",
+			mockSetup: func(m *mockService.MockCommentService) {
+				m.EXPECT().
+					DeleteComment(gomock.Any(), 1).
+					Return(errs.Forbidden("solo el autor puede modificar este recurso"))
+			},
+			expectedStatusCode: http.StatusForbidden,
+		},
 		{
 			name:      "retorna error cuando delete falla",
 			commentID: "1",
@@ -440,3 +479,109 @@ func TestDeleteComment(t *testing.T) {
 		})
 	}
 }
+
+func TestGetTrashedCommentsByMilestoneID(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tests := []struct {
+		name               string
+		milestoneID        string
+		mockSetup          func(*mockService.MockCommentService)
+		expectedStatusCode int
+	}{
+		{
+			name:        "lista lo borrado del milestone",
+			milestoneID: "3",
+			mockSetup: func(m *mockService.MockCommentService) {
+				m.EXPECT().GetTrashedCommentsByMilestoneID(gomock.Any(), 3).Return([]comment.Comment{{ID: 1, MilestoneID: 3}}, nil)
+			},
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name:               "retorna error para ID de milestone inválido",
+			milestoneID:        "invalid",
+			mockSetup:          func(m *mockService.MockCommentService) {},
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:        "retorna forbidden cuando el servicio lo rechaza",
+			milestoneID: "3",
+			mockSetup: func(m *mockService.MockCommentService) {
+				m.EXPECT().GetTrashedCommentsByMilestoneID(gomock.Any(), 3).Return(nil, errs.Forbidden("sin permisos"))
+			},
+			expectedStatusCode: http.StatusForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockSvc := mockService.NewMockCommentService(ctrl)
+			tt.mockSetup(mockSvc)
+			controller := New(mockSvc)
+			router := setupRouter()
+			router.GET("/comments/milestone/:milestoneId/trash", controller.GetTrashedCommentsByMilestoneID)
+			req, _ := http.NewRequest("GET", "/comments/milestone/"+tt.milestoneID+"/trash", nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+			assert.Equal(t, tt.expectedStatusCode, w.Code)
+		})
+	}
+}
+
+func TestRestoreComment(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tests := []struct {
+		name               string
+		commentID          string
+		mockSetup          func(*mockService.MockCommentService)
+		expectedStatusCode int
+	}{
+		{
+			name:      "restaura el comentario exitosamente",
+			commentID: "1",
+			mockSetup: func(m *mockService.MockCommentService) {
+				m.EXPECT().RestoreComment(gomock.Any(), 1).Return(nil)
+			},
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name:               "retorna error para ID inválido",
+			commentID:          "invalid",
+			mockSetup:          func(m *mockService.MockCommentService) {},
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:      "retorna forbidden cuando quien restaura no es el autor",
+			commentID: "1",
+			mockSetup: func(m *mockService.MockCommentService) {
+				m.EXPECT().RestoreComment(gomock.Any(), 1).Return(errs.Forbidden("sin permisos"))
+			},
+			expectedStatusCode: http.StatusForbidden,
+		},
+		{
+			name:      "retorna conflict cuando el milestone sigue en la papelera",
+			commentID: "1",
+			mockSetup: func(m *mockService.MockCommentService) {
+				m.EXPECT().RestoreComment(gomock.Any(), 1).Return(errs.Conflict("El milestone está en la papelera; restáuralo primero"))
+			},
+			expectedStatusCode: http.StatusConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockSvc := mockService.NewMockCommentService(ctrl)
+			tt.mockSetup(mockSvc)
+			controller := New(mockSvc)
+			router := setupRouter()
+			router.POST("/comments/:id/restore", controller.RestoreComment)
+			req, _ := http.NewRequest("POST", "/comments/"+tt.commentID+"/restore", nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+			assert.Equal(t, tt.expectedStatusCode, w.Code)
+		})
+	}
+}
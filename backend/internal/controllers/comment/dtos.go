@@ -1,10 +1,17 @@
 package comment
 
-import "time"
+import (
+	"softpharos/internal/controllers"
+	"time"
+)
+
+var listSpec = controllers.ListSpec{
+	Sortable: []string{"id", "created_at"},
+	Filters:  map[string]controllers.FilterKind{"milestone_id": controllers.FilterInt, "user_id": controllers.FilterInt},
+}
 
 type CreateCommentRequest struct {
 	MilestoneID int     `json:"milestone_id" binding:"required"`
-	UserID      int     `json:"user_id" binding:"required"`
 	Content     *string `json:"content"`
 }
 
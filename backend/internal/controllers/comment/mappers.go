@@ -6,10 +6,10 @@ import (
 	"softpharos/internal/core/domain/user"
 )
 
-func ToCommentDomain(req *CreateCommentRequest) *comment.Comment {
+func ToCommentDomain(req *CreateCommentRequest, userID int) *comment.Comment {
 	return &comment.Comment{
 		MilestoneID: req.MilestoneID,
-		UserID:      req.UserID,
+		UserID:      userID,
 		Content:     req.Content,
 	}
 }
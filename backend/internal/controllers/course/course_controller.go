@@ -0,0 +1,293 @@
+package course
+
+import (
+	"net/http"
+	"softpharos/internal/controllers"
+	"strconv"
+
+	"softpharos/internal/core/ports/services"
+
+	"github.com/gin-gonic/gin"
+)
+
+type Controller struct {
+	courseService services.CourseService
+}
+
+func New(courseService services.CourseService) *Controller {
+	return &Controller{
+		courseService: courseService,
+	}
+}
+
+func (c *Controller) GetAllCourses(ctx *gin.Context) {
+	params, err := controllers.ParseListQuery(ctx, listSpec)
+	if err != nil {
+		controllers.Response.BadRequest(ctx, err.Error())
+		return
+	}
+
+	page, err := c.courseService.GetAllCourses(ctx.Request.Context(), params)
+	if err != nil {
+		controllers.Response.FromError(ctx, err)
+		return
+	}
+
+	controllers.Response.Paginated(ctx, ToCourseListResponse(page.Items), controllers.ToPagination(page))
+}
+
+func (c *Controller) GetCourseByID(ctx *gin.Context) {
+	id, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		controllers.Response.InvalidID(ctx, "El ID debe ser un número válido")
+		return
+	}
+
+	course, err := c.courseService.GetCourseByID(ctx.Request.Context(), id)
+	if err != nil {
+		controllers.Response.FromError(ctx, err)
+		return
+	}
+
+	controllers.Response.Success(ctx, http.StatusOK, ToCourseResponse(course))
+}
+
+func (c *Controller) CreateCourse(ctx *gin.Context) {
+	var req CreateCourseRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		controllers.Response.BadRequest(ctx, err.Error())
+		return
+	}
+
+	course := ToCourseDomain(&req)
+	if err := c.courseService.CreateCourse(ctx.Request.Context(), course); err != nil {
+		controllers.Response.FromError(ctx, err)
+		return
+	}
+
+	controllers.Response.Success(ctx, http.StatusCreated, ToCourseResponse(course))
+}
+
+func (c *Controller) UpdateCourse(ctx *gin.Context) {
+	id, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		controllers.Response.InvalidID(ctx, "El ID debe ser un número válido")
+		return
+	}
+
+	var req UpdateCourseRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		controllers.Response.BadRequest(ctx, err.Error())
+		return
+	}
+
+	existingCourse, err := c.courseService.GetCourseByID(ctx.Request.Context(), id)
+	if err != nil {
+		controllers.Response.FromError(ctx, err)
+		return
+	}
+
+	if req.Code != nil {
+		existingCourse.Code = *req.Code
+	}
+	if req.Name != nil {
+		existingCourse.Name = *req.Name
+	}
+
+	if err := c.courseService.UpdateCourse(ctx.Request.Context(), existingCourse); err != nil {
+		controllers.Response.FromError(ctx, err)
+		return
+	}
+
+	controllers.Response.Success(ctx, http.StatusOK, ToCourseResponse(existingCourse))
+}
+
+func (c *Controller) DeleteCourse(ctx *gin.Context) {
+	id, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		controllers.Response.InvalidID(ctx, "El ID debe ser un número válido")
+		return
+	}
+
+	if err := c.courseService.DeleteCourse(ctx.Request.Context(), id); err != nil {
+		controllers.Response.FromError(ctx, err)
+		return
+	}
+
+	controllers.Response.Success(ctx, http.StatusOK, gin.H{
+		"message": "Asignatura eliminada exitosamente",
+	})
+}
+
+// GetCourseSections lista las secciones de la asignatura; ?term_id= acota a un periodo
+func (c *Controller) GetCourseSections(ctx *gin.Context) {
+	id, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		controllers.Response.InvalidID(ctx, "El ID debe ser un número válido")
+		return
+	}
+
+	var termID *int
+	if raw, ok := ctx.GetQuery("term_id"); ok {
+		value, err := strconv.Atoi(raw)
+		if err != nil {
+			controllers.Response.BadRequest(ctx, "el filtro 'term_id' debe ser un número válido")
+			return
+		}
+		termID = &value
+	}
+
+	sections, err := c.courseService.GetCourseSections(ctx.Request.Context(), id, termID)
+	if err != nil {
+		controllers.Response.FromError(ctx, err)
+		return
+	}
+
+	controllers.Response.Success(ctx, http.StatusOK, ToSectionListResponse(sections))
+}
+
+func (c *Controller) CreateCourseSection(ctx *gin.Context) {
+	id, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		controllers.Response.InvalidID(ctx, "El ID debe ser un número válido")
+		return
+	}
+
+	var req CreateSectionRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		controllers.Response.BadRequest(ctx, err.Error())
+		return
+	}
+
+	section := ToSectionDomain(&req, id)
+	if err := c.courseService.CreateCourseSection(ctx.Request.Context(), section); err != nil {
+		controllers.Response.FromError(ctx, err)
+		return
+	}
+
+	controllers.Response.Success(ctx, http.StatusCreated, ToSectionResponse(section))
+}
+
+func (c *Controller) DeleteCourseSection(ctx *gin.Context) {
+	id, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		controllers.Response.InvalidID(ctx, "El ID debe ser un número válido")
+		return
+	}
+
+	if err := c.courseService.DeleteCourseSection(ctx.Request.Context(), id); err != nil {
+		controllers.Response.FromError(ctx, err)
+		return
+	}
+
+	controllers.Response.Success(ctx, http.StatusOK, gin.H{
+		"message": "Sección eliminada exitosamente",
+	})
+}
+
+func (c *Controller) GetSectionMembers(ctx *gin.Context) {
+	id, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		controllers.Response.InvalidID(ctx, "El ID debe ser un número válido")
+		return
+	}
+
+	members, err := c.courseService.GetSectionMembers(ctx.Request.Context(), id)
+	if err != nil {
+		controllers.Response.FromError(ctx, err)
+		return
+	}
+
+	controllers.Response.Success(ctx, http.StatusOK, ToMemberListResponse(members))
+}
+
+func (c *Controller) AddSectionMember(ctx *gin.Context) {
+	id, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		controllers.Response.InvalidID(ctx, "El ID debe ser un número válido")
+		return
+	}
+
+	var req AddMemberRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		controllers.Response.BadRequest(ctx, err.Error())
+		return
+	}
+
+	member := ToMemberDomain(&req, id)
+	if err := c.courseService.AddSectionMember(ctx.Request.Context(), member); err != nil {
+		controllers.Response.FromError(ctx, err)
+		return
+	}
+
+	controllers.Response.Success(ctx, http.StatusCreated, ToMemberResponse(member))
+}
+
+func (c *Controller) RemoveSectionMember(ctx *gin.Context) {
+	id, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		controllers.Response.InvalidID(ctx, "El ID debe ser un número válido")
+		return
+	}
+	userID, err := strconv.Atoi(ctx.Param("userId"))
+	if err != nil {
+		controllers.Response.InvalidID(ctx, "El ID del usuario debe ser un número válido")
+		return
+	}
+
+	if err := c.courseService.RemoveSectionMember(ctx.Request.Context(), id, userID); err != nil {
+		controllers.Response.FromError(ctx, err)
+		return
+	}
+
+	controllers.Response.Success(ctx, http.StatusOK, gin.H{
+		"message": "Inscripción eliminada exitosamente",
+	})
+}
+
+// GetCourseProjects lista los proyectos de la asignatura; admite ?term_id= y ?section_id=
+func (c *Controller) GetCourseProjects(ctx *gin.Context) {
+	id, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		controllers.Response.InvalidID(ctx, "El ID debe ser un número válido")
+		return
+	}
+
+	params, err := controllers.ParseListQuery(ctx, projectsListSpec)
+	if err != nil {
+		controllers.Response.BadRequest(ctx, err.Error())
+		return
+	}
+
+	page, err := c.courseService.GetCourseProjects(ctx.Request.Context(), id, params)
+	if err != nil {
+		controllers.Response.FromError(ctx, err)
+		return
+	}
+
+	controllers.Response.Paginated(ctx, ToProjectListResponse(page.Items), controllers.ToPagination(page))
+}
+
+// GetOverdueMilestones lista los hitos vencidos de los proyectos de la
+// asignatura, del más atrasado al más reciente; admite ?term_id= y ?section_id=
+func (c *Controller) GetOverdueMilestones(ctx *gin.Context) {
+	id, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		controllers.Response.InvalidID(ctx, "El ID debe ser un número válido")
+		return
+	}
+
+	params, err := controllers.ParseListQuery(ctx, overdueListSpec)
+	if err != nil {
+		controllers.Response.BadRequest(ctx, err.Error())
+		return
+	}
+
+	page, err := c.courseService.GetOverdueMilestones(ctx.Request.Context(), id, params)
+	if err != nil {
+		controllers.Response.FromError(ctx, err)
+		return
+	}
+
+	controllers.Response.Paginated(ctx, ToOverdueMilestoneListResponse(page.Items), controllers.ToPagination(page))
+}
@@ -0,0 +1,165 @@
+package course
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"softpharos/internal/core/domain/course"
+	"softpharos/internal/core/domain/milestone"
+	"softpharos/internal/core/domain/project"
+	"softpharos/internal/core/domain/query"
+	"softpharos/internal/core/ports/services"
+	mockService "softpharos/mocks/core/ports/services"
+)
+
+func setupRouter() *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	return gin.New()
+}
+
+func TestGetCourseProjects(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	name := "Proyecto del curso"
+	sectionID := 9
+
+	tests := []struct {
+		name               string
+		url                string
+		mockSetup          func(*mockService.MockCourseService)
+		expectedStatusCode int
+		expectedBody       string
+	}{
+		{
+			name: "lista los proyectos filtrando por periodo y sección",
+			url:  "/courses/4/projects?term_id=2&section_id=9",
+			mockSetup: func(m *mockService.MockCourseService) {
+				m.EXPECT().
+					GetCourseProjects(gomock.Any(), 4, query.Params{Page: 1, PageSize: query.DefaultPageSize, Filters: map[string]any{"term_id": 2, "section_id": 9}}).
+					Return(&query.Page[project.Project]{Items: []project.Project{{ID: 5, Name: &name, SectionID: &sectionID}}, Total: 1}, nil)
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedBody:       `"section_id":9`,
+		},
+		{
+			name:               "rechaza un filtro de periodo no numérico",
+			url:                "/courses/4/projects?term_id=actual",
+			mockSetup:          func(m *mockService.MockCourseService) {},
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:               "rechaza un ID inválido",
+			url:                "/courses/abc/projects",
+			mockSetup:          func(m *mockService.MockCourseService) {},
+			expectedStatusCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockSvc := mockService.NewMockCourseService(ctrl)
+			tt.mockSetup(mockSvc)
+
+			router := setupRouter()
+			router.GET("/courses/:id/projects", New(mockSvc).GetCourseProjects)
+
+			req, _ := http.NewRequest("GET", tt.url, nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatusCode, w.Code)
+			assert.Contains(t, w.Body.String(), tt.expectedBody)
+		})
+	}
+}
+
+func TestAddSectionMember(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tests := []struct {
+		name               string
+		requestBody        string
+		mockSetup          func(*mockService.MockCourseService)
+		expectedStatusCode int
+	}{
+		{
+			name:        "inscribe al usuario en la sección",
+			requestBody: `{"user_id":8,"role":"student"}`,
+			mockSetup: func(m *mockService.MockCourseService) {
+				m.EXPECT().AddSectionMember(gomock.Any(), &course.Member{SectionID: 3, UserID: 8, Role: course.RoleStudent}).Return(nil)
+			},
+			expectedStatusCode: http.StatusCreated,
+		},
+		{
+			name:               "retorna error cuando falta el rol",
+			requestBody:        `{"user_id":8}`,
+			mockSetup:          func(m *mockService.MockCourseService) {},
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:        "retorna 403 cuando quien inscribe no es profesor de la sección",
+			requestBody: `{"user_id":8,"role":"student"}`,
+			mockSetup: func(m *mockService.MockCourseService) {
+				m.EXPECT().AddSectionMember(gomock.Any(), gomock.Any()).Return(services.ErrSectionForbidden)
+			},
+			expectedStatusCode: http.StatusForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockSvc := mockService.NewMockCourseService(ctrl)
+			tt.mockSetup(mockSvc)
+
+			router := setupRouter()
+			router.POST("/course-sections/:id/members", New(mockSvc).AddSectionMember)
+
+			req, _ := http.NewRequest("POST", "/course-sections/3/members", bytes.NewBufferString(tt.requestBody))
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatusCode, w.Code)
+		})
+	}
+}
+
+func TestGetOverdueMilestones(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	name := "Proyecto del curso"
+	sectionID := 9
+	dueDate := time.Date(2026, 5, 4, 0, 0, 0, 0, time.UTC)
+
+	mockSvc := mockService.NewMockCourseService(ctrl)
+	mockSvc.EXPECT().
+		GetOverdueMilestones(gomock.Any(), 4, query.Params{Page: 1, PageSize: query.DefaultPageSize, Filters: map[string]any{"section_id": 9}}).
+		Return(&query.Page[milestone.Milestone]{Items: []milestone.Milestone{{
+			ID:        8,
+			ProjectID: 5,
+			Project:   &project.Project{ID: 5, Name: &name, SectionID: &sectionID},
+			DueDate:   &dueDate,
+			Status:    milestone.StatusPlanned,
+		}}, Total: 1}, nil)
+
+	router := setupRouter()
+	router.GET("/courses/:id/overdue-milestones", New(mockSvc).GetOverdueMilestones)
+
+	req, _ := http.NewRequest("GET", "/courses/4/overdue-milestones?section_id=9", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"project_name":"Proyecto del curso","section_id":9`)
+	assert.Contains(t, w.Body.String(), `"due_date":"2026-05-04","status":"planned"`)
+}
@@ -0,0 +1,103 @@
+package course
+
+import (
+	"softpharos/internal/controllers"
+	"time"
+)
+
+var listSpec = controllers.ListSpec{
+	Sortable: []string{"id", "code", "name", "created_at"},
+	Filters:  map[string]controllers.FilterKind{"code": controllers.FilterString},
+}
+
+// projectsListSpec acota los proyectos de la asignatura a un periodo o a una sección
+var projectsListSpec = controllers.ListSpec{
+	Sortable: []string{"id", "name", "created_at", "updated_at"},
+	Filters:  map[string]controllers.FilterKind{"term_id": controllers.FilterInt, "section_id": controllers.FilterInt},
+}
+
+// overdueListSpec acota los hitos vencidos de la asignatura igual que projectsListSpec
+var overdueListSpec = controllers.ListSpec{
+	Sortable: []string{"id", "title", "due_date", "class_week"},
+	Filters:  map[string]controllers.FilterKind{"term_id": controllers.FilterInt, "section_id": controllers.FilterInt},
+}
+
+type CreateCourseRequest struct {
+	Code string `json:"code" binding:"required"`
+	Name string `json:"name" binding:"required"`
+}
+
+type UpdateCourseRequest struct {
+	Code *string `json:"code"`
+	Name *string `json:"name"`
+}
+
+type CreateSectionRequest struct {
+	TermID int    `json:"term_id" binding:"required"`
+	Name   string `json:"name" binding:"required"`
+}
+
+type AddMemberRequest struct {
+	UserID int    `json:"user_id" binding:"required"`
+	Role   string `json:"role" binding:"required"`
+}
+
+type CourseResponse struct {
+	ID        int       `json:"id"`
+	Code      string    `json:"code"`
+	Name      string    `json:"name"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type SectionResponse struct {
+	ID        int           `json:"id"`
+	CourseID  int           `json:"course_id"`
+	TermID    int           `json:"term_id"`
+	Term      *TermResponse `json:"term,omitempty"`
+	Name      string        `json:"name"`
+	CreatedAt time.Time     `json:"created_at"`
+}
+
+type TermResponse struct {
+	ID   int    `json:"id"`
+	Code string `json:"code"`
+}
+
+type MemberResponse struct {
+	ID        int           `json:"id"`
+	SectionID int           `json:"section_id"`
+	UserID    int           `json:"user_id"`
+	User      *UserResponse `json:"user,omitempty"`
+	Role      string        `json:"role"`
+	JoinedAt  time.Time     `json:"joined_at"`
+}
+
+type UserResponse struct {
+	ID    int     `json:"id"`
+	Name  *string `json:"name"`
+	Email string  `json:"email"`
+}
+
+type ProjectResponse struct {
+	ID         int           `json:"id"`
+	Name       *string       `json:"name"`
+	Objective  *string       `json:"objective"`
+	CreatedBy  int           `json:"created_by"`
+	Owner      *UserResponse `json:"owner,omitempty"`
+	SectionID  *int          `json:"section_id"`
+	CreatedAt  time.Time     `json:"created_at"`
+	UpdatedAt  time.Time     `json:"updated_at"`
+	ArchivedAt *time.Time    `json:"archived_at"`
+}
+
+// OverdueMilestoneResponse es un hito vencido junto al proyecto al que pertenece
+type OverdueMilestoneResponse struct {
+	ID          int     `json:"id"`
+	ProjectID   int     `json:"project_id"`
+	ProjectName *string `json:"project_name"`
+	SectionID   *int    `json:"section_id"`
+	Title       *string `json:"title"`
+	ClassWeek   *int    `json:"class_week"`
+	DueDate     string  `json:"due_date"`
+	Status      string  `json:"status"`
+}
@@ -0,0 +1,157 @@
+package course
+
+import (
+	"time"
+
+	"softpharos/internal/core/domain/course"
+	"softpharos/internal/core/domain/milestone"
+	"softpharos/internal/core/domain/project"
+	"softpharos/internal/core/domain/user"
+)
+
+func ToCourseDomain(req *CreateCourseRequest) *course.Course {
+	return &course.Course{
+		Code: req.Code,
+		Name: req.Name,
+	}
+}
+
+func ToSectionDomain(req *CreateSectionRequest, courseID int) *course.Section {
+	return &course.Section{
+		CourseID: courseID,
+		TermID:   req.TermID,
+		Name:     req.Name,
+	}
+}
+
+func ToMemberDomain(req *AddMemberRequest, sectionID int) *course.Member {
+	return &course.Member{
+		SectionID: sectionID,
+		UserID:    req.UserID,
+		Role:      req.Role,
+	}
+}
+
+func ToCourseResponse(c *course.Course) *CourseResponse {
+	if c == nil {
+		return nil
+	}
+
+	return &CourseResponse{
+		ID:        c.ID,
+		Code:      c.Code,
+		Name:      c.Name,
+		CreatedAt: c.CreatedAt,
+	}
+}
+
+func ToCourseListResponse(courses []course.Course) []CourseResponse {
+	responses := make([]CourseResponse, len(courses))
+	for i, c := range courses {
+		responses[i] = *ToCourseResponse(&c)
+	}
+	return responses
+}
+
+func ToSectionResponse(s *course.Section) *SectionResponse {
+	if s == nil {
+		return nil
+	}
+
+	response := &SectionResponse{
+		ID:        s.ID,
+		CourseID:  s.CourseID,
+		TermID:    s.TermID,
+		Name:      s.Name,
+		CreatedAt: s.CreatedAt,
+	}
+
+	if s.Term != nil {
+		response.Term = &TermResponse{ID: s.Term.ID, Code: s.Term.Code}
+	}
+
+	return response
+}
+
+func ToSectionListResponse(sections []course.Section) []SectionResponse {
+	responses := make([]SectionResponse, len(sections))
+	for i, s := range sections {
+		responses[i] = *ToSectionResponse(&s)
+	}
+	return responses
+}
+
+func ToMemberResponse(m *course.Member) *MemberResponse {
+	if m == nil {
+		return nil
+	}
+
+	return &MemberResponse{
+		ID:        m.ID,
+		SectionID: m.SectionID,
+		UserID:    m.UserID,
+		User:      ToUserResponse(m.User),
+		Role:      m.Role,
+		JoinedAt:  m.JoinedAt,
+	}
+}
+
+func ToMemberListResponse(members []course.Member) []MemberResponse {
+	responses := make([]MemberResponse, len(members))
+	for i, m := range members {
+		responses[i] = *ToMemberResponse(&m)
+	}
+	return responses
+}
+
+func ToUserResponse(u *user.User) *UserResponse {
+	if u == nil {
+		return nil
+	}
+
+	return &UserResponse{
+		ID:    u.ID,
+		Name:  u.Name,
+		Email: u.Email,
+	}
+}
+
+func ToProjectListResponse(projects []project.Project) []ProjectResponse {
+	responses := make([]ProjectResponse, len(projects))
+	for i, p := range projects {
+		responses[i] = ProjectResponse{
+			ID:         p.ID,
+			Name:       p.Name,
+			Objective:  p.Objective,
+			CreatedBy:  p.CreatedBy,
+			Owner:      ToUserResponse(p.Owner),
+			SectionID:  p.SectionID,
+			CreatedAt:  p.CreatedAt,
+			UpdatedAt:  p.UpdatedAt,
+			ArchivedAt: p.ArchivedAt,
+		}
+	}
+	return responses
+}
+
+func ToOverdueMilestoneListResponse(milestones []milestone.Milestone) []OverdueMilestoneResponse {
+	responses := make([]OverdueMilestoneResponse, len(milestones))
+	for i, m := range milestones {
+		responses[i] = OverdueMilestoneResponse{
+			ID:        m.ID,
+			ProjectID: m.ProjectID,
+			Title:     m.Title,
+			ClassWeek: m.ClassWeek,
+			Status:    m.Status,
+		}
+		// La consulta solo devuelve hitos con fecha límite
+		if m.DueDate != nil {
+			responses[i].DueDate = m.DueDate.Format(time.DateOnly)
+		}
+		if m.Project != nil {
+			responses[i].ProjectName = m.Project.Name
+			responses[i].SectionID = m.Project.SectionID
+		}
+	}
+	return responses
+}
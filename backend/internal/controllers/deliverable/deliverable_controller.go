@@ -21,13 +21,19 @@ func New(deliverableService services.DeliverableService) *Controller {
 }
 
 func (c *Controller) GetAllDeliverables(ctx *gin.Context) {
-	deliverables, err := c.deliverableService.GetAllDeliverables(ctx.Request.Context())
+	params, err := controllers.ParseListQuery(ctx, listSpec)
 	if err != nil {
-		controllers.Response.InternalError(ctx, err.Error())
+		controllers.Response.BadRequest(ctx, err.Error())
 		return
 	}
 
-	controllers.Response.Success(ctx, http.StatusOK, ToDeliverableListResponse(deliverables))
+	page, err := c.deliverableService.GetAllDeliverables(ctx.Request.Context(), params)
+	if err != nil {
+		controllers.Response.FromError(ctx, err)
+		return
+	}
+
+	controllers.Response.Paginated(ctx, ToDeliverableListResponse(page.Items), controllers.ToPagination(page))
 }
 
 func (c *Controller) GetDeliverableByID(ctx *gin.Context) {
@@ -40,7 +46,7 @@ func (c *Controller) GetDeliverableByID(ctx *gin.Context) {
 
 	deliverable, err := c.deliverableService.GetDeliverableByID(ctx.Request.Context(), id)
 	if err != nil {
-		controllers.Response.NotFound(ctx, "Entregable no encontrado")
+		controllers.Response.FromError(ctx, err)
 		return
 	}
 
@@ -57,7 +63,7 @@ func (c *Controller) GetDeliverablesByMilestoneID(ctx *gin.Context) {
 
 	deliverables, err := c.deliverableService.GetDeliverablesByMilestoneID(ctx.Request.Context(), milestoneID)
 	if err != nil {
-		controllers.Response.InternalError(ctx, err.Error())
+		controllers.Response.FromError(ctx, err)
 		return
 	}
 
@@ -73,7 +79,7 @@ func (c *Controller) CreateDeliverable(ctx *gin.Context) {
 
 	deliverable := ToDeliverableDomain(&req)
 	if err := c.deliverableService.CreateDeliverable(ctx.Request.Context(), deliverable); err != nil {
-		controllers.Response.InternalError(ctx, err.Error())
+		controllers.Response.FromError(ctx, err)
 		return
 	}
 
@@ -96,7 +102,7 @@ func (c *Controller) UpdateDeliverable(ctx *gin.Context) {
 
 	existingDeliverable, err := c.deliverableService.GetDeliverableByID(ctx.Request.Context(), id)
 	if err != nil {
-		controllers.Response.NotFound(ctx, "Entregable no encontrado")
+		controllers.Response.FromError(ctx, err)
 		return
 	}
 
@@ -108,7 +114,7 @@ func (c *Controller) UpdateDeliverable(ctx *gin.Context) {
 	}
 
 	if err := c.deliverableService.UpdateDeliverable(ctx.Request.Context(), existingDeliverable); err != nil {
-		controllers.Response.InternalError(ctx, err.Error())
+		controllers.Response.FromError(ctx, err)
 		return
 	}
 
@@ -124,7 +130,7 @@ func (c *Controller) DeleteDeliverable(ctx *gin.Context) {
 	}
 
 	if err := c.deliverableService.DeleteDeliverable(ctx.Request.Context(), id); err != nil {
-		controllers.Response.InternalError(ctx, err.Error())
+		controllers.Response.FromError(ctx, err)
 		return
 	}
 
@@ -132,3 +138,38 @@ func (c *Controller) DeleteDeliverable(ctx *gin.Context) {
 		"message": "Entregable eliminado exitosamente",
 	})
 }
+
+func (c *Controller) GetTrashedDeliverablesByMilestoneID(ctx *gin.Context) {
+	milestoneIDParam := ctx.Param("milestoneId")
+	milestoneID, err := strconv.Atoi(milestoneIDParam)
+	if err != nil {
+		controllers.Response.InvalidID(ctx, "El ID del milestone debe ser un número válido")
+		return
+	}
+
+	deliverables, err := c.deliverableService.GetTrashedDeliverablesByMilestoneID(ctx.Request.Context(), milestoneID)
+	if err != nil {
+		controllers.Response.FromError(ctx, err)
+		return
+	}
+
+	controllers.Response.Success(ctx, http.StatusOK, ToDeliverableListResponse(deliverables))
+}
+
+func (c *Controller) RestoreDeliverable(ctx *gin.Context) {
+	idParam := ctx.Param("id")
+	id, err := strconv.Atoi(idParam)
+	if err != nil {
+		controllers.Response.InvalidID(ctx, "El ID debe ser un número válido")
+		return
+	}
+
+	if err := c.deliverableService.RestoreDeliverable(ctx.Request.Context(), id); err != nil {
+		controllers.Response.FromError(ctx, err)
+		return
+	}
+
+	controllers.Response.Success(ctx, http.StatusOK, gin.H{
+		"message": "Entregable restaurado exitosamente",
+	})
+}
@@ -14,6 +14,9 @@ import (
 	"go.uber.org/mock/gomock"
 
 	"softpharos/internal/core/domain/deliverable"
+	"softpharos/internal/core/domain/query"
+	"softpharos/internal/core/errs"
+	"softpharos/internal/core/ports/services"
 	mockService "softpharos/mocks/core/ports/services"
 )
 
@@ -35,17 +38,17 @@ func TestGetAllDeliverables(t *testing.T) {
 		{
 			name: "retorna todos los entregables exitosamente",
 			mockSetup: func(m *mockService.MockDeliverableService) {
-				m.EXPECT().GetAllDeliverables(gomock.Any()).Return([]deliverable.Deliverable{
+				m.EXPECT().GetAllDeliverables(gomock.Any(), gomock.Any()).Return(&query.Page[deliverable.Deliverable]{Items: []deliverable.Deliverable{
 					{ID: 1, MilestoneID: 1, URL: "http://url1.com", CreatedAt: now},
 					{ID: 2, MilestoneID: 1, URL: "http://url2.com", CreatedAt: now},
-				}, nil)
+				}}, nil)
 			},
 			expectedStatusCode: http.StatusOK,
 		},
 		{
 			name: "retorna error cuando el service falla",
 			mockSetup: func(m *mockService.MockDeliverableService) {
-				m.EXPECT().GetAllDeliverables(gomock.Any()).Return(nil, errors.New("service error"))
+				m.EXPECT().GetAllDeliverables(gomock.Any(), gomock.Any()).Return(nil, errors.New("service error"))
 			},
 			expectedStatusCode: http.StatusInternalServerError,
 		},
@@ -97,7 +100,7 @@ func TestGetDeliverableByID(t *testing.T) {
 			name:          "retorna error cuando entregable no existe",
 			deliverableID: "999",
 			mockSetup: func(m *mockService.MockDeliverableService) {
-				m.EXPECT().GetDeliverableByID(gomock.Any(), 999).Return(nil, errors.New("not found"))
+				m.EXPECT().GetDeliverableByID(gomock.Any(), 999).Return(nil, errs.NotFound("no encontrado"))
 			},
 			expectedStatusCode: http.StatusNotFound,
 		},
@@ -263,7 +266,7 @@ func TestUpdateDeliverable(t *testing.T) {
 			deliverableID: "999",
 			requestBody:   UpdateDeliverableRequest{URL: &updatedURL},
 			mockSetup: func(m *mockService.MockDeliverableService) {
-				m.EXPECT().GetDeliverableByID(gomock.Any(), 999).Return(nil, errors.New("not found"))
+				m.EXPECT().GetDeliverableByID(gomock.Any(), 999).Return(nil, errs.NotFound("no encontrado"))
 			},
 			expectedStatusCode: http.StatusNotFound,
 		},
@@ -335,6 +338,14 @@ func TestDeleteDeliverable(t *testing.T) {
 			},
 			expectedStatusCode: http.StatusInternalServerError,
 		},
+		{
+			name:          "retorna forbidden cuando el usuario no tiene acceso al proyecto",
+			deliverableID: "1",
+			mockSetup: func(m *mockService.MockDeliverableService) {
+				m.EXPECT().DeleteDeliverable(gomock.Any(), 1).Return(services.ErrForbidden)
+			},
+			expectedStatusCode: http.StatusForbidden,
+		},
 	}
 
 	for _, tt := range tests {
@@ -351,3 +362,109 @@ func TestDeleteDeliverable(t *testing.T) {
 		})
 	}
 }
+
+func TestGetTrashedDeliverablesByMilestoneID(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tests := []struct {
+		name               string
+		milestoneID        string
+		mockSetup          func(*mockService.MockDeliverableService)
+		expectedStatusCode int
+	}{
+		{
+			name:        "lista lo borrado del milestone",
+			milestoneID: "3",
+			mockSetup: func(m *mockService.MockDeliverableService) {
+				m.EXPECT().GetTrashedDeliverablesByMilestoneID(gomock.Any(), 3).Return([]deliverable.Deliverable{{ID: 1, MilestoneID: 3}}, nil)
+			},
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name:               "retorna error para ID de milestone inválido",
+			milestoneID:        "invalid",
+			mockSetup:          func(m *mockService.MockDeliverableService) {},
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:        "retorna forbidden cuando el servicio lo rechaza",
+			milestoneID: "3",
+			mockSetup: func(m *mockService.MockDeliverableService) {
+				m.EXPECT().GetTrashedDeliverablesByMilestoneID(gomock.Any(), 3).Return(nil, errs.Forbidden("sin permisos"))
+			},
+			expectedStatusCode: http.StatusForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockSvc := mockService.NewMockDeliverableService(ctrl)
+			tt.mockSetup(mockSvc)
+			controller := New(mockSvc)
+			router := setupRouter()
+			router.GET("/deliverables/milestone/:milestoneId/trash", controller.GetTrashedDeliverablesByMilestoneID)
+			req, _ := http.NewRequest("GET", "/deliverables/milestone/"+tt.milestoneID+"/trash", nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+			assert.Equal(t, tt.expectedStatusCode, w.Code)
+		})
+	}
+}
+
+func TestRestoreDeliverable(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tests := []struct {
+		name               string
+		deliverableID      string
+		mockSetup          func(*mockService.MockDeliverableService)
+		expectedStatusCode int
+	}{
+		{
+			name:          "restaura el entregable exitosamente",
+			deliverableID: "1",
+			mockSetup: func(m *mockService.MockDeliverableService) {
+				m.EXPECT().RestoreDeliverable(gomock.Any(), 1).Return(nil)
+			},
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name:               "retorna error para ID inválido",
+			deliverableID:      "invalid",
+			mockSetup:          func(m *mockService.MockDeliverableService) {},
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:          "retorna forbidden cuando el usuario no puede subir entregables",
+			deliverableID: "1",
+			mockSetup: func(m *mockService.MockDeliverableService) {
+				m.EXPECT().RestoreDeliverable(gomock.Any(), 1).Return(errs.Forbidden("sin permisos"))
+			},
+			expectedStatusCode: http.StatusForbidden,
+		},
+		{
+			name:          "retorna conflict cuando el milestone sigue en la papelera",
+			deliverableID: "1",
+			mockSetup: func(m *mockService.MockDeliverableService) {
+				m.EXPECT().RestoreDeliverable(gomock.Any(), 1).Return(errs.Conflict("El milestone está en la papelera; restáuralo primero"))
+			},
+			expectedStatusCode: http.StatusConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockSvc := mockService.NewMockDeliverableService(ctrl)
+			tt.mockSetup(mockSvc)
+			controller := New(mockSvc)
+			router := setupRouter()
+			router.POST("/deliverables/:id/restore", controller.RestoreDeliverable)
+			req, _ := http.NewRequest("POST", "/deliverables/"+tt.deliverableID+"/restore", nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+			assert.Equal(t, tt.expectedStatusCode, w.Code)
+		})
+	}
+}
@@ -1,6 +1,14 @@
 package deliverable
 
-import "time"
+import (
+	"softpharos/internal/controllers"
+	"time"
+)
+
+var listSpec = controllers.ListSpec{
+	Sortable: []string{"id", "created_at"},
+	Filters:  map[string]controllers.FilterKind{"milestone_id": controllers.FilterInt, "type": controllers.FilterString},
+}
 
 type CreateDeliverableRequest struct {
 	MilestoneID int     `json:"milestone_id" binding:"required"`
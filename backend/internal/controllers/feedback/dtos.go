@@ -1,10 +1,17 @@
 package feedback
 
-import "time"
+import (
+	"softpharos/internal/controllers"
+	"time"
+)
+
+var listSpec = controllers.ListSpec{
+	Sortable: []string{"id", "created_at"},
+	Filters:  map[string]controllers.FilterKind{"milestone_id": controllers.FilterInt, "professor_id": controllers.FilterInt},
+}
 
 type CreateFeedbackRequest struct {
 	MilestoneID int    `json:"milestone_id" binding:"required"`
-	ProfessorID int    `json:"professor_id" binding:"required"`
 	Content     string `json:"content" binding:"required"`
 }
 
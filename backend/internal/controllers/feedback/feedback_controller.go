@@ -21,13 +21,19 @@ func New(feedbackService services.FeedbackService) *Controller {
 }
 
 func (c *Controller) GetAllFeedbacks(ctx *gin.Context) {
-	feedbacks, err := c.feedbackService.GetAllFeedbacks(ctx.Request.Context())
+	params, err := controllers.ParseListQuery(ctx, listSpec)
 	if err != nil {
-		controllers.Response.InternalError(ctx, err.Error())
+		controllers.Response.BadRequest(ctx, err.Error())
 		return
 	}
 
-	controllers.Response.Success(ctx, http.StatusOK, ToFeedbackListResponse(feedbacks))
+	page, err := c.feedbackService.GetAllFeedbacks(ctx.Request.Context(), params)
+	if err != nil {
+		controllers.Response.FromError(ctx, err)
+		return
+	}
+
+	controllers.Response.Paginated(ctx, ToFeedbackListResponse(page.Items), controllers.ToPagination(page))
 }
 
 func (c *Controller) GetFeedbackByID(ctx *gin.Context) {
@@ -40,7 +46,7 @@ func (c *Controller) GetFeedbackByID(ctx *gin.Context) {
 
 	feedback, err := c.feedbackService.GetFeedbackByID(ctx.Request.Context(), id)
 	if err != nil {
-		controllers.Response.NotFound(ctx, "Feedback no encontrado")
+		controllers.Response.FromError(ctx, err)
 		return
 	}
 
@@ -57,7 +63,7 @@ func (c *Controller) GetFeedbacksByMilestoneID(ctx *gin.Context) {
 
 	feedbacks, err := c.feedbackService.GetFeedbacksByMilestoneID(ctx.Request.Context(), milestoneID)
 	if err != nil {
-		controllers.Response.InternalError(ctx, err.Error())
+		controllers.Response.FromError(ctx, err)
 		return
 	}
 
@@ -71,9 +77,15 @@ func (c *Controller) CreateFeedback(ctx *gin.Context) {
 		return
 	}
 
-	feedback := ToFeedbackDomain(&req)
+	userID, ok := controllers.AuthenticatedUserID(ctx)
+	if !ok {
+		controllers.Response.Unauthorized(ctx, "Usuario no autenticado")
+		return
+	}
+
+	feedback := ToFeedbackDomain(&req, userID)
 	if err := c.feedbackService.CreateFeedback(ctx.Request.Context(), feedback); err != nil {
-		controllers.Response.InternalError(ctx, err.Error())
+		controllers.Response.FromError(ctx, err)
 		return
 	}
 
@@ -96,14 +108,14 @@ func (c *Controller) UpdateFeedback(ctx *gin.Context) {
 
 	existingFeedback, err := c.feedbackService.GetFeedbackByID(ctx.Request.Context(), id)
 	if err != nil {
-		controllers.Response.NotFound(ctx, "Feedback no encontrado")
+		controllers.Response.FromError(ctx, err)
 		return
 	}
 
 	existingFeedback.Content = req.Content
 
 	if err := c.feedbackService.UpdateFeedback(ctx.Request.Context(), existingFeedback); err != nil {
-		controllers.Response.InternalError(ctx, err.Error())
+		controllers.Response.FromError(ctx, err)
 		return
 	}
 
@@ -119,7 +131,7 @@ func (c *Controller) DeleteFeedback(ctx *gin.Context) {
 	}
 
 	if err := c.feedbackService.DeleteFeedback(ctx.Request.Context(), id); err != nil {
-		controllers.Response.InternalError(ctx, err.Error())
+		controllers.Response.FromError(ctx, err)
 		return
 	}
 
@@ -127,3 +139,38 @@ func (c *Controller) DeleteFeedback(ctx *gin.Context) {
 		"message": "Feedback eliminado exitosamente",
 	})
 }
+
+func (c *Controller) GetTrashedFeedbacksByMilestoneID(ctx *gin.Context) {
+	milestoneIDParam := ctx.Param("milestoneId")
+	milestoneID, err := strconv.Atoi(milestoneIDParam)
+	if err != nil {
+		controllers.Response.InvalidID(ctx, "El ID del milestone debe ser un número válido")
+		return
+	}
+
+	feedbacks, err := c.feedbackService.GetTrashedFeedbacksByMilestoneID(ctx.Request.Context(), milestoneID)
+	if err != nil {
+		controllers.Response.FromError(ctx, err)
+		return
+	}
+
+	controllers.Response.Success(ctx, http.StatusOK, ToFeedbackListResponse(feedbacks))
+}
+
+func (c *Controller) RestoreFeedback(ctx *gin.Context) {
+	idParam := ctx.Param("id")
+	id, err := strconv.Atoi(idParam)
+	if err != nil {
+		controllers.Response.InvalidID(ctx, "El ID debe ser un número válido")
+		return
+	}
+
+	if err := c.feedbackService.RestoreFeedback(ctx.Request.Context(), id); err != nil {
+		controllers.Response.FromError(ctx, err)
+		return
+	}
+
+	controllers.Response.Success(ctx, http.StatusOK, gin.H{
+		"message": "Feedback restaurado exitosamente",
+	})
+}
@@ -13,7 +13,10 @@ import (
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
 
+	"softpharos/internal/controllers/testutil"
 	"softpharos/internal/core/domain/feedback"
+	"softpharos/internal/core/domain/query"
+	"softpharos/internal/core/errs"
 	mockService "softpharos/mocks/core/ports/services"
 )
 
@@ -35,17 +38,17 @@ func TestGetAllFeedbacks(t *testing.T) {
 		{
 			name: "retorna todos los feedbacks exitosamente",
 			mockSetup: func(m *mockService.MockFeedbackService) {
-				m.EXPECT().GetAllFeedbacks(gomock.Any()).Return([]feedback.Feedback{
+				m.EXPECT().GetAllFeedbacks(gomock.Any(), gomock.Any()).Return(&query.Page[feedback.Feedback]{Items: []feedback.Feedback{
 					{ID: 1, MilestoneID: 1, ProfessorID: 1, Content: "Feedback 1", CreatedAt: now},
 					{ID: 2, MilestoneID: 1, ProfessorID: 1, Content: "Feedback 2", CreatedAt: now},
-				}, nil)
+				}}, nil)
 			},
 			expectedStatusCode: http.StatusOK,
 		},
 		{
 			name: "retorna error cuando el service falla",
 			mockSetup: func(m *mockService.MockFeedbackService) {
-				m.EXPECT().GetAllFeedbacks(gomock.Any()).Return(nil, errors.New("service error"))
+				m.EXPECT().GetAllFeedbacks(gomock.Any(), gomock.Any()).Return(nil, errors.New("service error"))
 			},
 			expectedStatusCode: http.StatusInternalServerError,
 		},
@@ -97,7 +100,7 @@ func TestGetFeedbackByID(t *testing.T) {
 			name:       "retorna error cuando feedback no existe",
 			feedbackID: "999",
 			mockSetup: func(m *mockService.MockFeedbackService) {
-				m.EXPECT().GetFeedbackByID(gomock.Any(), 999).Return(nil, errors.New("not found"))
+				m.EXPECT().GetFeedbackByID(gomock.Any(), 999).Return(nil, errs.NotFound("no encontrado"))
 			},
 			expectedStatusCode: http.StatusNotFound,
 		},
@@ -182,7 +185,7 @@ func TestCreateFeedback(t *testing.T) {
 	}{
 		{
 			name:        "crea feedback exitosamente",
-			requestBody: CreateFeedbackRequest{MilestoneID: 1, ProfessorID: 1, Content: "New feedback"},
+			requestBody: CreateFeedbackRequest{MilestoneID: 1, Content: "New feedback"},
 			mockSetup: func(m *mockService.MockFeedbackService) {
 				m.EXPECT().CreateFeedback(gomock.Any(), gomock.Any()).Return(nil)
 			},
@@ -196,12 +199,22 @@ func TestCreateFeedback(t *testing.T) {
 		},
 		{
 			name:        "retorna error cuando el service falla",
-			requestBody: CreateFeedbackRequest{MilestoneID: 1, ProfessorID: 1, Content: "New feedback"},
+			requestBody: CreateFeedbackRequest{MilestoneID: 1, Content: "New feedback"},
 			mockSetup: func(m *mockService.MockFeedbackService) {
 				m.EXPECT().CreateFeedback(gomock.Any(), gomock.Any()).Return(errors.New("service error"))
 			},
 			expectedStatusCode: http.StatusInternalServerError,
 		},
+		{
+			name:        "ignora el professor_id enviado por el cliente y usa el usuario autenticado",
+			requestBody: map[string]interface{}{"milestone_id": 1, "professor_id": 99, "content": "Suplantación"},
+			mockSetup: func(m *mockService.MockFeedbackService) {
+				m.EXPECT().
+					CreateFeedback(gomock.Any(), gomock.Cond(func(x *feedback.Feedback) bool { return x.ProfessorID == 1 })).
+					Return(nil)
+			},
+			expectedStatusCode: http.StatusCreated,
+		},
 	}
 
 	for _, tt := range tests {
@@ -210,7 +223,7 @@ func TestCreateFeedback(t *testing.T) {
 			tt.mockSetup(mockSvc)
 			controller := New(mockSvc)
 			router := setupRouter()
-			router.POST("/feedbacks", controller.CreateFeedback)
+			router.POST("/feedbacks", testutil.AuthenticatedAs(1), controller.CreateFeedback)
 			var body []byte
 			if str, ok := tt.requestBody.(string); ok {
 				body = []byte(str)
@@ -226,6 +239,24 @@ func TestCreateFeedback(t *testing.T) {
 	}
 }
 
+func TestCreateFeedback_Unauthenticated(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockSvc := mockService.NewMockFeedbackService(ctrl)
+	controller := New(mockSvc)
+	router := setupRouter()
+	router.POST("/feedbacks", controller.CreateFeedback)
+
+	body, _ := json.Marshal(map[string]interface{}{"milestone_id": 1, "professor_id": 99, "content": "Suplantación"})
+	req, _ := http.NewRequest("POST", "/feedbacks", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+}
+
 func TestUpdateFeedback(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -262,7 +293,7 @@ func TestUpdateFeedback(t *testing.T) {
 			feedbackID:  "999",
 			requestBody: UpdateFeedbackRequest{Content: "Updated feedback"},
 			mockSetup: func(m *mockService.MockFeedbackService) {
-				m.EXPECT().GetFeedbackByID(gomock.Any(), 999).Return(nil, errors.New("not found"))
+				m.EXPECT().GetFeedbackByID(gomock.Any(), 999).Return(nil, errs.NotFound("no encontrado"))
 			},
 			expectedStatusCode: http.StatusNotFound,
 		},
@@ -326,6 +357,14 @@ func TestDeleteFeedback(t *testing.T) {
 			mockSetup:          func(m *mockService.MockFeedbackService) {},
 			expectedStatusCode: http.StatusBadRequest,
 		},
+		{
+			name:       "retorna 403 cuando el usuario no es el autor",
+			feedbackID: "1",
+			mockSetup: func(m *mockService.MockFeedbackService) {
+				m.EXPECT().DeleteFeedback(gomock.Any(), 1).Return(errs.Forbidden("solo el autor puede modificar este recurso"))
+			},
+			expectedStatusCode: http.StatusForbidden,
+		},
 		{
 			name:       "retorna error cuando delete falla",
 			feedbackID: "1",
@@ -350,3 +389,109 @@ func TestDeleteFeedback(t *testing.T) {
 		})
 	}
 }
+
+func TestGetTrashedFeedbacksByMilestoneID(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tests := []struct {
+		name               string
+		milestoneID        string
+		mockSetup          func(*mockService.MockFeedbackService)
+		expectedStatusCode int
+	}{
+		{
+			name:        "lista lo borrado del milestone",
+			milestoneID: "3",
+			mockSetup: func(m *mockService.MockFeedbackService) {
+				m.EXPECT().GetTrashedFeedbacksByMilestoneID(gomock.Any(), 3).Return([]feedback.Feedback{{ID: 1, MilestoneID: 3}}, nil)
+			},
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name:               "retorna error para ID de milestone inválido",
+			milestoneID:        "invalid",
+			mockSetup:          func(m *mockService.MockFeedbackService) {},
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:        "retorna forbidden cuando el servicio lo rechaza",
+			milestoneID: "3",
+			mockSetup: func(m *mockService.MockFeedbackService) {
+				m.EXPECT().GetTrashedFeedbacksByMilestoneID(gomock.Any(), 3).Return(nil, errs.Forbidden("sin permisos"))
+			},
+			expectedStatusCode: http.StatusForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockSvc := mockService.NewMockFeedbackService(ctrl)
+			tt.mockSetup(mockSvc)
+			controller := New(mockSvc)
+			router := setupRouter()
+			router.GET("/feedbacks/milestone/:milestoneId/trash", controller.GetTrashedFeedbacksByMilestoneID)
+			req, _ := http.NewRequest("GET", "/feedbacks/milestone/"+tt.milestoneID+"/trash", nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+			assert.Equal(t, tt.expectedStatusCode, w.Code)
+		})
+	}
+}
+
+func TestRestoreFeedback(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tests := []struct {
+		name               string
+		feedbackID         string
+		mockSetup          func(*mockService.MockFeedbackService)
+		expectedStatusCode int
+	}{
+		{
+			name:       "restaura el feedback exitosamente",
+			feedbackID: "1",
+			mockSetup: func(m *mockService.MockFeedbackService) {
+				m.EXPECT().RestoreFeedback(gomock.Any(), 1).Return(nil)
+			},
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name:               "retorna error para ID inválido",
+			feedbackID:         "invalid",
+			mockSetup:          func(m *mockService.MockFeedbackService) {},
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:       "retorna forbidden cuando quien restaura no es el profesor autor",
+			feedbackID: "1",
+			mockSetup: func(m *mockService.MockFeedbackService) {
+				m.EXPECT().RestoreFeedback(gomock.Any(), 1).Return(errs.Forbidden("sin permisos"))
+			},
+			expectedStatusCode: http.StatusForbidden,
+		},
+		{
+			name:       "retorna conflict cuando el milestone sigue en la papelera",
+			feedbackID: "1",
+			mockSetup: func(m *mockService.MockFeedbackService) {
+				m.EXPECT().RestoreFeedback(gomock.Any(), 1).Return(errs.Conflict("El milestone está en la papelera; restáuralo primero"))
+			},
+			expectedStatusCode: http.StatusConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockSvc := mockService.NewMockFeedbackService(ctrl)
+			tt.mockSetup(mockSvc)
+			controller := New(mockSvc)
+			router := setupRouter()
+			router.POST("/feedbacks/:id/restore", controller.RestoreFeedback)
+			req, _ := http.NewRequest("POST", "/feedbacks/"+tt.feedbackID+"/restore", nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+			assert.Equal(t, tt.expectedStatusCode, w.Code)
+		})
+	}
+}
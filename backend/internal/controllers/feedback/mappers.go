@@ -6,10 +6,10 @@ import (
 	"softpharos/internal/core/domain/user"
 )
 
-func ToFeedbackDomain(req *CreateFeedbackRequest) *feedback.Feedback {
+func ToFeedbackDomain(req *CreateFeedbackRequest, professorID int) *feedback.Feedback {
 	return &feedback.Feedback{
 		MilestoneID: req.MilestoneID,
-		ProfessorID: req.ProfessorID,
+		ProfessorID: professorID,
 		Content:     req.Content,
 	}
 }
@@ -0,0 +1,16 @@
+package controllers
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"softpharos/internal/core/domain/identity"
+)
+
+// AuthenticatedUserID obtiene el ID del usuario que AuthMiddleware dejó en la petición
+func AuthenticatedUserID(ctx *gin.Context) (int, bool) {
+	id, ok := identity.FromContext(ctx.Request.Context())
+	if !ok {
+		return 0, false
+	}
+	return id.UserID, true
+}
@@ -0,0 +1,39 @@
+package invitation
+
+import "time"
+
+type CreateInvitationRequest struct {
+	Email string `json:"email" binding:"required,email"`
+	Role  string `json:"role"`
+}
+
+type TokenRequest struct {
+	Token string `json:"token" binding:"required"`
+}
+
+type InvitationResponse struct {
+	ID          int        `json:"id"`
+	ProjectID   int        `json:"project_id"`
+	Email       string     `json:"email"`
+	Role        string     `json:"role"`
+	Status      string     `json:"status"`
+	InvitedBy   *int       `json:"invited_by"`
+	ExpiresAt   time.Time  `json:"expires_at"`
+	RespondedAt *time.Time `json:"responded_at"`
+	CreatedAt   time.Time  `json:"created_at"`
+}
+
+// CreatedInvitationResponse incluye el token, que solo se entrega al crear la
+// invitación para que quien invita lo comparta con el invitado
+type CreatedInvitationResponse struct {
+	InvitationResponse
+	Token string `json:"token"`
+}
+
+type MemberResponse struct {
+	ID        int       `json:"id"`
+	ProjectID int       `json:"project_id"`
+	UserID    int       `json:"user_id"`
+	Role      string    `json:"role"`
+	JoinedAt  time.Time `json:"joined_at"`
+}
@@ -0,0 +1,139 @@
+package invitation
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"softpharos/internal/controllers"
+	"softpharos/internal/core/ports/services"
+)
+
+type Controller struct {
+	invitationService services.InvitationService
+}
+
+func New(invitationService services.InvitationService) *Controller {
+	return &Controller{
+		invitationService: invitationService,
+	}
+}
+
+func (c *Controller) CreateInvitation(ctx *gin.Context) {
+	projectID, err := strconv.Atoi(ctx.Param("projectId"))
+	if err != nil {
+		controllers.Response.InvalidID(ctx, "El ID del proyecto debe ser un número válido")
+		return
+	}
+
+	var req CreateInvitationRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		controllers.Response.BadRequest(ctx, err.Error())
+		return
+	}
+
+	inv := ToInvitationDomain(&req, projectID)
+	token, err := c.invitationService.CreateInvitation(ctx.Request.Context(), inv)
+	if err != nil {
+		controllers.Response.FromError(ctx, err)
+		return
+	}
+
+	controllers.Response.Success(ctx, http.StatusCreated, CreatedInvitationResponse{
+		InvitationResponse: *ToInvitationResponse(inv),
+		Token:              token,
+	})
+}
+
+func (c *Controller) GetInvitationsByProjectID(ctx *gin.Context) {
+	projectID, err := strconv.Atoi(ctx.Param("projectId"))
+	if err != nil {
+		controllers.Response.InvalidID(ctx, "El ID del proyecto debe ser un número válido")
+		return
+	}
+
+	invitations, err := c.invitationService.GetInvitationsByProjectID(ctx.Request.Context(), projectID)
+	if err != nil {
+		controllers.Response.FromError(ctx, err)
+		return
+	}
+
+	controllers.Response.Success(ctx, http.StatusOK, ToInvitationListResponse(invitations))
+}
+
+func (c *Controller) AcceptInvitation(ctx *gin.Context) {
+	var req TokenRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		controllers.Response.BadRequest(ctx, err.Error())
+		return
+	}
+
+	member, err := c.invitationService.AcceptInvitation(ctx.Request.Context(), req.Token)
+	if err != nil {
+		controllers.Response.FromError(ctx, err)
+		return
+	}
+
+	controllers.Response.Success(ctx, http.StatusOK, ToMemberResponse(member))
+}
+
+func (c *Controller) DeclineInvitation(ctx *gin.Context) {
+	var req TokenRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		controllers.Response.BadRequest(ctx, err.Error())
+		return
+	}
+
+	if err := c.invitationService.DeclineInvitation(ctx.Request.Context(), req.Token); err != nil {
+		controllers.Response.FromError(ctx, err)
+		return
+	}
+
+	controllers.Response.Success(ctx, http.StatusOK, gin.H{
+		"message": "Invitación rechazada",
+	})
+}
+
+func (c *Controller) GetMyInvitations(ctx *gin.Context) {
+	invitations, err := c.invitationService.GetMyInvitations(ctx.Request.Context())
+	if err != nil {
+		controllers.Response.FromError(ctx, err)
+		return
+	}
+
+	controllers.Response.Success(ctx, http.StatusOK, ToInvitationListResponse(invitations))
+}
+
+func (c *Controller) AcceptMyInvitation(ctx *gin.Context) {
+	id, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		controllers.Response.InvalidID(ctx, "El ID debe ser un número válido")
+		return
+	}
+
+	member, err := c.invitationService.AcceptInvitationByID(ctx.Request.Context(), id)
+	if err != nil {
+		controllers.Response.FromError(ctx, err)
+		return
+	}
+
+	controllers.Response.Success(ctx, http.StatusOK, ToMemberResponse(member))
+}
+
+func (c *Controller) DeclineMyInvitation(ctx *gin.Context) {
+	id, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		controllers.Response.InvalidID(ctx, "El ID debe ser un número válido")
+		return
+	}
+
+	if err := c.invitationService.DeclineInvitationByID(ctx.Request.Context(), id); err != nil {
+		controllers.Response.FromError(ctx, err)
+		return
+	}
+
+	controllers.Response.Success(ctx, http.StatusOK, gin.H{
+		"message": "Invitación rechazada",
+	})
+}
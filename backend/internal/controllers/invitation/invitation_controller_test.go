@@ -0,0 +1,272 @@
+package invitation
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"softpharos/internal/core/domain/invitation"
+	"softpharos/internal/core/domain/project_member"
+	"softpharos/internal/core/ports/services"
+	mockService "softpharos/mocks/core/ports/services"
+)
+
+func setupRouter() *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	return gin.New()
+}
+
+func TestCreateInvitation(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tests := []struct {
+		name               string
+		projectID          string
+		body               string
+		mockSetup          func(*mockService.MockInvitationService)
+		expectedStatusCode int
+	}{
+		{
+			name:      "crea la invitación y devuelve el token",
+			projectID: "1",
+			body:      `{"email": "invitado@unal.edu.co"}`,
+			mockSetup: func(m *mockService.MockInvitationService) {
+				m.EXPECT().CreateInvitation(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ any, inv *invitation.Invitation) (string, error) {
+						inv.ID = 3
+						inv.Status = invitation.StatusPending
+						return "token-secreto", nil
+					})
+			},
+			expectedStatusCode: http.StatusCreated,
+		},
+		{
+			name:               "retorna error para un email inválido",
+			projectID:          "1",
+			body:               `{"email": "no-es-email"}`,
+			mockSetup:          func(m *mockService.MockInvitationService) {},
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:               "retorna error para ID inválido",
+			projectID:          "abc",
+			body:               `{"email": "invitado@unal.edu.co"}`,
+			mockSetup:          func(m *mockService.MockInvitationService) {},
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:      "retorna conflicto cuando ya es miembro",
+			projectID: "1",
+			body:      `{"email": "invitado@unal.edu.co"}`,
+			mockSetup: func(m *mockService.MockInvitationService) {
+				m.EXPECT().CreateInvitation(gomock.Any(), gomock.Any()).Return("", services.ErrAlreadyProjectMember)
+			},
+			expectedStatusCode: http.StatusConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockSvc := mockService.NewMockInvitationService(ctrl)
+			tt.mockSetup(mockSvc)
+			controller := New(mockSvc)
+			router := setupRouter()
+			router.POST("/invitations/project/:projectId", controller.CreateInvitation)
+			req, _ := http.NewRequest("POST", "/invitations/project/"+tt.projectID, bytes.NewBufferString(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+			assert.Equal(t, tt.expectedStatusCode, w.Code)
+
+			if tt.expectedStatusCode == http.StatusCreated {
+				var body struct {
+					Data CreatedInvitationResponse `json:"data"`
+				}
+				assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+				assert.Equal(t, "token-secreto", body.Data.Token)
+				assert.Equal(t, 3, body.Data.ID)
+			}
+		})
+	}
+}
+
+func TestGetInvitationsByProjectID(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockSvc := mockService.NewMockInvitationService(ctrl)
+	mockSvc.EXPECT().GetInvitationsByProjectID(gomock.Any(), 1).Return([]invitation.Invitation{
+		{ID: 1, ProjectID: 1, Email: "a@unal.edu.co", Status: invitation.StatusPending, ExpiresAt: time.Now()},
+	}, nil)
+
+	router := setupRouter()
+	router.GET("/invitations/project/:projectId", New(mockSvc).GetInvitationsByProjectID)
+	req, _ := http.NewRequest("GET", "/invitations/project/1", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.NotContains(t, w.Body.String(), "token")
+}
+
+func TestAcceptInvitation(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	memberRole := project_member.RoleContributor
+
+	tests := []struct {
+		name               string
+		body               string
+		mockSetup          func(*mockService.MockInvitationService)
+		expectedStatusCode int
+	}{
+		{
+			name: "acepta la invitación",
+			body: `{"token": "abc"}`,
+			mockSetup: func(m *mockService.MockInvitationService) {
+				m.EXPECT().AcceptInvitation(gomock.Any(), "abc").
+					Return(&project_member.ProjectMember{ID: 1, ProjectID: 1, UserID: 7, Role: memberRole}, nil)
+			},
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name:               "retorna error sin token",
+			body:               `{}`,
+			mockSetup:          func(m *mockService.MockInvitationService) {},
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "retorna forbidden cuando la invitación es para otro email",
+			body: `{"token": "abc"}`,
+			mockSetup: func(m *mockService.MockInvitationService) {
+				m.EXPECT().AcceptInvitation(gomock.Any(), "abc").Return(nil, services.ErrInvitationForAnotherEmail)
+			},
+			expectedStatusCode: http.StatusForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockSvc := mockService.NewMockInvitationService(ctrl)
+			tt.mockSetup(mockSvc)
+			router := setupRouter()
+			router.POST("/invitations/accept", New(mockSvc).AcceptInvitation)
+			req, _ := http.NewRequest("POST", "/invitations/accept", bytes.NewBufferString(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+			assert.Equal(t, tt.expectedStatusCode, w.Code)
+		})
+	}
+}
+
+func TestDeclineInvitation(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockSvc := mockService.NewMockInvitationService(ctrl)
+	mockSvc.EXPECT().DeclineInvitation(gomock.Any(), "abc").Return(services.ErrInvitationClosed)
+
+	router := setupRouter()
+	router.POST("/invitations/decline", New(mockSvc).DeclineInvitation)
+	req, _ := http.NewRequest("POST", "/invitations/decline", bytes.NewBufferString(`{"token": "abc"}`))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusConflict, w.Code)
+}
+
+func TestGetMyInvitations(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockSvc := mockService.NewMockInvitationService(ctrl)
+	mockSvc.EXPECT().GetMyInvitations(gomock.Any()).Return([]invitation.Invitation{
+		{ID: 3, ProjectID: 10, Email: "invitado@unal.edu.co", Status: invitation.StatusPending, ExpiresAt: time.Now()},
+	}, nil)
+
+	router := setupRouter()
+	router.GET("/me/invitations", New(mockSvc).GetMyInvitations)
+	req, _ := http.NewRequest("GET", "/me/invitations", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), "invitado@unal.edu.co")
+	assert.NotContains(t, w.Body.String(), "token")
+}
+
+func TestAcceptMyInvitation(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tests := []struct {
+		name               string
+		invitationID       string
+		mockSetup          func(*mockService.MockInvitationService)
+		expectedStatusCode int
+	}{
+		{
+			name:         "acepta la invitación por ID",
+			invitationID: "3",
+			mockSetup: func(m *mockService.MockInvitationService) {
+				m.EXPECT().AcceptInvitationByID(gomock.Any(), 3).
+					Return(&project_member.ProjectMember{ID: 1, ProjectID: 10, UserID: 7, Role: project_member.RoleContributor}, nil)
+			},
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name:               "retorna error para ID inválido",
+			invitationID:       "invalid",
+			mockSetup:          func(m *mockService.MockInvitationService) {},
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:         "retorna forbidden cuando la invitación es para otro email",
+			invitationID: "3",
+			mockSetup: func(m *mockService.MockInvitationService) {
+				m.EXPECT().AcceptInvitationByID(gomock.Any(), 3).Return(nil, services.ErrInvitationForAnotherEmail)
+			},
+			expectedStatusCode: http.StatusForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockSvc := mockService.NewMockInvitationService(ctrl)
+			tt.mockSetup(mockSvc)
+			router := setupRouter()
+			router.POST("/me/invitations/:id/accept", New(mockSvc).AcceptMyInvitation)
+			req, _ := http.NewRequest("POST", "/me/invitations/"+tt.invitationID+"/accept", nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+			assert.Equal(t, tt.expectedStatusCode, w.Code)
+		})
+	}
+}
+
+func TestDeclineMyInvitation(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockSvc := mockService.NewMockInvitationService(ctrl)
+	mockSvc.EXPECT().DeclineInvitationByID(gomock.Any(), 3).Return(nil)
+
+	router := setupRouter()
+	router.POST("/me/invitations/:id/decline", New(mockSvc).DeclineMyInvitation)
+	req, _ := http.NewRequest("POST", "/me/invitations/3/decline", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+}
@@ -0,0 +1,54 @@
+package invitation
+
+import (
+	"softpharos/internal/core/domain/invitation"
+	"softpharos/internal/core/domain/project_member"
+)
+
+func ToInvitationDomain(req *CreateInvitationRequest, projectID int) *invitation.Invitation {
+	return &invitation.Invitation{
+		ProjectID: projectID,
+		Email:     req.Email,
+		Role:      req.Role,
+	}
+}
+
+func ToInvitationResponse(inv *invitation.Invitation) *InvitationResponse {
+	if inv == nil {
+		return nil
+	}
+
+	return &InvitationResponse{
+		ID:          inv.ID,
+		ProjectID:   inv.ProjectID,
+		Email:       inv.Email,
+		Role:        inv.Role,
+		Status:      inv.Status,
+		InvitedBy:   inv.InvitedBy,
+		ExpiresAt:   inv.ExpiresAt,
+		RespondedAt: inv.RespondedAt,
+		CreatedAt:   inv.CreatedAt,
+	}
+}
+
+func ToInvitationListResponse(invitations []invitation.Invitation) []InvitationResponse {
+	responses := make([]InvitationResponse, len(invitations))
+	for i, inv := range invitations {
+		responses[i] = *ToInvitationResponse(&inv)
+	}
+	return responses
+}
+
+func ToMemberResponse(pm *project_member.ProjectMember) *MemberResponse {
+	if pm == nil {
+		return nil
+	}
+
+	return &MemberResponse{
+		ID:        pm.ID,
+		ProjectID: pm.ProjectID,
+		UserID:    pm.UserID,
+		Role:      pm.Role,
+		JoinedAt:  pm.JoinedAt,
+	}
+}
@@ -0,0 +1,106 @@
+package controllers
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"softpharos/internal/core/domain/query"
+)
+
+type FilterKind int
+
+const (
+	FilterInt FilterKind = iota
+	FilterString
+)
+
+// ListSpec declara los campos por los que un listado se puede ordenar y filtrar.
+// Los nombres son los mismos del JSON de respuesta y de la columna en la tabla.
+type ListSpec struct {
+	Sortable []string
+	Filters  map[string]FilterKind
+}
+
+// ParseListQuery lee ?page=&page_size=&sort=-created_at,name&<filtro>=valor.
+// Rechaza campos fuera de spec y los parámetros desconocidos se ignoran.
+func ParseListQuery(ctx *gin.Context, spec ListSpec) (query.Params, error) {
+	var params query.Params
+
+	if raw := ctx.Query("page"); raw != "" {
+		page, err := strconv.Atoi(raw)
+		if err != nil || page < 1 {
+			return params, fmt.Errorf("page debe ser un entero mayor que 0")
+		}
+		params.Page = page
+	}
+
+	if raw := ctx.Query("page_size"); raw != "" {
+		pageSize, err := strconv.Atoi(raw)
+		if err != nil || pageSize < 1 || pageSize > query.MaxPageSize {
+			return params, fmt.Errorf("page_size debe estar entre 1 y %d", query.MaxPageSize)
+		}
+		params.PageSize = pageSize
+	}
+
+	if raw := ctx.Query("sort"); raw != "" {
+		for _, field := range strings.Split(raw, ",") {
+			field = strings.TrimSpace(field)
+			desc := strings.HasPrefix(field, "-")
+			field = strings.TrimPrefix(field, "-")
+			if !contains(spec.Sortable, field) {
+				return params, fmt.Errorf("no se puede ordenar por '%s'", field)
+			}
+			params.Sort = append(params.Sort, query.Sort{Field: field, Desc: desc})
+		}
+	}
+
+	for field, kind := range spec.Filters {
+		raw, ok := ctx.GetQuery(field)
+		if !ok {
+			continue
+		}
+		if params.Filters == nil {
+			params.Filters = map[string]any{}
+		}
+
+		switch kind {
+		case FilterInt:
+			value, err := strconv.Atoi(raw)
+			if err != nil {
+				return params, fmt.Errorf("el filtro '%s' debe ser un número válido", field)
+			}
+			params.Filters[field] = value
+		default:
+			params.Filters[field] = raw
+		}
+	}
+
+	return params.Normalize(), nil
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// ToPagination construye los metadatos de paginación de la respuesta
+func ToPagination[T any](page *query.Page[T]) *Pagination {
+	pagination := &Pagination{
+		Page:       page.Page,
+		PageSize:   page.PageSize,
+		Total:      page.Total,
+		TotalPages: page.TotalPages(),
+	}
+	if page.HasNext() {
+		next := page.Page + 1
+		pagination.NextPage = &next
+	}
+	return pagination
+}
@@ -0,0 +1,89 @@
+package controllers
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+
+	"softpharos/internal/core/domain/query"
+)
+
+func TestParseListQuery(t *testing.T) {
+	spec := ListSpec{
+		Sortable: []string{"id", "name", "created_at"},
+		Filters:  map[string]FilterKind{"created_by": FilterInt, "type": FilterString},
+	}
+
+	tests := []struct {
+		name        string
+		rawQuery    string
+		expected    query.Params
+		expectError bool
+	}{
+		{
+			name:     "usa valores por defecto",
+			rawQuery: "",
+			expected: query.Params{Page: 1, PageSize: query.DefaultPageSize},
+		},
+		{
+			name:     "lee página, orden y filtros",
+			rawQuery: "page=2&page_size=5&sort=-created_at,name&created_by=3&type=pdf&otro=x",
+			expected: query.Params{
+				Page:     2,
+				PageSize: 5,
+				Sort:     []query.Sort{{Field: "created_at", Desc: true}, {Field: "name"}},
+				Filters:  map[string]any{"created_by": 3, "type": "pdf"},
+			},
+		},
+		{name: "rechaza página inválida", rawQuery: "page=0", expectError: true},
+		{name: "rechaza page_size mayor al máximo", rawQuery: "page_size=1000", expectError: true},
+		{name: "rechaza orden por campo no permitido", rawQuery: "sort=password", expectError: true},
+		{name: "rechaza filtro numérico inválido", rawQuery: "created_by=abc", expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gin.SetMode(gin.TestMode)
+			ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+			ctx.Request = httptest.NewRequest("GET", "/items?"+tt.rawQuery, nil)
+
+			params, err := ParseListQuery(ctx, spec)
+
+			if tt.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, params)
+			}
+		})
+	}
+}
+
+func TestToPagination(t *testing.T) {
+	next := 2
+
+	tests := []struct {
+		name     string
+		page     *query.Page[int]
+		expected *Pagination
+	}{
+		{
+			name:     "incluye la siguiente página",
+			page:     &query.Page[int]{Items: []int{1, 2}, Total: 3, Page: 1, PageSize: 2},
+			expected: &Pagination{Page: 1, PageSize: 2, Total: 3, TotalPages: 2, NextPage: &next},
+		},
+		{
+			name:     "sin siguiente página en la última",
+			page:     &query.Page[int]{Items: []int{3}, Total: 3, Page: 2, PageSize: 2},
+			expected: &Pagination{Page: 2, PageSize: 2, Total: 3, TotalPages: 2},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, ToPagination(tt.page))
+		})
+	}
+}
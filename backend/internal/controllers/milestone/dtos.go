@@ -1,28 +1,101 @@
 package milestone
 
-import "time"
+import (
+	"softpharos/internal/controllers"
+	"time"
+)
+
+var listSpec = controllers.ListSpec{
+	Sortable: []string{"id", "title", "class_week", "due_date", "status", "created_at"},
+	Filters: map[string]controllers.FilterKind{
+		"project_id": controllers.FilterInt,
+		"class_week": controllers.FilterInt,
+		"status":     controllers.FilterString,
+	},
+}
 
 type CreateMilestoneRequest struct {
 	ProjectID   int     `json:"project_id" binding:"required"`
 	Title       *string `json:"title"`
 	Description *string `json:"description"`
 	ClassWeek   *int    `json:"class_week"`
+	DueDate     *string `json:"due_date" binding:"omitempty,datetime=2006-01-02"`
 }
 
 type UpdateMilestoneRequest struct {
 	Title       *string `json:"title"`
 	Description *string `json:"description"`
 	ClassWeek   *int    `json:"class_week"`
+	DueDate     *string `json:"due_date" binding:"omitempty,datetime=2006-01-02"`
 }
 
 type MilestoneResponse struct {
-	ID          int              `json:"id"`
-	ProjectID   int              `json:"project_id"`
-	Project     *ProjectResponse `json:"project,omitempty"`
-	Title       *string          `json:"title"`
-	Description *string          `json:"description"`
-	ClassWeek   *int             `json:"class_week"`
-	CreatedAt   time.Time        `json:"created_at"`
+	ID              int              `json:"id"`
+	ProjectID       int              `json:"project_id"`
+	Project         *ProjectResponse `json:"project,omitempty"`
+	Title           *string          `json:"title"`
+	Description     *string          `json:"description"`
+	ClassWeek       *int             `json:"class_week"`
+	WeekStart       *string          `json:"week_start"` // fechas de class_week en el calendario del periodo
+	WeekEnd         *string          `json:"week_end"`
+	DueDate         *string          `json:"due_date"`
+	Status          string           `json:"status"`
+	StatusChangedAt *time.Time       `json:"status_changed_at"`
+	Overdue         bool             `json:"overdue"`
+	Counts          *CountsResponse  `json:"counts,omitempty"` // solo en los listados
+	CreatedAt       time.Time        `json:"created_at"`
+	DeletedAt       *time.Time       `json:"deleted_at,omitempty"`
+}
+
+type CountsResponse struct {
+	Deliverables int            `json:"deliverables"`
+	Comments     int            `json:"comments"`
+	Reactions    map[string]int `json:"reactions"`
+	HasFeedback  bool           `json:"has_feedback"`
+}
+
+// MilestoneDetailResponse agrega al hito las relaciones pedidas con ?include=;
+// las que no se pidieron no aparecen
+type MilestoneDetailResponse struct {
+	MilestoneResponse
+	Deliverables *[]DeliverableResponse `json:"deliverables,omitempty"`
+	Feedback     *[]FeedbackResponse    `json:"feedback,omitempty"`
+	Comments     *[]CommentResponse     `json:"comments,omitempty"`
+	Reactions    *[]ReactionResponse    `json:"reactions,omitempty"`
+}
+
+type DeliverableResponse struct {
+	ID        int       `json:"id"`
+	URL       string    `json:"url"`
+	Type      *string   `json:"type"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type FeedbackResponse struct {
+	ID        int           `json:"id"`
+	Professor *UserResponse `json:"professor,omitempty"`
+	Content   string        `json:"content"`
+	CreatedAt time.Time     `json:"created_at"`
+}
+
+type CommentResponse struct {
+	ID        int           `json:"id"`
+	User      *UserResponse `json:"user,omitempty"`
+	Content   *string       `json:"content"`
+	CreatedAt time.Time     `json:"created_at"`
+}
+
+type ReactionResponse struct {
+	ID        int           `json:"id"`
+	User      *UserResponse `json:"user,omitempty"`
+	Type      *string       `json:"type"`
+	CreatedAt time.Time     `json:"created_at"`
+}
+
+type UserResponse struct {
+	ID    int     `json:"id"`
+	Name  *string `json:"name"`
+	Email string  `json:"email"`
 }
 
 type ProjectResponse struct {
@@ -1,8 +1,12 @@
 package milestone
 
 import (
+	"time"
+
 	"softpharos/internal/core/domain/milestone"
+	"softpharos/internal/core/domain/milestone_detail"
 	"softpharos/internal/core/domain/project"
+	"softpharos/internal/core/domain/user"
 )
 
 func ToMilestoneDomain(req *CreateMilestoneRequest) *milestone.Milestone {
@@ -11,6 +15,7 @@ func ToMilestoneDomain(req *CreateMilestoneRequest) *milestone.Milestone {
 		Title:       req.Title,
 		Description: req.Description,
 		ClassWeek:   req.ClassWeek,
+		DueDate:     parseDate(req.DueDate),
 	}
 }
 
@@ -20,21 +25,83 @@ func ToMilestoneResponse(m *milestone.Milestone) *MilestoneResponse {
 	}
 
 	response := &MilestoneResponse{
-		ID:          m.ID,
-		ProjectID:   m.ProjectID,
-		Title:       m.Title,
-		Description: m.Description,
-		ClassWeek:   m.ClassWeek,
-		CreatedAt:   m.CreatedAt,
+		ID:              m.ID,
+		ProjectID:       m.ProjectID,
+		Title:           m.Title,
+		Description:     m.Description,
+		ClassWeek:       m.ClassWeek,
+		WeekStart:       formatDate(m.WeekStart),
+		WeekEnd:         formatDate(m.WeekEnd),
+		DueDate:         formatDate(m.DueDate),
+		Status:          m.Status,
+		StatusChangedAt: m.StatusChangedAt,
+		Overdue:         m.Overdue,
+		CreatedAt:       m.CreatedAt,
+		DeletedAt:       m.DeletedAt,
 	}
 
 	if m.Project != nil {
 		response.Project = ToProjectResponse(m.Project)
 	}
+	if m.Counts != nil {
+		response.Counts = &CountsResponse{
+			Deliverables: m.Counts.Deliverables,
+			Comments:     m.Counts.Comments,
+			Reactions:    m.Counts.Reactions,
+			HasFeedback:  m.Counts.HasFeedback,
+		}
+	}
+
+	return response
+}
+
+func ToMilestoneDetailResponse(d *milestone_detail.Detail) *MilestoneDetailResponse {
+	response := &MilestoneDetailResponse{MilestoneResponse: *ToMilestoneResponse(&d.Milestone)}
+
+	if d.Deliverables != nil {
+		deliverables := make([]DeliverableResponse, len(d.Deliverables))
+		for i, dl := range d.Deliverables {
+			deliverables[i] = DeliverableResponse{ID: dl.ID, URL: dl.URL, Type: dl.Type, CreatedAt: dl.CreatedAt}
+		}
+		response.Deliverables = &deliverables
+	}
+	if d.Feedback != nil {
+		feedback := make([]FeedbackResponse, len(d.Feedback))
+		for i, f := range d.Feedback {
+			feedback[i] = FeedbackResponse{ID: f.ID, Professor: ToUserResponse(f.Professor), Content: f.Content, CreatedAt: f.CreatedAt}
+		}
+		response.Feedback = &feedback
+	}
+	if d.Comments != nil {
+		comments := make([]CommentResponse, len(d.Comments))
+		for i, c := range d.Comments {
+			comments[i] = CommentResponse{ID: c.ID, User: ToUserResponse(c.User), Content: c.Content, CreatedAt: c.CreatedAt}
+		}
+		response.Comments = &comments
+	}
+	if d.Reactions != nil {
+		reactions := make([]ReactionResponse, len(d.Reactions))
+		for i, r := range d.Reactions {
+			reactions[i] = ReactionResponse{ID: r.ID, User: ToUserResponse(r.User), Type: r.Type, CreatedAt: r.CreatedAt}
+		}
+		response.Reactions = &reactions
+	}
 
 	return response
 }
 
+func ToUserResponse(u *user.User) *UserResponse {
+	if u == nil {
+		return nil
+	}
+
+	return &UserResponse{
+		ID:    u.ID,
+		Name:  u.Name,
+		Email: u.Email,
+	}
+}
+
 func ToProjectResponse(p *project.Project) *ProjectResponse {
 	if p == nil {
 		return nil
@@ -54,3 +121,21 @@ func ToMilestoneListResponse(milestones []milestone.Milestone) []MilestoneRespon
 	}
 	return responses
 }
+
+func formatDate(date *time.Time) *string {
+	if date == nil {
+		return nil
+	}
+
+	formatted := date.Format(time.DateOnly)
+	return &formatted
+}
+
+func parseDate(value *string) *time.Time {
+	if value == nil {
+		return nil
+	}
+
+	date, _ := time.Parse(time.DateOnly, *value)
+	return &date
+}
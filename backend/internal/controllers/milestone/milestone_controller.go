@@ -4,7 +4,9 @@ import (
 	"net/http"
 	"softpharos/internal/controllers"
 	"strconv"
+	"strings"
 
+	"softpharos/internal/core/domain/milestone"
 	"softpharos/internal/core/ports/services"
 
 	"github.com/gin-gonic/gin"
@@ -21,15 +23,22 @@ func New(milestoneService services.MilestoneService) *Controller {
 }
 
 func (c *Controller) GetAllMilestones(ctx *gin.Context) {
-	milestones, err := c.milestoneService.GetAllMilestones(ctx.Request.Context())
+	params, err := controllers.ParseListQuery(ctx, listSpec)
 	if err != nil {
-		controllers.Response.InternalError(ctx, err.Error())
+		controllers.Response.BadRequest(ctx, err.Error())
 		return
 	}
 
-	controllers.Response.Success(ctx, http.StatusOK, ToMilestoneListResponse(milestones))
+	page, err := c.milestoneService.GetAllMilestones(ctx.Request.Context(), params)
+	if err != nil {
+		controllers.Response.FromError(ctx, err)
+		return
+	}
+
+	controllers.Response.Paginated(ctx, ToMilestoneListResponse(page.Items), controllers.ToPagination(page))
 }
 
+// GetMilestoneByID admite ?include=deliverables,feedback,comments,reactions
 func (c *Controller) GetMilestoneByID(ctx *gin.Context) {
 	idParam := ctx.Param("id")
 	id, err := strconv.Atoi(idParam)
@@ -38,13 +47,13 @@ func (c *Controller) GetMilestoneByID(ctx *gin.Context) {
 		return
 	}
 
-	milestone, err := c.milestoneService.GetMilestoneByID(ctx.Request.Context(), id)
+	detail, err := c.milestoneService.GetMilestoneDetail(ctx.Request.Context(), id, parseIncludes(ctx.Query("include")))
 	if err != nil {
-		controllers.Response.NotFound(ctx, "Milestone no encontrado")
+		controllers.Response.FromError(ctx, err)
 		return
 	}
 
-	controllers.Response.Success(ctx, http.StatusOK, ToMilestoneResponse(milestone))
+	controllers.Response.Success(ctx, http.StatusOK, ToMilestoneDetailResponse(detail))
 }
 
 func (c *Controller) GetMilestonesByProjectID(ctx *gin.Context) {
@@ -57,7 +66,7 @@ func (c *Controller) GetMilestonesByProjectID(ctx *gin.Context) {
 
 	milestones, err := c.milestoneService.GetMilestonesByProjectID(ctx.Request.Context(), projectID)
 	if err != nil {
-		controllers.Response.InternalError(ctx, err.Error())
+		controllers.Response.FromError(ctx, err)
 		return
 	}
 
@@ -73,7 +82,7 @@ func (c *Controller) CreateMilestone(ctx *gin.Context) {
 
 	milestone := ToMilestoneDomain(&req)
 	if err := c.milestoneService.CreateMilestone(ctx.Request.Context(), milestone); err != nil {
-		controllers.Response.InternalError(ctx, err.Error())
+		controllers.Response.FromError(ctx, err)
 		return
 	}
 
@@ -96,7 +105,7 @@ func (c *Controller) UpdateMilestone(ctx *gin.Context) {
 
 	existingMilestone, err := c.milestoneService.GetMilestoneByID(ctx.Request.Context(), id)
 	if err != nil {
-		controllers.Response.NotFound(ctx, "Milestone no encontrado")
+		controllers.Response.FromError(ctx, err)
 		return
 	}
 
@@ -109,9 +118,12 @@ func (c *Controller) UpdateMilestone(ctx *gin.Context) {
 	if req.ClassWeek != nil {
 		existingMilestone.ClassWeek = req.ClassWeek
 	}
+	if req.DueDate != nil {
+		existingMilestone.DueDate = parseDate(req.DueDate)
+	}
 
 	if err := c.milestoneService.UpdateMilestone(ctx.Request.Context(), existingMilestone); err != nil {
-		controllers.Response.InternalError(ctx, err.Error())
+		controllers.Response.FromError(ctx, err)
 		return
 	}
 
@@ -127,7 +139,7 @@ func (c *Controller) DeleteMilestone(ctx *gin.Context) {
 	}
 
 	if err := c.milestoneService.DeleteMilestone(ctx.Request.Context(), id); err != nil {
-		controllers.Response.InternalError(ctx, err.Error())
+		controllers.Response.FromError(ctx, err)
 		return
 	}
 
@@ -135,3 +147,95 @@ func (c *Controller) DeleteMilestone(ctx *gin.Context) {
 		"message": "Milestone eliminado exitosamente",
 	})
 }
+
+func (c *Controller) GetTrashedMilestonesByProjectID(ctx *gin.Context) {
+	projectIDParam := ctx.Param("projectId")
+	projectID, err := strconv.Atoi(projectIDParam)
+	if err != nil {
+		controllers.Response.InvalidID(ctx, "El ID del proyecto debe ser un número válido")
+		return
+	}
+
+	milestones, err := c.milestoneService.GetTrashedMilestonesByProjectID(ctx.Request.Context(), projectID)
+	if err != nil {
+		controllers.Response.FromError(ctx, err)
+		return
+	}
+
+	controllers.Response.Success(ctx, http.StatusOK, ToMilestoneListResponse(milestones))
+}
+
+func (c *Controller) RestoreMilestone(ctx *gin.Context) {
+	idParam := ctx.Param("id")
+	id, err := strconv.Atoi(idParam)
+	if err != nil {
+		controllers.Response.InvalidID(ctx, "El ID debe ser un número válido")
+		return
+	}
+
+	if err := c.milestoneService.RestoreMilestone(ctx.Request.Context(), id); err != nil {
+		controllers.Response.FromError(ctx, err)
+		return
+	}
+
+	controllers.Response.Success(ctx, http.StatusOK, gin.H{
+		"message": "Milestone restaurado exitosamente",
+	})
+}
+
+func (c *Controller) GetOverdueMilestonesByProjectID(ctx *gin.Context) {
+	projectIDParam := ctx.Param("projectId")
+	projectID, err := strconv.Atoi(projectIDParam)
+	if err != nil {
+		controllers.Response.InvalidID(ctx, "El ID del proyecto debe ser un número válido")
+		return
+	}
+
+	milestones, err := c.milestoneService.GetOverdueMilestonesByProjectID(ctx.Request.Context(), projectID)
+	if err != nil {
+		controllers.Response.FromError(ctx, err)
+		return
+	}
+
+	controllers.Response.Success(ctx, http.StatusOK, ToMilestoneListResponse(milestones))
+}
+
+func (c *Controller) StartMilestone(ctx *gin.Context) {
+	c.transitionMilestone(ctx, milestone.StatusInProgress)
+}
+
+func (c *Controller) SubmitMilestone(ctx *gin.Context) {
+	c.transitionMilestone(ctx, milestone.StatusSubmitted)
+}
+
+func (c *Controller) ReviewMilestone(ctx *gin.Context) {
+	c.transitionMilestone(ctx, milestone.StatusReviewed)
+}
+
+func (c *Controller) transitionMilestone(ctx *gin.Context, status string) {
+	idParam := ctx.Param("id")
+	id, err := strconv.Atoi(idParam)
+	if err != nil {
+		controllers.Response.InvalidID(ctx, "El ID debe ser un número válido")
+		return
+	}
+
+	m, err := c.milestoneService.TransitionMilestone(ctx.Request.Context(), id, status)
+	if err != nil {
+		controllers.Response.FromError(ctx, err)
+		return
+	}
+
+	controllers.Response.Success(ctx, http.StatusOK, ToMilestoneResponse(m))
+}
+
+// parseIncludes separa ?include= por comas; el servicio valida cada valor
+func parseIncludes(raw string) []string {
+	var includes []string
+	for _, include := range strings.Split(raw, ",") {
+		if include = strings.TrimSpace(include); include != "" {
+			includes = append(includes, include)
+		}
+	}
+	return includes
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -13,7 +14,14 @@ import (
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
 
+	"softpharos/internal/core/domain/comment"
 	"softpharos/internal/core/domain/milestone"
+	"softpharos/internal/core/domain/milestone_detail"
+	"softpharos/internal/core/domain/query"
+	"softpharos/internal/core/domain/reaction"
+	"softpharos/internal/core/domain/user"
+	"softpharos/internal/core/errs"
+	"softpharos/internal/core/ports/services"
 	mockService "softpharos/mocks/core/ports/services"
 )
 
@@ -39,11 +47,11 @@ func TestGetAllMilestones(t *testing.T) {
 			name: "retorna todos los milestones exitosamente",
 			mockSetup: func(m *mockService.MockMilestoneService) {
 				m.EXPECT().
-					GetAllMilestones(gomock.Any()).
-					Return([]milestone.Milestone{
+					GetAllMilestones(gomock.Any(), gomock.Any()).
+					Return(&query.Page[milestone.Milestone]{Items: []milestone.Milestone{
 						{ID: 1, ProjectID: 1, Title: &title1, CreatedAt: now},
 						{ID: 2, ProjectID: 1, Title: &title2, CreatedAt: now},
-					}, nil)
+					}}, nil)
 			},
 			expectedStatusCode: http.StatusOK,
 		},
@@ -51,7 +59,7 @@ func TestGetAllMilestones(t *testing.T) {
 			name: "retorna error cuando el service falla",
 			mockSetup: func(m *mockService.MockMilestoneService) {
 				m.EXPECT().
-					GetAllMilestones(gomock.Any()).
+					GetAllMilestones(gomock.Any(), gomock.Any()).
 					Return(nil, errors.New("service error"))
 			},
 			expectedStatusCode: http.StatusInternalServerError,
@@ -94,13 +102,13 @@ func TestGetMilestoneByID(t *testing.T) {
 			milestoneID: "1",
 			mockSetup: func(m *mockService.MockMilestoneService) {
 				m.EXPECT().
-					GetMilestoneByID(gomock.Any(), 1).
-					Return(&milestone.Milestone{
+					GetMilestoneDetail(gomock.Any(), 1, []string(nil)).
+					Return(&milestone_detail.Detail{Milestone: milestone.Milestone{
 						ID:        1,
 						ProjectID: 1,
 						Title:     &title,
 						CreatedAt: now,
-					}, nil)
+					}}, nil)
 			},
 			expectedStatusCode: http.StatusOK,
 		},
@@ -115,8 +123,8 @@ func TestGetMilestoneByID(t *testing.T) {
 			milestoneID: "999",
 			mockSetup: func(m *mockService.MockMilestoneService) {
 				m.EXPECT().
-					GetMilestoneByID(gomock.Any(), 999).
-					Return(nil, errors.New("not found"))
+					GetMilestoneDetail(gomock.Any(), 999, []string(nil)).
+					Return(nil, errs.NotFound("no encontrado"))
 			},
 			expectedStatusCode: http.StatusNotFound,
 		},
@@ -140,6 +148,110 @@ func TestGetMilestoneByID(t *testing.T) {
 	}
 }
 
+func TestGetMilestoneByIDWeekDates(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	week := 5
+	weekStart := time.Date(2026, 4, 6, 0, 0, 0, 0, time.UTC)
+	weekEnd := time.Date(2026, 4, 12, 0, 0, 0, 0, time.UTC)
+
+	mockSvc := mockService.NewMockMilestoneService(ctrl)
+	mockSvc.EXPECT().GetMilestoneDetail(gomock.Any(), 1, []string(nil)).Return(&milestone_detail.Detail{Milestone: milestone.Milestone{
+		ID:        1,
+		ProjectID: 1,
+		ClassWeek: &week,
+		WeekStart: &weekStart,
+		WeekEnd:   &weekEnd,
+	}}, nil)
+
+	router := setupRouter()
+	router.GET("/milestones/:id", New(mockSvc).GetMilestoneByID)
+
+	req, _ := http.NewRequest("GET", "/milestones/1", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"week_start":"2026-04-06","week_end":"2026-04-12"`)
+}
+
+func TestGetMilestoneByIDIncludes(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	content := "Revisen el diagrama"
+
+	tests := []struct {
+		name               string
+		url                string
+		mockSetup          func(*mockService.MockMilestoneService)
+		expectedStatusCode int
+		expectedBody       string
+		unexpectedBody     string
+	}{
+		{
+			name: "incluye solo las relaciones pedidas",
+			url:  "/milestones/1?include=comments,%20reactions",
+			mockSetup: func(m *mockService.MockMilestoneService) {
+				m.EXPECT().
+					GetMilestoneDetail(gomock.Any(), 1, []string{"comments", "reactions"}).
+					Return(&milestone_detail.Detail{
+						Milestone: milestone.Milestone{ID: 1, ProjectID: 1},
+						Comments:  []comment.Comment{{ID: 9, Content: &content, User: &user.User{ID: 2, Email: "ana@unal.edu.co"}}},
+						Reactions: []reaction.Reaction{},
+					}, nil)
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedBody:       `"comments":[{"id":9,"user":{"id":2,"name":null,"email":"ana@unal.edu.co"},"content":"Revisen el diagrama"`,
+			unexpectedBody:     `"deliverables"`,
+		},
+		{
+			name: "retorna una relación pedida sin elementos como lista vacía",
+			url:  "/milestones/1?include=reactions",
+			mockSetup: func(m *mockService.MockMilestoneService) {
+				m.EXPECT().
+					GetMilestoneDetail(gomock.Any(), 1, []string{"reactions"}).
+					Return(&milestone_detail.Detail{Milestone: milestone.Milestone{ID: 1, ProjectID: 1}, Reactions: []reaction.Reaction{}}, nil)
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedBody:       `"reactions":[]`,
+			unexpectedBody:     `"comments"`,
+		},
+		{
+			name: "retorna 400 para una relación no permitida",
+			url:  "/milestones/1?include=project",
+			mockSetup: func(m *mockService.MockMilestoneService) {
+				m.EXPECT().
+					GetMilestoneDetail(gomock.Any(), 1, []string{"project"}).
+					Return(nil, fmt.Errorf("%w: 'project' no se puede incluir", services.ErrInvalidInclude))
+			},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedBody:       `include`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockSvc := mockService.NewMockMilestoneService(ctrl)
+			tt.mockSetup(mockSvc)
+
+			router := setupRouter()
+			router.GET("/milestones/:id", New(mockSvc).GetMilestoneByID)
+
+			req, _ := http.NewRequest("GET", tt.url, nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatusCode, w.Code)
+			assert.Contains(t, w.Body.String(), tt.expectedBody)
+			if tt.unexpectedBody != "" {
+				assert.NotContains(t, w.Body.String(), tt.unexpectedBody)
+			}
+		})
+	}
+}
+
 func TestGetMilestonesByProjectID(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -203,6 +315,28 @@ func TestGetMilestonesByProjectID(t *testing.T) {
 	}
 }
 
+func TestGetMilestonesByProjectIDCounts(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockSvc := mockService.NewMockMilestoneService(ctrl)
+	mockSvc.EXPECT().GetMilestonesByProjectID(gomock.Any(), 1).Return([]milestone.Milestone{{
+		ID:        1,
+		ProjectID: 1,
+		Counts:    &milestone.Counts{Deliverables: 1, Comments: 3, Reactions: map[string]int{"like": 2}, HasFeedback: true},
+	}}, nil)
+
+	router := setupRouter()
+	router.GET("/milestones/project/:projectId", New(mockSvc).GetMilestonesByProjectID)
+
+	req, _ := http.NewRequest("GET", "/milestones/project/1", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"counts":{"deliverables":1,"comments":3,"reactions":{"like":2},"has_feedback":true}`)
+}
+
 func TestCreateMilestone(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -247,6 +381,34 @@ func TestCreateMilestone(t *testing.T) {
 			},
 			expectedStatusCode: http.StatusInternalServerError,
 		},
+		{
+			name:        "retorna 400 cuando la semana está fuera del periodo",
+			requestBody: `{"project_id":1,"title":"New Milestone","class_week":20}`,
+			mockSetup: func(m *mockService.MockMilestoneService) {
+				m.EXPECT().
+					CreateMilestone(gomock.Any(), gomock.Any()).
+					Return(fmt.Errorf("%w: el periodo 2026-1 tiene 16 semanas de clase", services.ErrInvalidClassWeek))
+			},
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:        "crea el hito con fecha límite",
+			requestBody: `{"project_id":1,"title":"New Milestone","due_date":"2026-06-01"}`,
+			mockSetup: func(m *mockService.MockMilestoneService) {
+				m.EXPECT().
+					CreateMilestone(gomock.Any(), gomock.Cond(func(created *milestone.Milestone) bool {
+						return created.DueDate != nil && created.DueDate.Equal(time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC))
+					})).
+					Return(nil)
+			},
+			expectedStatusCode: http.StatusCreated,
+		},
+		{
+			name:               "rechaza una fecha límite con formato inválido",
+			requestBody:        `{"project_id":1,"title":"New Milestone","due_date":"01/06/2026"}`,
+			mockSetup:          func(m *mockService.MockMilestoneService) {},
+			expectedStatusCode: http.StatusBadRequest,
+		},
 	}
 
 	for _, tt := range tests {
@@ -327,7 +489,7 @@ func TestUpdateMilestone(t *testing.T) {
 			mockSetup: func(m *mockService.MockMilestoneService) {
 				m.EXPECT().
 					GetMilestoneByID(gomock.Any(), 999).
-					Return(nil, errors.New("not found"))
+					Return(nil, errs.NotFound("no encontrado"))
 			},
 			expectedStatusCode: http.StatusNotFound,
 		},
@@ -416,6 +578,16 @@ func TestDeleteMilestone(t *testing.T) {
 			},
 			expectedStatusCode: http.StatusInternalServerError,
 		},
+		{
+			name:        "retorna forbidden cuando el usuario no tiene acceso al proyecto",
+			milestoneID: "1",
+			mockSetup: func(m *mockService.MockMilestoneService) {
+				m.EXPECT().
+					DeleteMilestone(gomock.Any(), 1).
+					Return(services.ErrForbidden)
+			},
+			expectedStatusCode: http.StatusForbidden,
+		},
 	}
 
 	for _, tt := range tests {
@@ -435,3 +607,86 @@ func TestDeleteMilestone(t *testing.T) {
 		})
 	}
 }
+
+func TestTransitionMilestone(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	changedAt := time.Date(2026, 5, 10, 15, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name               string
+		url                string
+		mockSetup          func(*mockService.MockMilestoneService)
+		expectedStatusCode int
+		expectedBody       string
+	}{
+		{
+			name: "entrega el hito",
+			url:  "/milestones/4/submit",
+			mockSetup: func(m *mockService.MockMilestoneService) {
+				m.EXPECT().
+					TransitionMilestone(gomock.Any(), 4, milestone.StatusSubmitted).
+					Return(&milestone.Milestone{ID: 4, ProjectID: 1, Status: milestone.StatusSubmitted, StatusChangedAt: &changedAt}, nil)
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedBody:       `"status":"submitted","status_changed_at":"2026-05-10T15:00:00Z"`,
+		},
+		{
+			name: "retorna conflict si el hito no puede pasar a ese estado",
+			url:  "/milestones/4/submit",
+			mockSetup: func(m *mockService.MockMilestoneService) {
+				m.EXPECT().
+					TransitionMilestone(gomock.Any(), 4, milestone.StatusSubmitted).
+					Return(nil, services.ErrInvalidStatusTransition)
+			},
+			expectedStatusCode: http.StatusConflict,
+		},
+		{
+			name:               "rechaza un ID inválido",
+			url:                "/milestones/abc/submit",
+			mockSetup:          func(m *mockService.MockMilestoneService) {},
+			expectedStatusCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockSvc := mockService.NewMockMilestoneService(ctrl)
+			tt.mockSetup(mockSvc)
+
+			router := setupRouter()
+			router.POST("/milestones/:id/submit", New(mockSvc).SubmitMilestone)
+
+			req, _ := http.NewRequest("POST", tt.url, nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatusCode, w.Code)
+			assert.Contains(t, w.Body.String(), tt.expectedBody)
+		})
+	}
+}
+
+func TestGetOverdueMilestonesByProjectID(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	dueDate := time.Date(2026, 5, 8, 0, 0, 0, 0, time.UTC)
+
+	mockSvc := mockService.NewMockMilestoneService(ctrl)
+	mockSvc.EXPECT().GetOverdueMilestonesByProjectID(gomock.Any(), 1).Return([]milestone.Milestone{
+		{ID: 3, ProjectID: 1, DueDate: &dueDate, Status: milestone.StatusInProgress, Overdue: true},
+	}, nil)
+
+	router := setupRouter()
+	router.GET("/milestones/project/:projectId/overdue", New(mockSvc).GetOverdueMilestonesByProjectID)
+
+	req, _ := http.NewRequest("GET", "/milestones/project/1/overdue", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), fmt.Sprintf(`"due_date":"%s","status":"in_progress"`, dueDate.Format(time.DateOnly)))
+	assert.Contains(t, w.Body.String(), `"overdue":true`)
+}
@@ -1,26 +1,62 @@
 package project
 
-import "time"
+import (
+	"softpharos/internal/controllers"
+	"time"
+)
+
+var listSpec = controllers.ListSpec{
+	Sortable: []string{"id", "name", "created_at", "updated_at"},
+	Filters:  map[string]controllers.FilterKind{"created_by": controllers.FilterInt},
+}
+
+// trashListSpec admite ordenar la papelera por fecha de borrado
+var trashListSpec = controllers.ListSpec{
+	Sortable: []string{"id", "name", "created_at", "deleted_at"},
+	Filters:  map[string]controllers.FilterKind{"created_by": controllers.FilterInt},
+}
+
+// myProjectsListSpec filtra por estado (active o archived) y por el rol del
+// usuario en el proyecto
+var myProjectsListSpec = controllers.ListSpec{
+	Sortable: []string{"id", "name", "created_at", "updated_at", "role", "joined_at"},
+	Filters:  map[string]controllers.FilterKind{"status": controllers.FilterString, "role": controllers.FilterString},
+}
 
 type CreateProjectRequest struct {
 	Name      *string `json:"name" binding:"required"`
 	Objective *string `json:"objective"`
-	CreatedBy int     `json:"created_by" binding:"required"`
+	SectionID *int    `json:"section_id"` // sección de asignatura a la que se vincula
 }
 
 type UpdateProjectRequest struct {
 	Name      *string `json:"name"`
 	Objective *string `json:"objective"`
+	SectionID *int    `json:"section_id"`
+}
+
+type TransferOwnershipRequest struct {
+	UserID int `json:"user_id" binding:"required"`
 }
 
 type ProjectResponse struct {
-	ID        int            `json:"id"`
-	Name      *string        `json:"name"`
-	Objective *string        `json:"objective"`
-	CreatedBy int            `json:"created_by"`
-	Owner     *OwnerResponse `json:"owner,omitempty"`
-	CreatedAt time.Time      `json:"created_at"`
-	UpdatedAt time.Time      `json:"updated_at"`
+	ID         int            `json:"id"`
+	Name       *string        `json:"name"`
+	Objective  *string        `json:"objective"`
+	CreatedBy  int            `json:"created_by"`
+	Owner      *OwnerResponse `json:"owner,omitempty"`
+	SectionID  *int           `json:"section_id"`
+	CreatedAt  time.Time      `json:"created_at"`
+	UpdatedAt  time.Time      `json:"updated_at"`
+	ArchivedAt *time.Time     `json:"archived_at"`
+	DeletedAt  *time.Time     `json:"deleted_at,omitempty"`
+}
+
+// MyProjectResponse es un proyecto del usuario autenticado junto con su rol en él
+type MyProjectResponse struct {
+	ProjectResponse
+	Role     string    `json:"role"`
+	JoinedAt time.Time `json:"joined_at"`
 }
 
 type OwnerResponse struct {
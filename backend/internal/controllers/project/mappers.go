@@ -5,11 +5,12 @@ import (
 	"softpharos/internal/core/domain/user"
 )
 
-func ToProjectDomain(req *CreateProjectRequest) *project.Project {
+func ToProjectDomain(req *CreateProjectRequest, ownerID int) *project.Project {
 	return &project.Project{
 		Name:      req.Name,
 		Objective: req.Objective,
-		CreatedBy: req.CreatedBy,
+		CreatedBy: ownerID,
+		SectionID: req.SectionID,
 	}
 }
 
@@ -19,12 +20,15 @@ func ToProjectResponse(proj *project.Project) *ProjectResponse {
 	}
 
 	response := &ProjectResponse{
-		ID:        proj.ID,
-		Name:      proj.Name,
-		Objective: proj.Objective,
-		CreatedBy: proj.CreatedBy,
-		CreatedAt: proj.CreatedAt,
-		UpdatedAt: proj.UpdatedAt,
+		ID:         proj.ID,
+		Name:       proj.Name,
+		Objective:  proj.Objective,
+		CreatedBy:  proj.CreatedBy,
+		SectionID:  proj.SectionID,
+		CreatedAt:  proj.CreatedAt,
+		UpdatedAt:  proj.UpdatedAt,
+		ArchivedAt: proj.ArchivedAt,
+		DeletedAt:  proj.DeletedAt,
 	}
 
 	if proj.Owner != nil {
@@ -53,3 +57,15 @@ func ToProjectListResponse(projects []project.Project) []ProjectResponse {
 	}
 	return responses
 }
+
+func ToMyProjectListResponse(projects []project.MemberProject) []MyProjectResponse {
+	responses := make([]MyProjectResponse, len(projects))
+	for i, mp := range projects {
+		responses[i] = MyProjectResponse{
+			ProjectResponse: *ToProjectResponse(&mp.Project),
+			Role:            mp.Role,
+			JoinedAt:        mp.JoinedAt,
+		}
+	}
+	return responses
+}
@@ -23,7 +23,6 @@ func TestToProjectDomain(t *testing.T) {
 			input: &CreateProjectRequest{
 				Name:      &name,
 				Objective: &objective,
-				CreatedBy: 1,
 			},
 			expected: &project.Project{
 				Name:      &name,
@@ -36,7 +35,6 @@ func TestToProjectDomain(t *testing.T) {
 			input: &CreateProjectRequest{
 				Name:      &name,
 				Objective: nil,
-				CreatedBy: 1,
 			},
 			expected: &project.Project{
 				Name:      &name,
@@ -48,7 +46,7 @@ func TestToProjectDomain(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result := ToProjectDomain(tt.input)
+			result := ToProjectDomain(tt.input, 1)
 			assert.Equal(t, tt.expected, result)
 		})
 	}
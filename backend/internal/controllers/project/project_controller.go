@@ -21,13 +21,19 @@ func New(projectService services.ProjectService) *Controller {
 }
 
 func (c *Controller) GetAllProjects(ctx *gin.Context) {
-	projects, err := c.projectService.GetAllProjects(ctx.Request.Context())
+	params, err := controllers.ParseListQuery(ctx, listSpec)
 	if err != nil {
-		controllers.Response.InternalError(ctx, err.Error())
+		controllers.Response.BadRequest(ctx, err.Error())
 		return
 	}
 
-	controllers.Response.Success(ctx, http.StatusOK, ToProjectListResponse(projects))
+	page, err := c.projectService.GetAllProjects(ctx.Request.Context(), params)
+	if err != nil {
+		controllers.Response.FromError(ctx, err)
+		return
+	}
+
+	controllers.Response.Paginated(ctx, ToProjectListResponse(page.Items), controllers.ToPagination(page))
 }
 
 func (c *Controller) GetProjectByID(ctx *gin.Context) {
@@ -40,7 +46,7 @@ func (c *Controller) GetProjectByID(ctx *gin.Context) {
 
 	project, err := c.projectService.GetProjectByID(ctx.Request.Context(), id)
 	if err != nil {
-		controllers.Response.NotFound(ctx, "Proyecto no encontrado")
+		controllers.Response.FromError(ctx, err)
 		return
 	}
 
@@ -57,13 +63,29 @@ func (c *Controller) GetProjectsByOwner(ctx *gin.Context) {
 
 	projects, err := c.projectService.GetProjectsByOwner(ctx.Request.Context(), OwnerId)
 	if err != nil {
-		controllers.Response.InternalError(ctx, err.Error())
+		controllers.Response.FromError(ctx, err)
 		return
 	}
 
 	controllers.Response.Success(ctx, http.StatusOK, ToProjectListResponse(projects))
 }
 
+func (c *Controller) GetMyProjects(ctx *gin.Context) {
+	params, err := controllers.ParseListQuery(ctx, myProjectsListSpec)
+	if err != nil {
+		controllers.Response.BadRequest(ctx, err.Error())
+		return
+	}
+
+	page, err := c.projectService.GetMyProjects(ctx.Request.Context(), params)
+	if err != nil {
+		controllers.Response.FromError(ctx, err)
+		return
+	}
+
+	controllers.Response.Paginated(ctx, ToMyProjectListResponse(page.Items), controllers.ToPagination(page))
+}
+
 func (c *Controller) CreateProject(ctx *gin.Context) {
 	var req CreateProjectRequest
 	if err := ctx.ShouldBindJSON(&req); err != nil {
@@ -71,9 +93,15 @@ func (c *Controller) CreateProject(ctx *gin.Context) {
 		return
 	}
 
-	project := ToProjectDomain(&req)
+	userID, ok := controllers.AuthenticatedUserID(ctx)
+	if !ok {
+		controllers.Response.Unauthorized(ctx, "Usuario no autenticado")
+		return
+	}
+
+	project := ToProjectDomain(&req, userID)
 	if err := c.projectService.CreateProject(ctx.Request.Context(), project); err != nil {
-		controllers.Response.InternalError(ctx, err.Error())
+		controllers.Response.FromError(ctx, err)
 		return
 	}
 
@@ -97,7 +125,7 @@ func (c *Controller) UpdateProject(ctx *gin.Context) {
 	// Primero obtenemos el proyecto existente
 	existingProject, err := c.projectService.GetProjectByID(ctx.Request.Context(), id)
 	if err != nil {
-		controllers.Response.NotFound(ctx, "Proyecto no encontrado")
+		controllers.Response.FromError(ctx, err)
 		return
 	}
 
@@ -108,9 +136,12 @@ func (c *Controller) UpdateProject(ctx *gin.Context) {
 	if req.Objective != nil {
 		existingProject.Objective = req.Objective
 	}
+	if req.SectionID != nil {
+		existingProject.SectionID = req.SectionID
+	}
 
 	if err := c.projectService.UpdateProject(ctx.Request.Context(), existingProject); err != nil {
-		controllers.Response.InternalError(ctx, err.Error())
+		controllers.Response.FromError(ctx, err)
 		return
 	}
 
@@ -126,7 +157,7 @@ func (c *Controller) DeleteProject(ctx *gin.Context) {
 	}
 
 	if err := c.projectService.DeleteProject(ctx.Request.Context(), id); err != nil {
-		controllers.Response.InternalError(ctx, err.Error())
+		controllers.Response.FromError(ctx, err)
 		return
 	}
 
@@ -134,3 +165,87 @@ func (c *Controller) DeleteProject(ctx *gin.Context) {
 		"message": "Proyecto eliminado exitosamente",
 	})
 }
+
+func (c *Controller) GetTrashedProjects(ctx *gin.Context) {
+	params, err := controllers.ParseListQuery(ctx, trashListSpec)
+	if err != nil {
+		controllers.Response.BadRequest(ctx, err.Error())
+		return
+	}
+
+	page, err := c.projectService.GetTrashedProjects(ctx.Request.Context(), params)
+	if err != nil {
+		controllers.Response.FromError(ctx, err)
+		return
+	}
+
+	controllers.Response.Paginated(ctx, ToProjectListResponse(page.Items), controllers.ToPagination(page))
+}
+
+func (c *Controller) TransferOwnership(ctx *gin.Context) {
+	idParam := ctx.Param("id")
+	id, err := strconv.Atoi(idParam)
+	if err != nil {
+		controllers.Response.InvalidID(ctx, "El ID debe ser un número válido")
+		return
+	}
+
+	var req TransferOwnershipRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		controllers.Response.BadRequest(ctx, err.Error())
+		return
+	}
+
+	if err := c.projectService.TransferOwnership(ctx.Request.Context(), id, req.UserID); err != nil {
+		controllers.Response.FromError(ctx, err)
+		return
+	}
+
+	controllers.Response.Success(ctx, http.StatusOK, gin.H{
+		"message": "Propiedad del proyecto transferida exitosamente",
+	})
+}
+
+func (c *Controller) ArchiveProject(ctx *gin.Context) {
+	c.setArchived(ctx, true, "Proyecto archivado exitosamente")
+}
+
+func (c *Controller) UnarchiveProject(ctx *gin.Context) {
+	c.setArchived(ctx, false, "Proyecto reactivado exitosamente")
+}
+
+func (c *Controller) setArchived(ctx *gin.Context, archived bool, message string) {
+	idParam := ctx.Param("id")
+	id, err := strconv.Atoi(idParam)
+	if err != nil {
+		controllers.Response.InvalidID(ctx, "El ID debe ser un número válido")
+		return
+	}
+
+	if err := c.projectService.SetProjectArchived(ctx.Request.Context(), id, archived); err != nil {
+		controllers.Response.FromError(ctx, err)
+		return
+	}
+
+	controllers.Response.Success(ctx, http.StatusOK, gin.H{
+		"message": message,
+	})
+}
+
+func (c *Controller) RestoreProject(ctx *gin.Context) {
+	idParam := ctx.Param("id")
+	id, err := strconv.Atoi(idParam)
+	if err != nil {
+		controllers.Response.InvalidID(ctx, "El ID debe ser un número válido")
+		return
+	}
+
+	if err := c.projectService.RestoreProject(ctx.Request.Context(), id); err != nil {
+		controllers.Response.FromError(ctx, err)
+		return
+	}
+
+	controllers.Response.Success(ctx, http.StatusOK, gin.H{
+		"message": "Proyecto restaurado exitosamente",
+	})
+}
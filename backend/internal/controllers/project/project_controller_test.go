@@ -6,8 +6,12 @@ import (
 	"errors"
 	"net/http"
 	"net/http/httptest"
+	"softpharos/internal/controllers/testutil"
 	"softpharos/internal/core/domain/project"
+	"softpharos/internal/core/domain/query"
 	"softpharos/internal/core/domain/user"
+	"softpharos/internal/core/errs"
+	"softpharos/internal/core/ports/services"
 	mockService "softpharos/mocks/core/ports/services"
 	"testing"
 	"time"
@@ -32,6 +36,7 @@ func TestGetAllProjects(t *testing.T) {
 
 	tests := []struct {
 		name               string
+		rawQuery           string
 		mockSetup          func(*mockService.MockProjectService)
 		expectedStatusCode int
 	}{
@@ -39,20 +44,41 @@ func TestGetAllProjects(t *testing.T) {
 			name: "retorna todos los proyectos exitosamente",
 			mockSetup: func(m *mockService.MockProjectService) {
 				m.EXPECT().
-					GetAllProjects(gomock.Any()).
-					Return([]project.Project{
+					GetAllProjects(gomock.Any(), gomock.Any()).
+					Return(&query.Page[project.Project]{Items: []project.Project{
 						{ID: 1, Name: &name1, CreatedBy: 1, CreatedAt: now, UpdatedAt: now},
 						{ID: 2, Name: &name2, CreatedBy: 2, CreatedAt: now, UpdatedAt: now},
-					}, nil)
+					}}, nil)
+			},
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name:     "pasa los parámetros de listado al service",
+			rawQuery: "?page=2&page_size=10&sort=-created_at&created_by=7",
+			mockSetup: func(m *mockService.MockProjectService) {
+				m.EXPECT().
+					GetAllProjects(gomock.Any(), query.Params{
+						Page:     2,
+						PageSize: 10,
+						Sort:     []query.Sort{{Field: "created_at", Desc: true}},
+						Filters:  map[string]any{"created_by": 7},
+					}).
+					Return(&query.Page[project.Project]{Items: []project.Project{}, Total: 11, Page: 2, PageSize: 10}, nil)
 			},
 			expectedStatusCode: http.StatusOK,
 		},
+		{
+			name:               "retorna error para un orden no permitido",
+			rawQuery:           "?sort=objective",
+			mockSetup:          func(m *mockService.MockProjectService) {},
+			expectedStatusCode: http.StatusBadRequest,
+		},
 		{
 			name: "retorna error cuando el service falla",
 			mockSetup: func(m *mockService.MockProjectService) {
 				m.EXPECT().
-					GetAllProjects(gomock.Any()).
-					Return([]project.Project{}, errors.New("service error"))
+					GetAllProjects(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("service error"))
 			},
 			expectedStatusCode: http.StatusInternalServerError,
 		},
@@ -67,7 +93,7 @@ func TestGetAllProjects(t *testing.T) {
 			router := setupRouter()
 			router.GET("/projects", controller.GetAllProjects)
 
-			req, _ := http.NewRequest("GET", "/projects", nil)
+			req, _ := http.NewRequest("GET", "/projects"+tt.rawQuery, nil)
 			w := httptest.NewRecorder()
 			router.ServeHTTP(w, req)
 
@@ -123,7 +149,7 @@ func TestGetProjectByID(t *testing.T) {
 			mockSetup: func(m *mockService.MockProjectService) {
 				m.EXPECT().
 					GetProjectByID(gomock.Any(), 999).
-					Return(nil, errors.New("not found"))
+					Return(nil, errs.NotFound("no encontrado"))
 			},
 			expectedStatusCode: http.StatusNotFound,
 		},
@@ -228,7 +254,6 @@ func TestCreateProject(t *testing.T) {
 			requestBody: CreateProjectRequest{
 				Name:      &name,
 				Objective: &objective,
-				CreatedBy: 1,
 			},
 			mockSetup: func(m *mockService.MockProjectService) {
 				m.EXPECT().
@@ -246,8 +271,7 @@ func TestCreateProject(t *testing.T) {
 		{
 			name: "retorna error cuando el service falla",
 			requestBody: CreateProjectRequest{
-				Name:      &name,
-				CreatedBy: 1,
+				Name: &name,
 			},
 			mockSetup: func(m *mockService.MockProjectService) {
 				m.EXPECT().
@@ -256,6 +280,16 @@ func TestCreateProject(t *testing.T) {
 			},
 			expectedStatusCode: http.StatusInternalServerError,
 		},
+		{
+			name:        "ignora el created_by enviado por el cliente y usa el usuario autenticado",
+			requestBody: map[string]interface{}{"name": "Proyecto", "created_by": 99},
+			mockSetup: func(m *mockService.MockProjectService) {
+				m.EXPECT().
+					CreateProject(gomock.Any(), gomock.Cond(func(x *project.Project) bool { return x.CreatedBy == 1 })).
+					Return(nil)
+			},
+			expectedStatusCode: http.StatusCreated,
+		},
 	}
 
 	for _, tt := range tests {
@@ -265,7 +299,7 @@ func TestCreateProject(t *testing.T) {
 
 			controller := New(mockSvc)
 			router := setupRouter()
-			router.POST("/projects", controller.CreateProject)
+			router.POST("/projects", testutil.AuthenticatedAs(1), controller.CreateProject)
 
 			var body []byte
 			if str, ok := tt.requestBody.(string); ok {
@@ -284,6 +318,24 @@ func TestCreateProject(t *testing.T) {
 	}
 }
 
+func TestCreateProject_Unauthenticated(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockSvc := mockService.NewMockProjectService(ctrl)
+	controller := New(mockSvc)
+	router := setupRouter()
+	router.POST("/projects", controller.CreateProject)
+
+	body, _ := json.Marshal(map[string]interface{}{"name": "Proyecto", "created_by": 99})
+	req, _ := http.NewRequest("POST", "/projects", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+}
+
 func TestUpdateProject(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -344,7 +396,7 @@ func TestUpdateProject(t *testing.T) {
 			mockSetup: func(m *mockService.MockProjectService) {
 				m.EXPECT().
 					GetProjectByID(gomock.Any(), 999).
-					Return(nil, errors.New("not found"))
+					Return(nil, errs.NotFound("no encontrado"))
 			},
 			expectedStatusCode: http.StatusNotFound,
 		},
@@ -428,6 +480,16 @@ func TestDeleteProject(t *testing.T) {
 			},
 			expectedStatusCode: http.StatusInternalServerError,
 		},
+		{
+			name:      "retorna forbidden cuando el usuario no tiene acceso al proyecto",
+			projectID: "1",
+			mockSetup: func(m *mockService.MockProjectService) {
+				m.EXPECT().
+					DeleteProject(gomock.Any(), 1).
+					Return(services.ErrForbidden)
+			},
+			expectedStatusCode: http.StatusForbidden,
+		},
 	}
 
 	for _, tt := range tests {
@@ -447,3 +509,142 @@ func TestDeleteProject(t *testing.T) {
 		})
 	}
 }
+
+func TestGetMyProjects(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	name := "Project 1"
+	now := time.Now()
+
+	tests := []struct {
+		name               string
+		rawQuery           string
+		mockSetup          func(*mockService.MockProjectService)
+		expectedStatusCode int
+		expectedRole       string
+	}{
+		{
+			name:     "retorna los proyectos del usuario con su rol",
+			rawQuery: "?status=active&role=maintainer&sort=-joined_at",
+			mockSetup: func(m *mockService.MockProjectService) {
+				m.EXPECT().
+					GetMyProjects(gomock.Any(), query.Params{
+						Page:     1,
+						PageSize: query.DefaultPageSize,
+						Sort:     []query.Sort{{Field: "joined_at", Desc: true}},
+						Filters:  map[string]any{"status": "active", "role": "maintainer"},
+					}).
+					Return(&query.Page[project.MemberProject]{Items: []project.MemberProject{
+						{Project: project.Project{ID: 1, Name: &name, CreatedBy: 2, CreatedAt: now, UpdatedAt: now}, Role: "maintainer", JoinedAt: now},
+					}, Total: 1, Page: 1, PageSize: query.DefaultPageSize}, nil)
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedRole:       "maintainer",
+		},
+		{
+			name:               "retorna error para un orden no permitido",
+			rawQuery:           "?sort=objective",
+			mockSetup:          func(m *mockService.MockProjectService) {},
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:     "retorna error para un estado desconocido",
+			rawQuery: "?status=deleted",
+			mockSetup: func(m *mockService.MockProjectService) {
+				m.EXPECT().
+					GetMyProjects(gomock.Any(), gomock.Any()).
+					Return(nil, services.ErrInvalidProjectStatus)
+			},
+			expectedStatusCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockSvc := mockService.NewMockProjectService(ctrl)
+			tt.mockSetup(mockSvc)
+
+			controller := New(mockSvc)
+			router := setupRouter()
+			router.GET("/me/projects", testutil.AuthenticatedAs(2), controller.GetMyProjects)
+
+			req, _ := http.NewRequest("GET", "/me/projects"+tt.rawQuery, nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatusCode, w.Code)
+			if tt.expectedRole != "" {
+				var body struct {
+					Data []MyProjectResponse `json:"data"`
+				}
+				assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+				if assert.Len(t, body.Data, 1) {
+					assert.Equal(t, tt.expectedRole, body.Data[0].Role)
+					assert.Equal(t, 1, body.Data[0].ID)
+				}
+			}
+		})
+	}
+}
+
+func TestArchiveProject(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tests := []struct {
+		name               string
+		path               string
+		mockSetup          func(*mockService.MockProjectService)
+		expectedStatusCode int
+	}{
+		{
+			name: "archiva el proyecto",
+			path: "/projects/1/archive",
+			mockSetup: func(m *mockService.MockProjectService) {
+				m.EXPECT().SetProjectArchived(gomock.Any(), 1, true).Return(nil)
+			},
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name: "reactiva el proyecto",
+			path: "/projects/1/unarchive",
+			mockSetup: func(m *mockService.MockProjectService) {
+				m.EXPECT().SetProjectArchived(gomock.Any(), 1, false).Return(nil)
+			},
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name:               "retorna error para ID inválido",
+			path:               "/projects/invalid/archive",
+			mockSetup:          func(m *mockService.MockProjectService) {},
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "retorna forbidden cuando el usuario no es owner",
+			path: "/projects/1/archive",
+			mockSetup: func(m *mockService.MockProjectService) {
+				m.EXPECT().SetProjectArchived(gomock.Any(), 1, true).Return(services.ErrForbidden)
+			},
+			expectedStatusCode: http.StatusForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockSvc := mockService.NewMockProjectService(ctrl)
+			tt.mockSetup(mockSvc)
+
+			controller := New(mockSvc)
+			router := setupRouter()
+			router.POST("/projects/:id/archive", controller.ArchiveProject)
+			router.POST("/projects/:id/unarchive", controller.UnarchiveProject)
+
+			req, _ := http.NewRequest("POST", tt.path, nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatusCode, w.Code)
+		})
+	}
+}
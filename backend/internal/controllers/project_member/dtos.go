@@ -1,11 +1,19 @@
 package project_member
 
-import "time"
+import (
+	"softpharos/internal/controllers"
+	"time"
+)
+
+var listSpec = controllers.ListSpec{
+	Sortable: []string{"id", "joined_at"},
+	Filters:  map[string]controllers.FilterKind{"project_id": controllers.FilterInt, "user_id": controllers.FilterInt, "role": controllers.FilterString},
+}
 
 type CreateProjectMemberRequest struct {
-	ProjectID int     `json:"project_id" binding:"required"`
-	UserID    int     `json:"user_id" binding:"required"`
-	Role      *string `json:"role"`
+	ProjectID int    `json:"project_id" binding:"required"`
+	UserID    int    `json:"user_id" binding:"required"`
+	Role      string `json:"role"`
 }
 
 type UpdateProjectMemberRequest struct {
@@ -18,7 +26,7 @@ type ProjectMemberResponse struct {
 	Project   *ProjectResponse `json:"project,omitempty"`
 	UserID    int              `json:"user_id"`
 	User      *UserResponse    `json:"user,omitempty"`
-	Role      *string          `json:"role"`
+	Role      string           `json:"role"`
 	JoinedAt  time.Time        `json:"joined_at"`
 }
 
@@ -21,13 +21,19 @@ func New(projectMemberService services.ProjectMemberService) *Controller {
 }
 
 func (c *Controller) GetAllProjectMembers(ctx *gin.Context) {
-	projectMembers, err := c.projectMemberService.GetAllProjectMembers(ctx.Request.Context())
+	params, err := controllers.ParseListQuery(ctx, listSpec)
 	if err != nil {
-		controllers.Response.InternalError(ctx, err.Error())
+		controllers.Response.BadRequest(ctx, err.Error())
 		return
 	}
 
-	controllers.Response.Success(ctx, http.StatusOK, ToProjectMemberListResponse(projectMembers))
+	page, err := c.projectMemberService.GetAllProjectMembers(ctx.Request.Context(), params)
+	if err != nil {
+		controllers.Response.FromError(ctx, err)
+		return
+	}
+
+	controllers.Response.Paginated(ctx, ToProjectMemberListResponse(page.Items), controllers.ToPagination(page))
 }
 
 func (c *Controller) GetProjectMemberByID(ctx *gin.Context) {
@@ -40,7 +46,7 @@ func (c *Controller) GetProjectMemberByID(ctx *gin.Context) {
 
 	projectMember, err := c.projectMemberService.GetProjectMemberByID(ctx.Request.Context(), id)
 	if err != nil {
-		controllers.Response.NotFound(ctx, "Miembro del proyecto no encontrado")
+		controllers.Response.FromError(ctx, err)
 		return
 	}
 
@@ -57,7 +63,7 @@ func (c *Controller) GetProjectMembersByProjectID(ctx *gin.Context) {
 
 	projectMembers, err := c.projectMemberService.GetProjectMembersByProjectID(ctx.Request.Context(), projectID)
 	if err != nil {
-		controllers.Response.InternalError(ctx, err.Error())
+		controllers.Response.FromError(ctx, err)
 		return
 	}
 
@@ -73,7 +79,7 @@ func (c *Controller) CreateProjectMember(ctx *gin.Context) {
 
 	projectMember := ToProjectMemberDomain(&req)
 	if err := c.projectMemberService.CreateProjectMember(ctx.Request.Context(), projectMember); err != nil {
-		controllers.Response.InternalError(ctx, err.Error())
+		controllers.Response.FromError(ctx, err)
 		return
 	}
 
@@ -96,16 +102,16 @@ func (c *Controller) UpdateProjectMember(ctx *gin.Context) {
 
 	existingProjectMember, err := c.projectMemberService.GetProjectMemberByID(ctx.Request.Context(), id)
 	if err != nil {
-		controllers.Response.NotFound(ctx, "Miembro del proyecto no encontrado")
+		controllers.Response.FromError(ctx, err)
 		return
 	}
 
 	if req.Role != nil {
-		existingProjectMember.Role = req.Role
+		existingProjectMember.Role = *req.Role
 	}
 
 	if err := c.projectMemberService.UpdateProjectMember(ctx.Request.Context(), existingProjectMember); err != nil {
-		controllers.Response.InternalError(ctx, err.Error())
+		controllers.Response.FromError(ctx, err)
 		return
 	}
 
@@ -121,7 +127,7 @@ func (c *Controller) DeleteProjectMember(ctx *gin.Context) {
 	}
 
 	if err := c.projectMemberService.DeleteProjectMember(ctx.Request.Context(), id); err != nil {
-		controllers.Response.InternalError(ctx, err.Error())
+		controllers.Response.FromError(ctx, err)
 		return
 	}
 
@@ -14,6 +14,9 @@ import (
 	"go.uber.org/mock/gomock"
 
 	"softpharos/internal/core/domain/project_member"
+	"softpharos/internal/core/domain/query"
+	"softpharos/internal/core/errs"
+	"softpharos/internal/core/ports/services"
 	mockService "softpharos/mocks/core/ports/services"
 )
 
@@ -26,8 +29,8 @@ func TestGetAllProjectMembers(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
-	role1 := "Developer"
-	role2 := "Designer"
+	role1 := project_member.RoleContributor
+	role2 := project_member.RoleViewer
 	now := time.Now()
 
 	tests := []struct {
@@ -38,17 +41,17 @@ func TestGetAllProjectMembers(t *testing.T) {
 		{
 			name: "retorna todos los miembros exitosamente",
 			mockSetup: func(m *mockService.MockProjectMemberService) {
-				m.EXPECT().GetAllProjectMembers(gomock.Any()).Return([]project_member.ProjectMember{
-					{ID: 1, ProjectID: 1, UserID: 1, Role: &role1, JoinedAt: now},
-					{ID: 2, ProjectID: 1, UserID: 2, Role: &role2, JoinedAt: now},
-				}, nil)
+				m.EXPECT().GetAllProjectMembers(gomock.Any(), gomock.Any()).Return(&query.Page[project_member.ProjectMember]{Items: []project_member.ProjectMember{
+					{ID: 1, ProjectID: 1, UserID: 1, Role: role1, JoinedAt: now},
+					{ID: 2, ProjectID: 1, UserID: 2, Role: role2, JoinedAt: now},
+				}}, nil)
 			},
 			expectedStatusCode: http.StatusOK,
 		},
 		{
 			name: "retorna error cuando el service falla",
 			mockSetup: func(m *mockService.MockProjectMemberService) {
-				m.EXPECT().GetAllProjectMembers(gomock.Any()).Return(nil, errors.New("service error"))
+				m.EXPECT().GetAllProjectMembers(gomock.Any(), gomock.Any()).Return(nil, errors.New("service error"))
 			},
 			expectedStatusCode: http.StatusInternalServerError,
 		},
@@ -73,7 +76,7 @@ func TestGetProjectMemberByID(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
-	role := "Developer"
+	role := project_member.RoleContributor
 	now := time.Now()
 
 	tests := []struct {
@@ -87,7 +90,7 @@ func TestGetProjectMemberByID(t *testing.T) {
 			memberID: "1",
 			mockSetup: func(m *mockService.MockProjectMemberService) {
 				m.EXPECT().GetProjectMemberByID(gomock.Any(), 1).Return(&project_member.ProjectMember{
-					ID: 1, ProjectID: 1, UserID: 1, Role: &role, JoinedAt: now,
+					ID: 1, ProjectID: 1, UserID: 1, Role: role, JoinedAt: now,
 				}, nil)
 			},
 			expectedStatusCode: http.StatusOK,
@@ -102,7 +105,7 @@ func TestGetProjectMemberByID(t *testing.T) {
 			name:     "retorna error cuando miembro no existe",
 			memberID: "999",
 			mockSetup: func(m *mockService.MockProjectMemberService) {
-				m.EXPECT().GetProjectMemberByID(gomock.Any(), 999).Return(nil, errors.New("not found"))
+				m.EXPECT().GetProjectMemberByID(gomock.Any(), 999).Return(nil, errs.NotFound("no encontrado"))
 			},
 			expectedStatusCode: http.StatusNotFound,
 		},
@@ -127,7 +130,7 @@ func TestGetProjectMembersByProjectID(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
-	role := "Developer"
+	role := project_member.RoleContributor
 	now := time.Now()
 
 	tests := []struct {
@@ -141,7 +144,7 @@ func TestGetProjectMembersByProjectID(t *testing.T) {
 			projectID: "1",
 			mockSetup: func(m *mockService.MockProjectMemberService) {
 				m.EXPECT().GetProjectMembersByProjectID(gomock.Any(), 1).Return([]project_member.ProjectMember{
-					{ID: 1, ProjectID: 1, UserID: 1, Role: &role, JoinedAt: now},
+					{ID: 1, ProjectID: 1, UserID: 1, Role: role, JoinedAt: now},
 				}, nil)
 			},
 			expectedStatusCode: http.StatusOK,
@@ -181,7 +184,7 @@ func TestCreateProjectMember(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
-	role := "Developer"
+	role := project_member.RoleContributor
 
 	tests := []struct {
 		name               string
@@ -191,7 +194,7 @@ func TestCreateProjectMember(t *testing.T) {
 	}{
 		{
 			name:        "crea miembro exitosamente",
-			requestBody: CreateProjectMemberRequest{ProjectID: 1, UserID: 1, Role: &role},
+			requestBody: CreateProjectMemberRequest{ProjectID: 1, UserID: 1, Role: role},
 			mockSetup: func(m *mockService.MockProjectMemberService) {
 				m.EXPECT().CreateProjectMember(gomock.Any(), gomock.Any()).Return(nil)
 			},
@@ -205,7 +208,7 @@ func TestCreateProjectMember(t *testing.T) {
 		},
 		{
 			name:        "retorna error cuando el service falla",
-			requestBody: CreateProjectMemberRequest{ProjectID: 1, UserID: 1, Role: &role},
+			requestBody: CreateProjectMemberRequest{ProjectID: 1, UserID: 1, Role: role},
 			mockSetup: func(m *mockService.MockProjectMemberService) {
 				m.EXPECT().CreateProjectMember(gomock.Any(), gomock.Any()).Return(errors.New("service error"))
 			},
@@ -239,8 +242,8 @@ func TestUpdateProjectMember(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
-	role := "Developer"
-	updatedRole := "Lead Developer"
+	role := project_member.RoleContributor
+	updatedRole := project_member.RoleMaintainer
 	now := time.Now()
 
 	tests := []struct {
@@ -256,7 +259,7 @@ func TestUpdateProjectMember(t *testing.T) {
 			requestBody: UpdateProjectMemberRequest{Role: &updatedRole},
 			mockSetup: func(m *mockService.MockProjectMemberService) {
 				m.EXPECT().GetProjectMemberByID(gomock.Any(), 1).Return(&project_member.ProjectMember{
-					ID: 1, ProjectID: 1, UserID: 1, Role: &role, JoinedAt: now,
+					ID: 1, ProjectID: 1, UserID: 1, Role: role, JoinedAt: now,
 				}, nil)
 				m.EXPECT().UpdateProjectMember(gomock.Any(), gomock.Any()).Return(nil)
 			},
@@ -274,7 +277,7 @@ func TestUpdateProjectMember(t *testing.T) {
 			memberID:    "999",
 			requestBody: UpdateProjectMemberRequest{Role: &updatedRole},
 			mockSetup: func(m *mockService.MockProjectMemberService) {
-				m.EXPECT().GetProjectMemberByID(gomock.Any(), 999).Return(nil, errors.New("not found"))
+				m.EXPECT().GetProjectMemberByID(gomock.Any(), 999).Return(nil, errs.NotFound("no encontrado"))
 			},
 			expectedStatusCode: http.StatusNotFound,
 		},
@@ -284,7 +287,7 @@ func TestUpdateProjectMember(t *testing.T) {
 			requestBody: UpdateProjectMemberRequest{Role: &updatedRole},
 			mockSetup: func(m *mockService.MockProjectMemberService) {
 				m.EXPECT().GetProjectMemberByID(gomock.Any(), 1).Return(&project_member.ProjectMember{
-					ID: 1, ProjectID: 1, UserID: 1, Role: &role, JoinedAt: now,
+					ID: 1, ProjectID: 1, UserID: 1, Role: role, JoinedAt: now,
 				}, nil)
 				m.EXPECT().UpdateProjectMember(gomock.Any(), gomock.Any()).Return(errors.New("update error"))
 			},
@@ -346,6 +349,14 @@ func TestDeleteProjectMember(t *testing.T) {
 			},
 			expectedStatusCode: http.StatusInternalServerError,
 		},
+		{
+			name:     "retorna forbidden cuando el usuario no tiene acceso al proyecto",
+			memberID: "1",
+			mockSetup: func(m *mockService.MockProjectMemberService) {
+				m.EXPECT().DeleteProjectMember(gomock.Any(), 1).Return(services.ErrForbidden)
+			},
+			expectedStatusCode: http.StatusForbidden,
+		},
 	}
 
 	for _, tt := range tests {
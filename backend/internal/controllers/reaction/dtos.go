@@ -1,10 +1,17 @@
 package reaction
 
-import "time"
+import (
+	"softpharos/internal/controllers"
+	"time"
+)
+
+var listSpec = controllers.ListSpec{
+	Sortable: []string{"id", "created_at"},
+	Filters:  map[string]controllers.FilterKind{"milestone_id": controllers.FilterInt, "user_id": controllers.FilterInt, "type": controllers.FilterString},
+}
 
 type CreateReactionRequest struct {
 	MilestoneID int     `json:"milestone_id" binding:"required"`
-	UserID      int     `json:"user_id" binding:"required"`
 	Type        *string `json:"type"`
 }
 
@@ -12,6 +19,11 @@ type UpdateReactionRequest struct {
 	Type *string `json:"type"`
 }
 
+// SetReactionRequest indica si el usuario reacciona (true) o retira su reacción (false)
+type SetReactionRequest struct {
+	Reacted *bool `json:"reacted" binding:"required"`
+}
+
 type ReactionResponse struct {
 	ID          int                `json:"id"`
 	MilestoneID int                `json:"milestone_id"`
@@ -32,3 +44,9 @@ type UserResponse struct {
 	Name  *string `json:"name"`
 	Email string  `json:"email"`
 }
+
+type TypeCountResponse struct {
+	Type    string `json:"type"`
+	Count   int    `json:"count"`
+	Reacted bool   `json:"reacted"`
+}
@@ -6,10 +6,10 @@ import (
 	"softpharos/internal/core/domain/user"
 )
 
-func ToReactionDomain(req *CreateReactionRequest) *reaction.Reaction {
+func ToReactionDomain(req *CreateReactionRequest, userID int) *reaction.Reaction {
 	return &reaction.Reaction{
 		MilestoneID: req.MilestoneID,
-		UserID:      req.UserID,
+		UserID:      userID,
 		Type:        req.Type,
 	}
 }
@@ -68,3 +68,11 @@ func ToReactionListResponse(reactions []reaction.Reaction) []ReactionResponse {
 	}
 	return responses
 }
+
+func ToTypeCountListResponse(counts []reaction.TypeCount) []TypeCountResponse {
+	responses := make([]TypeCountResponse, len(counts))
+	for i, c := range counts {
+		responses[i] = TypeCountResponse{Type: c.Type, Count: c.Count, Reacted: c.Reacted}
+	}
+	return responses
+}
@@ -21,13 +21,19 @@ func New(reactionService services.ReactionService) *Controller {
 }
 
 func (c *Controller) GetAllReactions(ctx *gin.Context) {
-	reactions, err := c.reactionService.GetAllReactions(ctx.Request.Context())
+	params, err := controllers.ParseListQuery(ctx, listSpec)
 	if err != nil {
-		controllers.Response.InternalError(ctx, err.Error())
+		controllers.Response.BadRequest(ctx, err.Error())
 		return
 	}
 
-	controllers.Response.Success(ctx, http.StatusOK, ToReactionListResponse(reactions))
+	page, err := c.reactionService.GetAllReactions(ctx.Request.Context(), params)
+	if err != nil {
+		controllers.Response.FromError(ctx, err)
+		return
+	}
+
+	controllers.Response.Paginated(ctx, ToReactionListResponse(page.Items), controllers.ToPagination(page))
 }
 
 func (c *Controller) GetReactionByID(ctx *gin.Context) {
@@ -40,7 +46,7 @@ func (c *Controller) GetReactionByID(ctx *gin.Context) {
 
 	reaction, err := c.reactionService.GetReactionByID(ctx.Request.Context(), id)
 	if err != nil {
-		controllers.Response.NotFound(ctx, "Reacción no encontrada")
+		controllers.Response.FromError(ctx, err)
 		return
 	}
 
@@ -57,7 +63,7 @@ func (c *Controller) GetReactionsByMilestoneID(ctx *gin.Context) {
 
 	reactions, err := c.reactionService.GetReactionsByMilestoneID(ctx.Request.Context(), milestoneID)
 	if err != nil {
-		controllers.Response.InternalError(ctx, err.Error())
+		controllers.Response.FromError(ctx, err)
 		return
 	}
 
@@ -71,9 +77,15 @@ func (c *Controller) CreateReaction(ctx *gin.Context) {
 		return
 	}
 
-	reaction := ToReactionDomain(&req)
+	userID, ok := controllers.AuthenticatedUserID(ctx)
+	if !ok {
+		controllers.Response.Unauthorized(ctx, "Usuario no autenticado")
+		return
+	}
+
+	reaction := ToReactionDomain(&req, userID)
 	if err := c.reactionService.CreateReaction(ctx.Request.Context(), reaction); err != nil {
-		controllers.Response.InternalError(ctx, err.Error())
+		controllers.Response.FromError(ctx, err)
 		return
 	}
 
@@ -96,7 +108,7 @@ func (c *Controller) UpdateReaction(ctx *gin.Context) {
 
 	existingReaction, err := c.reactionService.GetReactionByID(ctx.Request.Context(), id)
 	if err != nil {
-		controllers.Response.NotFound(ctx, "Reacción no encontrada")
+		controllers.Response.FromError(ctx, err)
 		return
 	}
 
@@ -105,7 +117,7 @@ func (c *Controller) UpdateReaction(ctx *gin.Context) {
 	}
 
 	if err := c.reactionService.UpdateReaction(ctx.Request.Context(), existingReaction); err != nil {
-		controllers.Response.InternalError(ctx, err.Error())
+		controllers.Response.FromError(ctx, err)
 		return
 	}
 
@@ -121,7 +133,7 @@ func (c *Controller) DeleteReaction(ctx *gin.Context) {
 	}
 
 	if err := c.reactionService.DeleteReaction(ctx.Request.Context(), id); err != nil {
-		controllers.Response.InternalError(ctx, err.Error())
+		controllers.Response.FromError(ctx, err)
 		return
 	}
 
@@ -129,3 +141,58 @@ func (c *Controller) DeleteReaction(ctx *gin.Context) {
 		"message": "Reacción eliminada exitosamente",
 	})
 }
+
+func (c *Controller) GetReactionTypes(ctx *gin.Context) {
+	controllers.Response.Success(ctx, http.StatusOK, c.reactionService.GetReactionTypes())
+}
+
+func (c *Controller) GetReactionSummary(ctx *gin.Context) {
+	milestoneID, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		controllers.Response.InvalidID(ctx, "El ID del milestone debe ser un número válido")
+		return
+	}
+
+	userID, ok := controllers.AuthenticatedUserID(ctx)
+	if !ok {
+		controllers.Response.Unauthorized(ctx, "Usuario no autenticado")
+		return
+	}
+
+	summary, err := c.reactionService.GetReactionSummary(ctx.Request.Context(), milestoneID, userID)
+	if err != nil {
+		controllers.Response.FromError(ctx, err)
+		return
+	}
+
+	controllers.Response.Success(ctx, http.StatusOK, ToTypeCountListResponse(summary))
+}
+
+// SetReaction agrega o retira la reacción del usuario autenticado; repetirla es idempotente
+func (c *Controller) SetReaction(ctx *gin.Context) {
+	milestoneID, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		controllers.Response.InvalidID(ctx, "El ID del milestone debe ser un número válido")
+		return
+	}
+
+	var req SetReactionRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		controllers.Response.BadRequest(ctx, err.Error())
+		return
+	}
+
+	userID, ok := controllers.AuthenticatedUserID(ctx)
+	if !ok {
+		controllers.Response.Unauthorized(ctx, "Usuario no autenticado")
+		return
+	}
+
+	summary, err := c.reactionService.SetReaction(ctx.Request.Context(), milestoneID, userID, ctx.Param("type"), *req.Reacted)
+	if err != nil {
+		controllers.Response.FromError(ctx, err)
+		return
+	}
+
+	controllers.Response.Success(ctx, http.StatusOK, ToTypeCountListResponse(summary))
+}
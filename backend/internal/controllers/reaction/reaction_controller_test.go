@@ -13,7 +13,10 @@ import (
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
 
+	"softpharos/internal/controllers/testutil"
+	"softpharos/internal/core/domain/query"
 	"softpharos/internal/core/domain/reaction"
+	"softpharos/internal/core/errs"
 	mockService "softpharos/mocks/core/ports/services"
 )
 
@@ -38,17 +41,17 @@ func TestGetAllReactions(t *testing.T) {
 		{
 			name: "retorna todas las reacciones exitosamente",
 			mockSetup: func(m *mockService.MockReactionService) {
-				m.EXPECT().GetAllReactions(gomock.Any()).Return([]reaction.Reaction{
+				m.EXPECT().GetAllReactions(gomock.Any(), gomock.Any()).Return(&query.Page[reaction.Reaction]{Items: []reaction.Reaction{
 					{ID: 1, MilestoneID: 1, UserID: 1, Type: &rType1, CreatedAt: now},
 					{ID: 2, MilestoneID: 1, UserID: 2, Type: &rType2, CreatedAt: now},
-				}, nil)
+				}}, nil)
 			},
 			expectedStatusCode: http.StatusOK,
 		},
 		{
 			name: "retorna error cuando el service falla",
 			mockSetup: func(m *mockService.MockReactionService) {
-				m.EXPECT().GetAllReactions(gomock.Any()).Return(nil, errors.New("service error"))
+				m.EXPECT().GetAllReactions(gomock.Any(), gomock.Any()).Return(nil, errors.New("service error"))
 			},
 			expectedStatusCode: http.StatusInternalServerError,
 		},
@@ -102,7 +105,7 @@ func TestGetReactionByID(t *testing.T) {
 			name:       "retorna error cuando reacción no existe",
 			reactionID: "999",
 			mockSetup: func(m *mockService.MockReactionService) {
-				m.EXPECT().GetReactionByID(gomock.Any(), 999).Return(nil, errors.New("not found"))
+				m.EXPECT().GetReactionByID(gomock.Any(), 999).Return(nil, errs.NotFound("no encontrado"))
 			},
 			expectedStatusCode: http.StatusNotFound,
 		},
@@ -191,7 +194,7 @@ func TestCreateReaction(t *testing.T) {
 	}{
 		{
 			name:        "crea reacción exitosamente",
-			requestBody: CreateReactionRequest{MilestoneID: 1, UserID: 1, Type: &rType},
+			requestBody: CreateReactionRequest{MilestoneID: 1, Type: &rType},
 			mockSetup: func(m *mockService.MockReactionService) {
 				m.EXPECT().CreateReaction(gomock.Any(), gomock.Any()).Return(nil)
 			},
@@ -205,12 +208,22 @@ func TestCreateReaction(t *testing.T) {
 		},
 		{
 			name:        "retorna error cuando el service falla",
-			requestBody: CreateReactionRequest{MilestoneID: 1, UserID: 1, Type: &rType},
+			requestBody: CreateReactionRequest{MilestoneID: 1, Type: &rType},
 			mockSetup: func(m *mockService.MockReactionService) {
 				m.EXPECT().CreateReaction(gomock.Any(), gomock.Any()).Return(errors.New("service error"))
 			},
 			expectedStatusCode: http.StatusInternalServerError,
 		},
+		{
+			name:        "ignora el user_id enviado por el cliente y usa el usuario autenticado",
+			requestBody: map[string]interface{}{"milestone_id": 1, "user_id": 99, "type": "like"},
+			mockSetup: func(m *mockService.MockReactionService) {
+				m.EXPECT().
+					CreateReaction(gomock.Any(), gomock.Cond(func(x *reaction.Reaction) bool { return x.UserID == 1 })).
+					Return(nil)
+			},
+			expectedStatusCode: http.StatusCreated,
+		},
 	}
 
 	for _, tt := range tests {
@@ -219,7 +232,7 @@ func TestCreateReaction(t *testing.T) {
 			tt.mockSetup(mockSvc)
 			controller := New(mockSvc)
 			router := setupRouter()
-			router.POST("/reactions", controller.CreateReaction)
+			router.POST("/reactions", testutil.AuthenticatedAs(1), controller.CreateReaction)
 			var body []byte
 			if str, ok := tt.requestBody.(string); ok {
 				body = []byte(str)
@@ -235,6 +248,24 @@ func TestCreateReaction(t *testing.T) {
 	}
 }
 
+func TestCreateReaction_Unauthenticated(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockSvc := mockService.NewMockReactionService(ctrl)
+	controller := New(mockSvc)
+	router := setupRouter()
+	router.POST("/reactions", controller.CreateReaction)
+
+	body, _ := json.Marshal(map[string]interface{}{"milestone_id": 1, "user_id": 99, "type": "like"})
+	req, _ := http.NewRequest("POST", "/reactions", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+}
+
 func TestUpdateReaction(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -274,7 +305,7 @@ func TestUpdateReaction(t *testing.T) {
 			reactionID:  "999",
 			requestBody: UpdateReactionRequest{Type: &updatedType},
 			mockSetup: func(m *mockService.MockReactionService) {
-				m.EXPECT().GetReactionByID(gomock.Any(), 999).Return(nil, errors.New("not found"))
+				m.EXPECT().GetReactionByID(gomock.Any(), 999).Return(nil, errs.NotFound("no encontrado"))
 			},
 			expectedStatusCode: http.StatusNotFound,
 		},
@@ -338,6 +369,14 @@ func TestDeleteReaction(t *testing.T) {
 			mockSetup:          func(m *mockService.MockReactionService) {},
 			expectedStatusCode: http.StatusBadRequest,
 		},
+		{
+			name:       "retorna 403 cuando el usuario no es el autor",
+			reactionID: "1",
+			mockSetup: func(m *mockService.MockReactionService) {
+				m.EXPECT().DeleteReaction(gomock.Any(), 1).Return(errs.Forbidden("solo el autor puede modificar este recurso"))
+			},
+			expectedStatusCode: http.StatusForbidden,
+		},
 		{
 			name:       "retorna error cuando delete falla",
 			reactionID: "1",
@@ -362,3 +401,125 @@ func TestDeleteReaction(t *testing.T) {
 		})
 	}
 }
+
+func TestSetReaction(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	summary := []reaction.TypeCount{{Type: "like", Count: 1, Reacted: true}, {Type: "love"}}
+
+	tests := []struct {
+		name               string
+		path               string
+		requestBody        interface{}
+		mockSetup          func(*mockService.MockReactionService)
+		expectedStatusCode int
+	}{
+		{
+			name:        "agrega la reacción del usuario autenticado",
+			path:        "/milestones/1/reactions/like",
+			requestBody: map[string]interface{}{"reacted": true},
+			mockSetup: func(m *mockService.MockReactionService) {
+				m.EXPECT().SetReaction(gomock.Any(), 1, 5, "like", true).Return(summary, nil)
+			},
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name:        "retira la reacción",
+			path:        "/milestones/1/reactions/like",
+			requestBody: map[string]interface{}{"reacted": false},
+			mockSetup: func(m *mockService.MockReactionService) {
+				m.EXPECT().SetReaction(gomock.Any(), 1, 5, "like", false).Return(summary, nil)
+			},
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name:               "exige el campo reacted",
+			path:               "/milestones/1/reactions/like",
+			requestBody:        map[string]interface{}{},
+			mockSetup:          func(m *mockService.MockReactionService) {},
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:               "retorna error para ID inválido",
+			path:               "/milestones/abc/reactions/like",
+			requestBody:        map[string]interface{}{"reacted": true},
+			mockSetup:          func(m *mockService.MockReactionService) {},
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:        "rechaza un tipo fuera del catálogo",
+			path:        "/milestones/1/reactions/angry",
+			requestBody: map[string]interface{}{"reacted": true},
+			mockSetup: func(m *mockService.MockReactionService) {
+				m.EXPECT().SetReaction(gomock.Any(), 1, 5, "angry", true).Return(nil, errs.Validation("tipo de reacción inválido", nil))
+			},
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:        "retorna 404 si el hito no existe",
+			path:        "/milestones/9/reactions/like",
+			requestBody: map[string]interface{}{"reacted": true},
+			mockSetup: func(m *mockService.MockReactionService) {
+				m.EXPECT().SetReaction(gomock.Any(), 9, 5, "like", true).Return(nil, errs.NotFound("milestone no encontrado"))
+			},
+			expectedStatusCode: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockSvc := mockService.NewMockReactionService(ctrl)
+			tt.mockSetup(mockSvc)
+			controller := New(mockSvc)
+			router := setupRouter()
+			router.PUT("/milestones/:id/reactions/:type", testutil.AuthenticatedAs(5), controller.SetReaction)
+			body, _ := json.Marshal(tt.requestBody)
+			req, _ := http.NewRequest("PUT", tt.path, bytes.NewBuffer(body))
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+			assert.Equal(t, tt.expectedStatusCode, w.Code)
+		})
+	}
+}
+
+func TestGetReactionSummary(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockSvc := mockService.NewMockReactionService(ctrl)
+	mockSvc.EXPECT().GetReactionSummary(gomock.Any(), 1, 5).Return([]reaction.TypeCount{{Type: "like", Count: 2, Reacted: true}}, nil)
+	controller := New(mockSvc)
+	router := setupRouter()
+	router.GET("/milestones/:id/reactions", testutil.AuthenticatedAs(5), controller.GetReactionSummary)
+
+	req, _ := http.NewRequest("GET", "/milestones/1/reactions", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	var body struct {
+		Data []TypeCountResponse `json:"data"`
+	}
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	assert.Equal(t, []TypeCountResponse{{Type: "like", Count: 2, Reacted: true}}, body.Data)
+}
+
+func TestGetReactionTypes(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockSvc := mockService.NewMockReactionService(ctrl)
+	mockSvc.EXPECT().GetReactionTypes().Return([]string{"like", "love"})
+	controller := New(mockSvc)
+	router := setupRouter()
+	router.GET("/reactions/types", controller.GetReactionTypes)
+
+	req, _ := http.NewRequest("GET", "/reactions/types", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `["like","love"]`)
+}
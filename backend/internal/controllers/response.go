@@ -1,21 +1,35 @@
 package controllers
 
 import (
+	"errors"
+	"log"
 	"time"
 
 	"github.com/gin-gonic/gin"
+
+	"softpharos/internal/core/errs"
 )
 
 type APIResponse struct {
-	Success   bool        `json:"success"`
-	Data      interface{} `json:"data,omitempty"`
-	Error     *ErrorInfo  `json:"error,omitempty"`
-	Timestamp string      `json:"timestamp"`
+	Success    bool        `json:"success"`
+	Data       interface{} `json:"data,omitempty"`
+	Pagination *Pagination `json:"pagination,omitempty"`
+	Error      *ErrorInfo  `json:"error,omitempty"`
+	Timestamp  string      `json:"timestamp"`
+}
+
+type Pagination struct {
+	Page       int   `json:"page"`
+	PageSize   int   `json:"page_size"`
+	Total      int64 `json:"total"`
+	TotalPages int   `json:"total_pages"`
+	NextPage   *int  `json:"next_page"`
 }
 
 type ErrorInfo struct {
-	Code    string `json:"code"`
-	Message string `json:"message"`
+	Code    string            `json:"code"`
+	Message string            `json:"message"`
+	Details map[string]string `json:"details,omitempty"`
 }
 
 const (
@@ -26,6 +40,8 @@ const (
 	ErrCodeInvalidRequest = "INVALID_REQUEST"
 	ErrCodeUnauthorized   = "UNAUTHORIZED"
 	ErrCodeForbidden      = "FORBIDDEN"
+	ErrCodeConflict       = "CONFLICT"
+	ErrCodeValidation     = "VALIDATION_ERROR"
 )
 
 var Response = ResponseBuilder{}
@@ -40,6 +56,15 @@ func (ResponseBuilder) Success(ctx *gin.Context, statusCode int, data interface{
 	})
 }
 
+func (ResponseBuilder) Paginated(ctx *gin.Context, data interface{}, pagination *Pagination) {
+	ctx.JSON(200, APIResponse{
+		Success:    true,
+		Data:       data,
+		Pagination: pagination,
+		Timestamp:  time.Now().Format(time.RFC3339),
+	})
+}
+
 func (ResponseBuilder) Error(ctx *gin.Context, statusCode int, errorCode, message string) {
 	ctx.JSON(statusCode, APIResponse{
 		Success: false,
@@ -94,3 +119,62 @@ func (ResponseBuilder) InvalidID(ctx *gin.Context, message string) {
 		Timestamp: time.Now().Format(time.RFC3339),
 	})
 }
+
+func (ResponseBuilder) Unauthorized(ctx *gin.Context, message string) {
+	ctx.JSON(401, APIResponse{
+		Success: false,
+		Error: &ErrorInfo{
+			Code:    ErrCodeUnauthorized,
+			Message: message,
+		},
+		Timestamp: time.Now().Format(time.RFC3339),
+	})
+}
+
+func (ResponseBuilder) Forbidden(ctx *gin.Context, message string) {
+	ctx.JSON(403, APIResponse{
+		Success: false,
+		Error: &ErrorInfo{
+			Code:    ErrCodeForbidden,
+			Message: message,
+		},
+		Timestamp: time.Now().Format(time.RFC3339),
+	})
+}
+
+// FromError traduce los errores del núcleo (paquete errs) a la respuesta HTTP.
+// Cualquier otro error se registra y se responde como error interno sin
+// exponer su mensaje, que puede contener detalles de la base de datos.
+func (r ResponseBuilder) FromError(ctx *gin.Context, err error) {
+	var statusCode int
+	var code string
+
+	switch {
+	case errors.Is(err, errs.ErrNotFound):
+		statusCode, code = 404, ErrCodeNotFound
+	case errors.Is(err, errs.ErrConflict):
+		statusCode, code = 409, ErrCodeConflict
+	case errors.Is(err, errs.ErrForbidden):
+		statusCode, code = 403, ErrCodeForbidden
+	case errors.Is(err, errs.ErrUnauthorized):
+		statusCode, code = 401, ErrCodeUnauthorized
+	case errors.Is(err, errs.ErrValidation):
+		statusCode, code = 400, ErrCodeValidation
+	default:
+		log.Printf("Error interno en %s %s: %v", ctx.Request.Method, ctx.Request.URL.Path, err)
+		r.InternalError(ctx, "Error interno del servidor")
+		return
+	}
+
+	info := &ErrorInfo{Code: code, Message: err.Error()}
+	var typed *errs.Error
+	if errors.As(err, &typed) {
+		info.Details = typed.Fields
+	}
+
+	ctx.JSON(statusCode, APIResponse{
+		Success:   false,
+		Error:     info,
+		Timestamp: time.Now().Format(time.RFC3339),
+	})
+}
@@ -0,0 +1,89 @@
+package controllers
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+
+	"softpharos/internal/core/errs"
+)
+
+func TestFromError(t *testing.T) {
+	tests := []struct {
+		name            string
+		err             error
+		expectedStatus  int
+		expectedCode    string
+		expectedMessage string
+		expectedDetails map[string]string
+	}{
+		{
+			name:            "not found responde 404",
+			err:             errs.NotFound("Recurso no encontrado"),
+			expectedStatus:  http.StatusNotFound,
+			expectedCode:    ErrCodeNotFound,
+			expectedMessage: "Recurso no encontrado",
+		},
+		{
+			name:            "conflicto responde 409",
+			err:             errs.Conflict("Ya existe un registro con esos datos"),
+			expectedStatus:  http.StatusConflict,
+			expectedCode:    ErrCodeConflict,
+			expectedMessage: "Ya existe un registro con esos datos",
+		},
+		{
+			name:            "reconoce el error aunque venga envuelto",
+			err:             fmt.Errorf("%w: sin permiso sobre el proyecto", errs.Forbidden("acceso denegado")),
+			expectedStatus:  http.StatusForbidden,
+			expectedCode:    ErrCodeForbidden,
+			expectedMessage: "acceso denegado: sin permiso sobre el proyecto",
+		},
+		{
+			name:            "no autenticado responde 401",
+			err:             errs.Unauthorized("token inválido"),
+			expectedStatus:  http.StatusUnauthorized,
+			expectedCode:    ErrCodeUnauthorized,
+			expectedMessage: "token inválido",
+		},
+		{
+			name:            "validación incluye los campos inválidos",
+			err:             errs.Validation("el rol no existe", map[string]string{"role_id": "no existe"}),
+			expectedStatus:  http.StatusBadRequest,
+			expectedCode:    ErrCodeValidation,
+			expectedMessage: "el rol no existe",
+			expectedDetails: map[string]string{"role_id": "no existe"},
+		},
+		{
+			name:            "oculta el mensaje de errores desconocidos",
+			err:             errors.New("pq: connection refused"),
+			expectedStatus:  http.StatusInternalServerError,
+			expectedCode:    ErrCodeInternalError,
+			expectedMessage: "Error interno del servidor",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gin.SetMode(gin.TestMode)
+			w := httptest.NewRecorder()
+			ctx, _ := gin.CreateTestContext(w)
+			ctx.Request = httptest.NewRequest(http.MethodGet, "/recurso", nil)
+
+			Response.FromError(ctx, tt.err)
+
+			var body APIResponse
+			assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			assert.False(t, body.Success)
+			assert.Equal(t, tt.expectedCode, body.Error.Code)
+			assert.Equal(t, tt.expectedMessage, body.Error.Message)
+			assert.Equal(t, tt.expectedDetails, body.Error.Details)
+		})
+	}
+}
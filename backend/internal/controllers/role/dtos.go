@@ -1,6 +1,14 @@
 package role
 
-import "time"
+import (
+	"softpharos/internal/controllers"
+	"time"
+)
+
+var listSpec = controllers.ListSpec{
+	Sortable: []string{"id", "name", "created_at"},
+	Filters:  map[string]controllers.FilterKind{"name": controllers.FilterString},
+}
 
 type RoleResponse struct {
 	ID          int       `json:"id"`
@@ -8,3 +16,13 @@ type RoleResponse struct {
 	Description *string   `json:"description"`
 	CreatedAt   time.Time `json:"created_at"`
 }
+
+type CreateRoleRequest struct {
+	Name        string  `json:"name" binding:"required"`
+	Description *string `json:"description"`
+}
+
+type UpdateRoleRequest struct {
+	Name        *string `json:"name"`
+	Description *string `json:"description"`
+}
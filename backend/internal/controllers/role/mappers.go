@@ -24,3 +24,10 @@ func ToRoleListResponse(roles []role.Role) []RoleResponse {
 	}
 	return responses
 }
+
+func ToRoleDomain(req *CreateRoleRequest) *role.Role {
+	return &role.Role{
+		Name:        req.Name,
+		Description: req.Description,
+	}
+}
@@ -21,13 +21,19 @@ func New(roleService services.RoleService) *Controller {
 }
 
 func (c *Controller) GetAllRoles(ctx *gin.Context) {
-	roles, err := c.roleService.GetAllRoles(ctx.Request.Context())
+	params, err := controllers.ParseListQuery(ctx, listSpec)
 	if err != nil {
-		controllers.Response.InternalError(ctx, err.Error())
+		controllers.Response.BadRequest(ctx, err.Error())
 		return
 	}
 
-	controllers.Response.Success(ctx, http.StatusOK, ToRoleListResponse(roles))
+	page, err := c.roleService.GetAllRoles(ctx.Request.Context(), params)
+	if err != nil {
+		controllers.Response.FromError(ctx, err)
+		return
+	}
+
+	controllers.Response.Paginated(ctx, ToRoleListResponse(page.Items), controllers.ToPagination(page))
 }
 
 func (c *Controller) GetRoleByID(ctx *gin.Context) {
@@ -40,7 +46,7 @@ func (c *Controller) GetRoleByID(ctx *gin.Context) {
 
 	role, err := c.roleService.GetRoleByID(ctx.Request.Context(), id)
 	if err != nil {
-		controllers.Response.NotFound(ctx, "Rol no encontrado")
+		controllers.Response.FromError(ctx, err)
 		return
 	}
 
@@ -56,9 +62,78 @@ func (c *Controller) GetRoleByName(ctx *gin.Context) {
 
 	role, err := c.roleService.GetRoleByName(ctx.Request.Context(), name)
 	if err != nil {
-		controllers.Response.NotFound(ctx, "Rol no encontrado")
+		controllers.Response.FromError(ctx, err)
 		return
 	}
 
 	controllers.Response.Success(ctx, http.StatusOK, ToRoleResponse(role))
 }
+
+func (c *Controller) CreateRole(ctx *gin.Context) {
+	var req CreateRoleRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		controllers.Response.BadRequest(ctx, err.Error())
+		return
+	}
+
+	role := ToRoleDomain(&req)
+	if err := c.roleService.CreateRole(ctx.Request.Context(), role); err != nil {
+		controllers.Response.FromError(ctx, err)
+		return
+	}
+
+	controllers.Response.Success(ctx, http.StatusCreated, ToRoleResponse(role))
+}
+
+func (c *Controller) UpdateRole(ctx *gin.Context) {
+	idParam := ctx.Param("id")
+	id, err := strconv.Atoi(idParam)
+	if err != nil {
+		controllers.Response.InvalidID(ctx, "El ID debe ser un número válido")
+		return
+	}
+
+	var req UpdateRoleRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		controllers.Response.BadRequest(ctx, err.Error())
+		return
+	}
+
+	existingRole, err := c.roleService.GetRoleByID(ctx.Request.Context(), id)
+	if err != nil {
+		controllers.Response.FromError(ctx, err)
+		return
+	}
+
+	if req.Name != nil {
+		existingRole.Name = *req.Name
+	}
+	if req.Description != nil {
+		existingRole.Description = req.Description
+	}
+
+	if err := c.roleService.UpdateRole(ctx.Request.Context(), existingRole); err != nil {
+		controllers.Response.FromError(ctx, err)
+		return
+	}
+
+	controllers.Response.Success(ctx, http.StatusOK, ToRoleResponse(existingRole))
+}
+
+func (c *Controller) DeleteRole(ctx *gin.Context) {
+	idParam := ctx.Param("id")
+	id, err := strconv.Atoi(idParam)
+	if err != nil {
+		controllers.Response.InvalidID(ctx, "El ID debe ser un número válido")
+		return
+	}
+
+	if err := c.roleService.DeleteRole(ctx.Request.Context(), id); err != nil {
+		controllers.Response.FromError(ctx, err)
+		return
+	}
+
+	controllers.Response.Success(ctx, http.StatusOK, gin.H{
+		"message": "Rol eliminado exitosamente",
+	})
+}
@@ -1,10 +1,14 @@
 package role
 
 import (
+	"bytes"
 	"errors"
 	"net/http"
 	"net/http/httptest"
+	"softpharos/internal/core/domain/query"
 	"softpharos/internal/core/domain/role"
+	"softpharos/internal/core/errs"
+	"softpharos/internal/core/ports/services"
 	mockService "softpharos/mocks/core/ports/services"
 	"testing"
 	"time"
@@ -36,11 +40,11 @@ func TestGetAllRoles(t *testing.T) {
 			name: "retorna todos los roles exitosamente",
 			mockSetup: func(m *mockService.MockRoleService) {
 				m.EXPECT().
-					GetAllRoles(gomock.Any()).
-					Return([]role.Role{
+					GetAllRoles(gomock.Any(), gomock.Any()).
+					Return(&query.Page[role.Role]{Items: []role.Role{
 						{ID: 1, Name: "Admin", Description: &desc1, CreatedAt: now},
 						{ID: 2, Name: "Developer", Description: &desc2, CreatedAt: now},
-					}, nil)
+					}}, nil)
 			},
 			expectedStatusCode: http.StatusOK,
 		},
@@ -48,8 +52,8 @@ func TestGetAllRoles(t *testing.T) {
 			name: "retorna error cuando el service falla",
 			mockSetup: func(m *mockService.MockRoleService) {
 				m.EXPECT().
-					GetAllRoles(gomock.Any()).
-					Return([]role.Role{}, errors.New("service error"))
+					GetAllRoles(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("service error"))
 			},
 			expectedStatusCode: http.StatusInternalServerError,
 		},
@@ -107,7 +111,7 @@ func TestGetRoleByID(t *testing.T) {
 			mockSetup: func(m *mockService.MockRoleService) {
 				m.EXPECT().
 					GetRoleByID(gomock.Any(), 999).
-					Return(nil, errors.New("role not found"))
+					Return(nil, errs.NotFound("no encontrado"))
 			},
 			expectedStatusCode: http.StatusNotFound,
 		},
@@ -171,7 +175,7 @@ func TestGetRoleByName(t *testing.T) {
 			mockSetup: func(m *mockService.MockRoleService) {
 				m.EXPECT().
 					GetRoleByName(gomock.Any(), "NonExistent").
-					Return(nil, errors.New("role not found"))
+					Return(nil, errs.NotFound("no encontrado"))
 			},
 			expectedStatusCode: http.StatusNotFound,
 		},
@@ -194,3 +198,163 @@ func TestGetRoleByName(t *testing.T) {
 		})
 	}
 }
+
+func TestCreateRole(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tests := []struct {
+		name               string
+		requestBody        string
+		mockSetup          func(*mockService.MockRoleService)
+		expectedStatusCode int
+	}{
+		{
+			name:        "crea rol exitosamente",
+			requestBody: `{"name":"assistant"}`,
+			mockSetup: func(m *mockService.MockRoleService) {
+				m.EXPECT().
+					CreateRole(gomock.Any(), gomock.Any()).
+					Return(nil)
+			},
+			expectedStatusCode: http.StatusCreated,
+		},
+		{
+			name:               "retorna error sin nombre",
+			requestBody:        `{}`,
+			mockSetup:          func(m *mockService.MockRoleService) {},
+			expectedStatusCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockSvc := mockService.NewMockRoleService(ctrl)
+			tt.mockSetup(mockSvc)
+
+			controller := New(mockSvc)
+			router := setupRouter()
+			router.POST("/roles", controller.CreateRole)
+
+			req, _ := http.NewRequest("POST", "/roles", bytes.NewBufferString(tt.requestBody))
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatusCode, w.Code)
+		})
+	}
+}
+
+func TestUpdateRole(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tests := []struct {
+		name               string
+		roleID             string
+		requestBody        string
+		mockSetup          func(*mockService.MockRoleService)
+		expectedStatusCode int
+	}{
+		{
+			name:        "actualiza rol exitosamente",
+			roleID:      "4",
+			requestBody: `{"name":"tutor"}`,
+			mockSetup: func(m *mockService.MockRoleService) {
+				m.EXPECT().GetRoleByID(gomock.Any(), 4).Return(&role.Role{ID: 4, Name: "assistant"}, nil)
+				m.EXPECT().UpdateRole(gomock.Any(), gomock.Any()).Return(nil)
+			},
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name:        "retorna forbidden al renombrar un rol del sistema",
+			roleID:      "1",
+			requestBody: `{"name":"superadmin"}`,
+			mockSetup: func(m *mockService.MockRoleService) {
+				m.EXPECT().GetRoleByID(gomock.Any(), 1).Return(&role.Role{ID: 1, Name: role.Admin}, nil)
+				m.EXPECT().UpdateRole(gomock.Any(), gomock.Any()).Return(services.ErrProtectedRole)
+			},
+			expectedStatusCode: http.StatusForbidden,
+		},
+		{
+			name:        "retorna error cuando el rol no existe",
+			roleID:      "999",
+			requestBody: `{"name":"tutor"}`,
+			mockSetup: func(m *mockService.MockRoleService) {
+				m.EXPECT().GetRoleByID(gomock.Any(), 999).Return(nil, errs.NotFound("no encontrado"))
+			},
+			expectedStatusCode: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockSvc := mockService.NewMockRoleService(ctrl)
+			tt.mockSetup(mockSvc)
+
+			controller := New(mockSvc)
+			router := setupRouter()
+			router.PUT("/roles/:id", controller.UpdateRole)
+
+			req, _ := http.NewRequest("PUT", "/roles/"+tt.roleID, bytes.NewBufferString(tt.requestBody))
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatusCode, w.Code)
+		})
+	}
+}
+
+func TestDeleteRole(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tests := []struct {
+		name               string
+		roleID             string
+		mockSetup          func(*mockService.MockRoleService)
+		expectedStatusCode int
+	}{
+		{
+			name:   "elimina rol exitosamente",
+			roleID: "4",
+			mockSetup: func(m *mockService.MockRoleService) {
+				m.EXPECT().DeleteRole(gomock.Any(), 4).Return(nil)
+			},
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name:   "retorna forbidden para un rol del sistema",
+			roleID: "1",
+			mockSetup: func(m *mockService.MockRoleService) {
+				m.EXPECT().DeleteRole(gomock.Any(), 1).Return(services.ErrProtectedRole)
+			},
+			expectedStatusCode: http.StatusForbidden,
+		},
+		{
+			name:               "retorna error cuando el ID es inválido",
+			roleID:             "invalid",
+			mockSetup:          func(m *mockService.MockRoleService) {},
+			expectedStatusCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockSvc := mockService.NewMockRoleService(ctrl)
+			tt.mockSetup(mockSvc)
+
+			controller := New(mockSvc)
+			router := setupRouter()
+			router.DELETE("/roles/:id", controller.DeleteRole)
+
+			req, _ := http.NewRequest("DELETE", "/roles/"+tt.roleID, nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatusCode, w.Code)
+		})
+	}
+}
@@ -0,0 +1,29 @@
+package signup_rule
+
+import (
+	"softpharos/internal/controllers"
+	"time"
+)
+
+var listSpec = controllers.ListSpec{
+	Sortable: []string{"id", "pattern", "created_at"},
+	Filters:  map[string]controllers.FilterKind{"role_id": controllers.FilterInt},
+}
+
+type CreateSignupRuleRequest struct {
+	Pattern string `json:"pattern" binding:"required"` // email exacto o dominio
+	RoleID  int    `json:"role_id" binding:"required"`
+}
+
+type UpdateSignupRuleRequest struct {
+	Pattern *string `json:"pattern"`
+	RoleID  *int    `json:"role_id"`
+}
+
+type SignupRuleResponse struct {
+	ID        int       `json:"id"`
+	Pattern   string    `json:"pattern"`
+	RoleID    int       `json:"role_id"`
+	RoleName  string    `json:"role_name,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+}
@@ -0,0 +1,37 @@
+package signup_rule
+
+import (
+	"softpharos/internal/core/domain/signup_rule"
+)
+
+func ToSignupRuleDomain(req *CreateSignupRuleRequest) *signup_rule.SignupRule {
+	return &signup_rule.SignupRule{
+		Pattern: req.Pattern,
+		RoleID:  req.RoleID,
+	}
+}
+
+func ToSignupRuleResponse(r *signup_rule.SignupRule) *SignupRuleResponse {
+	if r == nil {
+		return nil
+	}
+
+	response := &SignupRuleResponse{
+		ID:        r.ID,
+		Pattern:   r.Pattern,
+		RoleID:    r.RoleID,
+		CreatedAt: r.CreatedAt,
+	}
+	if r.Role != nil {
+		response.RoleName = r.Role.Name
+	}
+	return response
+}
+
+func ToSignupRuleListResponse(rules []signup_rule.SignupRule) []SignupRuleResponse {
+	responses := make([]SignupRuleResponse, len(rules))
+	for i, r := range rules {
+		responses[i] = *ToSignupRuleResponse(&r)
+	}
+	return responses
+}
@@ -0,0 +1,121 @@
+package signup_rule
+
+import (
+	"net/http"
+	"softpharos/internal/controllers"
+	"strconv"
+
+	"softpharos/internal/core/ports/services"
+
+	"github.com/gin-gonic/gin"
+)
+
+type Controller struct {
+	signupRuleService services.SignupRuleService
+}
+
+func New(signupRuleService services.SignupRuleService) *Controller {
+	return &Controller{
+		signupRuleService: signupRuleService,
+	}
+}
+
+func (c *Controller) GetAllSignupRules(ctx *gin.Context) {
+	params, err := controllers.ParseListQuery(ctx, listSpec)
+	if err != nil {
+		controllers.Response.BadRequest(ctx, err.Error())
+		return
+	}
+
+	page, err := c.signupRuleService.GetAllSignupRules(ctx.Request.Context(), params)
+	if err != nil {
+		controllers.Response.FromError(ctx, err)
+		return
+	}
+
+	controllers.Response.Paginated(ctx, ToSignupRuleListResponse(page.Items), controllers.ToPagination(page))
+}
+
+func (c *Controller) GetSignupRuleByID(ctx *gin.Context) {
+	id, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		controllers.Response.InvalidID(ctx, "El ID debe ser un número válido")
+		return
+	}
+
+	rule, err := c.signupRuleService.GetSignupRuleByID(ctx.Request.Context(), id)
+	if err != nil {
+		controllers.Response.FromError(ctx, err)
+		return
+	}
+
+	controllers.Response.Success(ctx, http.StatusOK, ToSignupRuleResponse(rule))
+}
+
+func (c *Controller) CreateSignupRule(ctx *gin.Context) {
+	var req CreateSignupRuleRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		controllers.Response.BadRequest(ctx, err.Error())
+		return
+	}
+
+	rule := ToSignupRuleDomain(&req)
+	if err := c.signupRuleService.CreateSignupRule(ctx.Request.Context(), rule); err != nil {
+		controllers.Response.FromError(ctx, err)
+		return
+	}
+
+	controllers.Response.Success(ctx, http.StatusCreated, ToSignupRuleResponse(rule))
+}
+
+func (c *Controller) UpdateSignupRule(ctx *gin.Context) {
+	id, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		controllers.Response.InvalidID(ctx, "El ID debe ser un número válido")
+		return
+	}
+
+	var req UpdateSignupRuleRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		controllers.Response.BadRequest(ctx, err.Error())
+		return
+	}
+
+	existingRule, err := c.signupRuleService.GetSignupRuleByID(ctx.Request.Context(), id)
+	if err != nil {
+		controllers.Response.FromError(ctx, err)
+		return
+	}
+
+	if req.Pattern != nil {
+		existingRule.Pattern = *req.Pattern
+	}
+	if req.RoleID != nil {
+		existingRule.RoleID = *req.RoleID
+		existingRule.Role = nil
+	}
+
+	if err := c.signupRuleService.UpdateSignupRule(ctx.Request.Context(), existingRule); err != nil {
+		controllers.Response.FromError(ctx, err)
+		return
+	}
+
+	controllers.Response.Success(ctx, http.StatusOK, ToSignupRuleResponse(existingRule))
+}
+
+func (c *Controller) DeleteSignupRule(ctx *gin.Context) {
+	id, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		controllers.Response.InvalidID(ctx, "El ID debe ser un número válido")
+		return
+	}
+
+	if err := c.signupRuleService.DeleteSignupRule(ctx.Request.Context(), id); err != nil {
+		controllers.Response.FromError(ctx, err)
+		return
+	}
+
+	controllers.Response.Success(ctx, http.StatusOK, gin.H{
+		"message": "Regla de registro eliminada exitosamente",
+	})
+}
@@ -0,0 +1,181 @@
+package signup_rule
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"softpharos/internal/core/domain/query"
+	"softpharos/internal/core/domain/role"
+	"softpharos/internal/core/domain/signup_rule"
+	"softpharos/internal/core/errs"
+	"softpharos/internal/core/ports/services"
+	mockService "softpharos/mocks/core/ports/services"
+)
+
+func setupRouter() *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	return gin.New()
+}
+
+func TestGetAllSignupRules(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockSvc := mockService.NewMockSignupRuleService(ctrl)
+	mockSvc.EXPECT().GetAllSignupRules(gomock.Any(), gomock.Any()).Return(&query.Page[signup_rule.SignupRule]{Items: []signup_rule.SignupRule{
+		{ID: 1, Pattern: "unal.edu.co", RoleID: 2, Role: &role.Role{ID: 2, Name: role.Professor}},
+	}}, nil)
+
+	router := setupRouter()
+	router.GET("/signup-rules", New(mockSvc).GetAllSignupRules)
+
+	req, _ := http.NewRequest("GET", "/signup-rules", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"role_name":"professor"`)
+}
+
+func TestCreateSignupRule(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tests := []struct {
+		name               string
+		requestBody        string
+		mockSetup          func(*mockService.MockSignupRuleService)
+		expectedStatusCode int
+	}{
+		{
+			name:        "crea la regla exitosamente",
+			requestBody: `{"pattern":"unal.edu.co","role_id":2}`,
+			mockSetup: func(m *mockService.MockSignupRuleService) {
+				m.EXPECT().CreateSignupRule(gomock.Any(), &signup_rule.SignupRule{Pattern: "unal.edu.co", RoleID: 2}).Return(nil)
+			},
+			expectedStatusCode: http.StatusCreated,
+		},
+		{
+			name:               "retorna error cuando faltan campos",
+			requestBody:        `{"pattern":"unal.edu.co"}`,
+			mockSetup:          func(m *mockService.MockSignupRuleService) {},
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:        "retorna 400 cuando la regla es inválida",
+			requestBody: `{"pattern":"localhost","role_id":2}`,
+			mockSetup: func(m *mockService.MockSignupRuleService) {
+				m.EXPECT().CreateSignupRule(gomock.Any(), gomock.Any()).
+					Return(fmt.Errorf("%w: el patrón debe ser un email o un dominio", services.ErrInvalidSignupRule))
+			},
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:        "retorna error cuando el servicio falla",
+			requestBody: `{"pattern":"unal.edu.co","role_id":2}`,
+			mockSetup: func(m *mockService.MockSignupRuleService) {
+				m.EXPECT().CreateSignupRule(gomock.Any(), gomock.Any()).Return(errors.New("database error"))
+			},
+			expectedStatusCode: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockSvc := mockService.NewMockSignupRuleService(ctrl)
+			tt.mockSetup(mockSvc)
+
+			router := setupRouter()
+			router.POST("/signup-rules", New(mockSvc).CreateSignupRule)
+
+			req, _ := http.NewRequest("POST", "/signup-rules", bytes.NewBufferString(tt.requestBody))
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatusCode, w.Code)
+		})
+	}
+}
+
+func TestUpdateSignupRule(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tests := []struct {
+		name               string
+		id                 string
+		requestBody        string
+		mockSetup          func(*mockService.MockSignupRuleService)
+		expectedStatusCode int
+	}{
+		{
+			name:        "cambia el rol de la regla",
+			id:          "1",
+			requestBody: `{"role_id":1}`,
+			mockSetup: func(m *mockService.MockSignupRuleService) {
+				m.EXPECT().GetSignupRuleByID(gomock.Any(), 1).Return(&signup_rule.SignupRule{ID: 1, Pattern: "unal.edu.co", RoleID: 2}, nil)
+				m.EXPECT().UpdateSignupRule(gomock.Any(), &signup_rule.SignupRule{ID: 1, Pattern: "unal.edu.co", RoleID: 1}).Return(nil)
+			},
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name:        "retorna 404 cuando la regla no existe",
+			id:          "9",
+			requestBody: `{"role_id":1}`,
+			mockSetup: func(m *mockService.MockSignupRuleService) {
+				m.EXPECT().GetSignupRuleByID(gomock.Any(), 9).Return(nil, errs.NotFound("no encontrado"))
+			},
+			expectedStatusCode: http.StatusNotFound,
+		},
+		{
+			name:               "retorna error con ID inválido",
+			id:                 "abc",
+			requestBody:        `{"role_id":1}`,
+			mockSetup:          func(m *mockService.MockSignupRuleService) {},
+			expectedStatusCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockSvc := mockService.NewMockSignupRuleService(ctrl)
+			tt.mockSetup(mockSvc)
+
+			router := setupRouter()
+			router.PUT("/signup-rules/:id", New(mockSvc).UpdateSignupRule)
+
+			req, _ := http.NewRequest("PUT", "/signup-rules/"+tt.id, bytes.NewBufferString(tt.requestBody))
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatusCode, w.Code)
+		})
+	}
+}
+
+func TestDeleteSignupRule(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockSvc := mockService.NewMockSignupRuleService(ctrl)
+	mockSvc.EXPECT().DeleteSignupRule(gomock.Any(), 1).Return(nil)
+
+	router := setupRouter()
+	router.DELETE("/signup-rules/:id", New(mockSvc).DeleteSignupRule)
+
+	req, _ := http.NewRequest("DELETE", "/signup-rules/1", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+}
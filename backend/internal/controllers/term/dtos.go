@@ -0,0 +1,53 @@
+package term
+
+import (
+	"softpharos/internal/controllers"
+	"time"
+)
+
+var listSpec = controllers.ListSpec{
+	Sortable: []string{"id", "code", "start_date", "end_date"},
+	Filters:  map[string]controllers.FilterKind{"code": controllers.FilterString},
+}
+
+// Las fechas de un periodo viajan como días calendario, en formato 2006-01-02
+type CreateTermRequest struct {
+	Code       string         `json:"code" binding:"required"`
+	StartDate  string         `json:"start_date" binding:"required,datetime=2006-01-02"`
+	EndDate    string         `json:"end_date" binding:"required,datetime=2006-01-02"`
+	WeekLength int            `json:"week_length"` // días por semana de clase, 7 si se omite
+	Breaks     []BreakRequest `json:"breaks" binding:"dive"`
+}
+
+// UpdateTermRequest reemplaza todos los recesos cuando incluye breaks
+type UpdateTermRequest struct {
+	Code       *string         `json:"code"`
+	StartDate  *string         `json:"start_date" binding:"omitempty,datetime=2006-01-02"`
+	EndDate    *string         `json:"end_date" binding:"omitempty,datetime=2006-01-02"`
+	WeekLength *int            `json:"week_length"`
+	Breaks     *[]BreakRequest `json:"breaks" binding:"omitempty,dive"`
+}
+
+type BreakRequest struct {
+	Name      string `json:"name" binding:"required"`
+	StartDate string `json:"start_date" binding:"required,datetime=2006-01-02"`
+	EndDate   string `json:"end_date" binding:"required,datetime=2006-01-02"`
+}
+
+type TermResponse struct {
+	ID         int             `json:"id"`
+	Code       string          `json:"code"`
+	StartDate  string          `json:"start_date"`
+	EndDate    string          `json:"end_date"`
+	WeekLength int             `json:"week_length"`
+	ClassWeeks int             `json:"class_weeks"`
+	Breaks     []BreakResponse `json:"breaks"`
+	CreatedAt  time.Time       `json:"created_at"`
+}
+
+type BreakResponse struct {
+	ID        int    `json:"id"`
+	Name      string `json:"name"`
+	StartDate string `json:"start_date"`
+	EndDate   string `json:"end_date"`
+}
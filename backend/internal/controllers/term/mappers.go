@@ -0,0 +1,74 @@
+package term
+
+import (
+	"time"
+
+	"softpharos/internal/core/domain/term"
+)
+
+// ToTermDomain convierte la petición; las fechas ya vienen validadas por el binding
+func ToTermDomain(req *CreateTermRequest) *term.Term {
+	return &term.Term{
+		Code:       req.Code,
+		StartDate:  parseDate(req.StartDate),
+		EndDate:    parseDate(req.EndDate),
+		WeekLength: req.WeekLength,
+		Breaks:     ToBreaksDomain(req.Breaks),
+	}
+}
+
+func ToBreaksDomain(reqs []BreakRequest) []term.Break {
+	if len(reqs) == 0 {
+		return nil
+	}
+
+	breaks := make([]term.Break, len(reqs))
+	for i, req := range reqs {
+		breaks[i] = term.Break{
+			Name:      req.Name,
+			StartDate: parseDate(req.StartDate),
+			EndDate:   parseDate(req.EndDate),
+		}
+	}
+	return breaks
+}
+
+func ToTermResponse(t *term.Term) *TermResponse {
+	if t == nil {
+		return nil
+	}
+
+	breaks := make([]BreakResponse, len(t.Breaks))
+	for i, b := range t.Breaks {
+		breaks[i] = BreakResponse{
+			ID:        b.ID,
+			Name:      b.Name,
+			StartDate: b.StartDate.Format(time.DateOnly),
+			EndDate:   b.EndDate.Format(time.DateOnly),
+		}
+	}
+
+	return &TermResponse{
+		ID:         t.ID,
+		Code:       t.Code,
+		StartDate:  t.StartDate.Format(time.DateOnly),
+		EndDate:    t.EndDate.Format(time.DateOnly),
+		WeekLength: t.WeekLength,
+		ClassWeeks: t.ClassWeeks(),
+		Breaks:     breaks,
+		CreatedAt:  t.CreatedAt,
+	}
+}
+
+func ToTermListResponse(terms []term.Term) []TermResponse {
+	responses := make([]TermResponse, len(terms))
+	for i, t := range terms {
+		responses[i] = *ToTermResponse(&t)
+	}
+	return responses
+}
+
+func parseDate(value string) time.Time {
+	date, _ := time.Parse(time.DateOnly, value)
+	return date
+}
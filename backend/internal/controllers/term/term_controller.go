@@ -0,0 +1,129 @@
+package term
+
+import (
+	"net/http"
+	"softpharos/internal/controllers"
+	"strconv"
+
+	"softpharos/internal/core/ports/services"
+
+	"github.com/gin-gonic/gin"
+)
+
+type Controller struct {
+	termService services.TermService
+}
+
+func New(termService services.TermService) *Controller {
+	return &Controller{
+		termService: termService,
+	}
+}
+
+func (c *Controller) GetAllTerms(ctx *gin.Context) {
+	params, err := controllers.ParseListQuery(ctx, listSpec)
+	if err != nil {
+		controllers.Response.BadRequest(ctx, err.Error())
+		return
+	}
+
+	page, err := c.termService.GetAllTerms(ctx.Request.Context(), params)
+	if err != nil {
+		controllers.Response.FromError(ctx, err)
+		return
+	}
+
+	controllers.Response.Paginated(ctx, ToTermListResponse(page.Items), controllers.ToPagination(page))
+}
+
+func (c *Controller) GetTermByID(ctx *gin.Context) {
+	id, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		controllers.Response.InvalidID(ctx, "El ID debe ser un número válido")
+		return
+	}
+
+	t, err := c.termService.GetTermByID(ctx.Request.Context(), id)
+	if err != nil {
+		controllers.Response.FromError(ctx, err)
+		return
+	}
+
+	controllers.Response.Success(ctx, http.StatusOK, ToTermResponse(t))
+}
+
+func (c *Controller) CreateTerm(ctx *gin.Context) {
+	var req CreateTermRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		controllers.Response.BadRequest(ctx, err.Error())
+		return
+	}
+
+	t := ToTermDomain(&req)
+	if err := c.termService.CreateTerm(ctx.Request.Context(), t); err != nil {
+		controllers.Response.FromError(ctx, err)
+		return
+	}
+
+	controllers.Response.Success(ctx, http.StatusCreated, ToTermResponse(t))
+}
+
+func (c *Controller) UpdateTerm(ctx *gin.Context) {
+	id, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		controllers.Response.InvalidID(ctx, "El ID debe ser un número válido")
+		return
+	}
+
+	var req UpdateTermRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		controllers.Response.BadRequest(ctx, err.Error())
+		return
+	}
+
+	existingTerm, err := c.termService.GetTermByID(ctx.Request.Context(), id)
+	if err != nil {
+		controllers.Response.FromError(ctx, err)
+		return
+	}
+
+	if req.Code != nil {
+		existingTerm.Code = *req.Code
+	}
+	if req.StartDate != nil {
+		existingTerm.StartDate = parseDate(*req.StartDate)
+	}
+	if req.EndDate != nil {
+		existingTerm.EndDate = parseDate(*req.EndDate)
+	}
+	if req.WeekLength != nil {
+		existingTerm.WeekLength = *req.WeekLength
+	}
+	if req.Breaks != nil {
+		existingTerm.Breaks = ToBreaksDomain(*req.Breaks)
+	}
+
+	if err := c.termService.UpdateTerm(ctx.Request.Context(), existingTerm); err != nil {
+		controllers.Response.FromError(ctx, err)
+		return
+	}
+
+	controllers.Response.Success(ctx, http.StatusOK, ToTermResponse(existingTerm))
+}
+
+func (c *Controller) DeleteTerm(ctx *gin.Context) {
+	id, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		controllers.Response.InvalidID(ctx, "El ID debe ser un número válido")
+		return
+	}
+
+	if err := c.termService.DeleteTerm(ctx.Request.Context(), id); err != nil {
+		controllers.Response.FromError(ctx, err)
+		return
+	}
+
+	controllers.Response.Success(ctx, http.StatusOK, gin.H{
+		"message": "Periodo eliminado exitosamente",
+	})
+}
@@ -0,0 +1,101 @@
+package term
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"softpharos/internal/core/domain/term"
+	"softpharos/internal/core/ports/services"
+	mockService "softpharos/mocks/core/ports/services"
+)
+
+func setupRouter() *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	return gin.New()
+}
+
+func TestCreateTerm(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	expected := &term.Term{
+		Code:      "2026-1",
+		StartDate: time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC),
+		EndDate:   time.Date(2026, 7, 3, 0, 0, 0, 0, time.UTC),
+	}
+
+	tests := []struct {
+		name               string
+		requestBody        string
+		mockSetup          func(*mockService.MockTermService)
+		expectedStatusCode int
+		expectedBody       string
+	}{
+		{
+			name:        "crea el periodo con fechas de calendario",
+			requestBody: `{"code":"2026-1","start_date":"2026-03-02","end_date":"2026-07-03"}`,
+			mockSetup: func(m *mockService.MockTermService) {
+				m.EXPECT().CreateTerm(gomock.Any(), expected).Return(nil)
+			},
+			expectedStatusCode: http.StatusCreated,
+			expectedBody:       `"start_date":"2026-03-02"`,
+		},
+		{
+			name:        "crea el periodo con sus recesos",
+			requestBody: `{"code":"2026-1","start_date":"2026-03-02","end_date":"2026-07-03","breaks":[{"name":"Semana santa","start_date":"2026-03-30","end_date":"2026-04-05"}]}`,
+			mockSetup: func(m *mockService.MockTermService) {
+				m.EXPECT().CreateTerm(gomock.Any(), gomock.Cond(func(t *term.Term) bool {
+					return len(t.Breaks) == 1 && t.Breaks[0].StartDate.Equal(time.Date(2026, 3, 30, 0, 0, 0, 0, time.UTC))
+				})).Return(nil)
+			},
+			expectedStatusCode: http.StatusCreated,
+			expectedBody:       `"class_weeks":17`,
+		},
+		{
+			name:               "rechaza un receso sin nombre",
+			requestBody:        `{"code":"2026-1","start_date":"2026-03-02","end_date":"2026-07-03","breaks":[{"start_date":"2026-03-30","end_date":"2026-04-05"}]}`,
+			mockSetup:          func(m *mockService.MockTermService) {},
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:               "rechaza una fecha con formato inválido",
+			requestBody:        `{"code":"2026-1","start_date":"02/03/2026","end_date":"2026-07-03"}`,
+			mockSetup:          func(m *mockService.MockTermService) {},
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:        "retorna 400 cuando el periodo termina antes de comenzar",
+			requestBody: `{"code":"2026-1","start_date":"2026-07-03","end_date":"2026-03-02"}`,
+			mockSetup: func(m *mockService.MockTermService) {
+				m.EXPECT().CreateTerm(gomock.Any(), gomock.Any()).Return(services.ErrInvalidTerm)
+			},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedBody:       `"end_date"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockSvc := mockService.NewMockTermService(ctrl)
+			tt.mockSetup(mockSvc)
+
+			router := setupRouter()
+			router.POST("/terms", New(mockSvc).CreateTerm)
+
+			req, _ := http.NewRequest("POST", "/terms", bytes.NewBufferString(tt.requestBody))
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatusCode, w.Code)
+			assert.Contains(t, w.Body.String(), tt.expectedBody)
+		})
+	}
+}
@@ -0,0 +1,18 @@
+// Package testutil reúne utilidades compartidas por los tests de los
+// controladores
+package testutil
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"softpharos/internal/core/domain/identity"
+)
+
+// AuthenticatedAs simula el middleware de autenticación dejando en la petición
+// la identidad del usuario userID
+func AuthenticatedAs(userID int) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Request = c.Request.WithContext(identity.NewContext(c.Request.Context(), &identity.Identity{UserID: userID}))
+		c.Next()
+	}
+}
@@ -0,0 +1,55 @@
+package timeline
+
+import (
+	"softpharos/internal/controllers"
+	"time"
+)
+
+var listSpec = controllers.ListSpec{
+	Sortable: []string{"occurred_at"},
+	Filters:  map[string]controllers.FilterKind{"kind": controllers.FilterString, "milestone_id": controllers.FilterInt},
+}
+
+// EventResponse incluye solo el objeto que corresponde a kind. Actor es quien
+// comentó, reaccionó o dio la retroalimentación; los hitos y entregables no lo registran.
+type EventResponse struct {
+	Kind        string               `json:"kind"`
+	ID          int                  `json:"id"`
+	MilestoneID int                  `json:"milestone_id"`
+	OccurredAt  time.Time            `json:"occurred_at"`
+	Actor       *UserResponse        `json:"actor,omitempty"`
+	Milestone   *MilestoneResponse   `json:"milestone,omitempty"`
+	Deliverable *DeliverableResponse `json:"deliverable,omitempty"`
+	Feedback    *FeedbackResponse    `json:"feedback,omitempty"`
+	Comment     *CommentResponse     `json:"comment,omitempty"`
+	Reaction    *ReactionResponse    `json:"reaction,omitempty"`
+}
+
+type UserResponse struct {
+	ID    int     `json:"id"`
+	Name  *string `json:"name"`
+	Email string  `json:"email"`
+}
+
+type MilestoneResponse struct {
+	Title     *string `json:"title"`
+	ClassWeek *int    `json:"class_week"`
+	Status    string  `json:"status"`
+}
+
+type DeliverableResponse struct {
+	URL  string  `json:"url"`
+	Type *string `json:"type"`
+}
+
+type FeedbackResponse struct {
+	Content string `json:"content"`
+}
+
+type CommentResponse struct {
+	Content *string `json:"content"`
+}
+
+type ReactionResponse struct {
+	Type *string `json:"type"`
+}
@@ -0,0 +1,53 @@
+package timeline
+
+import (
+	"softpharos/internal/core/domain/timeline"
+	"softpharos/internal/core/domain/user"
+)
+
+func ToEventResponse(e *timeline.Event) *EventResponse {
+	response := &EventResponse{
+		Kind:        e.Kind,
+		ID:          e.ID,
+		MilestoneID: e.MilestoneID,
+		OccurredAt:  e.OccurredAt,
+	}
+
+	switch {
+	case e.Milestone != nil:
+		response.Milestone = &MilestoneResponse{Title: e.Milestone.Title, ClassWeek: e.Milestone.ClassWeek, Status: e.Milestone.Status}
+	case e.Deliverable != nil:
+		response.Deliverable = &DeliverableResponse{URL: e.Deliverable.URL, Type: e.Deliverable.Type}
+	case e.Feedback != nil:
+		response.Feedback = &FeedbackResponse{Content: e.Feedback.Content}
+		response.Actor = ToUserResponse(e.Feedback.Professor)
+	case e.Comment != nil:
+		response.Comment = &CommentResponse{Content: e.Comment.Content}
+		response.Actor = ToUserResponse(e.Comment.User)
+	case e.Reaction != nil:
+		response.Reaction = &ReactionResponse{Type: e.Reaction.Type}
+		response.Actor = ToUserResponse(e.Reaction.User)
+	}
+
+	return response
+}
+
+func ToUserResponse(u *user.User) *UserResponse {
+	if u == nil {
+		return nil
+	}
+
+	return &UserResponse{
+		ID:    u.ID,
+		Name:  u.Name,
+		Email: u.Email,
+	}
+}
+
+func ToEventListResponse(events []timeline.Event) []EventResponse {
+	responses := make([]EventResponse, len(events))
+	for i, e := range events {
+		responses[i] = *ToEventResponse(&e)
+	}
+	return responses
+}
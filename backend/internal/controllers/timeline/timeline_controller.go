@@ -0,0 +1,44 @@
+package timeline
+
+import (
+	"softpharos/internal/controllers"
+	"strconv"
+
+	"softpharos/internal/core/ports/services"
+
+	"github.com/gin-gonic/gin"
+)
+
+type Controller struct {
+	timelineService services.TimelineService
+}
+
+func New(timelineService services.TimelineService) *Controller {
+	return &Controller{
+		timelineService: timelineService,
+	}
+}
+
+// GetProjectTimeline lista los eventos del proyecto del más antiguo al más
+// reciente (?sort=-occurred_at invierte el orden); admite ?kind= y ?milestone_id=
+func (c *Controller) GetProjectTimeline(ctx *gin.Context) {
+	id, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		controllers.Response.InvalidID(ctx, "El ID del proyecto debe ser un número válido")
+		return
+	}
+
+	params, err := controllers.ParseListQuery(ctx, listSpec)
+	if err != nil {
+		controllers.Response.BadRequest(ctx, err.Error())
+		return
+	}
+
+	page, err := c.timelineService.GetProjectTimeline(ctx.Request.Context(), id, params)
+	if err != nil {
+		controllers.Response.FromError(ctx, err)
+		return
+	}
+
+	controllers.Response.Paginated(ctx, ToEventListResponse(page.Items), controllers.ToPagination(page))
+}
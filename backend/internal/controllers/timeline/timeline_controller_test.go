@@ -0,0 +1,100 @@
+package timeline
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"softpharos/internal/core/domain/comment"
+	"softpharos/internal/core/domain/query"
+	"softpharos/internal/core/domain/timeline"
+	"softpharos/internal/core/domain/user"
+	"softpharos/internal/core/errs"
+	mockService "softpharos/mocks/core/ports/services"
+)
+
+func setupRouter() *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	return gin.New()
+}
+
+func TestGetProjectTimeline(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	content := "Buen avance"
+	occurredAt := time.Date(2026, 3, 2, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name               string
+		url                string
+		mockSetup          func(*mockService.MockTimelineService)
+		expectedStatusCode int
+		expectedBody       string
+	}{
+		{
+			name: "lista los eventos con quien los hizo",
+			url:  "/projects/3/timeline?sort=-occurred_at&kind=comment",
+			mockSetup: func(m *mockService.MockTimelineService) {
+				m.EXPECT().
+					GetProjectTimeline(gomock.Any(), 3, query.Params{
+						Page:     1,
+						PageSize: query.DefaultPageSize,
+						Sort:     []query.Sort{{Field: "occurred_at", Desc: true}},
+						Filters:  map[string]any{"kind": timeline.KindComment},
+					}).
+					Return(&query.Page[timeline.Event]{Items: []timeline.Event{{
+						Kind:        timeline.KindComment,
+						ID:          9,
+						MilestoneID: 4,
+						OccurredAt:  occurredAt,
+						Comment:     &comment.Comment{ID: 9, Content: &content, User: &user.User{ID: 2, Email: "ana@unal.edu.co"}},
+					}}, Total: 1}, nil)
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedBody:       `"actor":{"id":2,"name":null,"email":"ana@unal.edu.co"},"comment":{"content":"Buen avance"}`,
+		},
+		{
+			name: "retorna 404 cuando el proyecto no existe",
+			url:  "/projects/3/timeline",
+			mockSetup: func(m *mockService.MockTimelineService) {
+				m.EXPECT().GetProjectTimeline(gomock.Any(), 3, gomock.Any()).Return(nil, errs.NotFound("proyecto no encontrado"))
+			},
+			expectedStatusCode: http.StatusNotFound,
+		},
+		{
+			name:               "rechaza ordenar por un campo no permitido",
+			url:                "/projects/3/timeline?sort=kind",
+			mockSetup:          func(m *mockService.MockTimelineService) {},
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:               "rechaza un ID inválido",
+			url:                "/projects/abc/timeline",
+			mockSetup:          func(m *mockService.MockTimelineService) {},
+			expectedStatusCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockSvc := mockService.NewMockTimelineService(ctrl)
+			tt.mockSetup(mockSvc)
+
+			router := setupRouter()
+			router.GET("/projects/:id/timeline", New(mockSvc).GetProjectTimeline)
+
+			req, _ := http.NewRequest("GET", tt.url, nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatusCode, w.Code)
+			assert.Contains(t, w.Body.String(), tt.expectedBody)
+		})
+	}
+}
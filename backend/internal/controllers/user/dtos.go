@@ -1,6 +1,14 @@
 package user
 
-import "time"
+import (
+	"softpharos/internal/controllers"
+	"time"
+)
+
+var listSpec = controllers.ListSpec{
+	Sortable: []string{"id", "name", "email", "created_at"},
+	Filters:  map[string]controllers.FilterKind{"role_id": controllers.FilterInt, "email": controllers.FilterString},
+}
 
 type CreateUserRequest struct {
 	Name       *string `json:"name"`
@@ -15,6 +23,10 @@ type UpdateUserRequest struct {
 	RoleID *int    `json:"role_id"`
 }
 
+type ChangeUserRoleRequest struct {
+	RoleID int `json:"role_id" binding:"required"`
+}
+
 type UserResponse struct {
 	ID         int           `json:"id"`
 	Name       *string       `json:"name"`
@@ -21,13 +21,19 @@ func New(userService services.UserService) *Controller {
 }
 
 func (c *Controller) GetAllUsers(ctx *gin.Context) {
-	users, err := c.userService.GetAllUsers(ctx.Request.Context())
+	params, err := controllers.ParseListQuery(ctx, listSpec)
 	if err != nil {
-		controllers.Response.InternalError(ctx, err.Error())
+		controllers.Response.BadRequest(ctx, err.Error())
 		return
 	}
 
-	controllers.Response.Success(ctx, http.StatusOK, ToUserListResponse(users))
+	page, err := c.userService.GetAllUsers(ctx.Request.Context(), params)
+	if err != nil {
+		controllers.Response.FromError(ctx, err)
+		return
+	}
+
+	controllers.Response.Paginated(ctx, ToUserListResponse(page.Items), controllers.ToPagination(page))
 }
 
 func (c *Controller) GetUserByID(ctx *gin.Context) {
@@ -40,7 +46,7 @@ func (c *Controller) GetUserByID(ctx *gin.Context) {
 
 	user, err := c.userService.GetUserByID(ctx.Request.Context(), id)
 	if err != nil {
-		controllers.Response.NotFound(ctx, "Usuario no encontrado")
+		controllers.Response.FromError(ctx, err)
 		return
 	}
 
@@ -56,7 +62,7 @@ func (c *Controller) GetUserByEmail(ctx *gin.Context) {
 
 	user, err := c.userService.GetUserByEmail(ctx.Request.Context(), email)
 	if err != nil {
-		controllers.Response.NotFound(ctx, "Usuario no encontrado")
+		controllers.Response.FromError(ctx, err)
 		return
 	}
 
@@ -72,7 +78,7 @@ func (c *Controller) CreateUser(ctx *gin.Context) {
 
 	user := ToUserDomain(&req)
 	if err := c.userService.CreateUser(ctx.Request.Context(), user); err != nil {
-		controllers.Response.InternalError(ctx, err.Error())
+		controllers.Response.FromError(ctx, err)
 		return
 	}
 
@@ -95,20 +101,25 @@ func (c *Controller) UpdateUser(ctx *gin.Context) {
 
 	existingUser, err := c.userService.GetUserByID(ctx.Request.Context(), id)
 	if err != nil {
-		controllers.Response.NotFound(ctx, "Usuario no encontrado")
+		controllers.Response.FromError(ctx, err)
 		return
 	}
 
 	if req.Name != nil {
 		existingUser.Name = req.Name
-	}
-	if req.RoleID != nil {
-		existingUser.RoleID = *req.RoleID
+		if err := c.userService.UpdateUser(ctx.Request.Context(), existingUser); err != nil {
+			controllers.Response.FromError(ctx, err)
+			return
+		}
 	}
 
-	if err := c.userService.UpdateUser(ctx.Request.Context(), existingUser); err != nil {
-		controllers.Response.InternalError(ctx, err.Error())
-		return
+	// El cambio de rol pasa por el servicio para quedar auditado e invalidar los tokens
+	if req.RoleID != nil {
+		existingUser, err = c.userService.ChangeUserRole(ctx.Request.Context(), id, *req.RoleID)
+		if err != nil {
+			controllers.Response.FromError(ctx, err)
+			return
+		}
 	}
 
 	controllers.Response.Success(ctx, http.StatusOK, ToUserResponse(existingUser))
@@ -123,7 +134,7 @@ func (c *Controller) DeleteUser(ctx *gin.Context) {
 	}
 
 	if err := c.userService.DeleteUser(ctx.Request.Context(), id); err != nil {
-		controllers.Response.InternalError(ctx, err.Error())
+		controllers.Response.FromError(ctx, err)
 		return
 	}
 
@@ -131,3 +142,26 @@ func (c *Controller) DeleteUser(ctx *gin.Context) {
 		"message": "Usuario eliminado exitosamente",
 	})
 }
+
+func (c *Controller) ChangeUserRole(ctx *gin.Context) {
+	idParam := ctx.Param("id")
+	id, err := strconv.Atoi(idParam)
+	if err != nil {
+		controllers.Response.InvalidID(ctx, "El ID debe ser un número válido")
+		return
+	}
+
+	var req ChangeUserRoleRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		controllers.Response.BadRequest(ctx, err.Error())
+		return
+	}
+
+	user, err := c.userService.ChangeUserRole(ctx.Request.Context(), id, req.RoleID)
+	if err != nil {
+		controllers.Response.FromError(ctx, err)
+		return
+	}
+
+	controllers.Response.Success(ctx, http.StatusOK, ToUserResponse(user))
+}
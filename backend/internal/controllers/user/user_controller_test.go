@@ -13,7 +13,10 @@ import (
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
 
+	"softpharos/internal/core/domain/query"
 	"softpharos/internal/core/domain/user"
+	"softpharos/internal/core/errs"
+	"softpharos/internal/core/ports/services"
 	mockService "softpharos/mocks/core/ports/services"
 )
 
@@ -39,11 +42,11 @@ func TestGetAllUsers(t *testing.T) {
 			name: "retorna todos los usuarios exitosamente",
 			mockSetup: func(m *mockService.MockUserService) {
 				m.EXPECT().
-					GetAllUsers(gomock.Any()).
-					Return([]user.User{
+					GetAllUsers(gomock.Any(), gomock.Any()).
+					Return(&query.Page[user.User]{Items: []user.User{
 						{ID: 1, Name: &name1, Email: "user1@test.com", RoleID: 1, CreatedAt: now},
 						{ID: 2, Name: &name2, Email: "user2@test.com", RoleID: 2, CreatedAt: now},
-					}, nil)
+					}}, nil)
 			},
 			expectedStatusCode: http.StatusOK,
 		},
@@ -51,7 +54,7 @@ func TestGetAllUsers(t *testing.T) {
 			name: "retorna error cuando el service falla",
 			mockSetup: func(m *mockService.MockUserService) {
 				m.EXPECT().
-					GetAllUsers(gomock.Any()).
+					GetAllUsers(gomock.Any(), gomock.Any()).
 					Return(nil, errors.New("service error"))
 			},
 			expectedStatusCode: http.StatusInternalServerError,
@@ -117,7 +120,7 @@ func TestGetUserByID(t *testing.T) {
 			mockSetup: func(m *mockService.MockUserService) {
 				m.EXPECT().
 					GetUserByID(gomock.Any(), 999).
-					Return(nil, errors.New("not found"))
+					Return(nil, errs.NotFound("no encontrado"))
 			},
 			expectedStatusCode: http.StatusNotFound,
 		},
@@ -176,7 +179,7 @@ func TestGetUserByEmail(t *testing.T) {
 			mockSetup: func(m *mockService.MockUserService) {
 				m.EXPECT().
 					GetUserByEmail(gomock.Any(), "notfound@test.com").
-					Return(nil, errors.New("not found"))
+					Return(nil, errs.NotFound("no encontrado"))
 			},
 			expectedStatusCode: http.StatusNotFound,
 		},
@@ -282,6 +285,7 @@ func TestUpdateUser(t *testing.T) {
 
 	name := "Existing User"
 	updatedName := "Updated Name"
+	professorRoleID := 2
 	now := time.Now()
 
 	tests := []struct {
@@ -314,6 +318,22 @@ func TestUpdateUser(t *testing.T) {
 			},
 			expectedStatusCode: http.StatusOK,
 		},
+		{
+			name:   "delega el cambio de rol al servicio",
+			userID: "2",
+			requestBody: UpdateUserRequest{
+				RoleID: &professorRoleID,
+			},
+			mockSetup: func(m *mockService.MockUserService) {
+				m.EXPECT().
+					GetUserByID(gomock.Any(), 2).
+					Return(&user.User{ID: 2, Name: &name, RoleID: 3, CreatedAt: now}, nil)
+				m.EXPECT().
+					ChangeUserRole(gomock.Any(), 2, 2).
+					Return(&user.User{ID: 2, Name: &name, RoleID: 2, CreatedAt: now}, nil)
+			},
+			expectedStatusCode: http.StatusOK,
+		},
 		{
 			name:               "retorna error para ID inválido",
 			userID:             "invalid",
@@ -330,7 +350,7 @@ func TestUpdateUser(t *testing.T) {
 			mockSetup: func(m *mockService.MockUserService) {
 				m.EXPECT().
 					GetUserByID(gomock.Any(), 999).
-					Return(nil, errors.New("not found"))
+					Return(nil, errs.NotFound("no encontrado"))
 			},
 			expectedStatusCode: http.StatusNotFound,
 		},
@@ -440,3 +460,84 @@ func TestDeleteUser(t *testing.T) {
 		})
 	}
 }
+
+func TestChangeUserRole(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	now := time.Now()
+
+	tests := []struct {
+		name               string
+		userID             string
+		requestBody        interface{}
+		mockSetup          func(*mockService.MockUserService)
+		expectedStatusCode int
+	}{
+		{
+			name:        "cambia el rol exitosamente",
+			userID:      "2",
+			requestBody: ChangeUserRoleRequest{RoleID: 1},
+			mockSetup: func(m *mockService.MockUserService) {
+				m.EXPECT().
+					ChangeUserRole(gomock.Any(), 2, 1).
+					Return(&user.User{ID: 2, RoleID: 1, CreatedAt: now}, nil)
+			},
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name:               "retorna error sin role_id",
+			userID:             "2",
+			requestBody:        `{}`,
+			mockSetup:          func(m *mockService.MockUserService) {},
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:        "retorna forbidden al cambiar el propio rol",
+			userID:      "1",
+			requestBody: ChangeUserRoleRequest{RoleID: 3},
+			mockSetup: func(m *mockService.MockUserService) {
+				m.EXPECT().
+					ChangeUserRole(gomock.Any(), 1, 3).
+					Return(nil, services.ErrSelfRoleChange)
+			},
+			expectedStatusCode: http.StatusForbidden,
+		},
+		{
+			name:        "retorna error para rol inexistente",
+			userID:      "2",
+			requestBody: ChangeUserRoleRequest{RoleID: 99},
+			mockSetup: func(m *mockService.MockUserService) {
+				m.EXPECT().
+					ChangeUserRole(gomock.Any(), 2, 99).
+					Return(nil, services.ErrRoleNotFound)
+			},
+			expectedStatusCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockSvc := mockService.NewMockUserService(ctrl)
+			tt.mockSetup(mockSvc)
+
+			controller := New(mockSvc)
+			router := setupRouter()
+			router.PUT("/users/:id/role", controller.ChangeUserRole)
+
+			var body []byte
+			if str, ok := tt.requestBody.(string); ok {
+				body = []byte(str)
+			} else {
+				body, _ = json.Marshal(tt.requestBody)
+			}
+
+			req, _ := http.NewRequest("PUT", "/users/"+tt.userID+"/role", bytes.NewBuffer(body))
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatusCode, w.Code)
+		})
+	}
+}
@@ -0,0 +1,22 @@
+package audit
+
+import "time"
+
+// Acciones administrativas que quedan registradas en la auditoría
+const (
+	ActionRoleCreated     = "role.created"
+	ActionRoleUpdated     = "role.updated"
+	ActionRoleDeleted     = "role.deleted"
+	ActionUserRoleChanged = "user.role_changed"
+)
+
+// Entry registra quién ejecutó una acción administrativa y sobre qué entidad
+type Entry struct {
+	ID        int
+	ActorID   *int
+	Action    string
+	Entity    string
+	EntityID  int
+	Details   *string
+	CreatedAt time.Time
+}
@@ -0,0 +1,52 @@
+package course
+
+import (
+	"time"
+
+	"softpharos/internal/core/domain/term"
+	"softpharos/internal/core/domain/user"
+)
+
+// Roles de un usuario dentro de una sección
+const (
+	RoleProfessor = "professor"
+	RoleStudent   = "student"
+)
+
+func IsValidMemberRole(role string) bool {
+	return role == RoleProfessor || role == RoleStudent
+}
+
+// Course es una asignatura, independiente del periodo en que se dicta
+type Course struct {
+	ID        int
+	Code      string
+	Name      string
+	CreatedAt time.Time
+}
+
+// Section es un grupo de la asignatura en un periodo; los proyectos se
+// vinculan a una sección
+type Section struct {
+	ID        int
+	CourseID  int
+	Course    *Course
+	TermID    int
+	Term      *term.Term
+	Name      string
+	CreatedAt time.Time
+}
+
+// Member es la inscripción de un usuario en una sección como profesor o estudiante
+type Member struct {
+	ID        int
+	SectionID int
+	UserID    int
+	User      *user.User
+	Role      string
+	JoinedAt  time.Time
+}
+
+func (m *Member) IsProfessor() bool {
+	return m != nil && m.Role == RoleProfessor
+}
@@ -0,0 +1,35 @@
+package identity
+
+import (
+	"context"
+	"time"
+
+	"softpharos/internal/core/domain/role"
+)
+
+// Identity representa al usuario autenticado que ejecuta la petición
+type Identity struct {
+	UserID int
+	Email  string
+	RoleID int
+	Role   string
+
+	// TokenID y TokenExpiresAt identifican el access token de la petición
+	TokenID        string
+	TokenExpiresAt time.Time
+}
+
+func (i *Identity) IsAdmin() bool {
+	return i != nil && i.Role == role.Admin
+}
+
+type contextKey struct{}
+
+func NewContext(ctx context.Context, id *Identity) context.Context {
+	return context.WithValue(ctx, contextKey{}, id)
+}
+
+func FromContext(ctx context.Context) (*Identity, bool) {
+	id, ok := ctx.Value(contextKey{}).(*Identity)
+	return id, ok && id != nil
+}
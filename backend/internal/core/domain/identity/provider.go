@@ -0,0 +1,12 @@
+package identity
+
+// ProviderIdentity son los datos del usuario que entrega el proveedor de
+// identidad externo al verificar un ID token
+type ProviderIdentity struct {
+	Subject       string
+	Email         string
+	EmailVerified bool
+	Name          string
+	Picture       string
+	HostedDomain  string
+}
@@ -0,0 +1,47 @@
+package invitation
+
+import (
+	"time"
+
+	"softpharos/internal/core/domain/project"
+	"softpharos/internal/core/domain/project_member"
+)
+
+// Estados de una invitación. Solo las pendientes pueden aceptarse o rechazarse.
+const (
+	StatusPending  = "pending"
+	StatusAccepted = "accepted"
+	StatusDeclined = "declined"
+)
+
+// TTL es la vigencia de una invitación desde que se crea
+const TTL = 7 * 24 * time.Hour
+
+// Invitation invita a un email a unirse a un proyecto con un rol. Solo se
+// guarda el hash del token que recibe quien invita.
+type Invitation struct {
+	ID          int
+	ProjectID   int
+	Project     *project.Project
+	Email       string
+	Role        string
+	TokenHash   string
+	InvitedBy   *int
+	Status      string
+	ExpiresAt   time.Time
+	RespondedAt *time.Time
+	CreatedAt   time.Time
+}
+
+func (i *Invitation) IsPending(now time.Time) bool {
+	return i.Status == StatusPending && now.Before(i.ExpiresAt)
+}
+
+// Member es la membresía que crea la invitación al aceptarla userID
+func (i *Invitation) Member(userID int) *project_member.ProjectMember {
+	return &project_member.ProjectMember{
+		ProjectID: i.ProjectID,
+		UserID:    userID,
+		Role:      i.Role,
+	}
+}
@@ -1,11 +1,32 @@
 package milestone
 
 import (
+	"slices"
 	"time"
 
 	"softpharos/internal/core/domain/project"
 )
 
+// Estados de un hito. Solo avanzan en orden: planned → in_progress →
+// submitted → reviewed.
+const (
+	StatusPlanned    = "planned"
+	StatusInProgress = "in_progress"
+	StatusSubmitted  = "submitted"
+	StatusReviewed   = "reviewed"
+)
+
+// nextStatus indica el único estado al que puede pasar cada estado
+var nextStatus = map[string]string{
+	StatusPlanned:    StatusInProgress,
+	StatusInProgress: StatusSubmitted,
+	StatusSubmitted:  StatusReviewed,
+}
+
+// PendingStatuses son los estados en los que el hito aún no se entrega y,
+// pasada su fecha límite, está vencido
+var PendingStatuses = []string{StatusPlanned, StatusInProgress}
+
 type Milestone struct {
 	ID          int
 	ProjectID   int
@@ -13,5 +34,44 @@ type Milestone struct {
 	Title       *string
 	Description *string
 	ClassWeek   *int
-	CreatedAt   time.Time
+	// WeekStart y WeekEnd son las fechas de ClassWeek según el calendario del
+	// periodo del proyecto. No se guardan: el servicio las calcula al leer.
+	WeekStart *time.Time
+	WeekEnd   *time.Time
+	// DueDate es el último día, incluido, para entregar el hito
+	DueDate         *time.Time
+	Status          string
+	StatusChangedAt *time.Time
+	CreatedAt       time.Time
+	DeletedAt       *time.Time
+	// Overdue indica si el hito estaba vencido en el día de la lectura. No se
+	// guarda: el servicio lo calcula con su reloj.
+	Overdue bool
+	// Counts resume el contenido del hito en los listados; es nil en el resto de lecturas
+	Counts *Counts
+}
+
+// Counts es el resumen del contenido de un hito para mostrarlo sin cargarlo
+type Counts struct {
+	Deliverables int
+	Comments     int
+	// Reactions cuenta las reacciones por tipo; los tipos sin reacciones no aparecen
+	Reactions   map[string]int
+	HasFeedback bool
+}
+
+// CanTransitionTo indica si el hito puede pasar de su estado actual a status
+func (m *Milestone) CanTransitionTo(status string) bool {
+	return nextStatus[m.Status] == status
+}
+
+// IsOverdue indica si a la fecha today ya pasó la fecha límite sin que el hito se entregara
+func (m *Milestone) IsOverdue(today time.Time) bool {
+	return m.DueDate != nil && m.DueDate.Before(today) && slices.Contains(PendingStatuses, m.Status)
+}
+
+// Today retorna el día calendario de now como fecha sin hora, comparable con DueDate
+func Today(now time.Time) time.Time {
+	year, month, day := now.Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
 }
@@ -0,0 +1,60 @@
+package milestone
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCanTransitionTo(t *testing.T) {
+	tests := []struct {
+		from     string
+		to       string
+		expected bool
+	}{
+		{StatusPlanned, StatusInProgress, true},
+		{StatusInProgress, StatusSubmitted, true},
+		{StatusSubmitted, StatusReviewed, true},
+		{StatusPlanned, StatusSubmitted, false},
+		{StatusInProgress, StatusPlanned, false},
+		{StatusReviewed, StatusInProgress, false},
+		{StatusSubmitted, StatusSubmitted, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.from+"→"+tt.to, func(t *testing.T) {
+			m := &Milestone{Status: tt.from}
+			assert.Equal(t, tt.expected, m.CanTransitionTo(tt.to))
+		})
+	}
+}
+
+func TestIsOverdue(t *testing.T) {
+	today := time.Date(2026, 5, 10, 0, 0, 0, 0, time.UTC)
+	yesterday := today.AddDate(0, 0, -1)
+
+	tests := []struct {
+		name      string
+		milestone Milestone
+		expected  bool
+	}{
+		{name: "vencido si no se entregó a tiempo", milestone: Milestone{Status: StatusInProgress, DueDate: &yesterday}, expected: true},
+		{name: "no vence el mismo día límite", milestone: Milestone{Status: StatusPlanned, DueDate: &today}, expected: false},
+		{name: "no vence una vez entregado", milestone: Milestone{Status: StatusSubmitted, DueDate: &yesterday}, expected: false},
+		{name: "no vence sin fecha límite", milestone: Milestone{Status: StatusPlanned}, expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.milestone.IsOverdue(today))
+		})
+	}
+}
+
+func TestToday(t *testing.T) {
+	bogota := time.FixedZone("COT", -5*60*60)
+	now := time.Date(2026, 5, 10, 22, 30, 0, 0, bogota)
+
+	assert.Equal(t, time.Date(2026, 5, 10, 0, 0, 0, 0, time.UTC), Today(now))
+}
@@ -0,0 +1,35 @@
+package milestone_detail
+
+import (
+	"slices"
+
+	"softpharos/internal/core/domain/comment"
+	"softpharos/internal/core/domain/deliverable"
+	"softpharos/internal/core/domain/feedback"
+	"softpharos/internal/core/domain/milestone"
+	"softpharos/internal/core/domain/reaction"
+)
+
+// Relaciones que se pueden incluir al leer un hito
+const (
+	IncludeDeliverables = "deliverables"
+	IncludeFeedback     = "feedback"
+	IncludeComments     = "comments"
+	IncludeReactions    = "reactions"
+)
+
+var Includes = []string{IncludeDeliverables, IncludeFeedback, IncludeComments, IncludeReactions}
+
+func IsValidInclude(include string) bool {
+	return slices.Contains(Includes, include)
+}
+
+// Detail es un hito junto con las relaciones pedidas. Una relación que no se
+// pidió queda en nil; una pedida sin elementos es una lista vacía.
+type Detail struct {
+	milestone.Milestone
+	Deliverables []deliverable.Deliverable
+	Feedback     []feedback.Feedback
+	Comments     []comment.Comment
+	Reactions    []reaction.Reaction
+}
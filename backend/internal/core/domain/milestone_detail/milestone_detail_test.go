@@ -0,0 +1,15 @@
+package milestone_detail
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsValidInclude(t *testing.T) {
+	for _, include := range Includes {
+		assert.True(t, IsValidInclude(include), include)
+	}
+	assert.False(t, IsValidInclude("project"))
+	assert.False(t, IsValidInclude("Comments"))
+}
@@ -6,12 +6,36 @@ import (
 	"softpharos/internal/core/domain/user"
 )
 
+// Estados con los que se filtran los proyectos según estén archivados
+const (
+	StatusActive   = "active"
+	StatusArchived = "archived"
+)
+
 type Project struct {
 	ID        int
 	Name      *string
 	Objective *string
 	CreatedBy int
 	Owner     *user.User
+	// SectionID es la sección del curso a la que pertenece el proyecto, si la tiene
+	SectionID *int
 	CreatedAt time.Time
 	UpdatedAt time.Time
+	// ArchivedAt es nil mientras el proyecto siga activo
+	ArchivedAt *time.Time
+	// DeletedAt es nil salvo para los proyectos en la papelera
+	DeletedAt *time.Time
+}
+
+func (p *Project) IsArchived() bool {
+	return p.ArchivedAt != nil
+}
+
+// MemberProject es un proyecto visto por uno de sus miembros: incluye el rol
+// que tiene en él y desde cuándo participa
+type MemberProject struct {
+	Project
+	Role     string
+	JoinedAt time.Time
 }
@@ -7,12 +7,65 @@ import (
 	"softpharos/internal/core/domain/user"
 )
 
+// Roles de un miembro dentro de un proyecto
+const (
+	// RoleOwner es el rol con el que queda registrado el creador de un
+	// proyecto; todo proyecto conserva al menos un owner
+	RoleOwner = "owner"
+	// RoleMaintainer gestiona el proyecto junto al owner y es el rol que
+	// recibe un owner al transferir la propiedad
+	RoleMaintainer = "maintainer"
+	// RoleContributor es el rol por defecto de los nuevos miembros
+	RoleContributor = "contributor"
+	// RoleViewer solo puede consultar el proyecto
+	RoleViewer = "viewer"
+)
+
+// Ability es una acción del proyecto que se concede según el rol del miembro
+type Ability string
+
+const (
+	AbilityEditProject        Ability = "edit_project"
+	AbilityEditMilestones     Ability = "edit_milestones"
+	AbilityUploadDeliverables Ability = "upload_deliverables"
+	AbilityManageMembers      Ability = "manage_members"
+)
+
+var roleAbilities = map[string][]Ability{
+	RoleOwner:       {AbilityEditProject, AbilityEditMilestones, AbilityUploadDeliverables, AbilityManageMembers},
+	RoleMaintainer:  {AbilityEditProject, AbilityEditMilestones, AbilityUploadDeliverables, AbilityManageMembers},
+	RoleContributor: {AbilityUploadDeliverables},
+	RoleViewer:      {},
+}
+
+func IsValidRole(role string) bool {
+	_, ok := roleAbilities[role]
+	return ok
+}
+
 type ProjectMember struct {
 	ID        int
 	ProjectID int
 	Project   *project.Project
 	UserID    int
 	User      *user.User
-	Role      *string
+	Role      string
 	JoinedAt  time.Time
 }
+
+func (m *ProjectMember) IsOwner() bool {
+	return m != nil && m.Role == RoleOwner
+}
+
+// Can indica si el rol del miembro concede la habilidad
+func (m *ProjectMember) Can(ability Ability) bool {
+	if m == nil {
+		return false
+	}
+	for _, a := range roleAbilities[m.Role] {
+		if a == ability {
+			return true
+		}
+	}
+	return false
+}
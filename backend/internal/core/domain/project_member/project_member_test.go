@@ -0,0 +1,42 @@
+package project_member
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCan(t *testing.T) {
+	tests := []struct {
+		role     string
+		ability  Ability
+		expected bool
+	}{
+		{RoleOwner, AbilityManageMembers, true},
+		{RoleMaintainer, AbilityEditMilestones, true},
+		{RoleMaintainer, AbilityManageMembers, true},
+		{RoleContributor, AbilityUploadDeliverables, true},
+		{RoleContributor, AbilityEditMilestones, false},
+		{RoleContributor, AbilityManageMembers, false},
+		{RoleMaintainer, AbilityEditProject, true},
+		{RoleContributor, AbilityEditProject, false},
+		{RoleViewer, AbilityEditProject, false},
+		{RoleViewer, AbilityUploadDeliverables, false},
+		{"member", AbilityUploadDeliverables, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.role+"/"+string(tt.ability), func(t *testing.T) {
+			m := &ProjectMember{Role: tt.role}
+			assert.Equal(t, tt.expected, m.Can(tt.ability))
+		})
+	}
+}
+
+func TestIsValidRole(t *testing.T) {
+	for _, r := range []string{RoleOwner, RoleMaintainer, RoleContributor, RoleViewer} {
+		assert.True(t, IsValidRole(r), r)
+	}
+	assert.False(t, IsValidRole("member"))
+	assert.False(t, IsValidRole(""))
+}
@@ -0,0 +1,69 @@
+package query
+
+const (
+	DefaultPageSize = 20
+	MaxPageSize     = 100
+)
+
+// Sort indica un campo de ordenamiento
+type Sort struct {
+	Field string
+	Desc  bool
+}
+
+// Params describe una consulta de listado: página, orden y filtros por igualdad.
+// Los nombres de campo coinciden con las columnas de la tabla.
+type Params struct {
+	Page     int
+	PageSize int
+	Sort     []Sort
+	Filters  map[string]any
+}
+
+// Normalize completa los valores por defecto y acota el tamaño de página
+func (p Params) Normalize() Params {
+	if p.Page < 1 {
+		p.Page = 1
+	}
+	if p.PageSize < 1 {
+		p.PageSize = DefaultPageSize
+	}
+	if p.PageSize > MaxPageSize {
+		p.PageSize = MaxPageSize
+	}
+	return p
+}
+
+func (p Params) Offset() int {
+	p = p.Normalize()
+	return (p.Page - 1) * p.PageSize
+}
+
+// Page es una página de resultados junto con el total de registros que cumplen los filtros
+type Page[T any] struct {
+	Items    []T
+	Total    int64
+	Page     int
+	PageSize int
+}
+
+func NewPage[T any](items []T, total int64, params Params) *Page[T] {
+	params = params.Normalize()
+	return &Page[T]{
+		Items:    items,
+		Total:    total,
+		Page:     params.Page,
+		PageSize: params.PageSize,
+	}
+}
+
+func (p *Page[T]) TotalPages() int {
+	if p.PageSize == 0 {
+		return 0
+	}
+	return int((p.Total + int64(p.PageSize) - 1) / int64(p.PageSize))
+}
+
+func (p *Page[T]) HasNext() bool {
+	return p.Page < p.TotalPages()
+}
@@ -0,0 +1,49 @@
+package query
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParamsNormalize(t *testing.T) {
+	tests := []struct {
+		name     string
+		params   Params
+		expected Params
+		offset   int
+	}{
+		{name: "usa valores por defecto", params: Params{}, expected: Params{Page: 1, PageSize: DefaultPageSize}, offset: 0},
+		{name: "acota el tamaño de página", params: Params{Page: 2, PageSize: 500}, expected: Params{Page: 2, PageSize: MaxPageSize}, offset: MaxPageSize},
+		{name: "respeta valores válidos", params: Params{Page: 3, PageSize: 10}, expected: Params{Page: 3, PageSize: 10}, offset: 20},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.params.Normalize())
+			assert.Equal(t, tt.offset, tt.params.Offset())
+		})
+	}
+}
+
+func TestPage(t *testing.T) {
+	tests := []struct {
+		name       string
+		total      int64
+		params     Params
+		totalPages int
+		hasNext    bool
+	}{
+		{name: "sin resultados", total: 0, params: Params{}, totalPages: 0, hasNext: false},
+		{name: "primera de varias páginas", total: 45, params: Params{Page: 1, PageSize: 20}, totalPages: 3, hasNext: true},
+		{name: "última página", total: 45, params: Params{Page: 3, PageSize: 20}, totalPages: 3, hasNext: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			page := NewPage([]int{}, tt.total, tt.params)
+			assert.Equal(t, tt.totalPages, page.TotalPages())
+			assert.Equal(t, tt.hasNext, page.HasNext())
+		})
+	}
+}
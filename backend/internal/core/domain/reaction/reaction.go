@@ -1,12 +1,16 @@
 package reaction
 
 import (
+	"slices"
 	"time"
 
 	"softpharos/internal/core/domain/milestone"
 	"softpharos/internal/core/domain/user"
 )
 
+// DefaultTypes es el catálogo de tipos de reacción cuando no se configura otro
+var DefaultTypes = []string{"like", "love", "celebrate", "insightful", "confused"}
+
 type Reaction struct {
 	ID          int
 	MilestoneID int
@@ -16,3 +20,27 @@ type Reaction struct {
 	Type        *string
 	CreatedAt   time.Time
 }
+
+// TypeCount es el total de reacciones de un tipo en un hito e indica si quien
+// consulta es uno de los que reaccionaron
+type TypeCount struct {
+	Type    string
+	Count   int
+	Reacted bool
+}
+
+// Summary cuenta las reacciones de un hito por tipo, en el orden de catalog.
+// Los tipos del catálogo sin reacciones aparecen con Count 0 y los que ya no
+// están en el catálogo se omiten.
+func Summary(catalog []string, counts map[string]TypeCount) []TypeCount {
+	summary := make([]TypeCount, len(catalog))
+	for i, t := range catalog {
+		summary[i] = counts[t]
+		summary[i].Type = t
+	}
+	return summary
+}
+
+func IsValidType(catalog []string, reactionType string) bool {
+	return slices.Contains(catalog, reactionType)
+}
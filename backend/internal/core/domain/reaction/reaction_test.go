@@ -0,0 +1,28 @@
+package reaction
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSummary(t *testing.T) {
+	catalog := []string{"like", "love", "confused"}
+	counts := map[string]TypeCount{
+		"love":    {Type: "love", Count: 3, Reacted: true},
+		"like":    {Type: "like", Count: 1},
+		"retired": {Type: "retired", Count: 5},
+	}
+
+	assert.Equal(t, []TypeCount{
+		{Type: "like", Count: 1},
+		{Type: "love", Count: 3, Reacted: true},
+		{Type: "confused"},
+	}, Summary(catalog, counts))
+}
+
+func TestIsValidType(t *testing.T) {
+	assert.True(t, IsValidType(DefaultTypes, "like"))
+	assert.False(t, IsValidType(DefaultTypes, "Like"))
+	assert.False(t, IsValidType(DefaultTypes, ""))
+}
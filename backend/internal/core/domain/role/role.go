@@ -1,6 +1,25 @@
 package role
 
-import "time"
+import (
+	"slices"
+	"time"
+)
+
+// Nombres de los roles definidos en la tabla role (ver cmd/bd/seed.sql)
+const (
+	Admin     = "admin"
+	Professor = "professor"
+	Student   = "student"
+)
+
+// System son los roles de los que depende la política de permisos. Un rol
+// creado aparte no tiene permisos, así que no se puede asignar.
+var System = []string{Admin, Professor, Student}
+
+// IsSystem indica si el nombre corresponde a un rol de la política de permisos
+func IsSystem(name string) bool {
+	return slices.Contains(System, name)
+}
 
 type Role struct {
 	ID          int
@@ -0,0 +1,40 @@
+package session
+
+import "time"
+
+// Tokens es el par de credenciales que recibe el cliente al iniciar sesión
+type Tokens struct {
+	AccessToken  string
+	RefreshToken string
+	ExpiresAt    time.Time
+}
+
+// RefreshToken es un refresh token persistido. Solo se guarda el hash del
+// valor entregado al cliente.
+type RefreshToken struct {
+	ID        int
+	UserID    int
+	TokenHash string
+	ExpiresAt time.Time
+	RevokedAt *time.Time
+	CreatedAt time.Time
+}
+
+func (t *RefreshToken) IsActive(now time.Time) bool {
+	return t.RevokedAt == nil && now.Before(t.ExpiresAt)
+}
+
+// RevokedToken es un access token invalidado antes de su expiración
+type RevokedToken struct {
+	JTI       string
+	ExpiresAt time.Time
+	RevokedAt time.Time
+}
+
+// RevokedBy indica si un access token emitido en issuedAt queda invalidado por
+// una revocación de todos los tokens del usuario hecha en revokedAt. El iat
+// del JWT tiene precisión de segundos, así que un token del mismo segundo que
+// la revocación no se puede ordenar respecto a ella y también se rechaza.
+func RevokedBy(issuedAt, revokedAt time.Time) bool {
+	return !issuedAt.Truncate(time.Second).After(revokedAt)
+}
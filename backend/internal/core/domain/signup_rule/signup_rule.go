@@ -0,0 +1,31 @@
+package signup_rule
+
+import (
+	"softpharos/internal/core/domain/role"
+	"strings"
+	"time"
+)
+
+// SignupRule asigna un rol a las cuentas nuevas cuyo email coincide con
+// Pattern, que puede ser un email exacto o un dominio
+type SignupRule struct {
+	ID        int
+	Pattern   string
+	RoleID    int
+	Role      *role.Role
+	CreatedAt time.Time
+}
+
+// IsEmail indica si la regla aplica a un email exacto en lugar de a un dominio
+func (r *SignupRule) IsEmail() bool {
+	return strings.Contains(r.Pattern, "@")
+}
+
+// EmailDomain retorna el dominio de un email en minúsculas
+func EmailDomain(email string) string {
+	at := strings.LastIndex(email, "@")
+	if at < 0 {
+		return ""
+	}
+	return strings.ToLower(email[at+1:])
+}
@@ -0,0 +1,102 @@
+package term
+
+import (
+	"iter"
+	"time"
+)
+
+// DefaultWeekLength es la duración en días de una semana de clase cuando el
+// periodo no indica otra
+const DefaultWeekLength = 7
+
+// Term es un periodo académico, por ejemplo "2026-2". Sus fechas son días
+// calendario sin hora. El calendario se divide en bloques de WeekLength días
+// desde StartDate; los bloques que caen por completo dentro de un receso no
+// cuentan como semana de clase.
+type Term struct {
+	ID         int
+	Code       string
+	StartDate  time.Time
+	EndDate    time.Time
+	WeekLength int
+	Breaks     []Break
+	CreatedAt  time.Time
+}
+
+// Break es un receso o festivo dentro del periodo, con ambas fechas incluidas
+type Break struct {
+	ID        int
+	TermID    int
+	Name      string
+	StartDate time.Time
+	EndDate   time.Time
+}
+
+// WeekRange es el intervalo de días, ambos incluidos, de una semana de clase
+type WeekRange struct {
+	Start time.Time
+	End   time.Time
+}
+
+// Week resuelve la semana de clase number (desde 1) en sus fechas. Retorna
+// false si la semana queda fuera del periodo.
+func (t *Term) Week(number int) (WeekRange, bool) {
+	if number < 1 {
+		return WeekRange{}, false
+	}
+
+	week := 0
+	for r := range t.classWeeks() {
+		week++
+		if week == number {
+			return r, true
+		}
+	}
+	return WeekRange{}, false
+}
+
+// ClassWeeks cuenta las semanas de clase del periodo, sin los recesos
+func (t *Term) ClassWeeks() int {
+	weeks := 0
+	for range t.classWeeks() {
+		weeks++
+	}
+	return weeks
+}
+
+// classWeeks recorre en orden los bloques del periodo que no son receso. El
+// último bloque se recorta a EndDate.
+func (t *Term) classWeeks() iter.Seq[WeekRange] {
+	return func(yield func(WeekRange) bool) {
+		length := t.weekLength()
+		for start := t.StartDate; !start.After(t.EndDate); start = start.AddDate(0, 0, length) {
+			end := start.AddDate(0, 0, length-1)
+			if end.After(t.EndDate) {
+				end = t.EndDate
+			}
+			if t.skipped(start, end) {
+				continue
+			}
+			if !yield(WeekRange{Start: start, End: end}) {
+				return
+			}
+		}
+	}
+}
+
+func (t *Term) weekLength() int {
+	if t.WeekLength < 1 {
+		return DefaultWeekLength
+	}
+	return t.WeekLength
+}
+
+// skipped indica si algún receso cubre por completo el bloque [start, end]
+func (t *Term) skipped(start, end time.Time) bool {
+	for _, b := range t.Breaks {
+		if !b.StartDate.After(start) && !b.EndDate.Before(end) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,59 @@
+package term
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func date(month time.Month, day int) time.Time {
+	return time.Date(2026, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func TestWeek(t *testing.T) {
+	// Periodo de lunes 2 de marzo a viernes 3 de julio con semana santa
+	// (30 de marzo a 5 de abril) y un festivo suelto el 1 de mayo
+	calendar := &Term{
+		StartDate: date(time.March, 2),
+		EndDate:   date(time.July, 3),
+		Breaks: []Break{
+			{Name: "Semana santa", StartDate: date(time.March, 30), EndDate: date(time.April, 5)},
+			{Name: "Día del trabajo", StartDate: date(time.May, 1), EndDate: date(time.May, 1)},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		term     *Term
+		week     int
+		expected WeekRange
+		ok       bool
+	}{
+		{name: "primera semana", term: calendar, week: 1, expected: WeekRange{date(time.March, 2), date(time.March, 8)}, ok: true},
+		{name: "la semana anterior al receso", term: calendar, week: 4, expected: WeekRange{date(time.March, 23), date(time.March, 29)}, ok: true},
+		{name: "el receso no cuenta como semana", term: calendar, week: 5, expected: WeekRange{date(time.April, 6), date(time.April, 12)}, ok: true},
+		{name: "un festivo suelto no salta la semana", term: calendar, week: 8, expected: WeekRange{date(time.April, 27), date(time.May, 3)}, ok: true},
+		{name: "la última semana se recorta al fin del periodo", term: calendar, week: 17, expected: WeekRange{date(time.June, 29), date(time.July, 3)}, ok: true},
+		{name: "rechaza una semana posterior al periodo", term: calendar, week: 18},
+		{name: "rechaza la semana cero", term: calendar, week: 0},
+		{
+			name:     "usa la duración de semana del periodo",
+			term:     &Term{StartDate: date(time.March, 2), EndDate: date(time.March, 31), WeekLength: 14},
+			week:     2,
+			expected: WeekRange{date(time.March, 16), date(time.March, 29)},
+			ok:       true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, ok := tt.term.Week(tt.week)
+
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+
+	assert.Equal(t, 17, calendar.ClassWeeks())
+}
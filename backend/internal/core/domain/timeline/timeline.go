@@ -0,0 +1,34 @@
+package timeline
+
+import (
+	"time"
+
+	"softpharos/internal/core/domain/comment"
+	"softpharos/internal/core/domain/deliverable"
+	"softpharos/internal/core/domain/feedback"
+	"softpharos/internal/core/domain/milestone"
+	"softpharos/internal/core/domain/reaction"
+)
+
+// Tipos de evento de la historia de un proyecto, uno por cada entidad que la forma
+const (
+	KindMilestone   = "milestone"
+	KindDeliverable = "deliverable"
+	KindFeedback    = "feedback"
+	KindComment     = "comment"
+	KindReaction    = "reaction"
+)
+
+// Event es la creación de un hito o de algo dentro de él. Solo está presente
+// el puntero que corresponde a Kind; ID es el de esa entidad.
+type Event struct {
+	Kind        string
+	ID          int
+	MilestoneID int
+	OccurredAt  time.Time
+	Milestone   *milestone.Milestone
+	Deliverable *deliverable.Deliverable
+	Feedback    *feedback.Feedback
+	Comment     *comment.Comment
+	Reaction    *reaction.Reaction
+}
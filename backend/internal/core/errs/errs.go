@@ -0,0 +1,50 @@
+// Package errs define las categorías de error del núcleo. Repositorios y
+// servicios devuelven errores de estas categorías y los controladores las
+// traducen a códigos HTTP con controllers.Response.FromError.
+package errs
+
+import "errors"
+
+var (
+	ErrNotFound     = errors.New("recurso no encontrado")
+	ErrConflict     = errors.New("el recurso entra en conflicto con uno existente")
+	ErrForbidden    = errors.New("no tienes permisos para realizar esta acción")
+	ErrUnauthorized = errors.New("no autenticado")
+	ErrValidation   = errors.New("datos inválidos")
+)
+
+// Error es un error de una categoría con un mensaje apto para el cliente
+type Error struct {
+	Kind    error
+	Message string
+	// Fields detalla los errores de validación por campo
+	Fields map[string]string
+}
+
+func (e *Error) Error() string {
+	return e.Message
+}
+
+func (e *Error) Unwrap() error {
+	return e.Kind
+}
+
+func NotFound(message string) error {
+	return &Error{Kind: ErrNotFound, Message: message}
+}
+
+func Conflict(message string) error {
+	return &Error{Kind: ErrConflict, Message: message}
+}
+
+func Forbidden(message string) error {
+	return &Error{Kind: ErrForbidden, Message: message}
+}
+
+func Unauthorized(message string) error {
+	return &Error{Kind: ErrUnauthorized, Message: message}
+}
+
+func Validation(message string, fields map[string]string) error {
+	return &Error{Kind: ErrValidation, Message: message, Fields: fields}
+}
@@ -0,0 +1,35 @@
+package errs
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestErrorKinds(t *testing.T) {
+	tests := []struct {
+		name    string
+		err     error
+		kind    error
+		message string
+	}{
+		{name: "not found", err: NotFound("proyecto no encontrado"), kind: ErrNotFound, message: "proyecto no encontrado"},
+		{name: "conflict", err: Conflict("el email ya existe"), kind: ErrConflict, message: "el email ya existe"},
+		{name: "forbidden", err: Forbidden("sin permisos"), kind: ErrForbidden, message: "sin permisos"},
+		{name: "unauthorized", err: Unauthorized("token inválido"), kind: ErrUnauthorized, message: "token inválido"},
+		{name: "validation", err: Validation("datos inválidos", map[string]string{"name": "requerido"}), kind: ErrValidation, message: "datos inválidos"},
+		{name: "se conserva al envolver", err: fmt.Errorf("contexto: %w", NotFound("no existe")), kind: ErrNotFound, message: "contexto: no existe"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.ErrorIs(t, tt.err, tt.kind)
+			assert.Equal(t, tt.message, tt.err.Error())
+
+			var typed *Error
+			assert.True(t, errors.As(tt.err, &typed))
+		})
+	}
+}
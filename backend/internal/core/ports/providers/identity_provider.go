@@ -0,0 +1,12 @@
+package providers
+
+import (
+	"context"
+	"softpharos/internal/core/domain/identity"
+)
+
+// IdentityProvider verifica un ID token emitido por un proveedor externo
+// (Google, un OIDC genérico o el proveedor local de desarrollo)
+type IdentityProvider interface {
+	Verify(ctx context.Context, idToken string) (*identity.ProviderIdentity, error)
+}
@@ -0,0 +1,11 @@
+package repository
+
+import (
+	"context"
+	"softpharos/internal/core/domain/audit"
+)
+
+// AuditLogRepository define el contrato para registrar acciones administrativas
+type AuditLogRepository interface {
+	Create(ctx context.Context, entry *audit.Entry) error
+}
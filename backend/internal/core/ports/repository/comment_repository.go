@@ -3,13 +3,17 @@ package repository
 import (
 	"context"
 	"softpharos/internal/core/domain/comment"
+	"softpharos/internal/core/domain/query"
 )
 
 type CommentRepository interface {
-	GetAll(ctx context.Context) ([]comment.Comment, error)
+	GetAll(ctx context.Context, params query.Params) (*query.Page[comment.Comment], error)
 	GetByID(ctx context.Context, id int) (*comment.Comment, error)
 	GetByMilestoneID(ctx context.Context, milestoneID int) ([]comment.Comment, error)
 	Create(ctx context.Context, comment *comment.Comment) error
 	Update(ctx context.Context, comment *comment.Comment) error
 	Delete(ctx context.Context, id int) error
+	GetTrashedByID(ctx context.Context, id int) (*comment.Comment, error)
+	GetTrashedByMilestoneID(ctx context.Context, milestoneID int) ([]comment.Comment, error)
+	Restore(ctx context.Context, id int) error
 }
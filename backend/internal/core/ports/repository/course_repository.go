@@ -0,0 +1,29 @@
+package repository
+
+import (
+	"context"
+	"softpharos/internal/core/domain/course"
+	"softpharos/internal/core/domain/query"
+)
+
+// CourseRepository define el contrato para la persistencia de asignaturas y sus secciones
+type CourseRepository interface {
+	GetAll(ctx context.Context, params query.Params) (*query.Page[course.Course], error)
+	GetByID(ctx context.Context, id int) (*course.Course, error)
+	Create(ctx context.Context, course *course.Course) error
+	Update(ctx context.Context, course *course.Course) error
+	Delete(ctx context.Context, id int) error
+	// GetSections lista las secciones de la asignatura; termID las restringe a un periodo
+	GetSections(ctx context.Context, courseID int, termID *int) ([]course.Section, error)
+	GetSectionByID(ctx context.Context, id int) (*course.Section, error)
+	CreateSection(ctx context.Context, section *course.Section) error
+	DeleteSection(ctx context.Context, id int) error
+}
+
+// CourseMemberRepository define el contrato para la persistencia de las inscripciones en secciones
+type CourseMemberRepository interface {
+	GetBySectionID(ctx context.Context, sectionID int) ([]course.Member, error)
+	GetBySectionAndUser(ctx context.Context, sectionID int, userID int) (*course.Member, error)
+	Create(ctx context.Context, member *course.Member) error
+	Delete(ctx context.Context, sectionID int, userID int) error
+}
@@ -3,13 +3,17 @@ package repository
 import (
 	"context"
 	"softpharos/internal/core/domain/deliverable"
+	"softpharos/internal/core/domain/query"
 )
 
 type DeliverableRepository interface {
-	GetAll(ctx context.Context) ([]deliverable.Deliverable, error)
+	GetAll(ctx context.Context, params query.Params) (*query.Page[deliverable.Deliverable], error)
 	GetByID(ctx context.Context, id int) (*deliverable.Deliverable, error)
 	GetByMilestoneID(ctx context.Context, milestoneID int) ([]deliverable.Deliverable, error)
 	Create(ctx context.Context, deliverable *deliverable.Deliverable) error
 	Update(ctx context.Context, deliverable *deliverable.Deliverable) error
 	Delete(ctx context.Context, id int) error
+	GetTrashedByID(ctx context.Context, id int) (*deliverable.Deliverable, error)
+	GetTrashedByMilestoneID(ctx context.Context, milestoneID int) ([]deliverable.Deliverable, error)
+	Restore(ctx context.Context, id int) error
 }
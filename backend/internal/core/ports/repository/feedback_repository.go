@@ -3,13 +3,17 @@ package repository
 import (
 	"context"
 	"softpharos/internal/core/domain/feedback"
+	"softpharos/internal/core/domain/query"
 )
 
 type FeedbackRepository interface {
-	GetAll(ctx context.Context) ([]feedback.Feedback, error)
+	GetAll(ctx context.Context, params query.Params) (*query.Page[feedback.Feedback], error)
 	GetByID(ctx context.Context, id int) (*feedback.Feedback, error)
 	GetByMilestoneID(ctx context.Context, milestoneID int) ([]feedback.Feedback, error)
 	Create(ctx context.Context, feedback *feedback.Feedback) error
 	Update(ctx context.Context, feedback *feedback.Feedback) error
 	Delete(ctx context.Context, id int) error
+	GetTrashedByID(ctx context.Context, id int) (*feedback.Feedback, error)
+	GetTrashedByMilestoneID(ctx context.Context, milestoneID int) ([]feedback.Feedback, error)
+	Restore(ctx context.Context, id int) error
 }
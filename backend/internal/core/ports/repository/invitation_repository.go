@@ -0,0 +1,19 @@
+package repository
+
+import (
+	"context"
+	"softpharos/internal/core/domain/invitation"
+	"time"
+)
+
+type InvitationRepository interface {
+	Create(ctx context.Context, invitation *invitation.Invitation) error
+	GetByID(ctx context.Context, id int) (*invitation.Invitation, error)
+	GetByTokenHash(ctx context.Context, tokenHash string) (*invitation.Invitation, error)
+	GetByProjectID(ctx context.Context, projectID int) ([]invitation.Invitation, error)
+	// GetPendingByEmail devuelve las invitaciones pendientes y vigentes en now para el email
+	GetPendingByEmail(ctx context.Context, email string, now time.Time) ([]invitation.Invitation, error)
+	// Respond cierra la invitación con el estado indicado si sigue pendiente.
+	// Devuelve false si otra petición la cerró antes.
+	Respond(ctx context.Context, id int, status string, at time.Time) (bool, error)
+}
@@ -3,13 +3,32 @@ package repository
 import (
 	"context"
 	"softpharos/internal/core/domain/milestone"
+	"softpharos/internal/core/domain/milestone_detail"
+	"softpharos/internal/core/domain/query"
+	"time"
 )
 
 type MilestoneRepository interface {
-	GetAll(ctx context.Context) ([]milestone.Milestone, error)
+	GetAll(ctx context.Context, params query.Params) (*query.Page[milestone.Milestone], error)
 	GetByID(ctx context.Context, id int) (*milestone.Milestone, error)
 	GetByProjectID(ctx context.Context, projectID int) ([]milestone.Milestone, error)
 	Create(ctx context.Context, milestone *milestone.Milestone) error
 	Update(ctx context.Context, milestone *milestone.Milestone) error
+	// Delete envía el hito a la papelera junto con su contenido
 	Delete(ctx context.Context, id int) error
+	GetTrashedByID(ctx context.Context, id int) (*milestone.Milestone, error)
+	GetTrashedByProjectID(ctx context.Context, projectID int) ([]milestone.Milestone, error)
+	Restore(ctx context.Context, id int) error
+	// UpdateStatus pasa el hito de from a to solo si sigue en from; retorna
+	// false si otro cambio se adelantó
+	UpdateStatus(ctx context.Context, id int, from string, to string, at time.Time) (bool, error)
+	// GetOverdueByProjectID lista los hitos del proyecto pendientes con fecha límite anterior a today
+	GetOverdueByProjectID(ctx context.Context, projectID int, today time.Time) ([]milestone.Milestone, error)
+	// GetOverdueByCourse lista los hitos vencidos de los proyectos de la
+	// asignatura; admite los filtros term_id y section_id
+	GetOverdueByCourse(ctx context.Context, courseID int, today time.Time, params query.Params) (*query.Page[milestone.Milestone], error)
+	// GetDetail carga el hito con las relaciones de includes, que deben estar en milestone_detail.Includes
+	GetDetail(ctx context.Context, id int, includes []string) (*milestone_detail.Detail, error)
+	// GetCounts resume el contenido de cada hito de milestoneIDs, incluidos los que no tienen nada
+	GetCounts(ctx context.Context, milestoneIDs []int) (map[int]*milestone.Counts, error)
 }
@@ -3,13 +3,20 @@ package repository
 import (
 	"context"
 	"softpharos/internal/core/domain/project_member"
+	"softpharos/internal/core/domain/query"
 )
 
 type ProjectMemberRepository interface {
-	GetAll(ctx context.Context) ([]project_member.ProjectMember, error)
+	GetAll(ctx context.Context, params query.Params) (*query.Page[project_member.ProjectMember], error)
 	GetByID(ctx context.Context, id int) (*project_member.ProjectMember, error)
 	GetByProjectID(ctx context.Context, projectID int) ([]project_member.ProjectMember, error)
+	IsMember(ctx context.Context, projectID int, userID int) (bool, error)
+	GetByProjectAndUser(ctx context.Context, projectID int, userID int) (*project_member.ProjectMember, error)
+	// CountByRole cuenta los miembros del proyecto que tienen el rol indicado
+	CountByRole(ctx context.Context, projectID int, role string) (int64, error)
 	Create(ctx context.Context, projectMember *project_member.ProjectMember) error
 	Update(ctx context.Context, projectMember *project_member.ProjectMember) error
+	// UpdateRole cambia solo el rol del miembro
+	UpdateRole(ctx context.Context, id int, role string) error
 	Delete(ctx context.Context, id int) error
 }
@@ -3,14 +3,30 @@ package repository
 import (
 	"context"
 	"softpharos/internal/core/domain/project"
+	"softpharos/internal/core/domain/query"
+	"time"
 )
 
 // ProjectRepository define el contrato para las operaciones de persistencia de proyectos
 type ProjectRepository interface {
-	GetAll(ctx context.Context) ([]project.Project, error)
+	GetAll(ctx context.Context, params query.Params) (*query.Page[project.Project], error)
 	GetByID(ctx context.Context, id int) (*project.Project, error)
 	GetByOwner(ctx context.Context, ownerID int) ([]project.Project, error)
+	// GetByMember lista los proyectos en los que participa el usuario junto con su rol en cada uno
+	GetByMember(ctx context.Context, userID int, params query.Params) (*query.Page[project.MemberProject], error)
+	// GetByCourse lista los proyectos vinculados a alguna sección de la asignatura
+	GetByCourse(ctx context.Context, courseID int, params query.Params) (*query.Page[project.Project], error)
 	Create(ctx context.Context, project *project.Project) error
 	Update(ctx context.Context, project *project.Project) error
+	// UpdateOwner cambia solo el owner principal (created_by) del proyecto
+	UpdateOwner(ctx context.Context, id int, ownerID int) error
+	// SetArchivedAt archiva el proyecto, o lo reactiva cuando at es nil
+	SetArchivedAt(ctx context.Context, id int, at *time.Time) error
+	// Delete envía el proyecto a la papelera junto con sus hitos
 	Delete(ctx context.Context, id int) error
+	GetTrashed(ctx context.Context, params query.Params) (*query.Page[project.Project], error)
+	// GetTrashedByOwner lista los proyectos borrados en los que el usuario es owner
+	GetTrashedByOwner(ctx context.Context, userID int, params query.Params) (*query.Page[project.Project], error)
+	GetTrashedByID(ctx context.Context, id int) (*project.Project, error)
+	Restore(ctx context.Context, id int) error
 }
@@ -2,14 +2,22 @@ package repository
 
 import (
 	"context"
+	"softpharos/internal/core/domain/query"
 	"softpharos/internal/core/domain/reaction"
 )
 
 type ReactionRepository interface {
-	GetAll(ctx context.Context) ([]reaction.Reaction, error)
+	GetAll(ctx context.Context, params query.Params) (*query.Page[reaction.Reaction], error)
 	GetByID(ctx context.Context, id int) (*reaction.Reaction, error)
 	GetByMilestoneID(ctx context.Context, milestoneID int) ([]reaction.Reaction, error)
 	Create(ctx context.Context, reaction *reaction.Reaction) error
 	Update(ctx context.Context, reaction *reaction.Reaction) error
 	Delete(ctx context.Context, id int) error
+	// AddForUser registra la reacción salvo que el usuario ya haya reaccionado
+	// con ese tipo; en ambos casos termina sin error
+	AddForUser(ctx context.Context, reaction *reaction.Reaction) error
+	// RemoveForUser retira la reacción del usuario, si la tiene
+	RemoveForUser(ctx context.Context, milestoneID int, userID int, reactionType string) error
+	// CountByMilestone cuenta las reacciones del hito por tipo e indica en cuáles reaccionó userID
+	CountByMilestone(ctx context.Context, milestoneID int, userID int) (map[string]reaction.TypeCount, error)
 }
@@ -0,0 +1,19 @@
+package repository
+
+import (
+	"context"
+	"softpharos/internal/core/domain/session"
+	"time"
+)
+
+// RefreshTokenRepository define el contrato para la persistencia de refresh tokens
+type RefreshTokenRepository interface {
+	Create(ctx context.Context, token *session.RefreshToken) error
+	GetByTokenHash(ctx context.Context, tokenHash string) (*session.RefreshToken, error)
+	// Revoke marca el token como revocado. Retorna false si ya lo estaba.
+	Revoke(ctx context.Context, id int) (bool, error)
+	RevokeAllByUserID(ctx context.Context, userID int) error
+	// DeleteExpired elimina los refresh tokens que expiraron antes de now y
+	// retorna cuántos se eliminaron
+	DeleteExpired(ctx context.Context, now time.Time) (int64, error)
+}
@@ -0,0 +1,20 @@
+package repository
+
+import (
+	"context"
+	"softpharos/internal/core/domain/session"
+	"time"
+)
+
+// RevokedTokenRepository define el contrato para la lista de access tokens revocados
+type RevokedTokenRepository interface {
+	Create(ctx context.Context, token *session.RevokedToken) error
+	// RevokeUserTokens invalida los access tokens del usuario emitidos antes de
+	// before o en su mismo segundo (ver session.RevokedBy)
+	RevokeUserTokens(ctx context.Context, userID int, before time.Time) error
+	// IsRevoked indica si el token fue revocado por su jti o por una revocación del usuario
+	IsRevoked(ctx context.Context, jti string, userID int, issuedAt time.Time) (bool, error)
+	// DeleteExpired elimina los tokens revocados que expiraron antes de now y
+	// retorna cuántos se eliminaron
+	DeleteExpired(ctx context.Context, now time.Time) (int64, error)
+}
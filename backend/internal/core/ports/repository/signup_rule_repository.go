@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"context"
+	"softpharos/internal/core/domain/query"
+	"softpharos/internal/core/domain/signup_rule"
+)
+
+// SignupRuleRepository define el contrato para la persistencia de reglas de registro
+type SignupRuleRepository interface {
+	GetAll(ctx context.Context, params query.Params) (*query.Page[signup_rule.SignupRule], error)
+	GetByID(ctx context.Context, id int) (*signup_rule.SignupRule, error)
+	// GetByPatterns retorna las reglas cuyo patrón es alguno de los indicados
+	GetByPatterns(ctx context.Context, patterns []string) ([]signup_rule.SignupRule, error)
+	Create(ctx context.Context, rule *signup_rule.SignupRule) error
+	Update(ctx context.Context, rule *signup_rule.SignupRule) error
+	Delete(ctx context.Context, id int) error
+}
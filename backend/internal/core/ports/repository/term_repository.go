@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"context"
+	"softpharos/internal/core/domain/query"
+	"softpharos/internal/core/domain/term"
+)
+
+// TermRepository define el contrato para la persistencia de periodos académicos
+type TermRepository interface {
+	GetAll(ctx context.Context, params query.Params) (*query.Page[term.Term], error)
+	GetByID(ctx context.Context, id int) (*term.Term, error)
+	// GetByProjects retorna el periodo de cada proyecto vinculado a una sección, indexado por proyecto
+	GetByProjects(ctx context.Context, projectIDs []int) (map[int]*term.Term, error)
+	Create(ctx context.Context, term *term.Term) error
+	Update(ctx context.Context, term *term.Term) error
+	Delete(ctx context.Context, id int) error
+}
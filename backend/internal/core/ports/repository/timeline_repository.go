@@ -0,0 +1,11 @@
+package repository
+
+import (
+	"context"
+	"softpharos/internal/core/domain/query"
+	"softpharos/internal/core/domain/timeline"
+)
+
+type TimelineRepository interface {
+	GetByProjectID(ctx context.Context, projectID int, params query.Params) (*query.Page[timeline.Event], error)
+}
@@ -0,0 +1,13 @@
+package repository
+
+import (
+	"context"
+	"time"
+)
+
+// TrashRepository elimina definitivamente lo que lleva tiempo en la papelera
+type TrashRepository interface {
+	// Purge borra las filas enviadas a la papelera antes de before y devuelve
+	// cuántas eran. before va en UTC, igual que deleted_at (ver databases.TrashedAt).
+	Purge(ctx context.Context, before time.Time) (int64, error)
+}
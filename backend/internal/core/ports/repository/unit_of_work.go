@@ -0,0 +1,22 @@
+package repository
+
+import "context"
+
+// Repositories agrupa los repositorios que comparten la transacción de una
+// unidad de trabajo
+type Repositories struct {
+	Users          UserRepository
+	Roles          RoleRepository
+	Projects       ProjectRepository
+	ProjectMembers ProjectMemberRepository
+	Invitations    InvitationRepository
+	RevokedTokens  RevokedTokenRepository
+	AuditLog       AuditLogRepository
+}
+
+// UnitOfWork ejecuta varias operaciones de repositorio de forma atómica
+type UnitOfWork interface {
+	// Do ejecuta fn con repositorios ligados a una transacción. Si fn
+	// retorna un error se revierte todo lo hecho; si no, se confirma.
+	Do(ctx context.Context, fn func(repos Repositories) error) error
+}
@@ -2,15 +2,18 @@ package repository
 
 import (
 	"context"
+	"softpharos/internal/core/domain/query"
 	"softpharos/internal/core/domain/user"
 )
 
 type UserRepository interface {
-	GetAll(ctx context.Context) ([]user.User, error)
+	GetAll(ctx context.Context, params query.Params) (*query.Page[user.User], error)
 	GetByID(ctx context.Context, id int) (*user.User, error)
 	GetByEmail(ctx context.Context, email string) (*user.User, error)
 	GetByProviderID(ctx context.Context, providerID string) (*user.User, error)
 	Create(ctx context.Context, user *user.User) error
 	Update(ctx context.Context, user *user.User) error
+	// UpdateRole cambia solo el rol del usuario
+	UpdateRole(ctx context.Context, id int, roleID int) error
 	Delete(ctx context.Context, id int) error
 }
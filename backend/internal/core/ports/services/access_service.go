@@ -0,0 +1,30 @@
+package services
+
+import (
+	"context"
+	"softpharos/internal/core/domain/project_member"
+	"softpharos/internal/core/errs"
+)
+
+// ErrForbidden indica que el usuario autenticado no puede operar sobre el recurso
+var ErrForbidden = errs.Forbidden("no tienes permisos sobre este proyecto")
+
+// ErrNotAuthor indica que el usuario autenticado no es el autor del recurso
+var ErrNotAuthor = errs.Forbidden("solo el autor puede modificar este recurso")
+
+// ErrSectionForbidden indica que el usuario autenticado no está inscrito en la sección o no la dicta
+var ErrSectionForbidden = errs.Forbidden("no tienes permisos sobre esta sección")
+
+// AccessService verifica la propiedad, membresía y habilidades de proyecto del
+// usuario autenticado, su inscripción en las secciones de asignatura y la
+// autoría de comentarios, reacciones y retroalimentación
+type AccessService interface {
+	RequireAuthor(ctx context.Context, authorID int) error
+	RequireProjectMember(ctx context.Context, projectID int) error
+	RequireProjectOwner(ctx context.Context, projectID int) error
+	RequireMilestoneMember(ctx context.Context, milestoneID int) error
+	RequireProjectAbility(ctx context.Context, projectID int, ability project_member.Ability) error
+	RequireMilestoneAbility(ctx context.Context, milestoneID int, ability project_member.Ability) error
+	RequireSectionMember(ctx context.Context, sectionID int) error
+	RequireSectionProfessor(ctx context.Context, sectionID int) error
+}
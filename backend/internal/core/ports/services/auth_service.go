@@ -2,9 +2,27 @@ package services
 
 import (
 	"context"
+	"softpharos/internal/core/domain/session"
 	"softpharos/internal/core/domain/user"
+	"softpharos/internal/core/errs"
+)
+
+var (
+	// ErrInvalidIDToken indica que el proveedor de identidad rechazó el ID token
+	ErrInvalidIDToken = errs.Unauthorized("ID token inválido")
+	// ErrInvalidRefreshToken indica que el refresh token no existe, expiró o ya fue usado
+	ErrInvalidRefreshToken = errs.Unauthorized("refresh token inválido o expirado")
 )
 
 type AuthService interface {
-	AuthenticateWithGoogle(ctx context.Context, idToken string) (*user.User, string, error)
+	// Authenticate verifica el ID token con el proveedor de identidad configurado
+	// y registra al usuario en su primer inicio de sesión
+	Authenticate(ctx context.Context, idToken string) (*user.User, *session.Tokens, error)
+	// RefreshSession rota el refresh token y emite un nuevo access token
+	RefreshSession(ctx context.Context, refreshToken string) (*user.User, *session.Tokens, error)
+	// Logout revoca el access token de la petición y, si se envía, el refresh token
+	Logout(ctx context.Context, refreshToken string) error
+	// PurgeExpiredTokens elimina los refresh tokens y los access tokens
+	// revocados que ya expiraron y retorna cuántos se eliminaron
+	PurgeExpiredTokens(ctx context.Context) (int64, error)
 }
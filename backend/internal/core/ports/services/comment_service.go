@@ -3,13 +3,16 @@ package services
 import (
 	"context"
 	"softpharos/internal/core/domain/comment"
+	"softpharos/internal/core/domain/query"
 )
 
 type CommentService interface {
-	GetAllComments(ctx context.Context) ([]comment.Comment, error)
+	GetAllComments(ctx context.Context, params query.Params) (*query.Page[comment.Comment], error)
 	GetCommentByID(ctx context.Context, id int) (*comment.Comment, error)
 	GetCommentsByMilestoneID(ctx context.Context, milestoneID int) ([]comment.Comment, error)
 	CreateComment(ctx context.Context, comment *comment.Comment) error
 	UpdateComment(ctx context.Context, comment *comment.Comment) error
 	DeleteComment(ctx context.Context, id int) error
+	GetTrashedCommentsByMilestoneID(ctx context.Context, milestoneID int) ([]comment.Comment, error)
+	RestoreComment(ctx context.Context, id int) error
 }
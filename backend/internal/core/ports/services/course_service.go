@@ -0,0 +1,32 @@
+package services
+
+import (
+	"context"
+	"softpharos/internal/core/domain/course"
+	"softpharos/internal/core/domain/milestone"
+	"softpharos/internal/core/domain/project"
+	"softpharos/internal/core/domain/query"
+	"softpharos/internal/core/errs"
+)
+
+// ErrInvalidCourseRole indica que el rol de inscripción no es professor ni student
+var ErrInvalidCourseRole = errs.Validation("rol de sección inválido", map[string]string{"role": "debe ser professor o student"})
+
+type CourseService interface {
+	GetAllCourses(ctx context.Context, params query.Params) (*query.Page[course.Course], error)
+	GetCourseByID(ctx context.Context, id int) (*course.Course, error)
+	CreateCourse(ctx context.Context, c *course.Course) error
+	UpdateCourse(ctx context.Context, c *course.Course) error
+	DeleteCourse(ctx context.Context, id int) error
+	// GetCourseSections lista las secciones de la asignatura, opcionalmente de un solo periodo
+	GetCourseSections(ctx context.Context, courseID int, termID *int) ([]course.Section, error)
+	CreateCourseSection(ctx context.Context, section *course.Section) error
+	DeleteCourseSection(ctx context.Context, id int) error
+	GetSectionMembers(ctx context.Context, sectionID int) ([]course.Member, error)
+	AddSectionMember(ctx context.Context, member *course.Member) error
+	RemoveSectionMember(ctx context.Context, sectionID int, userID int) error
+	// GetCourseProjects lista los proyectos vinculados a las secciones de la asignatura
+	GetCourseProjects(ctx context.Context, courseID int, params query.Params) (*query.Page[project.Project], error)
+	// GetOverdueMilestones lista los hitos vencidos de los proyectos de la asignatura
+	GetOverdueMilestones(ctx context.Context, courseID int, params query.Params) (*query.Page[milestone.Milestone], error)
+}
@@ -0,0 +1,33 @@
+package services
+
+import (
+	"context"
+	"softpharos/internal/core/domain/invitation"
+	"softpharos/internal/core/domain/project_member"
+	"softpharos/internal/core/errs"
+)
+
+var (
+	// ErrInvitationNotFound indica que el token no corresponde a ninguna invitación
+	ErrInvitationNotFound = errs.NotFound("la invitación no existe")
+	// ErrInvitationClosed indica que la invitación ya se respondió o expiró
+	ErrInvitationClosed = errs.Conflict("la invitación ya no está vigente")
+	// ErrInvitationForAnotherEmail indica que el usuario autenticado no es el invitado
+	ErrInvitationForAnotherEmail = errs.Forbidden("la invitación es para otro email")
+	// ErrAlreadyProjectMember indica que el invitado ya pertenece al proyecto
+	ErrAlreadyProjectMember = errs.Conflict("el usuario ya es miembro del proyecto")
+)
+
+type InvitationService interface {
+	// CreateInvitation registra la invitación y devuelve su token. El token
+	// no se guarda, así que solo se conoce en este momento.
+	CreateInvitation(ctx context.Context, invitation *invitation.Invitation) (string, error)
+	GetInvitationsByProjectID(ctx context.Context, projectID int) ([]invitation.Invitation, error)
+	AcceptInvitation(ctx context.Context, token string) (*project_member.ProjectMember, error)
+	DeclineInvitation(ctx context.Context, token string) error
+	// GetMyInvitations lista las invitaciones pendientes para el email del
+	// usuario autenticado, que las responde por ID sin conocer el token
+	GetMyInvitations(ctx context.Context) ([]invitation.Invitation, error)
+	AcceptInvitationByID(ctx context.Context, id int) (*project_member.ProjectMember, error)
+	DeclineInvitationByID(ctx context.Context, id int) error
+}
@@ -3,13 +3,36 @@ package services
 import (
 	"context"
 	"softpharos/internal/core/domain/milestone"
+	"softpharos/internal/core/domain/milestone_detail"
+	"softpharos/internal/core/domain/query"
+	"softpharos/internal/core/errs"
 )
 
+// ErrInvalidClassWeek indica una semana de clase fuera del calendario del periodo
+var ErrInvalidClassWeek = errs.Validation("semana de clase inválida", map[string]string{"class_week": "debe ser una semana de clase del periodo"})
+
+// ErrInvalidStatusTransition indica un cambio de estado que no sigue el orden
+// planned → in_progress → submitted → reviewed
+var ErrInvalidStatusTransition = errs.Conflict("el hito no puede pasar a ese estado")
+
+// ErrReviewWithoutSection indica que solo un administrador puede revisar los
+// hitos de un proyecto sin sección
+var ErrReviewWithoutSection = errs.Forbidden("solo un administrador puede revisar los hitos de un proyecto sin sección")
+
+// ErrInvalidInclude indica una relación que no se puede incluir al leer un hito
+var ErrInvalidInclude = errs.Validation("include inválido", map[string]string{"include": "solo admite deliverables, feedback, comments y reactions"})
+
 type MilestoneService interface {
-	GetAllMilestones(ctx context.Context) ([]milestone.Milestone, error)
+	GetAllMilestones(ctx context.Context, params query.Params) (*query.Page[milestone.Milestone], error)
 	GetMilestoneByID(ctx context.Context, id int) (*milestone.Milestone, error)
+	// GetMilestoneDetail retorna el hito con las relaciones de includes
+	GetMilestoneDetail(ctx context.Context, id int, includes []string) (*milestone_detail.Detail, error)
 	GetMilestonesByProjectID(ctx context.Context, projectID int) ([]milestone.Milestone, error)
 	CreateMilestone(ctx context.Context, milestone *milestone.Milestone) error
 	UpdateMilestone(ctx context.Context, milestone *milestone.Milestone) error
 	DeleteMilestone(ctx context.Context, id int) error
+	GetTrashedMilestonesByProjectID(ctx context.Context, projectID int) ([]milestone.Milestone, error)
+	RestoreMilestone(ctx context.Context, id int) error
+	GetOverdueMilestonesByProjectID(ctx context.Context, projectID int) ([]milestone.Milestone, error)
+	TransitionMilestone(ctx context.Context, id int, status string) (*milestone.Milestone, error)
 }
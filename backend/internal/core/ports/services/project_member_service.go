@@ -3,10 +3,21 @@ package services
 import (
 	"context"
 	"softpharos/internal/core/domain/project_member"
+	"softpharos/internal/core/domain/query"
+	"softpharos/internal/core/errs"
+)
+
+var (
+	// ErrLastOwner indica que la operación dejaría al proyecto sin owners
+	ErrLastOwner = errs.Conflict("el proyecto debe tener al menos un owner")
+	// ErrNotProjectMember indica que el usuario indicado no pertenece al proyecto
+	ErrNotProjectMember = errs.Validation("el usuario no es miembro del proyecto", map[string]string{"user_id": "no es miembro del proyecto"})
+	// ErrInvalidProjectRole indica que el rol no es uno de los roles de proyecto
+	ErrInvalidProjectRole = errs.Validation("rol de proyecto inválido", map[string]string{"role": "debe ser owner, maintainer, contributor o viewer"})
 )
 
 type ProjectMemberService interface {
-	GetAllProjectMembers(ctx context.Context) ([]project_member.ProjectMember, error)
+	GetAllProjectMembers(ctx context.Context, params query.Params) (*query.Page[project_member.ProjectMember], error)
 	GetProjectMemberByID(ctx context.Context, id int) (*project_member.ProjectMember, error)
 	GetProjectMembersByProjectID(ctx context.Context, projectID int) ([]project_member.ProjectMember, error)
 	CreateProjectMember(ctx context.Context, projectMember *project_member.ProjectMember) error
@@ -3,13 +3,25 @@ package services
 import (
 	"context"
 	"softpharos/internal/core/domain/project"
+	"softpharos/internal/core/domain/query"
+	"softpharos/internal/core/errs"
 )
 
+// ErrInvalidProjectStatus indica un filtro de estado distinto de active o archived
+var ErrInvalidProjectStatus = errs.Validation("estado de proyecto inválido", map[string]string{"status": "debe ser active o archived"})
+
 type ProjectService interface {
-	GetAllProjects(ctx context.Context) ([]project.Project, error)
+	GetAllProjects(ctx context.Context, params query.Params) (*query.Page[project.Project], error)
 	GetProjectByID(ctx context.Context, id int) (*project.Project, error)
 	GetProjectsByOwner(ctx context.Context, ownerID int) ([]project.Project, error)
+	// GetMyProjects lista los proyectos del usuario autenticado; admite los filtros status y role
+	GetMyProjects(ctx context.Context, params query.Params) (*query.Page[project.MemberProject], error)
 	CreateProject(ctx context.Context, project *project.Project) error
 	UpdateProject(ctx context.Context, project *project.Project) error
 	DeleteProject(ctx context.Context, id int) error
+	SetProjectArchived(ctx context.Context, id int, archived bool) error
+	// TransferOwnership convierte a newOwnerID, que debe ser miembro, en el owner principal del proyecto
+	TransferOwnership(ctx context.Context, projectID int, newOwnerID int) error
+	GetTrashedProjects(ctx context.Context, params query.Params) (*query.Page[project.Project], error)
+	RestoreProject(ctx context.Context, id int) error
 }
@@ -2,14 +2,26 @@ package services
 
 import (
 	"context"
+	"softpharos/internal/core/domain/query"
 	"softpharos/internal/core/domain/reaction"
+	"softpharos/internal/core/errs"
 )
 
+// ErrInvalidReactionType indica un tipo de reacción ausente o fuera del catálogo
+var ErrInvalidReactionType = errs.Validation("tipo de reacción inválido", map[string]string{"type": "debe ser un tipo del catálogo"})
+
 type ReactionService interface {
-	GetAllReactions(ctx context.Context) ([]reaction.Reaction, error)
+	GetAllReactions(ctx context.Context, params query.Params) (*query.Page[reaction.Reaction], error)
 	GetReactionByID(ctx context.Context, id int) (*reaction.Reaction, error)
 	GetReactionsByMilestoneID(ctx context.Context, milestoneID int) ([]reaction.Reaction, error)
 	CreateReaction(ctx context.Context, reaction *reaction.Reaction) error
 	UpdateReaction(ctx context.Context, reaction *reaction.Reaction) error
 	DeleteReaction(ctx context.Context, id int) error
+	// GetReactionTypes retorna el catálogo de tipos de reacción
+	GetReactionTypes() []string
+	// GetReactionSummary cuenta las reacciones del hito por tipo del catálogo e
+	// indica en cuáles reaccionó userID
+	GetReactionSummary(ctx context.Context, milestoneID int, userID int) ([]reaction.TypeCount, error)
+	// SetReaction agrega o retira la reacción de userID y retorna el resumen actualizado
+	SetReaction(ctx context.Context, milestoneID int, userID int, reactionType string, reacted bool) ([]reaction.TypeCount, error)
 }
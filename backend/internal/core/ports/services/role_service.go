@@ -2,11 +2,19 @@ package services
 
 import (
 	"context"
+	"softpharos/internal/core/domain/query"
 	"softpharos/internal/core/domain/role"
+	"softpharos/internal/core/errs"
 )
 
+// ErrProtectedRole indica que se intentó renombrar o eliminar un rol del sistema
+var ErrProtectedRole = errs.Forbidden("los roles del sistema no se pueden renombrar ni eliminar")
+
 type RoleService interface {
-	GetAllRoles(ctx context.Context) ([]role.Role, error)
+	GetAllRoles(ctx context.Context, params query.Params) (*query.Page[role.Role], error)
 	GetRoleByID(ctx context.Context, id int) (*role.Role, error)
 	GetRoleByName(ctx context.Context, name string) (*role.Role, error)
+	CreateRole(ctx context.Context, role *role.Role) error
+	UpdateRole(ctx context.Context, role *role.Role) error
+	DeleteRole(ctx context.Context, id int) error
 }
@@ -0,0 +1,29 @@
+package services
+
+import (
+	"context"
+	"softpharos/internal/core/domain/identity"
+	"softpharos/internal/core/domain/query"
+	"softpharos/internal/core/domain/signup_rule"
+	"softpharos/internal/core/errs"
+)
+
+var (
+	// ErrDomainNotAllowed indica que la cuenta no pertenece a un dominio permitido
+	ErrDomainNotAllowed = errs.Forbidden("el dominio de la cuenta no está permitido")
+	// ErrInvalidSignupRule indica que el patrón o el rol de la regla no son válidos
+	ErrInvalidSignupRule = errs.Validation("regla de registro inválida", nil)
+)
+
+type SignupRuleService interface {
+	GetAllSignupRules(ctx context.Context, params query.Params) (*query.Page[signup_rule.SignupRule], error)
+	GetSignupRuleByID(ctx context.Context, id int) (*signup_rule.SignupRule, error)
+	CreateSignupRule(ctx context.Context, rule *signup_rule.SignupRule) error
+	UpdateSignupRule(ctx context.Context, rule *signup_rule.SignupRule) error
+	DeleteSignupRule(ctx context.Context, id int) error
+	// MatchAccount verifica que la cuenta pueda iniciar sesión y retorna la
+	// regla que le aplica (nil si ninguna). Los dominios se comparan contra el
+	// dominio alojado (hd) de la cuenta; las reglas por email tienen prioridad
+	// sobre las reglas por dominio.
+	MatchAccount(ctx context.Context, account *identity.ProviderIdentity) (*signup_rule.SignupRule, error)
+}
@@ -0,0 +1,25 @@
+package services
+
+import (
+	"context"
+	"softpharos/internal/core/domain/query"
+	"softpharos/internal/core/domain/term"
+	"softpharos/internal/core/errs"
+)
+
+var (
+	// ErrInvalidTerm indica que el periodo termina antes de comenzar
+	ErrInvalidTerm = errs.Validation("periodo inválido", map[string]string{"end_date": "debe ser posterior a start_date"})
+	// ErrInvalidWeekLength indica una duración de semana de clase negativa
+	ErrInvalidWeekLength = errs.Validation("duración de semana inválida", map[string]string{"week_length": "debe ser mayor que 0"})
+	// ErrInvalidTermBreak indica un receso con fechas invertidas o fuera del periodo
+	ErrInvalidTermBreak = errs.Validation("receso inválido", map[string]string{"breaks": "cada receso debe estar dentro del periodo y no terminar antes de comenzar"})
+)
+
+type TermService interface {
+	GetAllTerms(ctx context.Context, params query.Params) (*query.Page[term.Term], error)
+	GetTermByID(ctx context.Context, id int) (*term.Term, error)
+	CreateTerm(ctx context.Context, t *term.Term) error
+	UpdateTerm(ctx context.Context, t *term.Term) error
+	DeleteTerm(ctx context.Context, id int) error
+}
@@ -0,0 +1,12 @@
+package services
+
+import (
+	"context"
+	"softpharos/internal/core/domain/query"
+	"softpharos/internal/core/domain/timeline"
+)
+
+type TimelineService interface {
+	// GetProjectTimeline lista en orden cronológico todo lo que se ha creado en el proyecto
+	GetProjectTimeline(ctx context.Context, projectID int, params query.Params) (*query.Page[timeline.Event], error)
+}
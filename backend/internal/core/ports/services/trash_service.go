@@ -0,0 +1,9 @@
+package services
+
+import "context"
+
+// TrashService gestiona la retención de la papelera
+type TrashService interface {
+	// PurgeExpired elimina lo que superó el periodo de retención y devuelve cuántas filas borró
+	PurgeExpired(ctx context.Context) (int64, error)
+}
@@ -2,14 +2,29 @@ package services
 
 import (
 	"context"
+	"softpharos/internal/core/domain/query"
 	"softpharos/internal/core/domain/user"
+	"softpharos/internal/core/errs"
+)
+
+var (
+	// ErrSelfRoleChange evita que un administrador cambie su propio rol y pierda el acceso
+	ErrSelfRoleChange = errs.Forbidden("no puedes cambiar tu propio rol")
+	// ErrRoleNotFound indica que el rol asignado no existe
+	ErrRoleNotFound = errs.Validation("el rol no existe", map[string]string{"role_id": "no existe"})
+	// ErrRoleWithoutPermissions indica que el rol no está en la política de
+	// permisos y dejaría al usuario sin acceso a la API
+	ErrRoleWithoutPermissions = errs.Validation("el rol no tiene permisos en la API", map[string]string{"role_id": "debe ser admin, professor o student"})
 )
 
 type UserService interface {
-	GetAllUsers(ctx context.Context) ([]user.User, error)
+	GetAllUsers(ctx context.Context, params query.Params) (*query.Page[user.User], error)
 	GetUserByID(ctx context.Context, id int) (*user.User, error)
 	GetUserByEmail(ctx context.Context, email string) (*user.User, error)
 	CreateUser(ctx context.Context, user *user.User) error
 	UpdateUser(ctx context.Context, user *user.User) error
 	DeleteUser(ctx context.Context, id int) error
+	// ChangeUserRole asigna un nuevo rol al usuario, lo registra en la
+	// auditoría e invalida los access tokens emitidos con el rol anterior
+	ChangeUserRole(ctx context.Context, id int, roleID int) (*user.User, error)
 }
@@ -0,0 +1,29 @@
+package audit_log
+
+import (
+	"context"
+	"softpharos/internal/core/domain/audit"
+	"softpharos/internal/core/ports/repository"
+	"softpharos/internal/infra/databases"
+	"softpharos/internal/infra/databases/mappers"
+)
+
+type Repository struct {
+	client *databases.Client
+}
+
+func New(client *databases.Client) repository.AuditLogRepository {
+	return &Repository{client: client}
+}
+
+func (r *Repository) Create(ctx context.Context, entry *audit.Entry) error {
+	entryModel := mappers.AuditEntryToModel(entry)
+	result := r.client.DB.WithContext(ctx).Create(entryModel)
+	if result.Error != nil {
+		return databases.TranslateError(result.Error)
+	}
+
+	entry.ID = entryModel.ID
+	entry.CreatedAt = entryModel.CreatedAt
+	return nil
+}
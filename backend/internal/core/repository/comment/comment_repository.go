@@ -3,6 +3,7 @@ package comment
 import (
 	"context"
 	"softpharos/internal/core/domain/comment"
+	"softpharos/internal/core/domain/query"
 	"softpharos/internal/core/ports/repository"
 	"softpharos/internal/infra/databases"
 	"softpharos/internal/infra/databases/mappers"
@@ -17,21 +18,28 @@ func New(client *databases.Client) repository.CommentRepository {
 	return &Repository{client: client}
 }
 
-func (r *Repository) GetAll(ctx context.Context) ([]comment.Comment, error) {
+func (r *Repository) GetAll(ctx context.Context, params query.Params) (*query.Page[comment.Comment], error) {
+	var total int64
+	if err := r.client.DB.WithContext(ctx).Model(&models.CommentModel{}).Scopes(databases.Filter(params)).Count(&total).Error; err != nil {
+		return nil, databases.TranslateError(err)
+	}
+
 	var commentModels []models.CommentModel
-	result := r.client.DB.WithContext(ctx).Preload("Milestone").Preload("User").Find(&commentModels)
+	result := r.client.DB.WithContext(ctx).Preload("Milestone").Preload("User").
+		Scopes(databases.Filter(params), databases.Paginate(params)).
+		Find(&commentModels)
 	if result.Error != nil {
-		return nil, result.Error
+		return nil, databases.TranslateError(result.Error)
 	}
 
-	return mappers.CommentListToDomain(commentModels), nil
+	return query.NewPage(mappers.CommentListToDomain(commentModels), total, params), nil
 }
 
 func (r *Repository) GetByID(ctx context.Context, id int) (*comment.Comment, error) {
 	var commentModel models.CommentModel
 	result := r.client.DB.WithContext(ctx).Preload("Milestone").Preload("User").First(&commentModel, id)
 	if result.Error != nil {
-		return nil, result.Error
+		return nil, databases.TranslateError(result.Error)
 	}
 
 	return mappers.CommentToDomain(&commentModel), nil
@@ -45,7 +53,7 @@ func (r *Repository) GetByMilestoneID(ctx context.Context, milestoneID int) ([]c
 		Where("milestone_id = ?", milestoneID).
 		Find(&commentModels)
 	if result.Error != nil {
-		return nil, result.Error
+		return nil, databases.TranslateError(result.Error)
 	}
 
 	return mappers.CommentListToDomain(commentModels), nil
@@ -55,7 +63,7 @@ func (r *Repository) Create(ctx context.Context, domainComment *comment.Comment)
 	commentModel := mappers.CommentToModel(domainComment)
 	result := r.client.DB.WithContext(ctx).Create(commentModel)
 	if result.Error != nil {
-		return result.Error
+		return databases.TranslateError(result.Error)
 	}
 
 	domainComment.ID = commentModel.ID
@@ -65,9 +73,41 @@ func (r *Repository) Create(ctx context.Context, domainComment *comment.Comment)
 
 func (r *Repository) Update(ctx context.Context, domainComment *comment.Comment) error {
 	commentModel := mappers.CommentToModel(domainComment)
-	return r.client.DB.WithContext(ctx).Save(commentModel).Error
+	return databases.TranslateError(r.client.DB.WithContext(ctx).Save(commentModel).Error)
 }
 
 func (r *Repository) Delete(ctx context.Context, id int) error {
-	return r.client.DB.WithContext(ctx).Delete(&models.CommentModel{}, id).Error
+	return databases.TranslateError(r.client.DB.WithContext(ctx).Delete(&models.CommentModel{}, id).Error)
+}
+
+func (r *Repository) GetTrashedByID(ctx context.Context, id int) (*comment.Comment, error) {
+	var commentModel models.CommentModel
+	result := r.client.DB.WithContext(ctx).Unscoped().Preload("Milestone").Preload("User").
+		Where("deleted_at IS NOT NULL").
+		First(&commentModel, id)
+	if result.Error != nil {
+		return nil, databases.TranslateError(result.Error)
+	}
+
+	return mappers.CommentToDomain(&commentModel), nil
+}
+
+func (r *Repository) GetTrashedByMilestoneID(ctx context.Context, milestoneID int) ([]comment.Comment, error) {
+	var commentModels []models.CommentModel
+	result := r.client.DB.WithContext(ctx).Unscoped().
+		Preload("Milestone").
+		Preload("User").
+		Where("milestone_id = ? AND deleted_at IS NOT NULL", milestoneID).
+		Order("deleted_at DESC").
+		Find(&commentModels)
+	if result.Error != nil {
+		return nil, databases.TranslateError(result.Error)
+	}
+
+	return mappers.CommentListToDomain(commentModels), nil
+}
+
+// Restore saca de la papelera el comentario si su hito sigue vigente
+func (r *Repository) Restore(ctx context.Context, id int) error {
+	return databases.TranslateError(databases.RestoreMilestoneContent(r.client.DB.WithContext(ctx), &models.CommentModel{}, id))
 }
@@ -0,0 +1,52 @@
+package comment
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+
+	"softpharos/internal/core/errs"
+	"softpharos/internal/core/repository"
+)
+
+func TestRestore(t *testing.T) {
+	restore := `UPDATE "comment" SET "deleted_at"=$1 WHERE (id = $2 AND deleted_at IS NOT NULL) AND milestone_id IN (SELECT "id" FROM "milestone" WHERE "milestone"."deleted_at" IS NULL)`
+	countTrashed := `SELECT count(*) FROM "comment" WHERE id = $1 AND deleted_at IS NOT NULL`
+
+	tests := []struct {
+		name        string
+		restored    int64
+		trashed     int
+		expectedErr error
+	}{
+		{name: "recupera el comentario si su milestone está vigente", restored: 1},
+		{name: "rechaza recuperar si el milestone sigue en la papelera", trashed: 1, expectedErr: errs.ErrConflict},
+		{name: "retorna not found si el comentario no está en la papelera", expectedErr: errs.ErrNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, mock, sqlDB := repository.SetupMockDB(t)
+			defer sqlDB.Close()
+
+			mock.ExpectBegin()
+			mock.ExpectExec(regexp.QuoteMeta(restore)).
+				WithArgs(nil, 1).
+				WillReturnResult(sqlmock.NewResult(0, tt.restored))
+			mock.ExpectCommit()
+			if tt.restored == 0 {
+				mock.ExpectQuery(regexp.QuoteMeta(countTrashed)).
+					WithArgs(1).
+					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(tt.trashed))
+			}
+
+			err := New(client).Restore(context.Background(), 1)
+
+			assert.ErrorIs(t, err, tt.expectedErr)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
@@ -0,0 +1,71 @@
+package course
+
+import (
+	"context"
+	"softpharos/internal/core/domain/course"
+	"softpharos/internal/core/ports/repository"
+	"softpharos/internal/infra/databases"
+	"softpharos/internal/infra/databases/mappers"
+	"softpharos/internal/infra/databases/models"
+
+	"gorm.io/gorm"
+)
+
+type MemberRepository struct {
+	client *databases.Client
+}
+
+func NewMemberRepository(client *databases.Client) repository.CourseMemberRepository {
+	return &MemberRepository{client: client}
+}
+
+// GetBySectionID lista los inscritos de la sección, primero los profesores
+func (r *MemberRepository) GetBySectionID(ctx context.Context, sectionID int) ([]course.Member, error) {
+	var memberModels []models.CourseMemberModel
+	result := r.client.DB.WithContext(ctx).Preload("User").
+		Where("section_id = ?", sectionID).
+		Order("role").Order("id").
+		Find(&memberModels)
+	if result.Error != nil {
+		return nil, databases.TranslateError(result.Error)
+	}
+
+	return mappers.CourseMemberListToDomain(memberModels), nil
+}
+
+func (r *MemberRepository) GetBySectionAndUser(ctx context.Context, sectionID int, userID int) (*course.Member, error) {
+	var memberModel models.CourseMemberModel
+	result := r.client.DB.WithContext(ctx).
+		Where("section_id = ? AND user_id = ?", sectionID, userID).
+		First(&memberModel)
+	if result.Error != nil {
+		return nil, databases.TranslateError(result.Error)
+	}
+
+	return mappers.CourseMemberToDomain(&memberModel), nil
+}
+
+func (r *MemberRepository) Create(ctx context.Context, member *course.Member) error {
+	memberModel := mappers.CourseMemberToModel(member)
+	result := r.client.DB.WithContext(ctx).Create(memberModel)
+	if result.Error != nil {
+		return databases.TranslateError(result.Error)
+	}
+
+	member.ID = memberModel.ID
+	member.JoinedAt = memberModel.JoinedAt
+	return nil
+}
+
+func (r *MemberRepository) Delete(ctx context.Context, sectionID int, userID int) error {
+	result := r.client.DB.WithContext(ctx).
+		Where("section_id = ? AND user_id = ?", sectionID, userID).
+		Delete(&models.CourseMemberModel{})
+	if result.Error != nil {
+		return databases.TranslateError(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return databases.TranslateError(gorm.ErrRecordNotFound)
+	}
+	return nil
+}
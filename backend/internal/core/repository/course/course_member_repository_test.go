@@ -0,0 +1,70 @@
+package course
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+
+	"softpharos/internal/core/errs"
+	"softpharos/internal/core/repository"
+)
+
+func TestCourseMemberDelete(t *testing.T) {
+	tests := []struct {
+		name          string
+		rowsAffected  int64
+		expectedError error
+	}{
+		{name: "retira al inscrito de la sección", rowsAffected: 1},
+		{name: "retorna not found cuando el usuario no está inscrito", rowsAffected: 0, expectedError: errs.ErrNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, mock, sqlDB := repository.SetupMockDB(t)
+			defer sqlDB.Close()
+
+			mock.ExpectBegin()
+			mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM "course_member" WHERE section_id = $1 AND user_id = $2`)).
+				WithArgs(3, 8).
+				WillReturnResult(sqlmock.NewResult(0, tt.rowsAffected))
+			mock.ExpectCommit()
+
+			err := NewMemberRepository(client).Delete(context.Background(), 3, 8)
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestGetSections(t *testing.T) {
+	client, mock, sqlDB := repository.SetupMockDB(t)
+	defer sqlDB.Close()
+
+	termID := 2
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "course_section" WHERE course_id = $1 AND term_id = $2 ORDER BY name`)).
+		WithArgs(4, termID).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "course_id", "term_id", "name"}).AddRow(1, 4, termID, "A"))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "term" WHERE "term"."id" = $1`)).
+		WithArgs(termID).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "code"}).AddRow(termID, "2025-1"))
+
+	sections, err := New(client).GetSections(context.Background(), 4, &termID)
+
+	assert.NoError(t, err)
+	if assert.Len(t, sections, 1) {
+		assert.Equal(t, "A", sections[0].Name)
+		if assert.NotNil(t, sections[0].Term) {
+			assert.Equal(t, "2025-1", sections[0].Term.Code)
+		}
+	}
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
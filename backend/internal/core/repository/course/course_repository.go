@@ -0,0 +1,111 @@
+package course
+
+import (
+	"context"
+	"softpharos/internal/core/domain/course"
+	"softpharos/internal/core/domain/query"
+	"softpharos/internal/core/ports/repository"
+	"softpharos/internal/infra/databases"
+	"softpharos/internal/infra/databases/mappers"
+	"softpharos/internal/infra/databases/models"
+)
+
+type Repository struct {
+	client *databases.Client
+}
+
+func New(client *databases.Client) repository.CourseRepository {
+	return &Repository{client: client}
+}
+
+func (r *Repository) GetAll(ctx context.Context, params query.Params) (*query.Page[course.Course], error) {
+	if len(params.Sort) == 0 {
+		params.Sort = []query.Sort{{Field: "name"}}
+	}
+
+	var total int64
+	if err := r.client.DB.WithContext(ctx).Model(&models.CourseModel{}).Scopes(databases.Filter(params)).Count(&total).Error; err != nil {
+		return nil, databases.TranslateError(err)
+	}
+
+	var courseModels []models.CourseModel
+	result := r.client.DB.WithContext(ctx).
+		Scopes(databases.Filter(params), databases.Paginate(params)).
+		Find(&courseModels)
+	if result.Error != nil {
+		return nil, databases.TranslateError(result.Error)
+	}
+
+	return query.NewPage(mappers.CourseListToDomain(courseModels), total, params), nil
+}
+
+func (r *Repository) GetByID(ctx context.Context, id int) (*course.Course, error) {
+	var courseModel models.CourseModel
+	result := r.client.DB.WithContext(ctx).First(&courseModel, id)
+	if result.Error != nil {
+		return nil, databases.TranslateError(result.Error)
+	}
+
+	return mappers.CourseToDomain(&courseModel), nil
+}
+
+func (r *Repository) Create(ctx context.Context, c *course.Course) error {
+	courseModel := mappers.CourseToModel(c)
+	result := r.client.DB.WithContext(ctx).Create(courseModel)
+	if result.Error != nil {
+		return databases.TranslateError(result.Error)
+	}
+
+	c.ID = courseModel.ID
+	c.CreatedAt = courseModel.CreatedAt
+	return nil
+}
+
+func (r *Repository) Update(ctx context.Context, c *course.Course) error {
+	courseModel := mappers.CourseToModel(c)
+	return databases.TranslateError(r.client.DB.WithContext(ctx).Save(courseModel).Error)
+}
+
+func (r *Repository) Delete(ctx context.Context, id int) error {
+	return databases.TranslateError(r.client.DB.WithContext(ctx).Delete(&models.CourseModel{}, id).Error)
+}
+
+func (r *Repository) GetSections(ctx context.Context, courseID int, termID *int) ([]course.Section, error) {
+	db := r.client.DB.WithContext(ctx).Preload("Term").Where("course_id = ?", courseID)
+	if termID != nil {
+		db = db.Where("term_id = ?", *termID)
+	}
+
+	var sectionModels []models.CourseSectionModel
+	if err := db.Order("name").Find(&sectionModels).Error; err != nil {
+		return nil, databases.TranslateError(err)
+	}
+
+	return mappers.CourseSectionListToDomain(sectionModels), nil
+}
+
+func (r *Repository) GetSectionByID(ctx context.Context, id int) (*course.Section, error) {
+	var sectionModel models.CourseSectionModel
+	result := r.client.DB.WithContext(ctx).Preload("Course").Preload("Term").First(&sectionModel, id)
+	if result.Error != nil {
+		return nil, databases.TranslateError(result.Error)
+	}
+
+	return mappers.CourseSectionToDomain(&sectionModel), nil
+}
+
+func (r *Repository) CreateSection(ctx context.Context, section *course.Section) error {
+	sectionModel := mappers.CourseSectionToModel(section)
+	result := r.client.DB.WithContext(ctx).Create(sectionModel)
+	if result.Error != nil {
+		return databases.TranslateError(result.Error)
+	}
+
+	section.ID = sectionModel.ID
+	section.CreatedAt = sectionModel.CreatedAt
+	return nil
+}
+
+func (r *Repository) DeleteSection(ctx context.Context, id int) error {
+	return databases.TranslateError(r.client.DB.WithContext(ctx).Delete(&models.CourseSectionModel{}, id).Error)
+}
@@ -3,6 +3,7 @@ package deliverable
 import (
 	"context"
 	"softpharos/internal/core/domain/deliverable"
+	"softpharos/internal/core/domain/query"
 	"softpharos/internal/core/ports/repository"
 	"softpharos/internal/infra/databases"
 	"softpharos/internal/infra/databases/mappers"
@@ -17,21 +18,28 @@ func New(client *databases.Client) repository.DeliverableRepository {
 	return &Repository{client: client}
 }
 
-func (r *Repository) GetAll(ctx context.Context) ([]deliverable.Deliverable, error) {
+func (r *Repository) GetAll(ctx context.Context, params query.Params) (*query.Page[deliverable.Deliverable], error) {
+	var total int64
+	if err := r.client.DB.WithContext(ctx).Model(&models.DeliverableModel{}).Scopes(databases.Filter(params)).Count(&total).Error; err != nil {
+		return nil, databases.TranslateError(err)
+	}
+
 	var deliverableModels []models.DeliverableModel
-	result := r.client.DB.WithContext(ctx).Preload("Milestone").Find(&deliverableModels)
+	result := r.client.DB.WithContext(ctx).Preload("Milestone").
+		Scopes(databases.Filter(params), databases.Paginate(params)).
+		Find(&deliverableModels)
 	if result.Error != nil {
-		return nil, result.Error
+		return nil, databases.TranslateError(result.Error)
 	}
 
-	return mappers.DeliverableListToDomain(deliverableModels), nil
+	return query.NewPage(mappers.DeliverableListToDomain(deliverableModels), total, params), nil
 }
 
 func (r *Repository) GetByID(ctx context.Context, id int) (*deliverable.Deliverable, error) {
 	var deliverableModel models.DeliverableModel
 	result := r.client.DB.WithContext(ctx).Preload("Milestone").First(&deliverableModel, id)
 	if result.Error != nil {
-		return nil, result.Error
+		return nil, databases.TranslateError(result.Error)
 	}
 
 	return mappers.DeliverableToDomain(&deliverableModel), nil
@@ -44,7 +52,7 @@ func (r *Repository) GetByMilestoneID(ctx context.Context, milestoneID int) ([]d
 		Where("milestone_id = ?", milestoneID).
 		Find(&deliverableModels)
 	if result.Error != nil {
-		return nil, result.Error
+		return nil, databases.TranslateError(result.Error)
 	}
 
 	return mappers.DeliverableListToDomain(deliverableModels), nil
@@ -54,7 +62,7 @@ func (r *Repository) Create(ctx context.Context, domainDeliverable *deliverable.
 	deliverableModel := mappers.DeliverableToModel(domainDeliverable)
 	result := r.client.DB.WithContext(ctx).Create(deliverableModel)
 	if result.Error != nil {
-		return result.Error
+		return databases.TranslateError(result.Error)
 	}
 
 	domainDeliverable.ID = deliverableModel.ID
@@ -64,9 +72,40 @@ func (r *Repository) Create(ctx context.Context, domainDeliverable *deliverable.
 
 func (r *Repository) Update(ctx context.Context, domainDeliverable *deliverable.Deliverable) error {
 	deliverableModel := mappers.DeliverableToModel(domainDeliverable)
-	return r.client.DB.WithContext(ctx).Save(deliverableModel).Error
+	return databases.TranslateError(r.client.DB.WithContext(ctx).Save(deliverableModel).Error)
 }
 
 func (r *Repository) Delete(ctx context.Context, id int) error {
-	return r.client.DB.WithContext(ctx).Delete(&models.DeliverableModel{}, id).Error
+	return databases.TranslateError(r.client.DB.WithContext(ctx).Delete(&models.DeliverableModel{}, id).Error)
+}
+
+func (r *Repository) GetTrashedByID(ctx context.Context, id int) (*deliverable.Deliverable, error) {
+	var deliverableModel models.DeliverableModel
+	result := r.client.DB.WithContext(ctx).Unscoped().Preload("Milestone").
+		Where("deleted_at IS NOT NULL").
+		First(&deliverableModel, id)
+	if result.Error != nil {
+		return nil, databases.TranslateError(result.Error)
+	}
+
+	return mappers.DeliverableToDomain(&deliverableModel), nil
+}
+
+func (r *Repository) GetTrashedByMilestoneID(ctx context.Context, milestoneID int) ([]deliverable.Deliverable, error) {
+	var deliverableModels []models.DeliverableModel
+	result := r.client.DB.WithContext(ctx).Unscoped().
+		Preload("Milestone").
+		Where("milestone_id = ? AND deleted_at IS NOT NULL", milestoneID).
+		Order("deleted_at DESC").
+		Find(&deliverableModels)
+	if result.Error != nil {
+		return nil, databases.TranslateError(result.Error)
+	}
+
+	return mappers.DeliverableListToDomain(deliverableModels), nil
+}
+
+// Restore saca de la papelera el entregable si su hito sigue vigente
+func (r *Repository) Restore(ctx context.Context, id int) error {
+	return databases.TranslateError(databases.RestoreMilestoneContent(r.client.DB.WithContext(ctx), &models.DeliverableModel{}, id))
 }
@@ -3,6 +3,7 @@ package feedback
 import (
 	"context"
 	"softpharos/internal/core/domain/feedback"
+	"softpharos/internal/core/domain/query"
 	"softpharos/internal/core/ports/repository"
 	"softpharos/internal/infra/databases"
 	"softpharos/internal/infra/databases/mappers"
@@ -17,21 +18,28 @@ func New(client *databases.Client) repository.FeedbackRepository {
 	return &Repository{client: client}
 }
 
-func (r *Repository) GetAll(ctx context.Context) ([]feedback.Feedback, error) {
+func (r *Repository) GetAll(ctx context.Context, params query.Params) (*query.Page[feedback.Feedback], error) {
+	var total int64
+	if err := r.client.DB.WithContext(ctx).Model(&models.FeedbackModel{}).Scopes(databases.Filter(params)).Count(&total).Error; err != nil {
+		return nil, databases.TranslateError(err)
+	}
+
 	var feedbackModels []models.FeedbackModel
-	result := r.client.DB.WithContext(ctx).Preload("Milestone").Preload("Professor").Find(&feedbackModels)
+	result := r.client.DB.WithContext(ctx).Preload("Milestone").Preload("Professor").
+		Scopes(databases.Filter(params), databases.Paginate(params)).
+		Find(&feedbackModels)
 	if result.Error != nil {
-		return nil, result.Error
+		return nil, databases.TranslateError(result.Error)
 	}
 
-	return mappers.FeedbackListToDomain(feedbackModels), nil
+	return query.NewPage(mappers.FeedbackListToDomain(feedbackModels), total, params), nil
 }
 
 func (r *Repository) GetByID(ctx context.Context, id int) (*feedback.Feedback, error) {
 	var feedbackModel models.FeedbackModel
 	result := r.client.DB.WithContext(ctx).Preload("Milestone").Preload("Professor").First(&feedbackModel, id)
 	if result.Error != nil {
-		return nil, result.Error
+		return nil, databases.TranslateError(result.Error)
 	}
 
 	return mappers.FeedbackToDomain(&feedbackModel), nil
@@ -45,7 +53,7 @@ func (r *Repository) GetByMilestoneID(ctx context.Context, milestoneID int) ([]f
 		Where("milestone_id = ?", milestoneID).
 		Find(&feedbackModels)
 	if result.Error != nil {
-		return nil, result.Error
+		return nil, databases.TranslateError(result.Error)
 	}
 
 	return mappers.FeedbackListToDomain(feedbackModels), nil
@@ -55,7 +63,7 @@ func (r *Repository) Create(ctx context.Context, domainFeedback *feedback.Feedba
 	feedbackModel := mappers.FeedbackToModel(domainFeedback)
 	result := r.client.DB.WithContext(ctx).Create(feedbackModel)
 	if result.Error != nil {
-		return result.Error
+		return databases.TranslateError(result.Error)
 	}
 
 	domainFeedback.ID = feedbackModel.ID
@@ -65,9 +73,41 @@ func (r *Repository) Create(ctx context.Context, domainFeedback *feedback.Feedba
 
 func (r *Repository) Update(ctx context.Context, domainFeedback *feedback.Feedback) error {
 	feedbackModel := mappers.FeedbackToModel(domainFeedback)
-	return r.client.DB.WithContext(ctx).Save(feedbackModel).Error
+	return databases.TranslateError(r.client.DB.WithContext(ctx).Save(feedbackModel).Error)
 }
 
 func (r *Repository) Delete(ctx context.Context, id int) error {
-	return r.client.DB.WithContext(ctx).Delete(&models.FeedbackModel{}, id).Error
+	return databases.TranslateError(r.client.DB.WithContext(ctx).Delete(&models.FeedbackModel{}, id).Error)
+}
+
+func (r *Repository) GetTrashedByID(ctx context.Context, id int) (*feedback.Feedback, error) {
+	var feedbackModel models.FeedbackModel
+	result := r.client.DB.WithContext(ctx).Unscoped().Preload("Milestone").Preload("Professor").
+		Where("deleted_at IS NOT NULL").
+		First(&feedbackModel, id)
+	if result.Error != nil {
+		return nil, databases.TranslateError(result.Error)
+	}
+
+	return mappers.FeedbackToDomain(&feedbackModel), nil
+}
+
+func (r *Repository) GetTrashedByMilestoneID(ctx context.Context, milestoneID int) ([]feedback.Feedback, error) {
+	var feedbackModels []models.FeedbackModel
+	result := r.client.DB.WithContext(ctx).Unscoped().
+		Preload("Milestone").
+		Preload("Professor").
+		Where("milestone_id = ? AND deleted_at IS NOT NULL", milestoneID).
+		Order("deleted_at DESC").
+		Find(&feedbackModels)
+	if result.Error != nil {
+		return nil, databases.TranslateError(result.Error)
+	}
+
+	return mappers.FeedbackListToDomain(feedbackModels), nil
+}
+
+// Restore saca de la papelera la retroalimentación si su hito sigue vigente
+func (r *Repository) Restore(ctx context.Context, id int) error {
+	return databases.TranslateError(databases.RestoreMilestoneContent(r.client.DB.WithContext(ctx), &models.FeedbackModel{}, id))
 }
@@ -0,0 +1,90 @@
+package invitation
+
+import (
+	"context"
+	"time"
+
+	"softpharos/internal/core/domain/invitation"
+	"softpharos/internal/core/ports/repository"
+	"softpharos/internal/infra/databases"
+	"softpharos/internal/infra/databases/mappers"
+	"softpharos/internal/infra/databases/models"
+)
+
+type Repository struct {
+	client *databases.Client
+}
+
+func New(client *databases.Client) repository.InvitationRepository {
+	return &Repository{client: client}
+}
+
+func (r *Repository) Create(ctx context.Context, domainInvitation *invitation.Invitation) error {
+	invitationModel := mappers.InvitationToModel(domainInvitation)
+	result := r.client.DB.WithContext(ctx).Create(invitationModel)
+	if result.Error != nil {
+		return databases.TranslateError(result.Error)
+	}
+
+	domainInvitation.ID = invitationModel.ID
+	domainInvitation.CreatedAt = invitationModel.CreatedAt
+	return nil
+}
+
+func (r *Repository) GetByID(ctx context.Context, id int) (*invitation.Invitation, error) {
+	var invitationModel models.ProjectInvitationModel
+	result := r.client.DB.WithContext(ctx).First(&invitationModel, id)
+	if result.Error != nil {
+		return nil, databases.TranslateError(result.Error)
+	}
+
+	return mappers.InvitationToDomain(&invitationModel), nil
+}
+
+func (r *Repository) GetByTokenHash(ctx context.Context, tokenHash string) (*invitation.Invitation, error) {
+	var invitationModel models.ProjectInvitationModel
+	result := r.client.DB.WithContext(ctx).Where("token_hash = ?", tokenHash).First(&invitationModel)
+	if result.Error != nil {
+		return nil, databases.TranslateError(result.Error)
+	}
+
+	return mappers.InvitationToDomain(&invitationModel), nil
+}
+
+func (r *Repository) GetByProjectID(ctx context.Context, projectID int) ([]invitation.Invitation, error) {
+	var invitationModels []models.ProjectInvitationModel
+	result := r.client.DB.WithContext(ctx).
+		Where("project_id = ?", projectID).
+		Order("created_at DESC").
+		Find(&invitationModels)
+	if result.Error != nil {
+		return nil, databases.TranslateError(result.Error)
+	}
+
+	return mappers.InvitationListToDomain(invitationModels), nil
+}
+
+func (r *Repository) GetPendingByEmail(ctx context.Context, email string, now time.Time) ([]invitation.Invitation, error) {
+	var invitationModels []models.ProjectInvitationModel
+	result := r.client.DB.WithContext(ctx).
+		Where("email = ? AND status = ? AND expires_at > ?", email, invitation.StatusPending, now).
+		Order("id").
+		Find(&invitationModels)
+	if result.Error != nil {
+		return nil, databases.TranslateError(result.Error)
+	}
+
+	return mappers.InvitationListToDomain(invitationModels), nil
+}
+
+func (r *Repository) Respond(ctx context.Context, id int, status string, at time.Time) (bool, error) {
+	result := r.client.DB.WithContext(ctx).
+		Model(&models.ProjectInvitationModel{}).
+		Where("id = ? AND status = ?", id, invitation.StatusPending).
+		Updates(map[string]any{"status": status, "responded_at": at})
+	if result.Error != nil {
+		return false, databases.TranslateError(result.Error)
+	}
+
+	return result.RowsAffected > 0, nil
+}
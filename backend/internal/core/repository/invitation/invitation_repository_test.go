@@ -0,0 +1,66 @@
+package invitation
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+
+	"softpharos/internal/core/domain/invitation"
+	"softpharos/internal/core/repository"
+)
+
+func TestInvitationRespond(t *testing.T) {
+	at := time.Date(2025, 5, 10, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		affected int64
+		expected bool
+	}{
+		{name: "responde una invitación pendiente", affected: 1, expected: true},
+		{name: "retorna false cuando la invitación ya fue respondida", affected: 0, expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, mock, sqlDB := repository.SetupMockDB(t)
+			defer sqlDB.Close()
+
+			mock.ExpectBegin()
+			mock.ExpectExec(regexp.QuoteMeta(`UPDATE "project_invitation" SET "responded_at"=$1,"status"=$2 WHERE id = $3 AND status = $4`)).
+				WithArgs(at, invitation.StatusAccepted, 3, invitation.StatusPending).
+				WillReturnResult(sqlmock.NewResult(0, tt.affected))
+			mock.ExpectCommit()
+
+			responded, err := New(client).Respond(context.Background(), 3, invitation.StatusAccepted, at)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, responded)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestInvitationGetPendingByEmail(t *testing.T) {
+	now := time.Date(2025, 5, 10, 12, 0, 0, 0, time.UTC)
+
+	client, mock, sqlDB := repository.SetupMockDB(t)
+	defer sqlDB.Close()
+
+	rows := sqlmock.NewRows([]string{"id", "project_id", "email", "role", "status", "expires_at"}).
+		AddRow(1, 10, "invitado@unal.edu.co", "member", invitation.StatusPending, now.Add(time.Hour))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "project_invitation" WHERE email = $1 AND status = $2 AND expires_at > $3 ORDER BY id`)).
+		WithArgs("invitado@unal.edu.co", invitation.StatusPending, now).
+		WillReturnRows(rows)
+
+	pending, err := New(client).GetPendingByEmail(context.Background(), "invitado@unal.edu.co", now)
+
+	assert.NoError(t, err)
+	assert.Len(t, pending, 1)
+	assert.Equal(t, 10, pending[0].ProjectID)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -2,7 +2,13 @@ package milestone
 
 import (
 	"context"
+	"time"
+
+	"gorm.io/gorm"
+
 	"softpharos/internal/core/domain/milestone"
+	"softpharos/internal/core/domain/milestone_detail"
+	"softpharos/internal/core/domain/query"
 	"softpharos/internal/core/ports/repository"
 	"softpharos/internal/infra/databases"
 	"softpharos/internal/infra/databases/mappers"
@@ -17,21 +23,28 @@ func New(client *databases.Client) repository.MilestoneRepository {
 	return &Repository{client: client}
 }
 
-func (r *Repository) GetAll(ctx context.Context) ([]milestone.Milestone, error) {
+func (r *Repository) GetAll(ctx context.Context, params query.Params) (*query.Page[milestone.Milestone], error) {
+	var total int64
+	if err := r.client.DB.WithContext(ctx).Model(&models.MilestoneModel{}).Scopes(databases.Filter(params)).Count(&total).Error; err != nil {
+		return nil, databases.TranslateError(err)
+	}
+
 	var milestoneModels []models.MilestoneModel
-	result := r.client.DB.WithContext(ctx).Preload("Project").Find(&milestoneModels)
+	result := r.client.DB.WithContext(ctx).Preload("Project").
+		Scopes(databases.Filter(params), databases.Paginate(params)).
+		Find(&milestoneModels)
 	if result.Error != nil {
-		return nil, result.Error
+		return nil, databases.TranslateError(result.Error)
 	}
 
-	return mappers.MilestoneListToDomain(milestoneModels), nil
+	return query.NewPage(mappers.MilestoneListToDomain(milestoneModels), total, params), nil
 }
 
 func (r *Repository) GetByID(ctx context.Context, id int) (*milestone.Milestone, error) {
 	var milestoneModel models.MilestoneModel
 	result := r.client.DB.WithContext(ctx).Preload("Project").First(&milestoneModel, id)
 	if result.Error != nil {
-		return nil, result.Error
+		return nil, databases.TranslateError(result.Error)
 	}
 
 	return mappers.MilestoneToDomain(&milestoneModel), nil
@@ -44,7 +57,7 @@ func (r *Repository) GetByProjectID(ctx context.Context, projectID int) ([]miles
 		Where("project_id = ?", projectID).
 		Find(&milestoneModels)
 	if result.Error != nil {
-		return nil, result.Error
+		return nil, databases.TranslateError(result.Error)
 	}
 
 	return mappers.MilestoneListToDomain(milestoneModels), nil
@@ -54,7 +67,7 @@ func (r *Repository) Create(ctx context.Context, domainMilestone *milestone.Mile
 	milestoneModel := mappers.MilestoneToModel(domainMilestone)
 	result := r.client.DB.WithContext(ctx).Create(milestoneModel)
 	if result.Error != nil {
-		return result.Error
+		return databases.TranslateError(result.Error)
 	}
 
 	domainMilestone.ID = milestoneModel.ID
@@ -62,11 +75,234 @@ func (r *Repository) Create(ctx context.Context, domainMilestone *milestone.Mile
 	return nil
 }
 
+// Update no toca el estado: solo cambia con UpdateStatus
 func (r *Repository) Update(ctx context.Context, domainMilestone *milestone.Milestone) error {
 	milestoneModel := mappers.MilestoneToModel(domainMilestone)
-	return r.client.DB.WithContext(ctx).Save(milestoneModel).Error
+	return databases.TranslateError(r.client.DB.WithContext(ctx).Omit("status", "status_changed_at").Save(milestoneModel).Error)
 }
 
+// Delete envía a la papelera el hito y su contenido con una misma marca
 func (r *Repository) Delete(ctx context.Context, id int) error {
-	return r.client.DB.WithContext(ctx).Delete(&models.MilestoneModel{}, id).Error
+	return databases.TranslateError(r.client.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		trashed, err := databases.TrashMilestones(tx, databases.TrashedAt(tx), "id = ?", id)
+		if err != nil {
+			return err
+		}
+		if trashed == 0 {
+			return gorm.ErrRecordNotFound
+		}
+		return nil
+	}))
+}
+
+func (r *Repository) GetTrashedByID(ctx context.Context, id int) (*milestone.Milestone, error) {
+	var milestoneModel models.MilestoneModel
+	result := r.client.DB.WithContext(ctx).Unscoped().
+		Where("deleted_at IS NOT NULL").
+		First(&milestoneModel, id)
+	if result.Error != nil {
+		return nil, databases.TranslateError(result.Error)
+	}
+
+	return mappers.MilestoneToDomain(&milestoneModel), nil
+}
+
+func (r *Repository) GetTrashedByProjectID(ctx context.Context, projectID int) ([]milestone.Milestone, error) {
+	var milestoneModels []models.MilestoneModel
+	result := r.client.DB.WithContext(ctx).Unscoped().
+		Where("project_id = ? AND deleted_at IS NOT NULL", projectID).
+		Order("deleted_at DESC").
+		Find(&milestoneModels)
+	if result.Error != nil {
+		return nil, databases.TranslateError(result.Error)
+	}
+
+	return mappers.MilestoneListToDomain(milestoneModels), nil
+}
+
+// Restore saca de la papelera el hito y el contenido que se borró con él
+func (r *Repository) Restore(ctx context.Context, id int) error {
+	return databases.TranslateError(r.client.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var milestoneModel models.MilestoneModel
+		if err := tx.Unscoped().Where("deleted_at IS NOT NULL").First(&milestoneModel, id).Error; err != nil {
+			return err
+		}
+		return databases.RestoreMilestones(tx, milestoneModel.DeletedAt.Time, "id = ?", id)
+	}))
+}
+
+func (r *Repository) UpdateStatus(ctx context.Context, id int, from string, to string, at time.Time) (bool, error) {
+	result := r.client.DB.WithContext(ctx).
+		Model(&models.MilestoneModel{}).
+		Where("id = ? AND status = ?", id, from).
+		Updates(map[string]any{"status": to, "status_changed_at": at})
+	if result.Error != nil {
+		return false, databases.TranslateError(result.Error)
+	}
+
+	return result.RowsAffected == 1, nil
+}
+
+func (r *Repository) GetOverdueByProjectID(ctx context.Context, projectID int, today time.Time) ([]milestone.Milestone, error) {
+	var milestoneModels []models.MilestoneModel
+	result := r.client.DB.WithContext(ctx).
+		Where("project_id = ? AND due_date < ? AND status IN ?", projectID, today, milestone.PendingStatuses).
+		Order("due_date").Order("id").
+		Find(&milestoneModels)
+	if result.Error != nil {
+		return nil, databases.TranslateError(result.Error)
+	}
+
+	return mappers.MilestoneListToDomain(milestoneModels), nil
+}
+
+// GetOverdueByCourse ordena por defecto del hito más atrasado al más reciente
+func (r *Repository) GetOverdueByCourse(ctx context.Context, courseID int, today time.Time, params query.Params) (*query.Page[milestone.Milestone], error) {
+	if len(params.Sort) == 0 {
+		params.Sort = []query.Sort{{Field: "due_date"}}
+	}
+
+	overdue := r.client.DB.WithContext(ctx).Model(&models.MilestoneModel{}).
+		Joins(`JOIN "project" ON "project"."id" = "milestone"."project_id" AND "project"."deleted_at" IS NULL`).
+		Joins(`JOIN "course_section" ON "course_section"."id" = "project"."section_id"`).
+		Where(`"course_section"."course_id" = ?`, courseID).
+		Where(`"milestone"."due_date" < ? AND "milestone"."status" IN ?`, today, milestone.PendingStatuses).
+		Scopes(courseFilter(params)).
+		Session(&gorm.Session{})
+
+	var total int64
+	if err := overdue.Count(&total).Error; err != nil {
+		return nil, databases.TranslateError(err)
+	}
+
+	var milestoneModels []models.MilestoneModel
+	result := overdue.
+		Select(`"milestone".*`).
+		Preload("Project").
+		Scopes(databases.PaginateJoined(params, "milestone", nil)).
+		Find(&milestoneModels)
+	if result.Error != nil {
+		return nil, databases.TranslateError(result.Error)
+	}
+
+	return query.NewPage(mappers.MilestoneListToDomain(milestoneModels), total, params), nil
+}
+
+// includeRelations asocia cada relación de milestone_detail.Includes con su
+// asociación en MilestoneModel y, si la tiene, con la de su autor
+var includeRelations = map[string]struct{ relation, author string }{
+	milestone_detail.IncludeDeliverables: {relation: "Deliverables"},
+	milestone_detail.IncludeFeedback:     {relation: "Feedback", author: "Feedback.Professor"},
+	milestone_detail.IncludeComments:     {relation: "Comments", author: "Comments.User"},
+	milestone_detail.IncludeReactions:    {relation: "Reactions", author: "Reactions.User"},
+}
+
+// GetDetail carga cada relación pedida en orden cronológico
+func (r *Repository) GetDetail(ctx context.Context, id int, includes []string) (*milestone_detail.Detail, error) {
+	db := r.client.DB.WithContext(ctx).Preload("Project")
+	for _, include := range includes {
+		preload := includeRelations[include]
+		db = db.Preload(preload.relation, func(db *gorm.DB) *gorm.DB {
+			return db.Order("created_at").Order("id")
+		})
+		if preload.author != "" {
+			db = db.Preload(preload.author)
+		}
+	}
+
+	var milestoneModel models.MilestoneModel
+	if err := db.First(&milestoneModel, id).Error; err != nil {
+		return nil, databases.TranslateError(err)
+	}
+
+	detail := &milestone_detail.Detail{Milestone: *mappers.MilestoneToDomain(&milestoneModel)}
+	for _, include := range includes {
+		switch include {
+		case milestone_detail.IncludeDeliverables:
+			detail.Deliverables = mappers.DeliverableListToDomain(milestoneModel.Deliverables)
+		case milestone_detail.IncludeFeedback:
+			detail.Feedback = mappers.FeedbackListToDomain(milestoneModel.Feedback)
+		case milestone_detail.IncludeComments:
+			detail.Comments = mappers.CommentListToDomain(milestoneModel.Comments)
+		case milestone_detail.IncludeReactions:
+			detail.Reactions = mappers.ReactionListToDomain(milestoneModel.Reactions)
+		}
+	}
+	return detail, nil
+}
+
+// childCount es una fila de los conteos agrupados por hito
+type childCount struct {
+	MilestoneID int
+	Type        *string
+	Total       int
+}
+
+// GetCounts hace una consulta agrupada por cada tipo de contenido, sin importar cuántos hitos reciba
+func (r *Repository) GetCounts(ctx context.Context, milestoneIDs []int) (map[int]*milestone.Counts, error) {
+	counts := make(map[int]*milestone.Counts, len(milestoneIDs))
+	for _, id := range milestoneIDs {
+		counts[id] = &milestone.Counts{Reactions: map[string]int{}}
+	}
+	if len(milestoneIDs) == 0 {
+		return counts, nil
+	}
+
+	countBy := func(model any, columns string) ([]childCount, error) {
+		var rows []childCount
+		err := r.client.DB.WithContext(ctx).Model(model).
+			Select(columns+", count(*) AS total").
+			Where("milestone_id IN ?", milestoneIDs).
+			Group(columns).
+			Scan(&rows).Error
+		return rows, err
+	}
+
+	deliverables, err := countBy(&models.DeliverableModel{}, "milestone_id")
+	if err != nil {
+		return nil, databases.TranslateError(err)
+	}
+	for _, row := range deliverables {
+		counts[row.MilestoneID].Deliverables = row.Total
+	}
+
+	comments, err := countBy(&models.CommentModel{}, "milestone_id")
+	if err != nil {
+		return nil, databases.TranslateError(err)
+	}
+	for _, row := range comments {
+		counts[row.MilestoneID].Comments = row.Total
+	}
+
+	feedback, err := countBy(&models.FeedbackModel{}, "milestone_id")
+	if err != nil {
+		return nil, databases.TranslateError(err)
+	}
+	for _, row := range feedback {
+		counts[row.MilestoneID].HasFeedback = row.Total > 0
+	}
+
+	reactions, err := countBy(&models.ReactionModel{}, "milestone_id, type")
+	if err != nil {
+		return nil, databases.TranslateError(err)
+	}
+	for _, row := range reactions {
+		if row.Type != nil {
+			counts[row.MilestoneID].Reactions[*row.Type] = row.Total
+		}
+	}
+
+	return counts, nil
+}
+
+func courseFilter(params query.Params) func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if termID, ok := params.Filters["term_id"]; ok {
+			db = db.Where(`"course_section"."term_id" = ?`, termID)
+		}
+		if sectionID, ok := params.Filters["section_id"]; ok {
+			db = db.Where(`"project"."section_id" = ?`, sectionID)
+		}
+		return db
+	}
 }
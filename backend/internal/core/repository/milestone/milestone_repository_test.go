@@ -0,0 +1,158 @@
+package milestone
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+
+	"softpharos/internal/core/domain/milestone"
+	"softpharos/internal/core/domain/milestone_detail"
+	"softpharos/internal/core/domain/query"
+	"softpharos/internal/core/repository"
+)
+
+func TestUpdateStatus(t *testing.T) {
+	at := time.Date(2026, 5, 10, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		affected int64
+		expected bool
+	}{
+		{name: "cambia el estado del hito", affected: 1, expected: true},
+		{name: "retorna false cuando el hito ya cambió de estado", affected: 0, expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, mock, sqlDB := repository.SetupMockDB(t)
+			defer sqlDB.Close()
+
+			mock.ExpectBegin()
+			mock.ExpectExec(regexp.QuoteMeta(`UPDATE "milestone" SET "status"=$1,"status_changed_at"=$2 WHERE (id = $3 AND status = $4) AND "milestone"."deleted_at" IS NULL`)).
+				WithArgs(milestone.StatusSubmitted, at, 4, milestone.StatusInProgress).
+				WillReturnResult(sqlmock.NewResult(0, tt.affected))
+			mock.ExpectCommit()
+
+			changed, err := New(client).UpdateStatus(context.Background(), 4, milestone.StatusInProgress, milestone.StatusSubmitted, at)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, changed)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestGetOverdueByCourse(t *testing.T) {
+	today := time.Date(2026, 5, 10, 0, 0, 0, 0, time.UTC)
+	due := today.AddDate(0, 0, -3)
+
+	client, mock, sqlDB := repository.SetupMockDB(t)
+	defer sqlDB.Close()
+
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) FROM "milestone" JOIN "project" ON "project"."id" = "milestone"."project_id" AND "project"."deleted_at" IS NULL JOIN "course_section" ON "course_section"."id" = "project"."section_id" WHERE "course_section"."course_id" = $1 AND ("milestone"."due_date" < $2 AND "milestone"."status" IN ($3,$4)) AND "course_section"."term_id" = $5 AND "milestone"."deleted_at" IS NULL`)).
+		WithArgs(4, today, milestone.StatusPlanned, milestone.StatusInProgress, 2).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT "milestone".* FROM "milestone" JOIN "project" ON "project"."id" = "milestone"."project_id" AND "project"."deleted_at" IS NULL JOIN "course_section" ON "course_section"."id" = "project"."section_id" WHERE "course_section"."course_id" = $1 AND ("milestone"."due_date" < $2 AND "milestone"."status" IN ($3,$4)) AND "course_section"."term_id" = $5 AND "milestone"."deleted_at" IS NULL ORDER BY "milestone"."due_date","milestone"."id" LIMIT $6`)).
+		WithArgs(4, today, milestone.StatusPlanned, milestone.StatusInProgress, 2, query.DefaultPageSize).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "project_id", "due_date", "status"}).AddRow(8, 5, due, milestone.StatusInProgress))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "project" WHERE "project"."id" = $1 AND "project"."deleted_at" IS NULL`)).
+		WithArgs(5).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow(5, "Proyecto del curso"))
+
+	page, err := New(client).GetOverdueByCourse(context.Background(), 4, today, query.Params{Filters: map[string]any{"term_id": 2}})
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), page.Total)
+	if assert.Len(t, page.Items, 1) {
+		assert.Equal(t, 8, page.Items[0].ID)
+		assert.Equal(t, due, *page.Items[0].DueDate)
+		assert.Equal(t, "Proyecto del curso", *page.Items[0].Project.Name)
+	}
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUpdateKeepsStatus(t *testing.T) {
+	client, mock, sqlDB := repository.SetupMockDB(t)
+	defer sqlDB.Close()
+
+	title := "Entrega final"
+	createdAt := time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC)
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE "milestone" SET "project_id"=$1,"title"=$2,"description"=$3,"class_week"=$4,"due_date"=$5,"created_at"=$6,"deleted_at"=$7 WHERE "milestone"."deleted_at" IS NULL AND "id" = $8`)).
+		WithArgs(5, title, nil, nil, nil, createdAt, nil, 4).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	err := New(client).Update(context.Background(), &milestone.Milestone{
+		ID:        4,
+		ProjectID: 5,
+		Title:     &title,
+		Status:    milestone.StatusReviewed,
+		CreatedAt: createdAt,
+	})
+
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetDetail(t *testing.T) {
+	client, mock, sqlDB := repository.SetupMockDB(t)
+	defer sqlDB.Close()
+
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "milestone" WHERE "milestone"."id" = $1 AND "milestone"."deleted_at" IS NULL ORDER BY "milestone"."id" LIMIT $2`)).
+		WithArgs(4, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "project_id", "status"}).AddRow(4, 3, milestone.StatusPlanned))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "comment" WHERE "comment"."milestone_id" = $1 AND "comment"."deleted_at" IS NULL ORDER BY created_at,id`)).
+		WithArgs(4).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "milestone_id", "user_id"}).AddRow(9, 4, 2))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "user" WHERE "user"."id" = $1`)).
+		WithArgs(2).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "email"}).AddRow(2, "ana@unal.edu.co"))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "project" WHERE "project"."id" = $1 AND "project"."deleted_at" IS NULL`)).
+		WithArgs(3).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(3))
+
+	detail, err := New(client).GetDetail(context.Background(), 4, []string{milestone_detail.IncludeComments})
+
+	assert.NoError(t, err)
+	assert.Equal(t, 4, detail.ID)
+	if assert.Len(t, detail.Comments, 1) {
+		assert.Equal(t, "ana@unal.edu.co", detail.Comments[0].User.Email)
+	}
+	assert.Nil(t, detail.Deliverables)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetCounts(t *testing.T) {
+	client, mock, sqlDB := repository.SetupMockDB(t)
+	defer sqlDB.Close()
+
+	like := "like"
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT milestone_id, count(*) AS total FROM "deliverable" WHERE milestone_id IN ($1,$2) AND "deliverable"."deleted_at" IS NULL GROUP BY "milestone_id"`)).
+		WithArgs(4, 5).
+		WillReturnRows(sqlmock.NewRows([]string{"milestone_id", "total"}).AddRow(4, 2))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT milestone_id, count(*) AS total FROM "comment" WHERE milestone_id IN ($1,$2) AND "comment"."deleted_at" IS NULL GROUP BY "milestone_id"`)).
+		WithArgs(4, 5).
+		WillReturnRows(sqlmock.NewRows([]string{"milestone_id", "total"}).AddRow(4, 3).AddRow(5, 1))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT milestone_id, count(*) AS total FROM "feedback" WHERE milestone_id IN ($1,$2) AND "feedback"."deleted_at" IS NULL GROUP BY "milestone_id"`)).
+		WithArgs(4, 5).
+		WillReturnRows(sqlmock.NewRows([]string{"milestone_id", "total"}).AddRow(5, 1))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT milestone_id, type, count(*) AS total FROM "reaction" WHERE milestone_id IN ($1,$2) GROUP BY milestone_id, type`)).
+		WithArgs(4, 5).
+		WillReturnRows(sqlmock.NewRows([]string{"milestone_id", "type", "total"}).AddRow(4, like, 4))
+
+	counts, err := New(client).GetCounts(context.Background(), []int{4, 5})
+
+	assert.NoError(t, err)
+	assert.Equal(t, map[int]*milestone.Counts{
+		4: {Deliverables: 2, Comments: 3, Reactions: map[string]int{like: 4}},
+		5: {Comments: 1, Reactions: map[string]int{}, HasFeedback: true},
+	}, counts)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -2,7 +2,13 @@ package project
 
 import (
 	"context"
+	"time"
+
+	"gorm.io/gorm"
+
 	"softpharos/internal/core/domain/project"
+	"softpharos/internal/core/domain/project_member"
+	"softpharos/internal/core/domain/query"
 	"softpharos/internal/core/ports/repository"
 	"softpharos/internal/infra/databases"
 	"softpharos/internal/infra/databases/mappers"
@@ -17,21 +23,28 @@ func New(client *databases.Client) repository.ProjectRepository {
 	return &Repository{client: client}
 }
 
-func (r *Repository) GetAll(ctx context.Context) ([]project.Project, error) {
+func (r *Repository) GetAll(ctx context.Context, params query.Params) (*query.Page[project.Project], error) {
+	var total int64
+	if err := r.client.DB.WithContext(ctx).Model(&models.ProjectModel{}).Scopes(databases.Filter(params)).Count(&total).Error; err != nil {
+		return nil, databases.TranslateError(err)
+	}
+
 	var projectModels []models.ProjectModel
-	result := r.client.DB.WithContext(ctx).Preload("Owner").Find(&projectModels)
+	result := r.client.DB.WithContext(ctx).Preload("Owner").
+		Scopes(databases.Filter(params), databases.Paginate(params)).
+		Find(&projectModels)
 	if result.Error != nil {
-		return nil, result.Error
+		return nil, databases.TranslateError(result.Error)
 	}
 
-	return mappers.ProjectListToDomain(projectModels), nil
+	return query.NewPage(mappers.ProjectListToDomain(projectModels), total, params), nil
 }
 
 func (r *Repository) GetByID(ctx context.Context, id int) (*project.Project, error) {
 	var projectModel models.ProjectModel
 	result := r.client.DB.WithContext(ctx).Preload("Owner").First(&projectModel, id)
 	if result.Error != nil {
-		return nil, result.Error
+		return nil, databases.TranslateError(result.Error)
 	}
 
 	return mappers.ProjectToDomain(&projectModel), nil
@@ -44,17 +57,124 @@ func (r *Repository) GetByOwner(ctx context.Context, ownerID int) ([]project.Pro
 		Where("created_by = ?", ownerID).
 		Find(&projectModels)
 	if result.Error != nil {
-		return nil, result.Error
+		return nil, databases.TranslateError(result.Error)
 	}
 
 	return mappers.ProjectListToDomain(projectModels), nil
 }
 
+// memberProjectRow es una fila de GetByMember: el proyecto junto con la
+// membresía del usuario que consulta
+type memberProjectRow struct {
+	models.ProjectModel
+	MemberRole     string
+	MemberJoinedAt time.Time
+}
+
+// memberSortColumns ubica en la consulta de GetByMember cada campo por el que
+// se puede ordenar; los demás campos pertenecen a project
+var memberSortColumns = map[string]string{
+	"role":      "project_member.role",
+	"joined_at": "project_member.joined_at",
+}
+
+// GetByMember lista en una sola consulta los proyectos en los que participa
+// el usuario, con su rol en cada uno. Como el creador de un proyecto queda
+// registrado como owner, incluye tanto los propios como aquellos en los que
+// solo es miembro. Admite los filtros status (active o archived) y role.
+func (r *Repository) GetByMember(ctx context.Context, userID int, params query.Params) (*query.Page[project.MemberProject], error) {
+	memberProjects := r.client.DB.WithContext(ctx).Model(&models.ProjectModel{}).
+		Joins(`JOIN "project_member" ON "project_member"."project_id" = "project"."id"`).
+		Where(`"project_member"."user_id" = ?`, userID).
+		Scopes(memberFilter(params)).
+		Session(&gorm.Session{})
+
+	var total int64
+	if err := memberProjects.Count(&total).Error; err != nil {
+		return nil, databases.TranslateError(err)
+	}
+
+	var rows []memberProjectRow
+	result := memberProjects.
+		Select(`"project".*, "project_member"."role" AS member_role, "project_member"."joined_at" AS member_joined_at`).
+		Preload("Owner").
+		Scopes(databases.PaginateJoined(params, "project", memberSortColumns)).
+		Find(&rows)
+	if result.Error != nil {
+		return nil, databases.TranslateError(result.Error)
+	}
+
+	items := make([]project.MemberProject, len(rows))
+	for i, row := range rows {
+		items[i] = project.MemberProject{
+			Project:  *mappers.ProjectToDomain(&row.ProjectModel),
+			Role:     row.MemberRole,
+			JoinedAt: row.MemberJoinedAt,
+		}
+	}
+	return query.NewPage(items, total, params), nil
+}
+
+func memberFilter(params query.Params) func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		switch params.Filters["status"] {
+		case project.StatusActive:
+			db = db.Where(`"project"."archived_at" IS NULL`)
+		case project.StatusArchived:
+			db = db.Where(`"project"."archived_at" IS NOT NULL`)
+		}
+		if role, ok := params.Filters["role"]; ok {
+			db = db.Where(`"project_member"."role" = ?`, role)
+		}
+		return db
+	}
+}
+
+// GetByCourse lista los proyectos vinculados a las secciones de la
+// asignatura. Admite los filtros term_id y section_id para acotar a un
+// semestre o a una sección concreta.
+func (r *Repository) GetByCourse(ctx context.Context, courseID int, params query.Params) (*query.Page[project.Project], error) {
+	courseProjects := r.client.DB.WithContext(ctx).Model(&models.ProjectModel{}).
+		Joins(`JOIN "course_section" ON "course_section"."id" = "project"."section_id"`).
+		Where(`"course_section"."course_id" = ?`, courseID).
+		Scopes(courseFilter(params)).
+		Session(&gorm.Session{})
+
+	var total int64
+	if err := courseProjects.Count(&total).Error; err != nil {
+		return nil, databases.TranslateError(err)
+	}
+
+	var projectModels []models.ProjectModel
+	result := courseProjects.
+		Select(`"project".*`).
+		Preload("Owner").
+		Scopes(databases.PaginateJoined(params, "project", nil)).
+		Find(&projectModels)
+	if result.Error != nil {
+		return nil, databases.TranslateError(result.Error)
+	}
+
+	return query.NewPage(mappers.ProjectListToDomain(projectModels), total, params), nil
+}
+
+func courseFilter(params query.Params) func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if termID, ok := params.Filters["term_id"]; ok {
+			db = db.Where(`"course_section"."term_id" = ?`, termID)
+		}
+		if sectionID, ok := params.Filters["section_id"]; ok {
+			db = db.Where(`"project"."section_id" = ?`, sectionID)
+		}
+		return db
+	}
+}
+
 func (r *Repository) Create(ctx context.Context, domainProject *project.Project) error {
 	projectModel := mappers.ProjectToModel(domainProject)
 	result := r.client.DB.WithContext(ctx).Create(projectModel)
 	if result.Error != nil {
-		return result.Error
+		return databases.TranslateError(result.Error)
 	}
 
 	domainProject.ID = projectModel.ID
@@ -65,9 +185,110 @@ func (r *Repository) Create(ctx context.Context, domainProject *project.Project)
 
 func (r *Repository) Update(ctx context.Context, domainProject *project.Project) error {
 	projectModel := mappers.ProjectToModel(domainProject)
-	return r.client.DB.WithContext(ctx).Save(projectModel).Error
+	return databases.TranslateError(r.client.DB.WithContext(ctx).Save(projectModel).Error)
 }
 
+func (r *Repository) UpdateOwner(ctx context.Context, id int, ownerID int) error {
+	result := r.client.DB.WithContext(ctx).
+		Model(&models.ProjectModel{}).
+		Where("id = ?", id).
+		Update("created_by", ownerID)
+	return databases.TranslateError(result.Error)
+}
+
+// SetArchivedAt archiva el proyecto con la fecha indicada o, si es nil, lo
+// vuelve a activar
+func (r *Repository) SetArchivedAt(ctx context.Context, id int, at *time.Time) error {
+	result := r.client.DB.WithContext(ctx).
+		Model(&models.ProjectModel{}).
+		Where("id = ?", id).
+		Update("archived_at", at)
+	if result.Error != nil {
+		return databases.TranslateError(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return databases.TranslateError(gorm.ErrRecordNotFound)
+	}
+	return nil
+}
+
+// Delete envía a la papelera el proyecto, sus hitos y el contenido de estos
+// con una misma marca, para que Restore recupere exactamente lo que se borró
+// en esta operación.
 func (r *Repository) Delete(ctx context.Context, id int) error {
-	return r.client.DB.WithContext(ctx).Delete(&models.ProjectModel{}, id).Error
+	return databases.TranslateError(r.client.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		at := databases.TrashedAt(tx)
+		result := tx.Model(&models.ProjectModel{}).Where("id = ?", id).UpdateColumn("deleted_at", at)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+
+		_, err := databases.TrashMilestones(tx, at, "project_id = ?", id)
+		return err
+	}))
+}
+
+func (r *Repository) GetTrashed(ctx context.Context, params query.Params) (*query.Page[project.Project], error) {
+	return trashedPage(r.client.DB.WithContext(ctx).Unscoped().Where("deleted_at IS NOT NULL"), params)
+}
+
+// GetTrashedByOwner usa la membresía y no created_by, que puede quedar
+// desactualizado respecto de quién puede restaurar el proyecto
+func (r *Repository) GetTrashedByOwner(ctx context.Context, userID int, params query.Params) (*query.Page[project.Project], error) {
+	db := r.client.DB.WithContext(ctx)
+	owned := db.Model(&models.ProjectMemberModel{}).
+		Select("project_id").
+		Where("user_id = ? AND role = ?", userID, project_member.RoleOwner)
+
+	return trashedPage(db.Unscoped().Where("deleted_at IS NOT NULL AND id IN (?)", owned), params)
+}
+
+func trashedPage(db *gorm.DB, params query.Params) (*query.Page[project.Project], error) {
+	trashed := db.Session(&gorm.Session{})
+
+	var total int64
+	if err := trashed.Model(&models.ProjectModel{}).Scopes(databases.Filter(params)).Count(&total).Error; err != nil {
+		return nil, databases.TranslateError(err)
+	}
+
+	var projectModels []models.ProjectModel
+	result := trashed.Preload("Owner").
+		Scopes(databases.Filter(params), databases.Paginate(params)).
+		Find(&projectModels)
+	if result.Error != nil {
+		return nil, databases.TranslateError(result.Error)
+	}
+
+	return query.NewPage(mappers.ProjectListToDomain(projectModels), total, params), nil
+}
+
+func (r *Repository) GetTrashedByID(ctx context.Context, id int) (*project.Project, error) {
+	var projectModel models.ProjectModel
+	result := r.client.DB.WithContext(ctx).Unscoped().Preload("Owner").
+		Where("deleted_at IS NOT NULL").
+		First(&projectModel, id)
+	if result.Error != nil {
+		return nil, databases.TranslateError(result.Error)
+	}
+
+	return mappers.ProjectToDomain(&projectModel), nil
+}
+
+// Restore saca de la papelera el proyecto y lo que se borró junto con él
+func (r *Repository) Restore(ctx context.Context, id int) error {
+	return databases.TranslateError(r.client.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var projectModel models.ProjectModel
+		if err := tx.Unscoped().Where("deleted_at IS NOT NULL").First(&projectModel, id).Error; err != nil {
+			return err
+		}
+		at := projectModel.DeletedAt.Time
+
+		if err := tx.Unscoped().Model(&projectModel).UpdateColumn("deleted_at", nil).Error; err != nil {
+			return err
+		}
+		return databases.RestoreMilestones(tx, at, "project_id = ?", id)
+	}))
 }
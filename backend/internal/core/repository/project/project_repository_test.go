@@ -5,6 +5,8 @@ import (
 	"errors"
 	"regexp"
 	"softpharos/internal/core/domain/project"
+	"softpharos/internal/core/domain/query"
+	"softpharos/internal/core/errs"
 	"softpharos/internal/core/repository"
 	"testing"
 	"time"
@@ -23,8 +25,10 @@ func TestGetAll(t *testing.T) {
 
 	tests := []struct {
 		name          string
+		params        query.Params
 		mockSetup     func(sqlmock.Sqlmock)
 		expectedLen   int
+		expectedTotal int64
 		expectedError bool
 	}{
 		{
@@ -34,7 +38,10 @@ func TestGetAll(t *testing.T) {
 					AddRow(1, name1, nil, 1, now, now).
 					AddRow(2, name2, nil, 2, now, now)
 
-				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "project"`)).
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) FROM "project" WHERE "project"."deleted_at" IS NULL`)).
+					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(2))
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "project" WHERE "project"."deleted_at" IS NULL ORDER BY "id" LIMIT $1`)).
+					WithArgs(query.DefaultPageSize).
 					WillReturnRows(projectRows)
 
 				ownerRows := sqlmock.NewRows([]string{"id", "name", "email", "password", "role_id", "created_at"}).
@@ -46,12 +53,38 @@ func TestGetAll(t *testing.T) {
 					WillReturnRows(ownerRows)
 			},
 			expectedLen:   2,
+			expectedTotal: 2,
+			expectedError: false,
+		},
+		{
+			name: "aplica filtros, orden y página",
+			params: query.Params{
+				Page:     2,
+				PageSize: 1,
+				Sort:     []query.Sort{{Field: "name", Desc: true}},
+				Filters:  map[string]any{"created_by": 1},
+			},
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) FROM "project" WHERE "created_by" = $1 AND "project"."deleted_at" IS NULL`)).
+					WithArgs(1).
+					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(3))
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "project" WHERE "created_by" = $1 AND "project"."deleted_at" IS NULL ORDER BY "name" DESC,"id" LIMIT $2 OFFSET $3`)).
+					WithArgs(1, 1, 1).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "name", "objective", "created_by", "created_at", "updated_at"}).
+						AddRow(2, name2, nil, 1, now, now))
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "user" WHERE "user"."id" = $1`)).
+					WithArgs(1).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "name", "email", "role_id", "created_at"}).
+						AddRow(1, ownerName1, "owner1@example.com", 1, now))
+			},
+			expectedLen:   1,
+			expectedTotal: 3,
 			expectedError: false,
 		},
 		{
 			name: "retorna error cuando la query falla",
 			mockSetup: func(mock sqlmock.Sqlmock) {
-				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "project"`)).
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) FROM "project" WHERE "project"."deleted_at" IS NULL`)).
 					WillReturnError(errors.New("database error"))
 			},
 			expectedLen:   0,
@@ -69,13 +102,14 @@ func TestGetAll(t *testing.T) {
 			repo := New(client)
 			ctx := context.Background()
 
-			projects, err := repo.GetAll(ctx)
+			page, err := repo.GetAll(ctx, tt.params)
 
 			if tt.expectedError {
 				assert.Error(t, err)
 			} else {
 				assert.NoError(t, err)
-				assert.Len(t, projects, tt.expectedLen)
+				assert.Len(t, page.Items, tt.expectedLen)
+				assert.Equal(t, tt.expectedTotal, page.Total)
 			}
 			assert.NoError(t, mock.ExpectationsWereMet())
 		})
@@ -100,7 +134,7 @@ func TestGetByID(t *testing.T) {
 				projectRows := sqlmock.NewRows([]string{"id", "name", "objective", "created_by", "created_at", "updated_at"}).
 					AddRow(1, name, nil, 1, now, now)
 
-				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "project" WHERE "project"."id" = $1`)).
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "project" WHERE "project"."id" = $1 AND "project"."deleted_at" IS NULL`)).
 					WithArgs(1, 1).
 					WillReturnRows(projectRows)
 
@@ -117,7 +151,7 @@ func TestGetByID(t *testing.T) {
 			name:      "retorna error cuando el proyecto no existe",
 			projectID: 999,
 			mockSetup: func(mock sqlmock.Sqlmock) {
-				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "project" WHERE "project"."id" = $1`)).
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "project" WHERE "project"."id" = $1 AND "project"."deleted_at" IS NULL`)).
 					WithArgs(999, 1).
 					WillReturnError(gorm.ErrRecordNotFound)
 			},
@@ -165,7 +199,7 @@ func TestCreate(t *testing.T) {
 			mockSetup: func(mock sqlmock.Sqlmock) {
 				mock.ExpectBegin()
 				mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "project"`)).
-					WithArgs(name, nil, 1, sqlmock.AnyArg(), sqlmock.AnyArg()).
+					WithArgs(name, nil, 1, nil, sqlmock.AnyArg(), sqlmock.AnyArg(), nil, nil).
 					WillReturnRows(sqlmock.NewRows([]string{"id", "created_at", "updated_at"}).
 						AddRow(1, time.Now(), time.Now()))
 				mock.ExpectCommit()
@@ -178,7 +212,7 @@ func TestCreate(t *testing.T) {
 			mockSetup: func(mock sqlmock.Sqlmock) {
 				mock.ExpectBegin()
 				mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "project"`)).
-					WithArgs(name, nil, 1, sqlmock.AnyArg(), sqlmock.AnyArg()).
+					WithArgs(name, nil, 1, nil, sqlmock.AnyArg(), sqlmock.AnyArg(), nil, nil).
 					WillReturnError(errors.New("database error"))
 				mock.ExpectRollback()
 			},
@@ -230,7 +264,7 @@ func TestUpdate(t *testing.T) {
 			mockSetup: func(mock sqlmock.Sqlmock) {
 				mock.ExpectBegin()
 				mock.ExpectExec(regexp.QuoteMeta(`UPDATE "project" SET`)).
-					WithArgs(name, nil, 1, sqlmock.AnyArg(), sqlmock.AnyArg(), 1).
+					WithArgs(name, nil, 1, nil, sqlmock.AnyArg(), sqlmock.AnyArg(), nil, nil, 1).
 					WillReturnResult(sqlmock.NewResult(1, 1))
 				mock.ExpectCommit()
 			},
@@ -246,7 +280,7 @@ func TestUpdate(t *testing.T) {
 			mockSetup: func(mock sqlmock.Sqlmock) {
 				mock.ExpectBegin()
 				mock.ExpectExec(regexp.QuoteMeta(`UPDATE "project" SET`)).
-					WithArgs(name, nil, 1, sqlmock.AnyArg(), sqlmock.AnyArg(), 1).
+					WithArgs(name, nil, 1, nil, sqlmock.AnyArg(), sqlmock.AnyArg(), nil, nil, 1).
 					WillReturnError(errors.New("database error"))
 				mock.ExpectRollback()
 			},
@@ -281,31 +315,52 @@ func TestDelete(t *testing.T) {
 		name          string
 		projectID     int
 		mockSetup     func(sqlmock.Sqlmock)
-		expectedError bool
+		expectedError error
 	}{
 		{
-			name:      "elimina proyecto exitosamente",
+			name:      "envía a la papelera el proyecto, sus hitos y su contenido",
 			projectID: 1,
 			mockSetup: func(mock sqlmock.Sqlmock) {
 				mock.ExpectBegin()
-				mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM "project" WHERE "project"."id" = $1`)).
-					WithArgs(1).
+				mock.ExpectExec(regexp.QuoteMeta(`UPDATE "project" SET "deleted_at"=$1 WHERE id = $2 AND "project"."deleted_at" IS NULL`)).
+					WithArgs(sqlmock.AnyArg(), 1).
 					WillReturnResult(sqlmock.NewResult(0, 1))
+				for _, table := range []string{"deliverable", "feedback", "comment"} {
+					mock.ExpectExec(regexp.QuoteMeta(`UPDATE "`+table+`" SET "deleted_at"=$1 WHERE milestone_id IN (SELECT "id" FROM "milestone" WHERE project_id = $2 AND "milestone"."deleted_at" IS NULL) AND "`+table+`"."deleted_at" IS NULL`)).
+						WithArgs(sqlmock.AnyArg(), 1).
+						WillReturnResult(sqlmock.NewResult(0, 2))
+				}
+				mock.ExpectExec(regexp.QuoteMeta(`UPDATE "milestone" SET "deleted_at"=$1 WHERE project_id = $2 AND "milestone"."deleted_at" IS NULL`)).
+					WithArgs(sqlmock.AnyArg(), 1).
+					WillReturnResult(sqlmock.NewResult(0, 3))
 				mock.ExpectCommit()
 			},
-			expectedError: false,
 		},
 		{
-			name:      "retorna error cuando delete falla",
+			name:      "retorna not found cuando el proyecto no existe o ya está en la papelera",
+			projectID: 9,
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(regexp.QuoteMeta(`UPDATE "project" SET "deleted_at"=$1 WHERE id = $2 AND "project"."deleted_at" IS NULL`)).
+					WithArgs(sqlmock.AnyArg(), 9).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectRollback()
+			},
+			expectedError: errs.ErrNotFound,
+		},
+		{
+			name:      "revierte la transacción cuando falla el borrado de los hitos",
 			projectID: 1,
 			mockSetup: func(mock sqlmock.Sqlmock) {
 				mock.ExpectBegin()
-				mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM "project" WHERE "project"."id" = $1`)).
-					WithArgs(1).
+				mock.ExpectExec(regexp.QuoteMeta(`UPDATE "project" SET "deleted_at"=$1`)).
+					WithArgs(sqlmock.AnyArg(), 1).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(regexp.QuoteMeta(`UPDATE "deliverable" SET "deleted_at"=$1`)).
 					WillReturnError(errors.New("database error"))
 				mock.ExpectRollback()
 			},
-			expectedError: true,
+			expectedError: errors.New("database error"),
 		},
 	}
 
@@ -321,8 +376,11 @@ func TestDelete(t *testing.T) {
 
 			err := repo.Delete(ctx, tt.projectID)
 
-			if tt.expectedError {
+			if tt.expectedError != nil {
 				assert.Error(t, err)
+				if errors.Is(tt.expectedError, errs.ErrNotFound) {
+					assert.ErrorIs(t, err, errs.ErrNotFound)
+				}
 			} else {
 				assert.NoError(t, err)
 			}
@@ -330,3 +388,195 @@ func TestDelete(t *testing.T) {
 		})
 	}
 }
+
+func TestRestore(t *testing.T) {
+	deletedAt := time.Date(2025, 3, 1, 10, 0, 0, 0, time.UTC)
+
+	client, mock, sqlDB := repository.SetupMockDB(t)
+	defer sqlDB.Close()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "project" WHERE deleted_at IS NOT NULL AND "project"."id" = $1`)).
+		WithArgs(1, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "created_by", "deleted_at"}).AddRow(1, 1, deletedAt))
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE "project" SET "deleted_at"=$1 WHERE "id" = $2`)).
+		WithArgs(nil, 1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	// Solo se restaura lo que se borró con la misma marca que el proyecto
+	for _, table := range []string{"deliverable", "feedback", "comment"} {
+		mock.ExpectExec(regexp.QuoteMeta(`UPDATE "`+table+`" SET "deleted_at"=$1 WHERE milestone_id IN (SELECT "id" FROM "milestone" WHERE project_id = $2 AND deleted_at = $3) AND deleted_at = $4`)).
+			WithArgs(nil, 1, deletedAt, deletedAt).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+	}
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE "milestone" SET "deleted_at"=$1 WHERE project_id = $2 AND deleted_at = $3`)).
+		WithArgs(nil, 1, deletedAt).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectCommit()
+
+	err := New(client).Restore(context.Background(), 1)
+
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetTrashed(t *testing.T) {
+	now := time.Now()
+
+	client, mock, sqlDB := repository.SetupMockDB(t)
+	defer sqlDB.Close()
+
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) FROM "project" WHERE deleted_at IS NOT NULL AND "created_by" = $1`)).
+		WithArgs(2).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "project" WHERE deleted_at IS NOT NULL AND "created_by" = $1 ORDER BY "deleted_at" DESC,"id" LIMIT $2`)).
+		WithArgs(2, query.DefaultPageSize).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "created_by", "deleted_at"}).AddRow(4, 2, now))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "user" WHERE "user"."id" = $1`)).
+		WithArgs(2).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "email"}).AddRow(2, "owner@example.com"))
+
+	page, err := New(client).GetTrashed(context.Background(), query.Params{
+		Sort:    []query.Sort{{Field: "deleted_at", Desc: true}},
+		Filters: map[string]any{"created_by": 2},
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), page.Total)
+	assert.Len(t, page.Items, 1)
+	assert.NotNil(t, page.Items[0].DeletedAt)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetTrashedByOwner(t *testing.T) {
+	now := time.Now()
+
+	client, mock, sqlDB := repository.SetupMockDB(t)
+	defer sqlDB.Close()
+
+	owned := `deleted_at IS NOT NULL AND id IN (SELECT "project_id" FROM "project_member" WHERE user_id = $1 AND role = $2)`
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) FROM "project" WHERE `+owned)).
+		WithArgs(7, "owner").
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "project" WHERE `+owned+` ORDER BY "deleted_at" DESC,"id" LIMIT $3`)).
+		WithArgs(7, "owner", query.DefaultPageSize).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "created_by", "deleted_at"}).AddRow(4, 2, now))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "user" WHERE "user"."id" = $1`)).
+		WithArgs(2).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "email"}).AddRow(2, "creator@example.com"))
+
+	page, err := New(client).GetTrashedByOwner(context.Background(), 7, query.Params{
+		Sort: []query.Sort{{Field: "deleted_at", Desc: true}},
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), page.Total)
+	assert.Equal(t, 4, page.Items[0].ID)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetByMember(t *testing.T) {
+	now := time.Now()
+	joinedAt := time.Date(2025, 2, 1, 9, 0, 0, 0, time.UTC)
+
+	client, mock, sqlDB := repository.SetupMockDB(t)
+	defer sqlDB.Close()
+
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) FROM "project" JOIN "project_member" ON "project_member"."project_id" = "project"."id" WHERE "project_member"."user_id" = $1 AND "project"."archived_at" IS NULL AND "project_member"."role" = $2 AND "project"."deleted_at" IS NULL`)).
+		WithArgs(7, "contributor").
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT "project".*, "project_member"."role" AS member_role, "project_member"."joined_at" AS member_joined_at FROM "project" JOIN "project_member" ON "project_member"."project_id" = "project"."id" WHERE "project_member"."user_id" = $1 AND "project"."archived_at" IS NULL AND "project_member"."role" = $2 AND "project"."deleted_at" IS NULL ORDER BY "project_member"."joined_at" DESC,"project"."id" LIMIT $3`)).
+		WithArgs(7, "contributor", query.DefaultPageSize).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "created_by", "created_at", "updated_at", "member_role", "member_joined_at"}).
+			AddRow(3, "Proyecto ajeno", 2, now, now, "contributor", joinedAt))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "user" WHERE "user"."id" = $1`)).
+		WithArgs(2).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "email"}).AddRow(2, "owner@example.com"))
+
+	page, err := New(client).GetByMember(context.Background(), 7, query.Params{
+		Sort:    []query.Sort{{Field: "joined_at", Desc: true}},
+		Filters: map[string]any{"status": project.StatusActive, "role": "contributor"},
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), page.Total)
+	if assert.Len(t, page.Items, 1) {
+		item := page.Items[0]
+		assert.Equal(t, 3, item.ID)
+		assert.Equal(t, "contributor", item.Role)
+		assert.Equal(t, joinedAt, item.JoinedAt)
+		assert.Equal(t, "owner@example.com", item.Owner.Email)
+	}
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSetArchivedAt(t *testing.T) {
+	archivedAt := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name          string
+		at            *time.Time
+		rowsAffected  int64
+		expectedError error
+	}{
+		{name: "archiva el proyecto", at: &archivedAt, rowsAffected: 1},
+		{name: "reactiva el proyecto", at: nil, rowsAffected: 1},
+		{name: "retorna not found cuando el proyecto no existe", at: &archivedAt, rowsAffected: 0, expectedError: errs.ErrNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, mock, sqlDB := repository.SetupMockDB(t)
+			defer sqlDB.Close()
+
+			mock.ExpectBegin()
+			mock.ExpectExec(regexp.QuoteMeta(`UPDATE "project" SET "archived_at"=$1,"updated_at"=$2 WHERE id = $3 AND "project"."deleted_at" IS NULL`)).
+				WithArgs(tt.at, sqlmock.AnyArg(), 1).
+				WillReturnResult(sqlmock.NewResult(0, tt.rowsAffected))
+			mock.ExpectCommit()
+
+			err := New(client).SetArchivedAt(context.Background(), 1, tt.at)
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestGetByCourse(t *testing.T) {
+	now := time.Now()
+
+	client, mock, sqlDB := repository.SetupMockDB(t)
+	defer sqlDB.Close()
+
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) FROM "project" JOIN "course_section" ON "course_section"."id" = "project"."section_id" WHERE "course_section"."course_id" = $1 AND "course_section"."term_id" = $2 AND "project"."deleted_at" IS NULL`)).
+		WithArgs(4, 2).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT "project".* FROM "project" JOIN "course_section" ON "course_section"."id" = "project"."section_id" WHERE "course_section"."course_id" = $1 AND "course_section"."term_id" = $2 AND "project"."deleted_at" IS NULL ORDER BY "project"."name","project"."id" LIMIT $3`)).
+		WithArgs(4, 2, query.DefaultPageSize).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "created_by", "section_id", "created_at", "updated_at"}).
+			AddRow(5, "Proyecto del curso", 2, 9, now, now))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "user" WHERE "user"."id" = $1`)).
+		WithArgs(2).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "email"}).AddRow(2, "alumno@example.com"))
+
+	page, err := New(client).GetByCourse(context.Background(), 4, query.Params{
+		Sort:    []query.Sort{{Field: "name"}},
+		Filters: map[string]any{"term_id": 2},
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), page.Total)
+	if assert.Len(t, page.Items, 1) {
+		item := page.Items[0]
+		assert.Equal(t, 5, item.ID)
+		if assert.NotNil(t, item.SectionID) {
+			assert.Equal(t, 9, *item.SectionID)
+		}
+		assert.Equal(t, "alumno@example.com", item.Owner.Email)
+	}
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -3,6 +3,7 @@ package project_member
 import (
 	"context"
 	"softpharos/internal/core/domain/project_member"
+	"softpharos/internal/core/domain/query"
 	"softpharos/internal/core/ports/repository"
 	"softpharos/internal/infra/databases"
 	"softpharos/internal/infra/databases/mappers"
@@ -17,21 +18,28 @@ func New(client *databases.Client) repository.ProjectMemberRepository {
 	return &Repository{client: client}
 }
 
-func (r *Repository) GetAll(ctx context.Context) ([]project_member.ProjectMember, error) {
+func (r *Repository) GetAll(ctx context.Context, params query.Params) (*query.Page[project_member.ProjectMember], error) {
+	var total int64
+	if err := r.client.DB.WithContext(ctx).Model(&models.ProjectMemberModel{}).Scopes(databases.Filter(params)).Count(&total).Error; err != nil {
+		return nil, databases.TranslateError(err)
+	}
+
 	var projectMemberModels []models.ProjectMemberModel
-	result := r.client.DB.WithContext(ctx).Preload("Project").Preload("User").Find(&projectMemberModels)
+	result := r.client.DB.WithContext(ctx).Preload("Project").Preload("User").
+		Scopes(databases.Filter(params), databases.Paginate(params)).
+		Find(&projectMemberModels)
 	if result.Error != nil {
-		return nil, result.Error
+		return nil, databases.TranslateError(result.Error)
 	}
 
-	return mappers.ProjectMemberListToDomain(projectMemberModels), nil
+	return query.NewPage(mappers.ProjectMemberListToDomain(projectMemberModels), total, params), nil
 }
 
 func (r *Repository) GetByID(ctx context.Context, id int) (*project_member.ProjectMember, error) {
 	var projectMemberModel models.ProjectMemberModel
 	result := r.client.DB.WithContext(ctx).Preload("Project").Preload("User").First(&projectMemberModel, id)
 	if result.Error != nil {
-		return nil, result.Error
+		return nil, databases.TranslateError(result.Error)
 	}
 
 	return mappers.ProjectMemberToDomain(&projectMemberModel), nil
@@ -45,17 +53,55 @@ func (r *Repository) GetByProjectID(ctx context.Context, projectID int) ([]proje
 		Where("project_id = ?", projectID).
 		Find(&projectMemberModels)
 	if result.Error != nil {
-		return nil, result.Error
+		return nil, databases.TranslateError(result.Error)
 	}
 
 	return mappers.ProjectMemberListToDomain(projectMemberModels), nil
 }
 
+func (r *Repository) IsMember(ctx context.Context, projectID int, userID int) (bool, error) {
+	var count int64
+	result := r.client.DB.WithContext(ctx).
+		Model(&models.ProjectMemberModel{}).
+		Where("project_id = ? AND user_id = ?", projectID, userID).
+		Count(&count)
+	if result.Error != nil {
+		return false, databases.TranslateError(result.Error)
+	}
+
+	return count > 0, nil
+}
+
+func (r *Repository) GetByProjectAndUser(ctx context.Context, projectID int, userID int) (*project_member.ProjectMember, error) {
+	var projectMemberModel models.ProjectMemberModel
+	result := r.client.DB.WithContext(ctx).
+		Where("project_id = ? AND user_id = ?", projectID, userID).
+		First(&projectMemberModel)
+	if result.Error != nil {
+		return nil, databases.TranslateError(result.Error)
+	}
+
+	return mappers.ProjectMemberToDomain(&projectMemberModel), nil
+}
+
+func (r *Repository) CountByRole(ctx context.Context, projectID int, role string) (int64, error) {
+	var count int64
+	result := r.client.DB.WithContext(ctx).
+		Model(&models.ProjectMemberModel{}).
+		Where("project_id = ? AND role = ?", projectID, role).
+		Count(&count)
+	if result.Error != nil {
+		return 0, databases.TranslateError(result.Error)
+	}
+
+	return count, nil
+}
+
 func (r *Repository) Create(ctx context.Context, domainProjectMember *project_member.ProjectMember) error {
 	projectMemberModel := mappers.ProjectMemberToModel(domainProjectMember)
 	result := r.client.DB.WithContext(ctx).Create(projectMemberModel)
 	if result.Error != nil {
-		return result.Error
+		return databases.TranslateError(result.Error)
 	}
 
 	domainProjectMember.ID = projectMemberModel.ID
@@ -65,9 +111,17 @@ func (r *Repository) Create(ctx context.Context, domainProjectMember *project_me
 
 func (r *Repository) Update(ctx context.Context, domainProjectMember *project_member.ProjectMember) error {
 	projectMemberModel := mappers.ProjectMemberToModel(domainProjectMember)
-	return r.client.DB.WithContext(ctx).Save(projectMemberModel).Error
+	return databases.TranslateError(r.client.DB.WithContext(ctx).Save(projectMemberModel).Error)
+}
+
+func (r *Repository) UpdateRole(ctx context.Context, id int, role string) error {
+	result := r.client.DB.WithContext(ctx).
+		Model(&models.ProjectMemberModel{}).
+		Where("id = ?", id).
+		Update("role", role)
+	return databases.TranslateError(result.Error)
 }
 
 func (r *Repository) Delete(ctx context.Context, id int) error {
-	return r.client.DB.WithContext(ctx).Delete(&models.ProjectMemberModel{}, id).Error
+	return databases.TranslateError(r.client.DB.WithContext(ctx).Delete(&models.ProjectMemberModel{}, id).Error)
 }
@@ -2,11 +2,14 @@ package reaction
 
 import (
 	"context"
+	"softpharos/internal/core/domain/query"
 	"softpharos/internal/core/domain/reaction"
 	"softpharos/internal/core/ports/repository"
 	"softpharos/internal/infra/databases"
 	"softpharos/internal/infra/databases/mappers"
 	"softpharos/internal/infra/databases/models"
+
+	"gorm.io/gorm/clause"
 )
 
 type Repository struct {
@@ -17,21 +20,28 @@ func New(client *databases.Client) repository.ReactionRepository {
 	return &Repository{client: client}
 }
 
-func (r *Repository) GetAll(ctx context.Context) ([]reaction.Reaction, error) {
+func (r *Repository) GetAll(ctx context.Context, params query.Params) (*query.Page[reaction.Reaction], error) {
+	var total int64
+	if err := r.client.DB.WithContext(ctx).Model(&models.ReactionModel{}).Scopes(databases.Filter(params)).Count(&total).Error; err != nil {
+		return nil, databases.TranslateError(err)
+	}
+
 	var reactionModels []models.ReactionModel
-	result := r.client.DB.WithContext(ctx).Preload("Milestone").Preload("User").Find(&reactionModels)
+	result := r.client.DB.WithContext(ctx).Preload("Milestone").Preload("User").
+		Scopes(databases.Filter(params), databases.Paginate(params)).
+		Find(&reactionModels)
 	if result.Error != nil {
-		return nil, result.Error
+		return nil, databases.TranslateError(result.Error)
 	}
 
-	return mappers.ReactionListToDomain(reactionModels), nil
+	return query.NewPage(mappers.ReactionListToDomain(reactionModels), total, params), nil
 }
 
 func (r *Repository) GetByID(ctx context.Context, id int) (*reaction.Reaction, error) {
 	var reactionModel models.ReactionModel
 	result := r.client.DB.WithContext(ctx).Preload("Milestone").Preload("User").First(&reactionModel, id)
 	if result.Error != nil {
-		return nil, result.Error
+		return nil, databases.TranslateError(result.Error)
 	}
 
 	return mappers.ReactionToDomain(&reactionModel), nil
@@ -45,7 +55,7 @@ func (r *Repository) GetByMilestoneID(ctx context.Context, milestoneID int) ([]r
 		Where("milestone_id = ?", milestoneID).
 		Find(&reactionModels)
 	if result.Error != nil {
-		return nil, result.Error
+		return nil, databases.TranslateError(result.Error)
 	}
 
 	return mappers.ReactionListToDomain(reactionModels), nil
@@ -55,7 +65,7 @@ func (r *Repository) Create(ctx context.Context, domainReaction *reaction.Reacti
 	reactionModel := mappers.ReactionToModel(domainReaction)
 	result := r.client.DB.WithContext(ctx).Create(reactionModel)
 	if result.Error != nil {
-		return result.Error
+		return databases.TranslateError(result.Error)
 	}
 
 	domainReaction.ID = reactionModel.ID
@@ -65,9 +75,49 @@ func (r *Repository) Create(ctx context.Context, domainReaction *reaction.Reacti
 
 func (r *Repository) Update(ctx context.Context, domainReaction *reaction.Reaction) error {
 	reactionModel := mappers.ReactionToModel(domainReaction)
-	return r.client.DB.WithContext(ctx).Save(reactionModel).Error
+	return databases.TranslateError(r.client.DB.WithContext(ctx).Save(reactionModel).Error)
 }
 
 func (r *Repository) Delete(ctx context.Context, id int) error {
-	return r.client.DB.WithContext(ctx).Delete(&models.ReactionModel{}, id).Error
+	return databases.TranslateError(r.client.DB.WithContext(ctx).Delete(&models.ReactionModel{}, id).Error)
+}
+
+func (r *Repository) AddForUser(ctx context.Context, domainReaction *reaction.Reaction) error {
+	reactionModel := mappers.ReactionToModel(domainReaction)
+	result := r.client.DB.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "milestone_id"}, {Name: "user_id"}, {Name: "type"}},
+			DoNothing: true,
+		}).
+		Create(reactionModel)
+	return databases.TranslateError(result.Error)
+}
+
+func (r *Repository) RemoveForUser(ctx context.Context, milestoneID int, userID int, reactionType string) error {
+	result := r.client.DB.WithContext(ctx).
+		Where("milestone_id = ? AND user_id = ? AND type = ?", milestoneID, userID, reactionType).
+		Delete(&models.ReactionModel{})
+	return databases.TranslateError(result.Error)
+}
+
+func (r *Repository) CountByMilestone(ctx context.Context, milestoneID int, userID int) (map[string]reaction.TypeCount, error) {
+	var rows []struct {
+		Type    string
+		Count   int
+		Reacted bool
+	}
+	result := r.client.DB.WithContext(ctx).Model(&models.ReactionModel{}).
+		Select("type, count(*) AS count, bool_or(user_id = ?) AS reacted", userID).
+		Where("milestone_id = ?", milestoneID).
+		Group("type").
+		Scan(&rows)
+	if result.Error != nil {
+		return nil, databases.TranslateError(result.Error)
+	}
+
+	counts := make(map[string]reaction.TypeCount, len(rows))
+	for _, row := range rows {
+		counts[row.Type] = reaction.TypeCount{Type: row.Type, Count: row.Count, Reacted: row.Reacted}
+	}
+	return counts, nil
 }
@@ -0,0 +1,64 @@
+package reaction
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+
+	"softpharos/internal/core/domain/reaction"
+	"softpharos/internal/core/repository"
+)
+
+func TestAddForUser(t *testing.T) {
+	client, mock, sqlDB := repository.SetupMockDB(t)
+	defer sqlDB.Close()
+
+	like := "like"
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "reaction" ("milestone_id","user_id","type","created_at") VALUES ($1,$2,$3,$4) ON CONFLICT ("milestone_id","user_id","type") DO NOTHING RETURNING "id"`)).
+		WithArgs(4, 2, like, sqlmock.AnyArg()).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	mock.ExpectCommit()
+
+	err := New(client).AddForUser(context.Background(), &reaction.Reaction{MilestoneID: 4, UserID: 2, Type: &like})
+
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRemoveForUser(t *testing.T) {
+	client, mock, sqlDB := repository.SetupMockDB(t)
+	defer sqlDB.Close()
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM "reaction" WHERE milestone_id = $1 AND user_id = $2 AND type = $3`)).
+		WithArgs(4, 2, "like").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+
+	err := New(client).RemoveForUser(context.Background(), 4, 2, "like")
+
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCountByMilestone(t *testing.T) {
+	client, mock, sqlDB := repository.SetupMockDB(t)
+	defer sqlDB.Close()
+
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT type, count(*) AS count, bool_or(user_id = $1) AS reacted FROM "reaction" WHERE milestone_id = $2 GROUP BY "type"`)).
+		WithArgs(2, 4).
+		WillReturnRows(sqlmock.NewRows([]string{"type", "count", "reacted"}).AddRow("like", 3, true).AddRow("love", 1, false))
+
+	counts, err := New(client).CountByMilestone(context.Background(), 4, 2)
+
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]reaction.TypeCount{
+		"like": {Type: "like", Count: 3, Reacted: true},
+		"love": {Type: "love", Count: 1},
+	}, counts)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,71 @@
+package refresh_token
+
+import (
+	"context"
+	"softpharos/internal/core/domain/session"
+	"softpharos/internal/core/ports/repository"
+	"softpharos/internal/infra/databases"
+	"softpharos/internal/infra/databases/mappers"
+	"softpharos/internal/infra/databases/models"
+	"time"
+)
+
+type Repository struct {
+	client *databases.Client
+}
+
+func New(client *databases.Client) repository.RefreshTokenRepository {
+	return &Repository{client: client}
+}
+
+func (r *Repository) Create(ctx context.Context, token *session.RefreshToken) error {
+	tokenModel := mappers.RefreshTokenToModel(token)
+	result := r.client.DB.WithContext(ctx).Create(tokenModel)
+	if result.Error != nil {
+		return databases.TranslateError(result.Error)
+	}
+
+	token.ID = tokenModel.ID
+	token.CreatedAt = tokenModel.CreatedAt
+	return nil
+}
+
+func (r *Repository) GetByTokenHash(ctx context.Context, tokenHash string) (*session.RefreshToken, error) {
+	var tokenModel models.RefreshTokenModel
+	result := r.client.DB.WithContext(ctx).Where("token_hash = ?", tokenHash).First(&tokenModel)
+	if result.Error != nil {
+		return nil, databases.TranslateError(result.Error)
+	}
+
+	return mappers.RefreshTokenToDomain(&tokenModel), nil
+}
+
+func (r *Repository) Revoke(ctx context.Context, id int) (bool, error) {
+	// La condición sobre revoked_at evita que dos peticiones concurrentes roten el mismo token
+	result := r.client.DB.WithContext(ctx).
+		Model(&models.RefreshTokenModel{}).
+		Where("id = ? AND revoked_at IS NULL", id).
+		Update("revoked_at", time.Now())
+	if result.Error != nil {
+		return false, databases.TranslateError(result.Error)
+	}
+
+	return result.RowsAffected > 0, nil
+}
+
+func (r *Repository) RevokeAllByUserID(ctx context.Context, userID int) error {
+	result := r.client.DB.WithContext(ctx).
+		Model(&models.RefreshTokenModel{}).
+		Where("user_id = ? AND revoked_at IS NULL", userID).
+		Update("revoked_at", time.Now())
+	return databases.TranslateError(result.Error)
+}
+
+func (r *Repository) DeleteExpired(ctx context.Context, now time.Time) (int64, error) {
+	result := r.client.DB.WithContext(ctx).Where("expires_at < ?", now).Delete(&models.RefreshTokenModel{})
+	if result.Error != nil {
+		return 0, databases.TranslateError(result.Error)
+	}
+
+	return result.RowsAffected, nil
+}
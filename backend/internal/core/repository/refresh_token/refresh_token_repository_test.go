@@ -0,0 +1,148 @@
+package refresh_token
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+
+	"softpharos/internal/core/repository"
+)
+
+func TestRefreshTokenRevoke(t *testing.T) {
+	tests := []struct {
+		name          string
+		mockSetup     func(sqlmock.Sqlmock)
+		expected      bool
+		expectedError bool
+	}{
+		{
+			name: "revoca un token activo",
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(regexp.QuoteMeta(`UPDATE "refresh_token" SET "revoked_at"=$1 WHERE id = $2 AND revoked_at IS NULL`)).
+					WithArgs(sqlmock.AnyArg(), 7).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+			expected: true,
+		},
+		{
+			name: "retorna false cuando el token ya estaba revocado",
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(regexp.QuoteMeta(`UPDATE "refresh_token" SET "revoked_at"=$1 WHERE id = $2 AND revoked_at IS NULL`)).
+					WithArgs(sqlmock.AnyArg(), 7).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectCommit()
+			},
+			expected: false,
+		},
+		{
+			name: "retorna error cuando la query falla",
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(regexp.QuoteMeta(`UPDATE "refresh_token"`)).
+					WillReturnError(errors.New("database error"))
+				mock.ExpectRollback()
+			},
+			expectedError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, mock, sqlDB := repository.SetupMockDB(t)
+			defer sqlDB.Close()
+
+			tt.mockSetup(mock)
+
+			repo := New(client)
+			revoked, err := repo.Revoke(context.Background(), 7)
+
+			if tt.expectedError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, revoked)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestRefreshTokenGetByTokenHash(t *testing.T) {
+	client, mock, sqlDB := repository.SetupMockDB(t)
+	defer sqlDB.Close()
+
+	rows := sqlmock.NewRows([]string{"id", "user_id", "token_hash", "expires_at", "revoked_at", "created_at"}).
+		AddRow(7, 1, "hash", nil, nil, nil)
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "refresh_token" WHERE token_hash = $1`)).
+		WithArgs("hash", 1).
+		WillReturnRows(rows)
+
+	token, err := New(client).GetByTokenHash(context.Background(), "hash")
+
+	assert.NoError(t, err)
+	assert.Equal(t, 7, token.ID)
+	assert.Equal(t, 1, token.UserID)
+	assert.Nil(t, token.RevokedAt)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRefreshTokenDeleteExpired(t *testing.T) {
+	now := time.Date(2026, 5, 10, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name          string
+		mockSetup     func(sqlmock.Sqlmock)
+		expected      int64
+		expectedError bool
+	}{
+		{
+			name: "elimina los tokens expirados",
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM "refresh_token" WHERE expires_at < $1`)).
+					WithArgs(now).
+					WillReturnResult(sqlmock.NewResult(0, 3))
+				mock.ExpectCommit()
+			},
+			expected: 3,
+		},
+		{
+			name: "retorna error cuando la query falla",
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM "refresh_token"`)).
+					WillReturnError(errors.New("database error"))
+				mock.ExpectRollback()
+			},
+			expectedError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, mock, sqlDB := repository.SetupMockDB(t)
+			defer sqlDB.Close()
+
+			tt.mockSetup(mock)
+
+			repo := New(client)
+			deleted, err := repo.DeleteExpired(context.Background(), now)
+
+			if tt.expectedError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, deleted)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
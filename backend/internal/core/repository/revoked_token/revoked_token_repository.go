@@ -0,0 +1,72 @@
+package revoked_token
+
+import (
+	"context"
+	"softpharos/internal/core/domain/session"
+	"softpharos/internal/core/ports/repository"
+	"softpharos/internal/infra/databases"
+	"softpharos/internal/infra/databases/mappers"
+	"softpharos/internal/infra/databases/models"
+	"time"
+
+	"gorm.io/gorm/clause"
+)
+
+type Repository struct {
+	client *databases.Client
+}
+
+func New(client *databases.Client) repository.RevokedTokenRepository {
+	return &Repository{client: client}
+}
+
+func (r *Repository) Create(ctx context.Context, token *session.RevokedToken) error {
+	return databases.TranslateError(r.client.DB.WithContext(ctx).Create(mappers.RevokedTokenToModel(token)).Error)
+}
+
+func (r *Repository) RevokeUserTokens(ctx context.Context, userID int, before time.Time) error {
+	result := r.client.DB.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "user_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"revoked_before"}),
+		}).
+		Create(&models.RevokedUserTokenModel{UserID: userID, RevokedBefore: before})
+	return databases.TranslateError(result.Error)
+}
+
+func (r *Repository) IsRevoked(ctx context.Context, jti string, userID int, issuedAt time.Time) (bool, error) {
+	var count int64
+	result := r.client.DB.WithContext(ctx).
+		Model(&models.RevokedTokenModel{}).
+		Where("jti = ?", jti).
+		Count(&count)
+	if result.Error != nil {
+		return false, databases.TranslateError(result.Error)
+	}
+	if count > 0 {
+		return true, nil
+	}
+
+	var userRevocation models.RevokedUserTokenModel
+	result = r.client.DB.WithContext(ctx).
+		Where("user_id = ?", userID).
+		Limit(1).
+		Find(&userRevocation)
+	if result.Error != nil {
+		return false, databases.TranslateError(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return false, nil
+	}
+
+	return session.RevokedBy(issuedAt, userRevocation.RevokedBefore), nil
+}
+
+func (r *Repository) DeleteExpired(ctx context.Context, now time.Time) (int64, error) {
+	result := r.client.DB.WithContext(ctx).Where("expires_at < ?", now).Delete(&models.RevokedTokenModel{})
+	if result.Error != nil {
+		return 0, databases.TranslateError(result.Error)
+	}
+
+	return result.RowsAffected, nil
+}
@@ -0,0 +1,154 @@
+package revoked_token
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+
+	"softpharos/internal/core/repository"
+)
+
+func TestRevokedTokenIsRevoked(t *testing.T) {
+	// El iat del JWT tiene precisión de segundos
+	issuedAt := time.Date(2026, 5, 10, 12, 0, 0, 0, time.UTC)
+	userRevocation := `SELECT * FROM "revoked_user_token" WHERE user_id = $1 LIMIT $2`
+	notRevokedByJTI := func(mock sqlmock.Sqlmock) {
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) FROM "revoked_token" WHERE jti = $1`)).
+			WithArgs("abc").
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+	}
+	revokedBefore := func(mock sqlmock.Sqlmock, at time.Time) {
+		notRevokedByJTI(mock)
+		mock.ExpectQuery(regexp.QuoteMeta(userRevocation)).
+			WithArgs(5, 1).
+			WillReturnRows(sqlmock.NewRows([]string{"user_id", "revoked_before"}).AddRow(5, at))
+	}
+
+	tests := []struct {
+		name          string
+		mockSetup     func(sqlmock.Sqlmock)
+		expected      bool
+		expectedError bool
+	}{
+		{
+			name: "revocado por jti",
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) FROM "revoked_token" WHERE jti = $1`)).
+					WithArgs("abc").
+					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+			},
+			expected: true,
+		},
+		{
+			name:      "revocado por cambio de rol posterior a la emisión",
+			mockSetup: func(mock sqlmock.Sqlmock) { revokedBefore(mock, issuedAt.Add(time.Minute)) },
+			expected:  true,
+		},
+		{
+			name:      "revocado por cambio de rol en el mismo segundo de la emisión",
+			mockSetup: func(mock sqlmock.Sqlmock) { revokedBefore(mock, issuedAt.Add(500*time.Millisecond)) },
+			expected:  true,
+		},
+		{
+			name:      "vigente si se emitió en un segundo posterior al cambio de rol",
+			mockSetup: func(mock sqlmock.Sqlmock) { revokedBefore(mock, issuedAt.Add(-500*time.Millisecond)) },
+			expected:  false,
+		},
+		{
+			name: "token vigente",
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				notRevokedByJTI(mock)
+				mock.ExpectQuery(regexp.QuoteMeta(userRevocation)).
+					WithArgs(5, 1).
+					WillReturnRows(sqlmock.NewRows([]string{"user_id", "revoked_before"}))
+			},
+			expected: false,
+		},
+		{
+			name: "retorna error cuando la query falla",
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) FROM "revoked_token"`)).
+					WillReturnError(errors.New("database error"))
+			},
+			expectedError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, mock, sqlDB := repository.SetupMockDB(t)
+			defer sqlDB.Close()
+
+			tt.mockSetup(mock)
+
+			repo := New(client)
+			revoked, err := repo.IsRevoked(context.Background(), "abc", 5, issuedAt)
+
+			if tt.expectedError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, revoked)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestRevokedTokenDeleteExpired(t *testing.T) {
+	now := time.Date(2026, 5, 10, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name          string
+		mockSetup     func(sqlmock.Sqlmock)
+		expected      int64
+		expectedError bool
+	}{
+		{
+			name: "elimina los tokens expirados",
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM "revoked_token" WHERE expires_at < $1`)).
+					WithArgs(now).
+					WillReturnResult(sqlmock.NewResult(0, 3))
+				mock.ExpectCommit()
+			},
+			expected: 3,
+		},
+		{
+			name: "retorna error cuando la query falla",
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM "revoked_token"`)).
+					WillReturnError(errors.New("database error"))
+				mock.ExpectRollback()
+			},
+			expectedError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, mock, sqlDB := repository.SetupMockDB(t)
+			defer sqlDB.Close()
+
+			tt.mockSetup(mock)
+
+			repo := New(client)
+			deleted, err := repo.DeleteExpired(context.Background(), now)
+
+			if tt.expectedError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, deleted)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
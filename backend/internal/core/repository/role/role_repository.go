@@ -2,6 +2,7 @@ package role
 
 import (
 	"context"
+	"softpharos/internal/core/domain/query"
 	"softpharos/internal/core/domain/role"
 	"softpharos/internal/core/ports/repository"
 	"softpharos/internal/infra/databases"
@@ -17,21 +18,28 @@ func New(client *databases.Client) repository.RoleRepository {
 	return &Repository{client: client}
 }
 
-func (r *Repository) GetAll(ctx context.Context) ([]role.Role, error) {
+func (r *Repository) GetAll(ctx context.Context, params query.Params) (*query.Page[role.Role], error) {
+	var total int64
+	if err := r.client.DB.WithContext(ctx).Model(&models.RoleModel{}).Scopes(databases.Filter(params)).Count(&total).Error; err != nil {
+		return nil, databases.TranslateError(err)
+	}
+
 	var roleModels []models.RoleModel
-	result := r.client.DB.WithContext(ctx).Find(&roleModels)
+	result := r.client.DB.WithContext(ctx).
+		Scopes(databases.Filter(params), databases.Paginate(params)).
+		Find(&roleModels)
 	if result.Error != nil {
-		return nil, result.Error
+		return nil, databases.TranslateError(result.Error)
 	}
 
-	return mappers.RoleListToDomain(roleModels), nil
+	return query.NewPage(mappers.RoleListToDomain(roleModels), total, params), nil
 }
 
 func (r *Repository) GetByID(ctx context.Context, id int) (*role.Role, error) {
 	var roleModel models.RoleModel
 	result := r.client.DB.WithContext(ctx).First(&roleModel, id)
 	if result.Error != nil {
-		return nil, result.Error
+		return nil, databases.TranslateError(result.Error)
 	}
 
 	return mappers.RoleToDomain(&roleModel), nil
@@ -41,7 +49,7 @@ func (r *Repository) GetByName(ctx context.Context, name string) (*role.Role, er
 	var roleModel models.RoleModel
 	result := r.client.DB.WithContext(ctx).Where("name = ?", name).First(&roleModel)
 	if result.Error != nil {
-		return nil, result.Error
+		return nil, databases.TranslateError(result.Error)
 	}
 
 	return mappers.RoleToDomain(&roleModel), nil
@@ -51,7 +59,7 @@ func (r *Repository) Create(ctx context.Context, domainRole *role.Role) error {
 	roleModel := mappers.RoleToModel(domainRole)
 	result := r.client.DB.WithContext(ctx).Create(roleModel)
 	if result.Error != nil {
-		return result.Error
+		return databases.TranslateError(result.Error)
 	}
 
 	domainRole.ID = roleModel.ID
@@ -60,9 +68,9 @@ func (r *Repository) Create(ctx context.Context, domainRole *role.Role) error {
 
 func (r *Repository) Update(ctx context.Context, domainRole *role.Role) error {
 	roleModel := mappers.RoleToModel(domainRole)
-	return r.client.DB.WithContext(ctx).Save(roleModel).Error
+	return databases.TranslateError(r.client.DB.WithContext(ctx).Save(roleModel).Error)
 }
 
 func (r *Repository) Delete(ctx context.Context, id int) error {
-	return r.client.DB.WithContext(ctx).Delete(&models.RoleModel{}, id).Error
+	return databases.TranslateError(r.client.DB.WithContext(ctx).Delete(&models.RoleModel{}, id).Error)
 }
@@ -8,10 +8,13 @@ import (
 	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/stretchr/testify/assert"
 	"gorm.io/gorm"
 
+	"softpharos/internal/core/domain/query"
 	"softpharos/internal/core/domain/role"
+	"softpharos/internal/core/errs"
 	"softpharos/internal/core/repository"
 )
 
@@ -33,7 +36,10 @@ func TestRoleGetAll(t *testing.T) {
 					AddRow(1, "Admin", desc1, now).
 					AddRow(2, "User", desc2, now)
 
-				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "role"`)).
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) FROM "role"`)).
+					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(2))
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "role" ORDER BY "id" LIMIT $1`)).
+					WithArgs(query.DefaultPageSize).
 					WillReturnRows(roleRows)
 			},
 			expectedLen:   2,
@@ -42,7 +48,7 @@ func TestRoleGetAll(t *testing.T) {
 		{
 			name: "retorna error cuando la query falla",
 			mockSetup: func(mock sqlmock.Sqlmock) {
-				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "role"`)).
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) FROM "role"`)).
 					WillReturnError(errors.New("database error"))
 			},
 			expectedLen:   0,
@@ -60,13 +66,14 @@ func TestRoleGetAll(t *testing.T) {
 			repo := New(client)
 			ctx := context.Background()
 
-			roles, err := repo.GetAll(ctx)
+			page, err := repo.GetAll(ctx, query.Params{})
 
 			if tt.expectedError {
 				assert.Error(t, err)
 			} else {
 				assert.NoError(t, err)
-				assert.Len(t, roles, tt.expectedLen)
+				assert.Len(t, page.Items, tt.expectedLen)
+				assert.Equal(t, int64(tt.expectedLen), page.Total)
 			}
 			assert.NoError(t, mock.ExpectationsWereMet())
 		})
@@ -324,6 +331,7 @@ func TestRoleDelete(t *testing.T) {
 		roleID        int
 		mockSetup     func(sqlmock.Sqlmock)
 		expectedError bool
+		expectedKind  error
 	}{
 		{
 			name:   "elimina role exitosamente",
@@ -349,6 +357,19 @@ func TestRoleDelete(t *testing.T) {
 			},
 			expectedError: true,
 		},
+		{
+			name:   "retorna conflicto cuando el rol está asignado a usuarios",
+			roleID: 2,
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM "role" WHERE "role"."id" = $1`)).
+					WithArgs(2).
+					WillReturnError(&pgconn.PgError{Code: "23503", ConstraintName: "user_role_id_fkey"})
+				mock.ExpectRollback()
+			},
+			expectedError: true,
+			expectedKind:  errs.ErrConflict,
+		},
 	}
 
 	for _, tt := range tests {
@@ -365,6 +386,9 @@ func TestRoleDelete(t *testing.T) {
 
 			if tt.expectedError {
 				assert.Error(t, err)
+				if tt.expectedKind != nil {
+					assert.ErrorIs(t, err, tt.expectedKind)
+				}
 			} else {
 				assert.NoError(t, err)
 			}
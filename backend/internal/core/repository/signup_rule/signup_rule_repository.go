@@ -0,0 +1,81 @@
+package signup_rule
+
+import (
+	"context"
+	"softpharos/internal/core/domain/query"
+	"softpharos/internal/core/domain/signup_rule"
+	"softpharos/internal/core/ports/repository"
+	"softpharos/internal/infra/databases"
+	"softpharos/internal/infra/databases/mappers"
+	"softpharos/internal/infra/databases/models"
+)
+
+type Repository struct {
+	client *databases.Client
+}
+
+func New(client *databases.Client) repository.SignupRuleRepository {
+	return &Repository{client: client}
+}
+
+func (r *Repository) GetAll(ctx context.Context, params query.Params) (*query.Page[signup_rule.SignupRule], error) {
+	if len(params.Sort) == 0 {
+		params.Sort = []query.Sort{{Field: "pattern"}}
+	}
+
+	var total int64
+	if err := r.client.DB.WithContext(ctx).Model(&models.SignupRuleModel{}).Scopes(databases.Filter(params)).Count(&total).Error; err != nil {
+		return nil, databases.TranslateError(err)
+	}
+
+	var ruleModels []models.SignupRuleModel
+	result := r.client.DB.WithContext(ctx).Preload("Role").
+		Scopes(databases.Filter(params), databases.Paginate(params)).
+		Find(&ruleModels)
+	if result.Error != nil {
+		return nil, databases.TranslateError(result.Error)
+	}
+
+	return query.NewPage(mappers.SignupRuleListToDomain(ruleModels), total, params), nil
+}
+
+func (r *Repository) GetByID(ctx context.Context, id int) (*signup_rule.SignupRule, error) {
+	var ruleModel models.SignupRuleModel
+	result := r.client.DB.WithContext(ctx).Preload("Role").First(&ruleModel, id)
+	if result.Error != nil {
+		return nil, databases.TranslateError(result.Error)
+	}
+
+	return mappers.SignupRuleToDomain(&ruleModel), nil
+}
+
+func (r *Repository) GetByPatterns(ctx context.Context, patterns []string) ([]signup_rule.SignupRule, error) {
+	var ruleModels []models.SignupRuleModel
+	result := r.client.DB.WithContext(ctx).Preload("Role").Where("pattern IN ?", patterns).Find(&ruleModels)
+	if result.Error != nil {
+		return nil, databases.TranslateError(result.Error)
+	}
+
+	return mappers.SignupRuleListToDomain(ruleModels), nil
+}
+
+func (r *Repository) Create(ctx context.Context, rule *signup_rule.SignupRule) error {
+	ruleModel := mappers.SignupRuleToModel(rule)
+	result := r.client.DB.WithContext(ctx).Create(ruleModel)
+	if result.Error != nil {
+		return databases.TranslateError(result.Error)
+	}
+
+	rule.ID = ruleModel.ID
+	rule.CreatedAt = ruleModel.CreatedAt
+	return nil
+}
+
+func (r *Repository) Update(ctx context.Context, rule *signup_rule.SignupRule) error {
+	ruleModel := mappers.SignupRuleToModel(rule)
+	return databases.TranslateError(r.client.DB.WithContext(ctx).Save(ruleModel).Error)
+}
+
+func (r *Repository) Delete(ctx context.Context, id int) error {
+	return databases.TranslateError(r.client.DB.WithContext(ctx).Delete(&models.SignupRuleModel{}, id).Error)
+}
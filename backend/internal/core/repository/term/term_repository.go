@@ -0,0 +1,152 @@
+package term
+
+import (
+	"context"
+	"softpharos/internal/core/domain/query"
+	"softpharos/internal/core/domain/term"
+	"softpharos/internal/core/ports/repository"
+	"softpharos/internal/infra/databases"
+	"softpharos/internal/infra/databases/mappers"
+	"softpharos/internal/infra/databases/models"
+
+	"gorm.io/gorm"
+)
+
+type Repository struct {
+	client *databases.Client
+}
+
+func New(client *databases.Client) repository.TermRepository {
+	return &Repository{client: client}
+}
+
+// withBreaks carga los recesos de cada periodo en orden cronológico
+func withBreaks(db *gorm.DB) *gorm.DB {
+	return db.Preload("Breaks", func(db *gorm.DB) *gorm.DB {
+		return db.Order("start_date")
+	})
+}
+
+func (r *Repository) GetAll(ctx context.Context, params query.Params) (*query.Page[term.Term], error) {
+	if len(params.Sort) == 0 {
+		params.Sort = []query.Sort{{Field: "start_date", Desc: true}}
+	}
+
+	var total int64
+	if err := r.client.DB.WithContext(ctx).Model(&models.TermModel{}).Scopes(databases.Filter(params)).Count(&total).Error; err != nil {
+		return nil, databases.TranslateError(err)
+	}
+
+	var termModels []models.TermModel
+	result := r.client.DB.WithContext(ctx).
+		Scopes(withBreaks, databases.Filter(params), databases.Paginate(params)).
+		Find(&termModels)
+	if result.Error != nil {
+		return nil, databases.TranslateError(result.Error)
+	}
+
+	return query.NewPage(mappers.TermListToDomain(termModels), total, params), nil
+}
+
+func (r *Repository) GetByID(ctx context.Context, id int) (*term.Term, error) {
+	var termModel models.TermModel
+	result := r.client.DB.WithContext(ctx).Scopes(withBreaks).First(&termModel, id)
+	if result.Error != nil {
+		return nil, databases.TranslateError(result.Error)
+	}
+
+	return mappers.TermToDomain(&termModel), nil
+}
+
+// GetByProjects retorna el periodo de cada proyecto vinculado a una sección,
+// indexado por el ID del proyecto. Los proyectos sin sección no aparecen.
+func (r *Repository) GetByProjects(ctx context.Context, projectIDs []int) (map[int]*term.Term, error) {
+	terms := make(map[int]*term.Term)
+	if len(projectIDs) == 0 {
+		return terms, nil
+	}
+
+	var links []struct {
+		ProjectID int
+		TermID    int
+	}
+	result := r.client.DB.WithContext(ctx).Model(&models.ProjectModel{}).
+		Select(`"project"."id" AS project_id, "course_section"."term_id"`).
+		Joins(`JOIN "course_section" ON "course_section"."id" = "project"."section_id"`).
+		Where(`"project"."id" IN ?`, projectIDs).
+		Scan(&links)
+	if result.Error != nil {
+		return nil, databases.TranslateError(result.Error)
+	}
+	if len(links) == 0 {
+		return terms, nil
+	}
+
+	termIDs := make([]int, 0, len(links))
+	for _, link := range links {
+		termIDs = append(termIDs, link.TermID)
+	}
+
+	var termModels []models.TermModel
+	if err := r.client.DB.WithContext(ctx).Scopes(withBreaks).Find(&termModels, termIDs).Error; err != nil {
+		return nil, databases.TranslateError(err)
+	}
+
+	byID := make(map[int]*term.Term, len(termModels))
+	for i := range termModels {
+		byID[termModels[i].ID] = mappers.TermToDomain(&termModels[i])
+	}
+	for _, link := range links {
+		terms[link.ProjectID] = byID[link.TermID]
+	}
+	return terms, nil
+}
+
+func (r *Repository) Create(ctx context.Context, t *term.Term) error {
+	termModel := mappers.TermToModel(t)
+	result := r.client.DB.WithContext(ctx).Create(termModel)
+	if result.Error != nil {
+		return databases.TranslateError(result.Error)
+	}
+
+	t.ID = termModel.ID
+	t.CreatedAt = termModel.CreatedAt
+	for i := range t.Breaks {
+		t.Breaks[i].ID = termModel.Breaks[i].ID
+		t.Breaks[i].TermID = termModel.ID
+	}
+	return nil
+}
+
+// Update guarda el periodo y reemplaza sus recesos por los de t
+func (r *Repository) Update(ctx context.Context, t *term.Term) error {
+	termModel := mappers.TermToModel(t)
+
+	return databases.TranslateError(r.client.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit("Breaks").Save(termModel).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("term_id = ?", t.ID).Delete(&models.TermBreakModel{}).Error; err != nil {
+			return err
+		}
+		if len(termModel.Breaks) == 0 {
+			return nil
+		}
+		for i := range termModel.Breaks {
+			termModel.Breaks[i].ID = 0
+		}
+		if err := tx.Create(&termModel.Breaks).Error; err != nil {
+			return err
+		}
+
+		for i := range t.Breaks {
+			t.Breaks[i].ID = termModel.Breaks[i].ID
+			t.Breaks[i].TermID = t.ID
+		}
+		return nil
+	}))
+}
+
+func (r *Repository) Delete(ctx context.Context, id int) error {
+	return databases.TranslateError(r.client.DB.WithContext(ctx).Delete(&models.TermModel{}, id).Error)
+}
@@ -0,0 +1,81 @@
+package term
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+
+	"softpharos/internal/core/domain/term"
+	"softpharos/internal/core/repository"
+)
+
+func TestGetByProjects(t *testing.T) {
+	start := time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2026, 7, 3, 0, 0, 0, 0, time.UTC)
+
+	client, mock, sqlDB := repository.SetupMockDB(t)
+	defer sqlDB.Close()
+
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT "project"."id" AS project_id, "course_section"."term_id" FROM "project" JOIN "course_section" ON "course_section"."id" = "project"."section_id" WHERE "project"."id" IN ($1,$2,$3) AND "project"."deleted_at" IS NULL`)).
+		WithArgs(1, 2, 3).
+		WillReturnRows(sqlmock.NewRows([]string{"project_id", "term_id"}).AddRow(1, 7).AddRow(2, 7))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "term" WHERE "term"."id" IN ($1,$2)`)).
+		WithArgs(7, 7).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "code", "start_date", "end_date", "week_length"}).AddRow(7, "2026-1", start, end, 7))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "term_break" WHERE "term_break"."term_id" = $1 ORDER BY start_date`)).
+		WithArgs(7).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "term_id", "name", "start_date", "end_date"}).
+			AddRow(1, 7, "Semana santa", start.AddDate(0, 0, 28), start.AddDate(0, 0, 34)))
+
+	terms, err := New(client).GetByProjects(context.Background(), []int{1, 2, 3})
+
+	assert.NoError(t, err)
+	assert.Len(t, terms, 2)
+	assert.Same(t, terms[1], terms[2])
+	assert.NotContains(t, terms, 3)
+	if assert.NotNil(t, terms[1]) {
+		assert.Equal(t, "2026-1", terms[1].Code)
+		assert.Len(t, terms[1].Breaks, 1)
+	}
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUpdateReplacesBreaks(t *testing.T) {
+	start := time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2026, 7, 3, 0, 0, 0, 0, time.UTC)
+	breakStart := start.AddDate(0, 0, 28)
+
+	client, mock, sqlDB := repository.SetupMockDB(t)
+	defer sqlDB.Close()
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE "term" SET "code"=$1,"start_date"=$2,"end_date"=$3,"week_length"=$4,"created_at"=$5 WHERE "id" = $6`)).
+		WithArgs("2026-1", start, end, 7, sqlmock.AnyArg(), 7).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM "term_break" WHERE term_id = $1`)).
+		WithArgs(7).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "term_break" ("term_id","name","start_date","end_date") VALUES ($1,$2,$3,$4) RETURNING "id"`)).
+		WithArgs(7, "Semana santa", breakStart, breakStart.AddDate(0, 0, 6)).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(12))
+	mock.ExpectCommit()
+
+	updated := &term.Term{
+		ID:         7,
+		Code:       "2026-1",
+		StartDate:  start,
+		EndDate:    end,
+		WeekLength: 7,
+		Breaks:     []term.Break{{ID: 3, Name: "Semana santa", StartDate: breakStart, EndDate: breakStart.AddDate(0, 0, 6)}},
+	}
+	err := New(client).Update(context.Background(), updated)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 12, updated.Breaks[0].ID)
+	assert.Equal(t, 7, updated.Breaks[0].TermID)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,156 @@
+package timeline
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+
+	"softpharos/internal/core/domain/query"
+	"softpharos/internal/core/domain/timeline"
+	"softpharos/internal/core/ports/repository"
+	"softpharos/internal/infra/databases"
+	"softpharos/internal/infra/databases/mappers"
+	"softpharos/internal/infra/databases/models"
+)
+
+type Repository struct {
+	client *databases.Client
+}
+
+func New(client *databases.Client) repository.TimelineRepository {
+	return &Repository{client: client}
+}
+
+// entry es una fila de la unión de eventos, antes de cargar su entidad
+type entry struct {
+	Kind        string
+	ID          int
+	MilestoneID int
+	OccurredAt  time.Time
+}
+
+// GetByProjectID pagina en SQL la unión de hitos, entregables, retroalimentación,
+// comentarios y reacciones del proyecto, y luego carga las entidades de la página
+// con una consulta por tipo. Por defecto ordena del evento más antiguo al más reciente.
+func (r *Repository) GetByProjectID(ctx context.Context, projectID int, params query.Params) (*query.Page[timeline.Event], error) {
+	db := r.client.DB.WithContext(ctx)
+	events := db.Table("(?) AS timeline", eventsOf(db, projectID)).
+		Scopes(databases.Filter(params)).
+		Session(&gorm.Session{})
+
+	var total int64
+	if err := events.Count(&total).Error; err != nil {
+		return nil, databases.TranslateError(err)
+	}
+
+	// Varios eventos pueden compartir instante e id; kind desempata entre tipos
+	order := params
+	if len(order.Sort) == 0 {
+		order.Sort = []query.Sort{{Field: "occurred_at"}}
+	}
+	order.Sort = append(order.Sort, query.Sort{Field: "kind"})
+
+	var entries []entry
+	if err := events.Scopes(databases.Paginate(order)).Scan(&entries).Error; err != nil {
+		return nil, databases.TranslateError(err)
+	}
+
+	items, err := r.load(db, entries)
+	if err != nil {
+		return nil, databases.TranslateError(err)
+	}
+
+	return query.NewPage(items, total, params), nil
+}
+
+// eventsOf une la fecha de creación de cada entidad del proyecto. Los hijos de
+// un hito en la papelera quedan fuera aunque no estén borrados.
+func eventsOf(db *gorm.DB, projectID int) *gorm.DB {
+	milestones := db.Model(&models.MilestoneModel{}).
+		Select(fmt.Sprintf(`'%s' AS kind, "milestone"."id", "milestone"."id" AS milestone_id, "milestone"."created_at" AS occurred_at`, timeline.KindMilestone)).
+		Where(`"milestone"."project_id" = ?`, projectID)
+
+	children := func(model any, table string, kind string) *gorm.DB {
+		return db.Model(model).
+			Select(fmt.Sprintf(`'%s' AS kind, "%s"."id", "%s"."milestone_id", "%s"."created_at" AS occurred_at`, kind, table, table, table)).
+			Joins(fmt.Sprintf(`JOIN "milestone" ON "milestone"."id" = "%s"."milestone_id" AND "milestone"."deleted_at" IS NULL`, table)).
+			Where(`"milestone"."project_id" = ?`, projectID)
+	}
+
+	return db.Raw("(?) UNION ALL (?) UNION ALL (?) UNION ALL (?) UNION ALL (?)",
+		milestones,
+		children(&models.DeliverableModel{}, "deliverable", timeline.KindDeliverable),
+		children(&models.FeedbackModel{}, "feedback", timeline.KindFeedback),
+		children(&models.CommentModel{}, "comment", timeline.KindComment),
+		children(&models.ReactionModel{}, "reaction", timeline.KindReaction),
+	)
+}
+
+// load arma los eventos en el orden de entries con una consulta por cada tipo presente
+func (r *Repository) load(db *gorm.DB, entries []entry) ([]timeline.Event, error) {
+	ids := make(map[string][]int)
+	for _, e := range entries {
+		ids[e.Kind] = append(ids[e.Kind], e.ID)
+	}
+
+	var (
+		milestoneModels   []models.MilestoneModel
+		deliverableModels []models.DeliverableModel
+		feedbackModels    []models.FeedbackModel
+		commentModels     []models.CommentModel
+		reactionModels    []models.ReactionModel
+	)
+	queries := []struct {
+		kind string
+		db   *gorm.DB
+		dest any
+	}{
+		{timeline.KindMilestone, db, &milestoneModels},
+		{timeline.KindDeliverable, db, &deliverableModels},
+		{timeline.KindFeedback, db.Preload("Professor"), &feedbackModels},
+		{timeline.KindComment, db.Preload("User"), &commentModels},
+		{timeline.KindReaction, db.Preload("User"), &reactionModels},
+	}
+	for _, q := range queries {
+		if len(ids[q.kind]) == 0 {
+			continue
+		}
+		if err := q.db.Where("id IN ?", ids[q.kind]).Find(q.dest).Error; err != nil {
+			return nil, err
+		}
+	}
+
+	events := make(map[string]map[int]*timeline.Event)
+	for _, kind := range []string{timeline.KindMilestone, timeline.KindDeliverable, timeline.KindFeedback, timeline.KindComment, timeline.KindReaction} {
+		events[kind] = make(map[int]*timeline.Event)
+	}
+	for i := range milestoneModels {
+		events[timeline.KindMilestone][milestoneModels[i].ID] = &timeline.Event{Milestone: mappers.MilestoneToDomain(&milestoneModels[i])}
+	}
+	for i := range deliverableModels {
+		events[timeline.KindDeliverable][deliverableModels[i].ID] = &timeline.Event{Deliverable: mappers.DeliverableToDomain(&deliverableModels[i])}
+	}
+	for i := range feedbackModels {
+		events[timeline.KindFeedback][feedbackModels[i].ID] = &timeline.Event{Feedback: mappers.FeedbackToDomain(&feedbackModels[i])}
+	}
+	for i := range commentModels {
+		events[timeline.KindComment][commentModels[i].ID] = &timeline.Event{Comment: mappers.CommentToDomain(&commentModels[i])}
+	}
+	for i := range reactionModels {
+		events[timeline.KindReaction][reactionModels[i].ID] = &timeline.Event{Reaction: mappers.ReactionToDomain(&reactionModels[i])}
+	}
+
+	items := make([]timeline.Event, 0, len(entries))
+	for _, e := range entries {
+		event, ok := events[e.Kind][e.ID]
+		if !ok {
+			// Se borró entre la consulta de la página y la carga
+			continue
+		}
+		event.Kind, event.ID, event.MilestoneID, event.OccurredAt = e.Kind, e.ID, e.MilestoneID, e.OccurredAt
+		items = append(items, *event)
+	}
+	return items, nil
+}
@@ -0,0 +1,56 @@
+package timeline
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+
+	"softpharos/internal/core/domain/query"
+	"softpharos/internal/core/domain/timeline"
+	"softpharos/internal/core/repository"
+)
+
+func TestGetByProjectID(t *testing.T) {
+	client, mock, sqlDB := repository.SetupMockDB(t)
+	defer sqlDB.Close()
+
+	start := time.Date(2026, 3, 2, 10, 0, 0, 0, time.UTC)
+	union := regexp.QuoteMeta(`FROM ((SELECT 'milestone' AS kind, "milestone"."id", "milestone"."id" AS milestone_id, "milestone"."created_at" AS occurred_at FROM "milestone" WHERE "milestone"."project_id" = $1 AND "milestone"."deleted_at" IS NULL) UNION ALL (SELECT 'deliverable' AS kind`) +
+		`.+` + regexp.QuoteMeta(`UNION ALL (SELECT 'reaction' AS kind, "reaction"."id", "reaction"."milestone_id", "reaction"."created_at" AS occurred_at FROM "reaction" JOIN "milestone" ON "milestone"."id" = "reaction"."milestone_id" AND "milestone"."deleted_at" IS NULL WHERE "milestone"."project_id" = $5)) AS timeline`)
+
+	mock.ExpectQuery(`SELECT count\(\*\) `+union+`$`).
+		WithArgs(3, 3, 3, 3, 3).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(3))
+	mock.ExpectQuery(`SELECT \* `+union+regexp.QuoteMeta(` ORDER BY "occurred_at","kind","id" LIMIT $6`)).
+		WithArgs(3, 3, 3, 3, 3, query.DefaultPageSize).
+		WillReturnRows(sqlmock.NewRows([]string{"kind", "id", "milestone_id", "occurred_at"}).
+			AddRow(timeline.KindMilestone, 4, 4, start).
+			AddRow(timeline.KindComment, 9, 4, start.Add(time.Hour)).
+			AddRow(timeline.KindComment, 11, 4, start.Add(2*time.Hour)))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "milestone" WHERE id IN ($1) AND "milestone"."deleted_at" IS NULL`)).
+		WithArgs(4).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "project_id", "status"}).AddRow(4, 3, "planned"))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "comment" WHERE id IN ($1,$2) AND "comment"."deleted_at" IS NULL`)).
+		WithArgs(9, 11).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "milestone_id", "user_id"}).AddRow(9, 4, 2).AddRow(11, 4, 5))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "user" WHERE "user"."id" IN ($1,$2)`)).
+		WithArgs(2, 5).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "email"}).AddRow(2, "ana@unal.edu.co").AddRow(5, "luis@unal.edu.co"))
+
+	page, err := New(client).GetByProjectID(context.Background(), 3, query.Params{})
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), page.Total)
+	if assert.Len(t, page.Items, 3) {
+		assert.Equal(t, timeline.KindMilestone, page.Items[0].Kind)
+		assert.Equal(t, 4, page.Items[0].Milestone.ID)
+		assert.Equal(t, 11, page.Items[2].ID)
+		assert.Equal(t, start.Add(2*time.Hour), page.Items[2].OccurredAt)
+		assert.Equal(t, "luis@unal.edu.co", page.Items[2].Comment.User.Email)
+	}
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
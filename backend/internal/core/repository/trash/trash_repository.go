@@ -0,0 +1,48 @@
+package trash
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+
+	"softpharos/internal/core/ports/repository"
+	"softpharos/internal/infra/databases"
+	"softpharos/internal/infra/databases/models"
+)
+
+type Repository struct {
+	client *databases.Client
+}
+
+func New(client *databases.Client) repository.TrashRepository {
+	return &Repository{client: client}
+}
+
+// trashable lista los modelos con papelera, de hijos a padres
+var trashable = []any{
+	&models.CommentModel{},
+	&models.FeedbackModel{},
+	&models.DeliverableModel{},
+	&models.MilestoneModel{},
+	&models.ProjectModel{},
+}
+
+func (r *Repository) Purge(ctx context.Context, before time.Time) (int64, error) {
+	var purged int64
+	err := r.client.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for _, model := range trashable {
+			result := tx.Unscoped().Where("deleted_at < ?", before).Delete(model)
+			if result.Error != nil {
+				return result.Error
+			}
+			purged += result.RowsAffected
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, databases.TranslateError(err)
+	}
+
+	return purged, nil
+}
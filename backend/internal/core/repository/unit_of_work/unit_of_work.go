@@ -0,0 +1,42 @@
+package unit_of_work
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	"softpharos/internal/core/ports/repository"
+	"softpharos/internal/core/repository/audit_log"
+	"softpharos/internal/core/repository/invitation"
+	"softpharos/internal/core/repository/project"
+	"softpharos/internal/core/repository/project_member"
+	"softpharos/internal/core/repository/revoked_token"
+	"softpharos/internal/core/repository/role"
+	"softpharos/internal/core/repository/user"
+	"softpharos/internal/infra/databases"
+)
+
+type UnitOfWork struct {
+	client *databases.Client
+}
+
+func New(client *databases.Client) repository.UnitOfWork {
+	return &UnitOfWork{client: client}
+}
+
+// Do abre una transacción y construye los repositorios sobre un cliente que
+// la envuelve, así que cada llamada dentro de fn usa la misma conexión
+func (u *UnitOfWork) Do(ctx context.Context, fn func(repos repository.Repositories) error) error {
+	return u.client.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		txClient := &databases.Client{DB: tx}
+		return fn(repository.Repositories{
+			Users:          user.New(txClient),
+			Roles:          role.New(txClient),
+			Projects:       project.New(txClient),
+			ProjectMembers: project_member.New(txClient),
+			Invitations:    invitation.New(txClient),
+			RevokedTokens:  revoked_token.New(txClient),
+			AuditLog:       audit_log.New(txClient),
+		})
+	})
+}
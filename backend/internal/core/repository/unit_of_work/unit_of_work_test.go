@@ -0,0 +1,127 @@
+package unit_of_work
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+
+	"softpharos/internal/core/domain/audit"
+	"softpharos/internal/core/domain/project"
+	"softpharos/internal/core/domain/project_member"
+	"softpharos/internal/core/ports/repository"
+	mockDB "softpharos/internal/core/repository"
+)
+
+// createProjectWithOwner reproduce lo que hace el servicio al crear un proyecto
+func createProjectWithOwner(ctx context.Context, repos repository.Repositories) error {
+	name := "Proyecto"
+	proj := &project.Project{Name: &name, CreatedBy: 1}
+	if err := repos.Projects.Create(ctx, proj); err != nil {
+		return err
+	}
+	role := project_member.RoleOwner
+	return repos.ProjectMembers.Create(ctx, &project_member.ProjectMember{ProjectID: proj.ID, UserID: 1, Role: role})
+}
+
+// changeUserRole reproduce el cambio de rol auditado del servicio de usuarios
+func changeUserRole(ctx context.Context, repos repository.Repositories) error {
+	if err := repos.Users.UpdateRole(ctx, 5, 2); err != nil {
+		return err
+	}
+	if err := repos.RevokedTokens.RevokeUserTokens(ctx, 5, time.Now()); err != nil {
+		return err
+	}
+	actorID := 1
+	return repos.AuditLog.Create(ctx, &audit.Entry{ActorID: &actorID, Action: audit.ActionUserRoleChanged, Entity: "user", EntityID: 5})
+}
+
+func TestDo(t *testing.T) {
+	tests := []struct {
+		name          string
+		fn            func(context.Context, repository.Repositories) error
+		mockSetup     func(sqlmock.Sqlmock)
+		expectedError error
+	}{
+		{
+			name: "confirma cuando todas las operaciones terminan bien",
+			fn:   createProjectWithOwner,
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "project"`)).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(10))
+				mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "project_member"`)).
+					WithArgs(10, 1, "owner", sqlmock.AnyArg()).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name: "revierte el proyecto cuando falla el registro del owner",
+			fn:   createProjectWithOwner,
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "project"`)).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(10))
+				mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "project_member"`)).
+					WillReturnError(errors.New("database error"))
+				mock.ExpectRollback()
+			},
+			expectedError: errors.New("database error"),
+		},
+		{
+			name: "revierte el cambio de rol cuando falla la auditoría",
+			fn:   changeUserRole,
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(regexp.QuoteMeta(`UPDATE "user" SET "role_id"`)).
+					WithArgs(2, 5).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO "revoked_user_token"`)).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "audit_log"`)).
+					WillReturnError(errors.New("database error"))
+				mock.ExpectRollback()
+			},
+			expectedError: errors.New("database error"),
+		},
+		{
+			name: "revierte cuando la función retorna un error propio",
+			fn: func(context.Context, repository.Repositories) error {
+				return errors.New("regla de negocio")
+			},
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectRollback()
+			},
+			expectedError: errors.New("regla de negocio"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, mock, sqlDB := mockDB.SetupMockDB(t)
+			defer sqlDB.Close()
+
+			tt.mockSetup(mock)
+
+			uow := New(client)
+			ctx := context.Background()
+
+			err := uow.Do(ctx, func(repos repository.Repositories) error {
+				return tt.fn(ctx, repos)
+			})
+
+			if tt.expectedError != nil {
+				assert.EqualError(t, err, tt.expectedError.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
@@ -2,6 +2,7 @@ package user
 
 import (
 	"context"
+	"softpharos/internal/core/domain/query"
 	"softpharos/internal/core/domain/user"
 	"softpharos/internal/core/ports/repository"
 	"softpharos/internal/infra/databases"
@@ -17,21 +18,28 @@ func New(client *databases.Client) repository.UserRepository {
 	return &Repository{client: client}
 }
 
-func (r *Repository) GetAll(ctx context.Context) ([]user.User, error) {
+func (r *Repository) GetAll(ctx context.Context, params query.Params) (*query.Page[user.User], error) {
+	var total int64
+	if err := r.client.DB.WithContext(ctx).Model(&models.UserModel{}).Scopes(databases.Filter(params)).Count(&total).Error; err != nil {
+		return nil, databases.TranslateError(err)
+	}
+
 	var userModels []models.UserModel
-	result := r.client.DB.WithContext(ctx).Preload("Role").Find(&userModels)
+	result := r.client.DB.WithContext(ctx).Preload("Role").
+		Scopes(databases.Filter(params), databases.Paginate(params)).
+		Find(&userModels)
 	if result.Error != nil {
-		return nil, result.Error
+		return nil, databases.TranslateError(result.Error)
 	}
 
-	return mappers.UserListToDomain(userModels), nil
+	return query.NewPage(mappers.UserListToDomain(userModels), total, params), nil
 }
 
 func (r *Repository) GetByID(ctx context.Context, id int) (*user.User, error) {
 	var userModel models.UserModel
 	result := r.client.DB.WithContext(ctx).Preload("Role").First(&userModel, id)
 	if result.Error != nil {
-		return nil, result.Error
+		return nil, databases.TranslateError(result.Error)
 	}
 
 	return mappers.UserToDomain(&userModel), nil
@@ -41,7 +49,7 @@ func (r *Repository) GetByEmail(ctx context.Context, email string) (*user.User,
 	var userModel models.UserModel
 	result := r.client.DB.WithContext(ctx).Preload("Role").Where("email = ?", email).First(&userModel)
 	if result.Error != nil {
-		return nil, result.Error
+		return nil, databases.TranslateError(result.Error)
 	}
 
 	return mappers.UserToDomain(&userModel), nil
@@ -51,7 +59,7 @@ func (r *Repository) GetByProviderID(ctx context.Context, providerID string) (*u
 	var userModel models.UserModel
 	result := r.client.DB.WithContext(ctx).Preload("Role").Where("provider_id = ?", providerID).First(&userModel)
 	if result.Error != nil {
-		return nil, result.Error
+		return nil, databases.TranslateError(result.Error)
 	}
 
 	return mappers.UserToDomain(&userModel), nil
@@ -61,7 +69,7 @@ func (r *Repository) Create(ctx context.Context, domainUser *user.User) error {
 	userModel := mappers.UserToModel(domainUser)
 	result := r.client.DB.WithContext(ctx).Create(userModel)
 	if result.Error != nil {
-		return result.Error
+		return databases.TranslateError(result.Error)
 	}
 
 	domainUser.ID = userModel.ID
@@ -71,9 +79,17 @@ func (r *Repository) Create(ctx context.Context, domainUser *user.User) error {
 
 func (r *Repository) Update(ctx context.Context, domainUser *user.User) error {
 	userModel := mappers.UserToModel(domainUser)
-	return r.client.DB.WithContext(ctx).Save(userModel).Error
+	return databases.TranslateError(r.client.DB.WithContext(ctx).Save(userModel).Error)
+}
+
+func (r *Repository) UpdateRole(ctx context.Context, id int, roleID int) error {
+	result := r.client.DB.WithContext(ctx).
+		Model(&models.UserModel{}).
+		Where("id = ?", id).
+		Update("role_id", roleID)
+	return databases.TranslateError(result.Error)
 }
 
 func (r *Repository) Delete(ctx context.Context, id int) error {
-	return r.client.DB.WithContext(ctx).Delete(&models.UserModel{}, id).Error
+	return databases.TranslateError(r.client.DB.WithContext(ctx).Delete(&models.UserModel{}, id).Error)
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"regexp"
+	"softpharos/internal/core/domain/query"
 	"softpharos/internal/core/domain/user"
 	"softpharos/internal/core/repository"
 	"testing"
@@ -33,7 +34,10 @@ func TestGetAll(t *testing.T) {
 					AddRow(1, name1, "user1@example.com", "hash1", 1, now).
 					AddRow(2, name2, "user2@example.com", "hash2", 2, now)
 
-				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "user"`)).
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) FROM "user"`)).
+					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(2))
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "user" ORDER BY "id" LIMIT $1`)).
+					WithArgs(query.DefaultPageSize).
 					WillReturnRows(userRows)
 
 				roleRows := sqlmock.NewRows([]string{"id", "name", "description", "created_at"}).
@@ -50,7 +54,7 @@ func TestGetAll(t *testing.T) {
 		{
 			name: "retorna error cuando la query falla",
 			mockSetup: func(mock sqlmock.Sqlmock) {
-				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "user"`)).
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) FROM "user"`)).
 					WillReturnError(errors.New("database error"))
 			},
 			expectedLen:   0,
@@ -68,13 +72,13 @@ func TestGetAll(t *testing.T) {
 			repo := New(client)
 			ctx := context.Background()
 
-			users, err := repo.GetAll(ctx)
+			page, err := repo.GetAll(ctx, query.Params{})
 
 			if tt.expectedError {
 				assert.Error(t, err)
 			} else {
 				assert.NoError(t, err)
-				assert.Len(t, users, tt.expectedLen)
+				assert.Len(t, page.Items, tt.expectedLen)
 			}
 			assert.NoError(t, mock.ExpectationsWereMet())
 		})
@@ -0,0 +1,187 @@
+package access
+
+import (
+	"context"
+	"errors"
+
+	"softpharos/internal/core/domain/course"
+	"softpharos/internal/core/domain/identity"
+	"softpharos/internal/core/domain/project_member"
+	"softpharos/internal/core/errs"
+	"softpharos/internal/core/ports/repository"
+	"softpharos/internal/core/ports/services"
+)
+
+type Service struct {
+	projectRepo       repository.ProjectRepository
+	projectMemberRepo repository.ProjectMemberRepository
+	milestoneRepo     repository.MilestoneRepository
+	courseMemberRepo  repository.CourseMemberRepository
+}
+
+func New(
+	projectRepo repository.ProjectRepository,
+	projectMemberRepo repository.ProjectMemberRepository,
+	milestoneRepo repository.MilestoneRepository,
+	courseMemberRepo repository.CourseMemberRepository,
+) services.AccessService {
+	return &Service{
+		projectRepo:       projectRepo,
+		projectMemberRepo: projectMemberRepo,
+		milestoneRepo:     milestoneRepo,
+		courseMemberRepo:  courseMemberRepo,
+	}
+}
+
+// RequireAuthor permite el acceso al usuario authorID y a los administradores
+func (s *Service) RequireAuthor(ctx context.Context, authorID int) error {
+	id, ok := identity.FromContext(ctx)
+	if !ok {
+		return services.ErrNotAuthor
+	}
+	if id.IsAdmin() || id.UserID == authorID {
+		return nil
+	}
+
+	return services.ErrNotAuthor
+}
+
+// RequireProjectMember permite el acceso a los miembros del proyecto y a los administradores
+func (s *Service) RequireProjectMember(ctx context.Context, projectID int) error {
+	id, ok := identity.FromContext(ctx)
+	if !ok {
+		return services.ErrForbidden
+	}
+	if id.IsAdmin() {
+		return nil
+	}
+
+	if _, err := s.projectRepo.GetByID(ctx, projectID); err != nil {
+		return err
+	}
+
+	isMember, err := s.projectMemberRepo.IsMember(ctx, projectID, id.UserID)
+	if err != nil {
+		return err
+	}
+	if !isMember {
+		return services.ErrForbidden
+	}
+
+	return nil
+}
+
+// RequireProjectOwner permite el acceso solo a los miembros con rol owner y a los administradores
+func (s *Service) RequireProjectOwner(ctx context.Context, projectID int) error {
+	member, err := s.callerMembership(ctx, projectID)
+	if err != nil {
+		return err
+	}
+	if member != nil && !member.IsOwner() {
+		return services.ErrForbidden
+	}
+
+	return nil
+}
+
+// RequireProjectAbility permite el acceso a los miembros cuyo rol concede la
+// habilidad y a los administradores
+func (s *Service) RequireProjectAbility(ctx context.Context, projectID int, ability project_member.Ability) error {
+	member, err := s.callerMembership(ctx, projectID)
+	if err != nil {
+		return err
+	}
+	if member != nil && !member.Can(ability) {
+		return services.ErrForbidden
+	}
+
+	return nil
+}
+
+// RequireMilestoneMember aplica RequireProjectMember sobre el proyecto al que pertenece el milestone
+func (s *Service) RequireMilestoneMember(ctx context.Context, milestoneID int) error {
+	m, err := s.milestoneRepo.GetByID(ctx, milestoneID)
+	if err != nil {
+		return err
+	}
+
+	return s.RequireProjectMember(ctx, m.ProjectID)
+}
+
+// RequireMilestoneAbility aplica RequireProjectAbility sobre el proyecto al que pertenece el milestone
+func (s *Service) RequireMilestoneAbility(ctx context.Context, milestoneID int, ability project_member.Ability) error {
+	m, err := s.milestoneRepo.GetByID(ctx, milestoneID)
+	if err != nil {
+		return err
+	}
+
+	return s.RequireProjectAbility(ctx, m.ProjectID, ability)
+}
+
+// RequireSectionMember permite el acceso a los inscritos en la sección, sean
+// profesores o estudiantes, y a los administradores
+func (s *Service) RequireSectionMember(ctx context.Context, sectionID int) error {
+	_, err := s.callerEnrollment(ctx, sectionID)
+	return err
+}
+
+// RequireSectionProfessor permite el acceso solo a los profesores de la sección y a los administradores
+func (s *Service) RequireSectionProfessor(ctx context.Context, sectionID int) error {
+	member, err := s.callerEnrollment(ctx, sectionID)
+	if err != nil {
+		return err
+	}
+	if member != nil && !member.IsProfessor() {
+		return services.ErrSectionForbidden
+	}
+
+	return nil
+}
+
+// callerEnrollment devuelve la inscripción del usuario autenticado en la
+// sección, o nil si es administrador
+func (s *Service) callerEnrollment(ctx context.Context, sectionID int) (*course.Member, error) {
+	id, ok := identity.FromContext(ctx)
+	if !ok {
+		return nil, services.ErrSectionForbidden
+	}
+	if id.IsAdmin() {
+		return nil, nil
+	}
+
+	member, err := s.courseMemberRepo.GetBySectionAndUser(ctx, sectionID, id.UserID)
+	if errors.Is(err, errs.ErrNotFound) {
+		return nil, services.ErrSectionForbidden
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return member, nil
+}
+
+// callerMembership devuelve la membresía del usuario autenticado en el
+// proyecto, o nil si es administrador. Quien no es miembro recibe ErrForbidden.
+func (s *Service) callerMembership(ctx context.Context, projectID int) (*project_member.ProjectMember, error) {
+	id, ok := identity.FromContext(ctx)
+	if !ok {
+		return nil, services.ErrForbidden
+	}
+	if id.IsAdmin() {
+		return nil, nil
+	}
+
+	if _, err := s.projectRepo.GetByID(ctx, projectID); err != nil {
+		return nil, err
+	}
+
+	member, err := s.projectMemberRepo.GetByProjectAndUser(ctx, projectID, id.UserID)
+	if errors.Is(err, errs.ErrNotFound) {
+		return nil, services.ErrForbidden
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return member, nil
+}
@@ -0,0 +1,398 @@
+package access
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"softpharos/internal/core/domain/course"
+	"softpharos/internal/core/domain/identity"
+	"softpharos/internal/core/domain/milestone"
+	"softpharos/internal/core/domain/project"
+	"softpharos/internal/core/domain/project_member"
+	"softpharos/internal/core/domain/role"
+	"softpharos/internal/core/errs"
+	"softpharos/internal/core/ports/services"
+	mockRepo "softpharos/mocks/core/ports/repository"
+)
+
+func contextAs(userID int, roleName string) context.Context {
+	return identity.NewContext(context.Background(), &identity.Identity{UserID: userID, Role: roleName})
+}
+
+func TestRequireAuthor(t *testing.T) {
+	tests := []struct {
+		name        string
+		ctx         context.Context
+		expectedErr error
+	}{
+		{name: "permite al autor", ctx: contextAs(5, role.Student)},
+		{name: "permite a un administrador", ctx: contextAs(1, role.Admin)},
+		{name: "rechaza a otro usuario", ctx: contextAs(6, role.Professor), expectedErr: services.ErrNotAuthor},
+		{name: "rechaza sin usuario autenticado", ctx: context.Background(), expectedErr: services.ErrNotAuthor},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := New(nil, nil, nil, nil)
+
+			err := service.RequireAuthor(tt.ctx, 5)
+
+			assert.ErrorIs(t, err, tt.expectedErr)
+		})
+	}
+}
+
+func TestRequireProjectMember(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tests := []struct {
+		name        string
+		ctx         context.Context
+		mockSetup   func(*mockRepo.MockProjectRepository, *mockRepo.MockProjectMemberRepository)
+		expectedErr error
+	}{
+		{
+			name: "permite a un miembro del proyecto",
+			ctx:  contextAs(2, role.Student),
+			mockSetup: func(p *mockRepo.MockProjectRepository, pm *mockRepo.MockProjectMemberRepository) {
+				p.EXPECT().GetByID(gomock.Any(), 10).Return(&project.Project{ID: 10, CreatedBy: 1}, nil)
+				pm.EXPECT().IsMember(gomock.Any(), 10, 2).Return(true, nil)
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "rechaza a quien no es miembro",
+			ctx:  contextAs(3, role.Student),
+			mockSetup: func(p *mockRepo.MockProjectRepository, pm *mockRepo.MockProjectMemberRepository) {
+				p.EXPECT().GetByID(gomock.Any(), 10).Return(&project.Project{ID: 10, CreatedBy: 1}, nil)
+				pm.EXPECT().IsMember(gomock.Any(), 10, 3).Return(false, nil)
+			},
+			expectedErr: services.ErrForbidden,
+		},
+		{
+			name:        "permite al administrador sin consultar el proyecto",
+			ctx:         contextAs(4, role.Admin),
+			mockSetup:   func(p *mockRepo.MockProjectRepository, pm *mockRepo.MockProjectMemberRepository) {},
+			expectedErr: nil,
+		},
+		{
+			name:        "rechaza cuando no hay usuario autenticado",
+			ctx:         context.Background(),
+			mockSetup:   func(p *mockRepo.MockProjectRepository, pm *mockRepo.MockProjectMemberRepository) {},
+			expectedErr: services.ErrForbidden,
+		},
+		{
+			name: "retorna error cuando el proyecto no existe",
+			ctx:  contextAs(1, role.Student),
+			mockSetup: func(p *mockRepo.MockProjectRepository, pm *mockRepo.MockProjectMemberRepository) {
+				p.EXPECT().GetByID(gomock.Any(), 10).Return(nil, errors.New("record not found"))
+			},
+			expectedErr: errors.New("record not found"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			projectRepo := mockRepo.NewMockProjectRepository(ctrl)
+			memberRepo := mockRepo.NewMockProjectMemberRepository(ctrl)
+			tt.mockSetup(projectRepo, memberRepo)
+
+			service := New(projectRepo, memberRepo, mockRepo.NewMockMilestoneRepository(ctrl), mockRepo.NewMockCourseMemberRepository(ctrl))
+			err := service.RequireProjectMember(tt.ctx, 10)
+
+			if tt.expectedErr != nil {
+				assert.EqualError(t, err, tt.expectedErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestRequireProjectOwner(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	owner := project_member.RoleOwner
+	member := project_member.RoleMaintainer
+
+	tests := []struct {
+		name        string
+		ctx         context.Context
+		mockSetup   func(*mockRepo.MockProjectRepository, *mockRepo.MockProjectMemberRepository)
+		expectedErr error
+	}{
+		{
+			name: "permite a un miembro owner",
+			ctx:  contextAs(1, role.Student),
+			mockSetup: func(p *mockRepo.MockProjectRepository, pm *mockRepo.MockProjectMemberRepository) {
+				p.EXPECT().GetByID(gomock.Any(), 10).Return(&project.Project{ID: 10, CreatedBy: 1}, nil)
+				pm.EXPECT().GetByProjectAndUser(gomock.Any(), 10, 1).
+					Return(&project_member.ProjectMember{ProjectID: 10, UserID: 1, Role: owner}, nil)
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "rechaza a un miembro sin rol owner",
+			ctx:  contextAs(2, role.Student),
+			mockSetup: func(p *mockRepo.MockProjectRepository, pm *mockRepo.MockProjectMemberRepository) {
+				p.EXPECT().GetByID(gomock.Any(), 10).Return(&project.Project{ID: 10, CreatedBy: 1}, nil)
+				pm.EXPECT().GetByProjectAndUser(gomock.Any(), 10, 2).
+					Return(&project_member.ProjectMember{ProjectID: 10, UserID: 2, Role: member}, nil)
+			},
+			expectedErr: services.ErrForbidden,
+		},
+		{
+			name: "rechaza al creador original después de transferir la propiedad",
+			ctx:  contextAs(1, role.Student),
+			mockSetup: func(p *mockRepo.MockProjectRepository, pm *mockRepo.MockProjectMemberRepository) {
+				p.EXPECT().GetByID(gomock.Any(), 10).Return(&project.Project{ID: 10, CreatedBy: 2}, nil)
+				pm.EXPECT().GetByProjectAndUser(gomock.Any(), 10, 1).
+					Return(&project_member.ProjectMember{ProjectID: 10, UserID: 1, Role: member}, nil)
+			},
+			expectedErr: services.ErrForbidden,
+		},
+		{
+			name: "rechaza a quien no es miembro",
+			ctx:  contextAs(3, role.Student),
+			mockSetup: func(p *mockRepo.MockProjectRepository, pm *mockRepo.MockProjectMemberRepository) {
+				p.EXPECT().GetByID(gomock.Any(), 10).Return(&project.Project{ID: 10, CreatedBy: 1}, nil)
+				pm.EXPECT().GetByProjectAndUser(gomock.Any(), 10, 3).Return(nil, errs.NotFound("no encontrado"))
+			},
+			expectedErr: services.ErrForbidden,
+		},
+		{
+			name:        "permite al administrador",
+			ctx:         contextAs(4, role.Admin),
+			mockSetup:   func(p *mockRepo.MockProjectRepository, pm *mockRepo.MockProjectMemberRepository) {},
+			expectedErr: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			projectRepo := mockRepo.NewMockProjectRepository(ctrl)
+			memberRepo := mockRepo.NewMockProjectMemberRepository(ctrl)
+			tt.mockSetup(projectRepo, memberRepo)
+
+			service := New(projectRepo, memberRepo, mockRepo.NewMockMilestoneRepository(ctrl), mockRepo.NewMockCourseMemberRepository(ctrl))
+			err := service.RequireProjectOwner(tt.ctx, 10)
+
+			if tt.expectedErr != nil {
+				assert.EqualError(t, err, tt.expectedErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestRequireMilestoneMember(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	projectRepo := mockRepo.NewMockProjectRepository(ctrl)
+	memberRepo := mockRepo.NewMockProjectMemberRepository(ctrl)
+	milestoneRepo := mockRepo.NewMockMilestoneRepository(ctrl)
+
+	milestoneRepo.EXPECT().GetByID(gomock.Any(), 5).Return(&milestone.Milestone{ID: 5, ProjectID: 10}, nil)
+	projectRepo.EXPECT().GetByID(gomock.Any(), 10).Return(&project.Project{ID: 10, CreatedBy: 1}, nil)
+	memberRepo.EXPECT().IsMember(gomock.Any(), 10, 2).Return(false, nil)
+
+	service := New(projectRepo, memberRepo, milestoneRepo, mockRepo.NewMockCourseMemberRepository(ctrl))
+	err := service.RequireMilestoneMember(contextAs(2, role.Student), 5)
+
+	assert.ErrorIs(t, err, services.ErrForbidden)
+}
+
+func TestRequireProjectAbility(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tests := []struct {
+		name        string
+		ctx         context.Context
+		ability     project_member.Ability
+		mockSetup   func(*mockRepo.MockProjectRepository, *mockRepo.MockProjectMemberRepository)
+		expectedErr error
+	}{
+		{
+			name:    "permite a un maintainer editar hitos",
+			ctx:     contextAs(2, role.Student),
+			ability: project_member.AbilityEditMilestones,
+			mockSetup: func(p *mockRepo.MockProjectRepository, pm *mockRepo.MockProjectMemberRepository) {
+				p.EXPECT().GetByID(gomock.Any(), 10).Return(&project.Project{ID: 10}, nil)
+				pm.EXPECT().GetByProjectAndUser(gomock.Any(), 10, 2).
+					Return(&project_member.ProjectMember{ProjectID: 10, UserID: 2, Role: project_member.RoleMaintainer}, nil)
+			},
+			expectedErr: nil,
+		},
+		{
+			name:    "permite a un contributor subir entregables",
+			ctx:     contextAs(3, role.Student),
+			ability: project_member.AbilityUploadDeliverables,
+			mockSetup: func(p *mockRepo.MockProjectRepository, pm *mockRepo.MockProjectMemberRepository) {
+				p.EXPECT().GetByID(gomock.Any(), 10).Return(&project.Project{ID: 10}, nil)
+				pm.EXPECT().GetByProjectAndUser(gomock.Any(), 10, 3).
+					Return(&project_member.ProjectMember{ProjectID: 10, UserID: 3, Role: project_member.RoleContributor}, nil)
+			},
+			expectedErr: nil,
+		},
+		{
+			name:    "rechaza a un viewer que edita el proyecto",
+			ctx:     contextAs(4, role.Student),
+			ability: project_member.AbilityEditProject,
+			mockSetup: func(p *mockRepo.MockProjectRepository, pm *mockRepo.MockProjectMemberRepository) {
+				p.EXPECT().GetByID(gomock.Any(), 10).Return(&project.Project{ID: 10}, nil)
+				pm.EXPECT().GetByProjectAndUser(gomock.Any(), 10, 4).
+					Return(&project_member.ProjectMember{ProjectID: 10, UserID: 4, Role: project_member.RoleViewer}, nil)
+			},
+			expectedErr: services.ErrForbidden,
+		},
+		{
+			name:    "rechaza a un contributor que gestiona miembros",
+			ctx:     contextAs(3, role.Student),
+			ability: project_member.AbilityManageMembers,
+			mockSetup: func(p *mockRepo.MockProjectRepository, pm *mockRepo.MockProjectMemberRepository) {
+				p.EXPECT().GetByID(gomock.Any(), 10).Return(&project.Project{ID: 10}, nil)
+				pm.EXPECT().GetByProjectAndUser(gomock.Any(), 10, 3).
+					Return(&project_member.ProjectMember{ProjectID: 10, UserID: 3, Role: project_member.RoleContributor}, nil)
+			},
+			expectedErr: services.ErrForbidden,
+		},
+		{
+			name:    "rechaza a un viewer que sube entregables",
+			ctx:     contextAs(4, role.Student),
+			ability: project_member.AbilityUploadDeliverables,
+			mockSetup: func(p *mockRepo.MockProjectRepository, pm *mockRepo.MockProjectMemberRepository) {
+				p.EXPECT().GetByID(gomock.Any(), 10).Return(&project.Project{ID: 10}, nil)
+				pm.EXPECT().GetByProjectAndUser(gomock.Any(), 10, 4).
+					Return(&project_member.ProjectMember{ProjectID: 10, UserID: 4, Role: project_member.RoleViewer}, nil)
+			},
+			expectedErr: services.ErrForbidden,
+		},
+		{
+			name:    "rechaza a quien no es miembro",
+			ctx:     contextAs(5, role.Student),
+			ability: project_member.AbilityUploadDeliverables,
+			mockSetup: func(p *mockRepo.MockProjectRepository, pm *mockRepo.MockProjectMemberRepository) {
+				p.EXPECT().GetByID(gomock.Any(), 10).Return(&project.Project{ID: 10}, nil)
+				pm.EXPECT().GetByProjectAndUser(gomock.Any(), 10, 5).Return(nil, errs.NotFound("no encontrado"))
+			},
+			expectedErr: services.ErrForbidden,
+		},
+		{
+			name:        "permite al administrador",
+			ctx:         contextAs(1, role.Admin),
+			ability:     project_member.AbilityManageMembers,
+			mockSetup:   func(p *mockRepo.MockProjectRepository, pm *mockRepo.MockProjectMemberRepository) {},
+			expectedErr: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			projectRepo := mockRepo.NewMockProjectRepository(ctrl)
+			memberRepo := mockRepo.NewMockProjectMemberRepository(ctrl)
+			tt.mockSetup(projectRepo, memberRepo)
+
+			service := New(projectRepo, memberRepo, mockRepo.NewMockMilestoneRepository(ctrl), mockRepo.NewMockCourseMemberRepository(ctrl))
+			err := service.RequireProjectAbility(tt.ctx, 10, tt.ability)
+
+			if tt.expectedErr != nil {
+				assert.EqualError(t, err, tt.expectedErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestRequireMilestoneAbility(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	projectRepo := mockRepo.NewMockProjectRepository(ctrl)
+	memberRepo := mockRepo.NewMockProjectMemberRepository(ctrl)
+	milestoneRepo := mockRepo.NewMockMilestoneRepository(ctrl)
+
+	milestoneRepo.EXPECT().GetByID(gomock.Any(), 5).Return(&milestone.Milestone{ID: 5, ProjectID: 10}, nil)
+	projectRepo.EXPECT().GetByID(gomock.Any(), 10).Return(&project.Project{ID: 10}, nil)
+	memberRepo.EXPECT().GetByProjectAndUser(gomock.Any(), 10, 2).
+		Return(&project_member.ProjectMember{ProjectID: 10, UserID: 2, Role: project_member.RoleContributor}, nil)
+
+	service := New(projectRepo, memberRepo, milestoneRepo, mockRepo.NewMockCourseMemberRepository(ctrl))
+	err := service.RequireMilestoneAbility(contextAs(2, role.Student), 5, project_member.AbilityEditMilestones)
+
+	assert.ErrorIs(t, err, services.ErrForbidden)
+}
+
+func TestRequireSectionProfessor(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tests := []struct {
+		name        string
+		ctx         context.Context
+		mockSetup   func(*mockRepo.MockCourseMemberRepository)
+		expectedErr error
+	}{
+		{
+			name: "permite al profesor de la sección",
+			ctx:  contextAs(2, role.Professor),
+			mockSetup: func(cm *mockRepo.MockCourseMemberRepository) {
+				cm.EXPECT().GetBySectionAndUser(gomock.Any(), 5, 2).Return(&course.Member{SectionID: 5, UserID: 2, Role: course.RoleProfessor}, nil)
+			},
+		},
+		{
+			name: "rechaza al estudiante de la sección",
+			ctx:  contextAs(3, role.Student),
+			mockSetup: func(cm *mockRepo.MockCourseMemberRepository) {
+				cm.EXPECT().GetBySectionAndUser(gomock.Any(), 5, 3).Return(&course.Member{SectionID: 5, UserID: 3, Role: course.RoleStudent}, nil)
+			},
+			expectedErr: services.ErrSectionForbidden,
+		},
+		{
+			name: "rechaza a quien no está inscrito",
+			ctx:  contextAs(4, role.Professor),
+			mockSetup: func(cm *mockRepo.MockCourseMemberRepository) {
+				cm.EXPECT().GetBySectionAndUser(gomock.Any(), 5, 4).Return(nil, errs.NotFound("no encontrado"))
+			},
+			expectedErr: services.ErrSectionForbidden,
+		},
+		{
+			name:      "permite al administrador sin consultar la sección",
+			ctx:       contextAs(1, role.Admin),
+			mockSetup: func(cm *mockRepo.MockCourseMemberRepository) {},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			courseMemberRepo := mockRepo.NewMockCourseMemberRepository(ctrl)
+			tt.mockSetup(courseMemberRepo)
+
+			service := New(mockRepo.NewMockProjectRepository(ctrl), mockRepo.NewMockProjectMemberRepository(ctrl), mockRepo.NewMockMilestoneRepository(ctrl), courseMemberRepo)
+			err := service.RequireSectionProfessor(tt.ctx, 5)
+
+			assert.Equal(t, tt.expectedErr, err)
+		})
+	}
+}
+
+func TestRequireSectionMember(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	courseMemberRepo := mockRepo.NewMockCourseMemberRepository(ctrl)
+	courseMemberRepo.EXPECT().GetBySectionAndUser(gomock.Any(), 5, 3).Return(&course.Member{SectionID: 5, UserID: 3, Role: course.RoleStudent}, nil)
+
+	service := New(mockRepo.NewMockProjectRepository(ctrl), mockRepo.NewMockProjectMemberRepository(ctrl), mockRepo.NewMockMilestoneRepository(ctrl), courseMemberRepo)
+
+	assert.NoError(t, service.RequireSectionMember(contextAs(3, role.Student), 5))
+}
@@ -3,62 +3,112 @@ package auth
 import (
 	"context"
 	"errors"
-
-	"gorm.io/gorm"
+	"fmt"
+	"strings"
+	"time"
 
 	"softpharos/internal/auth"
+	"softpharos/internal/core/domain/identity"
+	"softpharos/internal/core/domain/invitation"
+	"softpharos/internal/core/domain/role"
+	"softpharos/internal/core/domain/session"
 	"softpharos/internal/core/domain/user"
+	"softpharos/internal/core/errs"
+	"softpharos/internal/core/ports/providers"
 	"softpharos/internal/core/ports/repository"
 	"softpharos/internal/core/ports/services"
 )
 
 type Service struct {
-	userRepo repository.UserRepository
-	roleRepo repository.RoleRepository
+	identityProvider providers.IdentityProvider
+	signupRules      services.SignupRuleService
+	userRepo         repository.UserRepository
+	roleRepo         repository.RoleRepository
+	refreshTokenRepo repository.RefreshTokenRepository
+	revokedTokenRepo repository.RevokedTokenRepository
+	unitOfWork       repository.UnitOfWork
 }
 
-func New(userRepo repository.UserRepository, roleRepo repository.RoleRepository) services.AuthService {
+func New(
+	identityProvider providers.IdentityProvider,
+	signupRules services.SignupRuleService,
+	userRepo repository.UserRepository,
+	roleRepo repository.RoleRepository,
+	refreshTokenRepo repository.RefreshTokenRepository,
+	revokedTokenRepo repository.RevokedTokenRepository,
+	unitOfWork repository.UnitOfWork,
+) services.AuthService {
 	return &Service{
-		userRepo: userRepo,
-		roleRepo: roleRepo,
+		identityProvider: identityProvider,
+		signupRules:      signupRules,
+		userRepo:         userRepo,
+		roleRepo:         roleRepo,
+		refreshTokenRepo: refreshTokenRepo,
+		revokedTokenRepo: revokedTokenRepo,
+		unitOfWork:       unitOfWork,
 	}
 }
 
-func (s *Service) AuthenticateWithGoogle(ctx context.Context, idToken string) (*user.User, string, error) {
-	tokenInfo, err := auth.VerifyGoogleToken(ctx, idToken)
+func (s *Service) Authenticate(ctx context.Context, idToken string) (*user.User, *session.Tokens, error) {
+	tokenInfo, err := s.identityProvider.Verify(ctx, idToken)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: %v", services.ErrInvalidIDToken, err)
+	}
+
+	if !tokenInfo.EmailVerified {
+		return nil, nil, fmt.Errorf("%w: el email no está verificado por el proveedor de identidad", services.ErrInvalidIDToken)
+	}
+
+	rule, err := s.signupRules.MatchAccount(ctx, tokenInfo)
 	if err != nil {
-		return nil, "", err
+		return nil, nil, err
 	}
 
-	existingUser, err := s.userRepo.GetByProviderID(ctx, tokenInfo.Sub)
+	existingUser, err := s.userRepo.GetByProviderID(ctx, tokenInfo.Subject)
 
-	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
-		return nil, "", err
+	if err != nil && !errors.Is(err, errs.ErrNotFound) {
+		return nil, nil, err
 	}
 
 	var domainUser *user.User
 
-	if errors.Is(err, gorm.ErrRecordNotFound) {
-		studentRole, err := s.roleRepo.GetByName(ctx, "student")
-		if err != nil {
-			return nil, "", err
+	if errors.Is(err, errs.ErrNotFound) {
+		// Sin una regla que aplique, las cuentas nuevas son estudiantes
+		var roleID int
+		if rule != nil {
+			roleID = rule.RoleID
+		} else {
+			studentRole, err := s.roleRepo.GetByName(ctx, role.Student)
+			if err != nil {
+				return nil, nil, err
+			}
+			roleID = studentRole.ID
 		}
 
 		domainUser = &user.User{
 			Name:       &tokenInfo.Name,
 			Email:      tokenInfo.Email,
-			ProviderID: tokenInfo.Sub,
-			RoleID:     studentRole.ID,
+			ProviderID: tokenInfo.Subject,
+			RoleID:     roleID,
 			PictureURL: &tokenInfo.Picture,
 		}
 
-		if err := s.userRepo.Create(ctx, domainUser); err != nil {
-			return nil, "", err
-		}
-
-		domainUser, err = s.userRepo.GetByID(ctx, domainUser.ID)
+		// Se relee dentro de la misma transacción para devolver el usuario con
+		// su rol. Las invitaciones pendientes para su email se aceptan al
+		// crear la cuenta.
+		err = s.unitOfWork.Do(ctx, func(repos repository.Repositories) error {
+			if err := repos.Users.Create(ctx, domainUser); err != nil {
+				return err
+			}
+			created, err := repos.Users.GetByID(ctx, domainUser.ID)
+			if err != nil {
+				return err
+			}
+			domainUser = created
+			return acceptPendingInvitations(ctx, repos, created)
+		})
 		if err != nil {
-			return nil, "", err
+			return nil, nil, err
 		}
 	} else {
 		domainUser = existingUser
@@ -78,10 +128,161 @@ func (s *Service) AuthenticateWithGoogle(ctx context.Context, idToken string) (*
 		}
 	}
 
-	accessToken, err := auth.GenerateJWT(domainUser.ID, domainUser.Email, domainUser.RoleID)
+	tokens, err := s.issueTokens(ctx, domainUser)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return domainUser, tokens, nil
+}
+
+func (s *Service) RefreshSession(ctx context.Context, refreshToken string) (*user.User, *session.Tokens, error) {
+	stored, err := s.refreshTokenRepo.GetByTokenHash(ctx, auth.HashRefreshToken(refreshToken))
+	if errors.Is(err, errs.ErrNotFound) {
+		return nil, nil, services.ErrInvalidRefreshToken
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Un refresh token ya rotado que vuelve a usarse indica que fue filtrado:
+	// se revocan todas las sesiones del usuario
+	if stored.RevokedAt != nil {
+		if err := s.refreshTokenRepo.RevokeAllByUserID(ctx, stored.UserID); err != nil {
+			return nil, nil, err
+		}
+		return nil, nil, services.ErrInvalidRefreshToken
+	}
+
+	if !stored.IsActive(time.Now()) {
+		return nil, nil, services.ErrInvalidRefreshToken
+	}
+
+	rotated, err := s.refreshTokenRepo.Revoke(ctx, stored.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !rotated {
+		return nil, nil, services.ErrInvalidRefreshToken
+	}
+
+	domainUser, err := s.userRepo.GetByID(ctx, stored.UserID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tokens, err := s.issueTokens(ctx, domainUser)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return domainUser, tokens, nil
+}
+
+func (s *Service) Logout(ctx context.Context, refreshToken string) error {
+	id, ok := identity.FromContext(ctx)
+	if !ok {
+		return errs.Unauthorized("usuario no autenticado")
+	}
+
+	if refreshToken != "" {
+		stored, err := s.refreshTokenRepo.GetByTokenHash(ctx, auth.HashRefreshToken(refreshToken))
+		if err != nil && !errors.Is(err, errs.ErrNotFound) {
+			return err
+		}
+		// Solo se revocan refresh tokens del propio usuario
+		if err == nil && stored.UserID == id.UserID {
+			if _, err := s.refreshTokenRepo.Revoke(ctx, stored.ID); err != nil {
+				return err
+			}
+		}
+	}
+
+	if id.TokenID == "" {
+		return nil
+	}
+
+	return s.revokedTokenRepo.Create(ctx, &session.RevokedToken{
+		JTI:       id.TokenID,
+		ExpiresAt: id.TokenExpiresAt,
+		RevokedAt: time.Now(),
+	})
+}
+
+func (s *Service) PurgeExpiredTokens(ctx context.Context) (int64, error) {
+	// expires_at se guarda con la hora local del servidor, igual que aquí
+	now := time.Now()
+
+	refreshTokens, err := s.refreshTokenRepo.DeleteExpired(ctx, now)
 	if err != nil {
-		return nil, "", err
+		return 0, err
+	}
+
+	revokedTokens, err := s.revokedTokenRepo.DeleteExpired(ctx, now)
+	if err != nil {
+		return refreshTokens, err
+	}
+
+	return refreshTokens + revokedTokens, nil
+}
+
+// acceptPendingInvitations convierte en membresías las invitaciones vigentes
+// dirigidas al email del usuario
+func acceptPendingInvitations(ctx context.Context, repos repository.Repositories, u *user.User) error {
+	now := time.Now()
+	pending, err := repos.Invitations.GetPendingByEmail(ctx, strings.ToLower(u.Email), now)
+	if err != nil {
+		return err
+	}
+
+	for _, inv := range pending {
+		accepted, err := repos.Invitations.Respond(ctx, inv.ID, invitation.StatusAccepted, now)
+		if err != nil {
+			return err
+		}
+		if !accepted {
+			continue
+		}
+		if err := repos.ProjectMembers.Create(ctx, inv.Member(u.ID)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// issueTokens emite un access token y un refresh token nuevo para el usuario
+func (s *Service) issueTokens(ctx context.Context, domainUser *user.User) (*session.Tokens, error) {
+	if domainUser.Role == nil {
+		var err error
+		domainUser.Role, err = s.roleRepo.GetByID(ctx, domainUser.RoleID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	accessToken, err := auth.GenerateJWT(domainUser.ID, domainUser.Email, domainUser.RoleID, domainUser.Role.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	refreshToken, err := auth.GenerateRefreshToken()
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	if err := s.refreshTokenRepo.Create(ctx, &session.RefreshToken{
+		UserID:    domainUser.ID,
+		TokenHash: auth.HashRefreshToken(refreshToken),
+		ExpiresAt: now.Add(auth.RefreshTokenTTL),
+	}); err != nil {
+		return nil, err
 	}
 
-	return domainUser, accessToken, nil
+	return &session.Tokens{
+		AccessToken:  accessToken,
+		RefreshToken: refreshToken,
+		ExpiresAt:    now.Add(auth.AccessTokenTTL),
+	}, nil
 }
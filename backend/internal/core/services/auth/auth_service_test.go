@@ -1,28 +1,439 @@
 package auth
 
 import (
+	"context"
+	"errors"
+	"os"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
 
+	"softpharos/internal/auth"
+	"softpharos/internal/core/domain/identity"
+	"softpharos/internal/core/domain/invitation"
+	"softpharos/internal/core/domain/project_member"
+	"softpharos/internal/core/domain/role"
+	"softpharos/internal/core/domain/session"
+	"softpharos/internal/core/domain/signup_rule"
+	"softpharos/internal/core/domain/user"
+	"softpharos/internal/core/errs"
+	"softpharos/internal/core/ports/repository"
+	"softpharos/internal/core/ports/services"
+	mockProvider "softpharos/mocks/core/ports/providers"
 	mockRepo "softpharos/mocks/core/ports/repository"
+	mockService "softpharos/mocks/core/ports/services"
 )
 
+type mocks struct {
+	provider     *mockProvider.MockIdentityProvider
+	signupRules  *mockService.MockSignupRuleService
+	user         *mockRepo.MockUserRepository
+	role         *mockRepo.MockRoleRepository
+	refreshToken *mockRepo.MockRefreshTokenRepository
+	revokedToken *mockRepo.MockRevokedTokenRepository
+	invitation   *mockRepo.MockInvitationRepository
+	member       *mockRepo.MockProjectMemberRepository
+	unitOfWork   *mockRepo.MockUnitOfWork
+}
+
+func newTestService(ctrl *gomock.Controller) (services.AuthService, mocks) {
+	m := mocks{
+		provider:     mockProvider.NewMockIdentityProvider(ctrl),
+		signupRules:  mockService.NewMockSignupRuleService(ctrl),
+		user:         mockRepo.NewMockUserRepository(ctrl),
+		role:         mockRepo.NewMockRoleRepository(ctrl),
+		refreshToken: mockRepo.NewMockRefreshTokenRepository(ctrl),
+		revokedToken: mockRepo.NewMockRevokedTokenRepository(ctrl),
+		invitation:   mockRepo.NewMockInvitationRepository(ctrl),
+		member:       mockRepo.NewMockProjectMemberRepository(ctrl),
+		unitOfWork:   mockRepo.NewMockUnitOfWork(ctrl),
+	}
+	// La transacción usa el mismo mock de usuarios que el resto del servicio
+	m.unitOfWork.EXPECT().
+		Do(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, fn func(repository.Repositories) error) error {
+			return fn(repository.Repositories{Users: m.user, Invitations: m.invitation, ProjectMembers: m.member})
+		}).
+		AnyTimes()
+	return New(m.provider, m.signupRules, m.user, m.role, m.refreshToken, m.revokedToken, m.unitOfWork), m
+}
+
 func TestNew(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
-	mockUserRepo := mockRepo.NewMockUserRepository(ctrl)
-	mockRoleRepo := mockRepo.NewMockRoleRepository(ctrl)
-
-	service := New(mockUserRepo, mockRoleRepo)
+	service, _ := newTestService(ctrl)
 
 	assert.NotNil(t, service)
 
 	// Verificar que el service tiene los repositorios correctos
 	svc, ok := service.(*Service)
 	assert.True(t, ok)
+	assert.NotNil(t, svc.identityProvider)
+	assert.NotNil(t, svc.signupRules)
 	assert.NotNil(t, svc.userRepo)
 	assert.NotNil(t, svc.roleRepo)
+	assert.NotNil(t, svc.refreshTokenRepo)
+	assert.NotNil(t, svc.revokedTokenRepo)
+	assert.NotNil(t, svc.unitOfWork)
+}
+
+func TestAuthenticate(t *testing.T) {
+	os.Setenv("JWT_SECRET", "test-secret")
+	defer os.Unsetenv("JWT_SECRET")
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	verified := &identity.ProviderIdentity{Subject: "google-123", Email: "test@unal.edu.co", EmailVerified: true, Name: "John Doe"}
+	studentRole := &role.Role{ID: 3, Name: role.Student}
+
+	tests := []struct {
+		name        string
+		mockSetup   func(m mocks)
+		expectedErr error
+	}{
+		{
+			name: "registra al usuario en su primer inicio de sesión",
+			mockSetup: func(m mocks) {
+				m.provider.EXPECT().Verify(gomock.Any(), "id-token").Return(verified, nil)
+				m.signupRules.EXPECT().MatchAccount(gomock.Any(), verified).Return(nil, nil)
+				m.user.EXPECT().GetByProviderID(gomock.Any(), "google-123").Return(nil, errs.NotFound("no encontrado"))
+				m.role.EXPECT().GetByName(gomock.Any(), role.Student).Return(studentRole, nil)
+				m.user.EXPECT().Create(gomock.Any(), gomock.Cond(func(x *user.User) bool {
+					return x.ProviderID == "google-123" && x.Email == "test@unal.edu.co" && x.RoleID == 3
+				})).DoAndReturn(func(ctx context.Context, u *user.User) error {
+					u.ID = 1
+					return nil
+				})
+				m.user.EXPECT().GetByID(gomock.Any(), 1).Return(&user.User{ID: 1, Email: "test@unal.edu.co", RoleID: 3, Role: studentRole}, nil)
+				m.invitation.EXPECT().GetPendingByEmail(gomock.Any(), "test@unal.edu.co", gomock.Any()).Return(nil, nil)
+				m.refreshToken.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+			},
+		},
+		{
+			name: "acepta las invitaciones pendientes al crear la cuenta",
+			mockSetup: func(m mocks) {
+				m.provider.EXPECT().Verify(gomock.Any(), "id-token").Return(verified, nil)
+				m.signupRules.EXPECT().MatchAccount(gomock.Any(), verified).Return(nil, nil)
+				m.user.EXPECT().GetByProviderID(gomock.Any(), "google-123").Return(nil, errs.NotFound("no encontrado"))
+				m.role.EXPECT().GetByName(gomock.Any(), role.Student).Return(studentRole, nil)
+				m.user.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, u *user.User) error {
+					u.ID = 1
+					return nil
+				})
+				m.user.EXPECT().GetByID(gomock.Any(), 1).Return(&user.User{ID: 1, Email: "Test@unal.edu.co", RoleID: 3, Role: studentRole}, nil)
+				m.invitation.EXPECT().GetPendingByEmail(gomock.Any(), "test@unal.edu.co", gomock.Any()).
+					Return([]invitation.Invitation{{ID: 5, ProjectID: 10, Role: project_member.RoleContributor}, {ID: 6, ProjectID: 11, Role: project_member.RoleContributor}}, nil)
+				m.invitation.EXPECT().Respond(gomock.Any(), 5, invitation.StatusAccepted, gomock.Any()).Return(true, nil)
+				m.member.EXPECT().Create(gomock.Any(), gomock.Cond(func(x *project_member.ProjectMember) bool {
+					return x.ProjectID == 10 && x.UserID == 1 && x.Role == project_member.RoleContributor
+				})).Return(nil)
+				// Otra petición ya respondió esta invitación
+				m.invitation.EXPECT().Respond(gomock.Any(), 6, invitation.StatusAccepted, gomock.Any()).Return(false, nil)
+				m.refreshToken.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+			},
+		},
+		{
+			name: "retorna error cuando falla la relectura del usuario nuevo",
+			mockSetup: func(m mocks) {
+				m.provider.EXPECT().Verify(gomock.Any(), "id-token").Return(verified, nil)
+				m.signupRules.EXPECT().MatchAccount(gomock.Any(), verified).Return(nil, nil)
+				m.user.EXPECT().GetByProviderID(gomock.Any(), "google-123").Return(nil, errs.NotFound("no encontrado"))
+				m.role.EXPECT().GetByName(gomock.Any(), role.Student).Return(studentRole, nil)
+				m.user.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, u *user.User) error {
+					u.ID = 1
+					return nil
+				})
+				m.user.EXPECT().GetByID(gomock.Any(), 1).Return(nil, errors.New("database error"))
+			},
+			expectedErr: errors.New("database error"),
+		},
+		{
+			name: "inicia sesión con un usuario existente",
+			mockSetup: func(m mocks) {
+				name := "John Doe"
+				m.provider.EXPECT().Verify(gomock.Any(), "id-token").Return(verified, nil)
+				m.signupRules.EXPECT().MatchAccount(gomock.Any(), verified).Return(nil, nil)
+				m.user.EXPECT().GetByProviderID(gomock.Any(), "google-123").
+					Return(&user.User{ID: 1, Name: &name, Email: "test@unal.edu.co", RoleID: 3, Role: studentRole}, nil)
+				m.refreshToken.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+			},
+		},
+		{
+			name: "asigna el rol de la regla de registro que aplica",
+			mockSetup: func(m mocks) {
+				professorRole := &role.Role{ID: 2, Name: role.Professor}
+				m.provider.EXPECT().Verify(gomock.Any(), "id-token").Return(verified, nil)
+				m.signupRules.EXPECT().MatchAccount(gomock.Any(), verified).
+					Return(&signup_rule.SignupRule{ID: 1, Pattern: "unal.edu.co", RoleID: 2}, nil)
+				m.user.EXPECT().GetByProviderID(gomock.Any(), "google-123").Return(nil, errs.NotFound("no encontrado"))
+				m.user.EXPECT().Create(gomock.Any(), gomock.Cond(func(x *user.User) bool {
+					return x.RoleID == 2
+				})).DoAndReturn(func(ctx context.Context, u *user.User) error {
+					u.ID = 1
+					return nil
+				})
+				m.user.EXPECT().GetByID(gomock.Any(), 1).Return(&user.User{ID: 1, Email: "test@unal.edu.co", RoleID: 2, Role: professorRole}, nil)
+				m.invitation.EXPECT().GetPendingByEmail(gomock.Any(), "test@unal.edu.co", gomock.Any()).Return(nil, nil)
+				m.refreshToken.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+			},
+		},
+		{
+			name: "retorna error cuando el dominio no está permitido",
+			mockSetup: func(m mocks) {
+				m.provider.EXPECT().Verify(gomock.Any(), "id-token").Return(verified, nil)
+				m.signupRules.EXPECT().MatchAccount(gomock.Any(), verified).Return(nil, services.ErrDomainNotAllowed)
+			},
+			expectedErr: services.ErrDomainNotAllowed,
+		},
+		{
+			name: "retorna error cuando el proveedor rechaza el token",
+			mockSetup: func(m mocks) {
+				m.provider.EXPECT().Verify(gomock.Any(), "id-token").Return(nil, errors.New("token inválido"))
+			},
+			expectedErr: errors.New("ID token inválido: token inválido"),
+		},
+		{
+			name: "retorna error cuando el email no está verificado",
+			mockSetup: func(m mocks) {
+				m.provider.EXPECT().Verify(gomock.Any(), "id-token").
+					Return(&identity.ProviderIdentity{Subject: "google-123", Email: "test@unal.edu.co"}, nil)
+			},
+			expectedErr: errors.New("ID token inválido: el email no está verificado por el proveedor de identidad"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, m := newTestService(ctrl)
+			tt.mockSetup(m)
+
+			result, tokens, err := service.Authenticate(context.Background(), "id-token")
+
+			if tt.expectedErr != nil {
+				assert.EqualError(t, err, tt.expectedErr.Error())
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, 1, result.ID)
+				assert.NotEmpty(t, tokens.AccessToken)
+				assert.NotEmpty(t, tokens.RefreshToken)
+			}
+		})
+	}
+}
+
+func TestRefreshSession(t *testing.T) {
+	os.Setenv("JWT_SECRET", "test-secret")
+	defer os.Unsetenv("JWT_SECRET")
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	hash := auth.HashRefreshToken("refresh-123")
+	revokedAt := time.Now().Add(-time.Minute)
+	student := &user.User{ID: 1, Email: "test@unal.edu.co", RoleID: 3, Role: &role.Role{ID: 3, Name: role.Student}}
+
+	tests := []struct {
+		name        string
+		mockSetup   func(m mocks)
+		expectedErr error
+	}{
+		{
+			name: "rota el refresh token y emite nuevas credenciales",
+			mockSetup: func(m mocks) {
+				m.refreshToken.EXPECT().GetByTokenHash(gomock.Any(), hash).
+					Return(&session.RefreshToken{ID: 7, UserID: 1, ExpiresAt: time.Now().Add(time.Hour)}, nil)
+				m.refreshToken.EXPECT().Revoke(gomock.Any(), 7).Return(true, nil)
+				m.user.EXPECT().GetByID(gomock.Any(), 1).Return(student, nil)
+				m.refreshToken.EXPECT().Create(gomock.Any(), gomock.Cond(func(x *session.RefreshToken) bool {
+					return x.UserID == 1 && x.TokenHash != hash
+				})).Return(nil)
+			},
+		},
+		{
+			name: "retorna error cuando el refresh token no existe",
+			mockSetup: func(m mocks) {
+				m.refreshToken.EXPECT().GetByTokenHash(gomock.Any(), hash).Return(nil, errs.NotFound("no encontrado"))
+			},
+			expectedErr: services.ErrInvalidRefreshToken,
+		},
+		{
+			name: "retorna error cuando el refresh token expiró",
+			mockSetup: func(m mocks) {
+				m.refreshToken.EXPECT().GetByTokenHash(gomock.Any(), hash).
+					Return(&session.RefreshToken{ID: 7, UserID: 1, ExpiresAt: time.Now().Add(-time.Hour)}, nil)
+			},
+			expectedErr: services.ErrInvalidRefreshToken,
+		},
+		{
+			name: "revoca todas las sesiones cuando se reutiliza un token rotado",
+			mockSetup: func(m mocks) {
+				m.refreshToken.EXPECT().GetByTokenHash(gomock.Any(), hash).
+					Return(&session.RefreshToken{ID: 7, UserID: 1, ExpiresAt: time.Now().Add(time.Hour), RevokedAt: &revokedAt}, nil)
+				m.refreshToken.EXPECT().RevokeAllByUserID(gomock.Any(), 1).Return(nil)
+			},
+			expectedErr: services.ErrInvalidRefreshToken,
+		},
+		{
+			name: "retorna error cuando otra petición ya rotó el token",
+			mockSetup: func(m mocks) {
+				m.refreshToken.EXPECT().GetByTokenHash(gomock.Any(), hash).
+					Return(&session.RefreshToken{ID: 7, UserID: 1, ExpiresAt: time.Now().Add(time.Hour)}, nil)
+				m.refreshToken.EXPECT().Revoke(gomock.Any(), 7).Return(false, nil)
+			},
+			expectedErr: services.ErrInvalidRefreshToken,
+		},
+		{
+			name: "retorna error cuando falla la base de datos",
+			mockSetup: func(m mocks) {
+				m.refreshToken.EXPECT().GetByTokenHash(gomock.Any(), hash).Return(nil, errors.New("database error"))
+			},
+			expectedErr: errors.New("database error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, m := newTestService(ctrl)
+			tt.mockSetup(m)
+
+			result, tokens, err := service.RefreshSession(context.Background(), "refresh-123")
+
+			if tt.expectedErr != nil {
+				assert.EqualError(t, err, tt.expectedErr.Error())
+				assert.Nil(t, result)
+				assert.Nil(t, tokens)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, student, result)
+				assert.NotEmpty(t, tokens.AccessToken)
+				assert.NotEmpty(t, tokens.RefreshToken)
+				assert.NotEqual(t, "refresh-123", tokens.RefreshToken)
+			}
+		})
+	}
+}
+
+func TestLogout(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	hash := auth.HashRefreshToken("refresh-123")
+	expiresAt := time.Now().Add(10 * time.Minute)
+	ctx := identity.NewContext(context.Background(), &identity.Identity{UserID: 1, TokenID: "jti-1", TokenExpiresAt: expiresAt})
+
+	tests := []struct {
+		name         string
+		ctx          context.Context
+		refreshToken string
+		mockSetup    func(m mocks)
+		expectedErr  error
+	}{
+		{
+			name:         "revoca el access token y el refresh token",
+			ctx:          ctx,
+			refreshToken: "refresh-123",
+			mockSetup: func(m mocks) {
+				m.refreshToken.EXPECT().GetByTokenHash(gomock.Any(), hash).Return(&session.RefreshToken{ID: 7, UserID: 1}, nil)
+				m.refreshToken.EXPECT().Revoke(gomock.Any(), 7).Return(true, nil)
+				m.revokedToken.EXPECT().Create(gomock.Any(), gomock.Cond(func(x *session.RevokedToken) bool {
+					return x.JTI == "jti-1" && x.ExpiresAt.Equal(expiresAt)
+				})).Return(nil)
+			},
+		},
+		{
+			name: "revoca solo el access token sin refresh token",
+			ctx:  ctx,
+			mockSetup: func(m mocks) {
+				m.revokedToken.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+			},
+		},
+		{
+			name:         "no revoca refresh tokens de otro usuario",
+			ctx:          ctx,
+			refreshToken: "refresh-123",
+			mockSetup: func(m mocks) {
+				m.refreshToken.EXPECT().GetByTokenHash(gomock.Any(), hash).Return(&session.RefreshToken{ID: 7, UserID: 2}, nil)
+				m.revokedToken.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+			},
+		},
+		{
+			name:        "rechaza cuando no hay usuario autenticado",
+			ctx:         context.Background(),
+			mockSetup:   func(m mocks) {},
+			expectedErr: errs.ErrUnauthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, m := newTestService(ctrl)
+			tt.mockSetup(m)
+
+			err := service.Logout(tt.ctx, tt.refreshToken)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestPurgeExpiredTokens(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tests := []struct {
+		name        string
+		mockSetup   func(m mocks)
+		expected    int64
+		expectedErr error
+	}{
+		{
+			name: "elimina refresh tokens y access tokens revocados expirados",
+			mockSetup: func(m mocks) {
+				m.refreshToken.EXPECT().DeleteExpired(gomock.Any(), gomock.Any()).Return(int64(2), nil)
+				m.revokedToken.EXPECT().DeleteExpired(gomock.Any(), gomock.Any()).Return(int64(3), nil)
+			},
+			expected: 5,
+		},
+		{
+			name: "retorna error cuando falla la purga de refresh tokens",
+			mockSetup: func(m mocks) {
+				m.refreshToken.EXPECT().DeleteExpired(gomock.Any(), gomock.Any()).Return(int64(0), errors.New("database error"))
+			},
+			expectedErr: errors.New("database error"),
+		},
+		{
+			name: "retorna error cuando falla la purga de tokens revocados",
+			mockSetup: func(m mocks) {
+				m.refreshToken.EXPECT().DeleteExpired(gomock.Any(), gomock.Any()).Return(int64(2), nil)
+				m.revokedToken.EXPECT().DeleteExpired(gomock.Any(), gomock.Any()).Return(int64(0), errors.New("database error"))
+			},
+			expected:    2,
+			expectedErr: errors.New("database error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, m := newTestService(ctrl)
+			tt.mockSetup(m)
+
+			purged, err := service.PurgeExpiredTokens(context.Background())
+
+			if tt.expectedErr != nil {
+				assert.EqualError(t, err, tt.expectedErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.expected, purged)
+		})
+	}
 }
@@ -2,23 +2,28 @@ package comment
 
 import (
 	"context"
+	"slices"
 	"softpharos/internal/core/domain/comment"
+	"softpharos/internal/core/domain/identity"
+	"softpharos/internal/core/domain/query"
 	"softpharos/internal/core/ports/repository"
 	"softpharos/internal/core/ports/services"
 )
 
 type Service struct {
-	commentRepo repository.CommentRepository
+	commentRepo   repository.CommentRepository
+	accessService services.AccessService
 }
 
-func New(commentRepo repository.CommentRepository) services.CommentService {
+func New(commentRepo repository.CommentRepository, accessService services.AccessService) services.CommentService {
 	return &Service{
-		commentRepo: commentRepo,
+		commentRepo:   commentRepo,
+		accessService: accessService,
 	}
 }
 
-func (s *Service) GetAllComments(ctx context.Context) ([]comment.Comment, error) {
-	return s.commentRepo.GetAll(ctx)
+func (s *Service) GetAllComments(ctx context.Context, params query.Params) (*query.Page[comment.Comment], error) {
+	return s.commentRepo.GetAll(ctx, params)
 }
 
 func (s *Service) GetCommentByID(ctx context.Context, id int) (*comment.Comment, error) {
@@ -33,10 +38,63 @@ func (s *Service) CreateComment(ctx context.Context, c *comment.Comment) error {
 	return s.commentRepo.Create(ctx, c)
 }
 
+// UpdateComment solo permite editar al autor del comentario o a un administrador
 func (s *Service) UpdateComment(ctx context.Context, c *comment.Comment) error {
+	existing, err := s.requireAuthor(ctx, c.ID)
+	if err != nil {
+		return err
+	}
+
+	c.UserID = existing.UserID
 	return s.commentRepo.Update(ctx, c)
 }
 
 func (s *Service) DeleteComment(ctx context.Context, id int) error {
+	if _, err := s.requireAuthor(ctx, id); err != nil {
+		return err
+	}
 	return s.commentRepo.Delete(ctx, id)
 }
+
+// GetTrashedCommentsByMilestoneID lista los comentarios borrados del hito: los
+// propios, o todos para un administrador
+func (s *Service) GetTrashedCommentsByMilestoneID(ctx context.Context, milestoneID int) ([]comment.Comment, error) {
+	id, ok := identity.FromContext(ctx)
+	if !ok {
+		return nil, services.ErrForbidden
+	}
+
+	comments, err := s.commentRepo.GetTrashedByMilestoneID(ctx, milestoneID)
+	if err != nil {
+		return nil, err
+	}
+	if id.IsAdmin() {
+		return comments, nil
+	}
+	return slices.DeleteFunc(comments, func(c comment.Comment) bool { return c.UserID != id.UserID }), nil
+}
+
+// RestoreComment saca un comentario de la papelera. Solo su autor o un
+// administrador pueden hacerlo, y el hito debe seguir vigente.
+func (s *Service) RestoreComment(ctx context.Context, id int) error {
+	existing, err := s.commentRepo.GetTrashedByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if err := s.accessService.RequireAuthor(ctx, existing.UserID); err != nil {
+		return err
+	}
+	return s.commentRepo.Restore(ctx, id)
+}
+
+// requireAuthor carga el comentario y verifica que el usuario autenticado sea su autor
+func (s *Service) requireAuthor(ctx context.Context, id int) (*comment.Comment, error) {
+	existing, err := s.commentRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.accessService.RequireAuthor(ctx, existing.UserID); err != nil {
+		return nil, err
+	}
+	return existing, nil
+}
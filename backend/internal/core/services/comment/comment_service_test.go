@@ -2,8 +2,15 @@ package comment
 
 import (
 	"context"
+	"slices"
 	"softpharos/internal/core/domain/comment"
+	"softpharos/internal/core/domain/identity"
+	"softpharos/internal/core/domain/query"
+	"softpharos/internal/core/domain/role"
+	"softpharos/internal/core/errs"
+	"softpharos/internal/core/ports/services"
 	mockRepo "softpharos/mocks/core/ports/repository"
+	mockService "softpharos/mocks/core/ports/services"
 	"testing"
 	"time"
 
@@ -21,17 +28,17 @@ func TestGetAllComments(t *testing.T) {
 
 	mockRepo := mockRepo.NewMockCommentRepository(ctrl)
 	mockRepo.EXPECT().
-		GetAll(gomock.Any()).
-		Return([]comment.Comment{
+		GetAll(gomock.Any(), gomock.Any()).
+		Return(&query.Page[comment.Comment]{Items: []comment.Comment{
 			{ID: 1, MilestoneID: 1, UserID: 1, Content: &content1, CreatedAt: now},
 			{ID: 2, MilestoneID: 1, UserID: 1, Content: &content2, CreatedAt: now},
-		}, nil)
+		}}, nil)
 
-	service := New(mockRepo)
-	result, err := service.GetAllComments(context.Background())
+	service := New(mockRepo, nil)
+	result, err := service.GetAllComments(context.Background(), query.Params{})
 
 	assert.NoError(t, err)
-	assert.Len(t, result, 2)
+	assert.Len(t, result.Items, 2)
 }
 
 func TestGetCommentByID(t *testing.T) {
@@ -46,7 +53,7 @@ func TestGetCommentByID(t *testing.T) {
 		GetByID(gomock.Any(), 1).
 		Return(&comment.Comment{ID: 1, MilestoneID: 1, UserID: 1, Content: &content, CreatedAt: now}, nil)
 
-	service := New(mockRepo)
+	service := New(mockRepo, nil)
 	result, err := service.GetCommentByID(context.Background(), 1)
 
 	assert.NoError(t, err)
@@ -68,7 +75,7 @@ func TestGetCommentsByMilestoneID(t *testing.T) {
 			{ID: 1, MilestoneID: 1, UserID: 1, Content: &content1, CreatedAt: now},
 		}, nil)
 
-	service := New(mockRepo)
+	service := New(mockRepo, nil)
 	result, err := service.GetCommentsByMilestoneID(context.Background(), 1)
 
 	assert.NoError(t, err)
@@ -86,40 +93,162 @@ func TestCreateComment(t *testing.T) {
 		Create(gomock.Any(), gomock.Any()).
 		Return(nil)
 
-	service := New(mockRepo)
+	service := New(mockRepo, nil)
 	err := service.CreateComment(context.Background(), &comment.Comment{MilestoneID: 1, UserID: 1, Content: &content})
 
 	assert.NoError(t, err)
 }
 
 func TestUpdateComment(t *testing.T) {
-	ctrl := gomock.NewController(t)
-	defer ctrl.Finish()
-
 	content := "Updated Comment"
 
-	mockRepo := mockRepo.NewMockCommentRepository(ctrl)
-	mockRepo.EXPECT().
-		Update(gomock.Any(), gomock.Any()).
-		Return(nil)
-
-	service := New(mockRepo)
-	err := service.UpdateComment(context.Background(), &comment.Comment{ID: 1, MilestoneID: 1, UserID: 1, Content: &content})
-
-	assert.NoError(t, err)
+	tests := []struct {
+		name        string
+		accessErr   error
+		expectedErr error
+	}{
+		{name: "el autor edita su comentario"},
+		{name: "rechaza a quien no es el autor", accessErr: services.ErrNotAuthor, expectedErr: services.ErrNotAuthor},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mockRepo.NewMockCommentRepository(ctrl)
+			mockRepo.EXPECT().GetByID(gomock.Any(), 1).Return(&comment.Comment{ID: 1, MilestoneID: 1, UserID: 4}, nil)
+			mockAccess := mockService.NewMockAccessService(ctrl)
+			mockAccess.EXPECT().RequireAuthor(gomock.Any(), 4).Return(tt.accessErr)
+			if tt.expectedErr == nil {
+				mockRepo.EXPECT().
+					Update(gomock.Any(), gomock.Cond(func(c *comment.Comment) bool { return c.UserID == 4 })).
+					Return(nil)
+			}
+
+			service := New(mockRepo, mockAccess)
+			err := service.UpdateComment(context.Background(), &comment.Comment{ID: 1, MilestoneID: 1, UserID: 9, Content: &content})
+
+			assert.ErrorIs(t, err, tt.expectedErr)
+		})
+	}
 }
 
 func TestDeleteComment(t *testing.T) {
-	ctrl := gomock.NewController(t)
-	defer ctrl.Finish()
-
-	mockRepo := mockRepo.NewMockCommentRepository(ctrl)
-	mockRepo.EXPECT().
-		Delete(gomock.Any(), 1).
-		Return(nil)
+	tests := []struct {
+		name        string
+		accessErr   error
+		expectedErr error
+	}{
+		{name: "el autor borra su comentario"},
+		{name: "rechaza a quien no es el autor", accessErr: services.ErrNotAuthor, expectedErr: services.ErrNotAuthor},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mockRepo.NewMockCommentRepository(ctrl)
+			mockRepo.EXPECT().GetByID(gomock.Any(), 1).Return(&comment.Comment{ID: 1, UserID: 4}, nil)
+			mockAccess := mockService.NewMockAccessService(ctrl)
+			mockAccess.EXPECT().RequireAuthor(gomock.Any(), 4).Return(tt.accessErr)
+			if tt.expectedErr == nil {
+				mockRepo.EXPECT().Delete(gomock.Any(), 1).Return(nil)
+			}
+
+			service := New(mockRepo, mockAccess)
+			err := service.DeleteComment(context.Background(), 1)
+
+			assert.ErrorIs(t, err, tt.expectedErr)
+		})
+	}
+}
 
-	service := New(mockRepo)
-	err := service.DeleteComment(context.Background(), 1)
+func TestGetTrashedCommentsByMilestoneID(t *testing.T) {
+	trashed := []comment.Comment{{ID: 1, MilestoneID: 3, UserID: 7}, {ID: 2, MilestoneID: 3, UserID: 8}}
+
+	tests := []struct {
+		name        string
+		ctx         context.Context
+		expectedIDs []int
+		expectedErr error
+	}{
+		{
+			name:        "quien no es administrador solo ve lo que escribió",
+			ctx:         identity.NewContext(context.Background(), &identity.Identity{UserID: 7, Role: role.Student}),
+			expectedIDs: []int{1},
+		},
+		{
+			name:        "un administrador ve todo lo borrado del milestone",
+			ctx:         identity.NewContext(context.Background(), &identity.Identity{UserID: 1, Role: role.Admin}),
+			expectedIDs: []int{1, 2},
+		},
+		{
+			name:        "retorna forbidden sin identidad",
+			ctx:         context.Background(),
+			expectedErr: services.ErrForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mockRepo.NewMockCommentRepository(ctrl)
+			if tt.expectedErr == nil {
+				mockRepo.EXPECT().GetTrashedByMilestoneID(gomock.Any(), 3).Return(slices.Clone(trashed), nil)
+			}
+
+			service := New(mockRepo, mockService.NewMockAccessService(ctrl))
+			result, err := service.GetTrashedCommentsByMilestoneID(tt.ctx, 3)
+
+			assert.ErrorIs(t, err, tt.expectedErr)
+			var ids []int
+			for _, item := range result {
+				ids = append(ids, item.ID)
+			}
+			assert.Equal(t, tt.expectedIDs, ids)
+		})
+	}
+}
 
-	assert.NoError(t, err)
+func TestRestoreComment(t *testing.T) {
+	tests := []struct {
+		name        string
+		trashedErr  error
+		accessErr   error
+		restoreErr  error
+		expectedErr error
+	}{
+		{name: "el autor recupera lo que borró"},
+		{name: "retorna not found si no está en la papelera", trashedErr: errs.NotFound("Recurso no encontrado"), expectedErr: errs.ErrNotFound},
+		{name: "rechaza a quien no es el autor", accessErr: services.ErrNotAuthor, expectedErr: services.ErrNotAuthor},
+		{name: "rechaza recuperar si el milestone sigue en la papelera", restoreErr: errs.Conflict("El milestone está en la papelera; restáuralo primero"), expectedErr: errs.ErrConflict},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mockRepo.NewMockCommentRepository(ctrl)
+			mockAccess := mockService.NewMockAccessService(ctrl)
+			if tt.trashedErr != nil {
+				mockRepo.EXPECT().GetTrashedByID(gomock.Any(), 1).Return(nil, tt.trashedErr)
+			} else {
+				mockRepo.EXPECT().GetTrashedByID(gomock.Any(), 1).Return(&comment.Comment{ID: 1, MilestoneID: 3, UserID: 4}, nil)
+				mockAccess.EXPECT().RequireAuthor(gomock.Any(), 4).Return(tt.accessErr)
+				if tt.accessErr == nil {
+					mockRepo.EXPECT().Restore(gomock.Any(), 1).Return(tt.restoreErr)
+				}
+			}
+
+			service := New(mockRepo, mockAccess)
+			err := service.RestoreComment(context.Background(), 1)
+
+			assert.ErrorIs(t, err, tt.expectedErr)
+		})
+	}
 }
@@ -0,0 +1,131 @@
+package course
+
+import (
+	"context"
+	"time"
+
+	"softpharos/internal/core/domain/course"
+	"softpharos/internal/core/domain/milestone"
+	"softpharos/internal/core/domain/project"
+	"softpharos/internal/core/domain/query"
+	"softpharos/internal/core/ports/repository"
+	"softpharos/internal/core/ports/services"
+)
+
+type Service struct {
+	courseRepo       repository.CourseRepository
+	courseMemberRepo repository.CourseMemberRepository
+	projectRepo      repository.ProjectRepository
+	milestoneRepo    repository.MilestoneRepository
+	accessService    services.AccessService
+	now              func() time.Time
+}
+
+func New(
+	courseRepo repository.CourseRepository,
+	courseMemberRepo repository.CourseMemberRepository,
+	projectRepo repository.ProjectRepository,
+	milestoneRepo repository.MilestoneRepository,
+	accessService services.AccessService,
+) services.CourseService {
+	return &Service{
+		courseRepo:       courseRepo,
+		courseMemberRepo: courseMemberRepo,
+		projectRepo:      projectRepo,
+		milestoneRepo:    milestoneRepo,
+		accessService:    accessService,
+		now:              time.Now,
+	}
+}
+
+func (s *Service) GetAllCourses(ctx context.Context, params query.Params) (*query.Page[course.Course], error) {
+	return s.courseRepo.GetAll(ctx, params)
+}
+
+func (s *Service) GetCourseByID(ctx context.Context, id int) (*course.Course, error) {
+	return s.courseRepo.GetByID(ctx, id)
+}
+
+func (s *Service) CreateCourse(ctx context.Context, c *course.Course) error {
+	return s.courseRepo.Create(ctx, c)
+}
+
+func (s *Service) UpdateCourse(ctx context.Context, c *course.Course) error {
+	return s.courseRepo.Update(ctx, c)
+}
+
+// DeleteCourse elimina la asignatura; falla con conflicto si aún tiene secciones
+func (s *Service) DeleteCourse(ctx context.Context, id int) error {
+	return s.courseRepo.Delete(ctx, id)
+}
+
+func (s *Service) GetCourseSections(ctx context.Context, courseID int, termID *int) ([]course.Section, error) {
+	if _, err := s.courseRepo.GetByID(ctx, courseID); err != nil {
+		return nil, err
+	}
+	return s.courseRepo.GetSections(ctx, courseID, termID)
+}
+
+func (s *Service) CreateCourseSection(ctx context.Context, section *course.Section) error {
+	if _, err := s.courseRepo.GetByID(ctx, section.CourseID); err != nil {
+		return err
+	}
+	return s.courseRepo.CreateSection(ctx, section)
+}
+
+// DeleteCourseSection elimina la sección con sus inscripciones; los proyectos
+// vinculados quedan sin sección
+func (s *Service) DeleteCourseSection(ctx context.Context, id int) error {
+	return s.courseRepo.DeleteSection(ctx, id)
+}
+
+// GetSectionMembers lista los inscritos; solo pueden verlos los propios
+// inscritos y los administradores
+func (s *Service) GetSectionMembers(ctx context.Context, sectionID int) ([]course.Member, error) {
+	if _, err := s.courseRepo.GetSectionByID(ctx, sectionID); err != nil {
+		return nil, err
+	}
+	if err := s.accessService.RequireSectionMember(ctx, sectionID); err != nil {
+		return nil, err
+	}
+	return s.courseMemberRepo.GetBySectionID(ctx, sectionID)
+}
+
+// AddSectionMember inscribe a un usuario en la sección; lo pueden hacer sus
+// profesores y los administradores
+func (s *Service) AddSectionMember(ctx context.Context, member *course.Member) error {
+	if !course.IsValidMemberRole(member.Role) {
+		return services.ErrInvalidCourseRole
+	}
+	if _, err := s.courseRepo.GetSectionByID(ctx, member.SectionID); err != nil {
+		return err
+	}
+	if err := s.accessService.RequireSectionProfessor(ctx, member.SectionID); err != nil {
+		return err
+	}
+	return s.courseMemberRepo.Create(ctx, member)
+}
+
+func (s *Service) RemoveSectionMember(ctx context.Context, sectionID int, userID int) error {
+	if _, err := s.courseRepo.GetSectionByID(ctx, sectionID); err != nil {
+		return err
+	}
+	if err := s.accessService.RequireSectionProfessor(ctx, sectionID); err != nil {
+		return err
+	}
+	return s.courseMemberRepo.Delete(ctx, sectionID, userID)
+}
+
+func (s *Service) GetCourseProjects(ctx context.Context, courseID int, params query.Params) (*query.Page[project.Project], error) {
+	if _, err := s.courseRepo.GetByID(ctx, courseID); err != nil {
+		return nil, err
+	}
+	return s.projectRepo.GetByCourse(ctx, courseID, params)
+}
+
+func (s *Service) GetOverdueMilestones(ctx context.Context, courseID int, params query.Params) (*query.Page[milestone.Milestone], error) {
+	if _, err := s.courseRepo.GetByID(ctx, courseID); err != nil {
+		return nil, err
+	}
+	return s.milestoneRepo.GetOverdueByCourse(ctx, courseID, milestone.Today(s.now()), params)
+}
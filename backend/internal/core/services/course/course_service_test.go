@@ -0,0 +1,143 @@
+package course
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"softpharos/internal/core/domain/course"
+	"softpharos/internal/core/domain/milestone"
+	"softpharos/internal/core/domain/project"
+	"softpharos/internal/core/domain/query"
+	"softpharos/internal/core/errs"
+	"softpharos/internal/core/ports/services"
+	mockRepo "softpharos/mocks/core/ports/repository"
+	mockService "softpharos/mocks/core/ports/services"
+)
+
+func TestAddSectionMember(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	notFound := errs.NotFound("sección no encontrada")
+
+	tests := []struct {
+		name        string
+		member      *course.Member
+		mockSetup   func(*mockRepo.MockCourseRepository, *mockRepo.MockCourseMemberRepository, *mockService.MockAccessService)
+		expectedErr error
+	}{
+		{
+			name:   "el profesor inscribe a un estudiante",
+			member: &course.Member{SectionID: 3, UserID: 8, Role: course.RoleStudent},
+			mockSetup: func(c *mockRepo.MockCourseRepository, cm *mockRepo.MockCourseMemberRepository, a *mockService.MockAccessService) {
+				c.EXPECT().GetSectionByID(gomock.Any(), 3).Return(&course.Section{ID: 3}, nil)
+				a.EXPECT().RequireSectionProfessor(gomock.Any(), 3).Return(nil)
+				cm.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+			},
+		},
+		{
+			name:   "rechaza un rol desconocido",
+			member: &course.Member{SectionID: 3, UserID: 8, Role: "assistant"},
+			mockSetup: func(c *mockRepo.MockCourseRepository, cm *mockRepo.MockCourseMemberRepository, a *mockService.MockAccessService) {
+			},
+			expectedErr: services.ErrInvalidCourseRole,
+		},
+		{
+			name:   "retorna not found cuando la sección no existe",
+			member: &course.Member{SectionID: 3, UserID: 8, Role: course.RoleStudent},
+			mockSetup: func(c *mockRepo.MockCourseRepository, cm *mockRepo.MockCourseMemberRepository, a *mockService.MockAccessService) {
+				c.EXPECT().GetSectionByID(gomock.Any(), 3).Return(nil, notFound)
+			},
+			expectedErr: notFound,
+		},
+		{
+			name:   "rechaza a quien no es profesor de la sección",
+			member: &course.Member{SectionID: 3, UserID: 8, Role: course.RoleStudent},
+			mockSetup: func(c *mockRepo.MockCourseRepository, cm *mockRepo.MockCourseMemberRepository, a *mockService.MockAccessService) {
+				c.EXPECT().GetSectionByID(gomock.Any(), 3).Return(&course.Section{ID: 3}, nil)
+				a.EXPECT().RequireSectionProfessor(gomock.Any(), 3).Return(services.ErrSectionForbidden)
+			},
+			expectedErr: services.ErrSectionForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			courseRepo := mockRepo.NewMockCourseRepository(ctrl)
+			courseMemberRepo := mockRepo.NewMockCourseMemberRepository(ctrl)
+			access := mockService.NewMockAccessService(ctrl)
+			tt.mockSetup(courseRepo, courseMemberRepo, access)
+
+			service := New(courseRepo, courseMemberRepo, mockRepo.NewMockProjectRepository(ctrl), mockRepo.NewMockMilestoneRepository(ctrl), access)
+			err := service.AddSectionMember(context.Background(), tt.member)
+
+			assert.ErrorIs(t, err, tt.expectedErr)
+		})
+	}
+}
+
+func TestGetCourseProjects(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	notFound := errs.NotFound("asignatura no encontrada")
+	params := query.Params{Filters: map[string]any{"term_id": 2}}
+	page := &query.Page[project.Project]{Items: []project.Project{{ID: 5}}, Total: 1}
+
+	tests := []struct {
+		name         string
+		courseErr    error
+		expectedPage *query.Page[project.Project]
+		expectedErr  error
+	}{
+		{name: "lista los proyectos de la asignatura", expectedPage: page},
+		{name: "retorna not found cuando la asignatura no existe", courseErr: notFound, expectedErr: notFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			courseRepo := mockRepo.NewMockCourseRepository(ctrl)
+			projectRepo := mockRepo.NewMockProjectRepository(ctrl)
+			if tt.courseErr != nil {
+				courseRepo.EXPECT().GetByID(gomock.Any(), 4).Return(nil, tt.courseErr)
+			} else {
+				courseRepo.EXPECT().GetByID(gomock.Any(), 4).Return(&course.Course{ID: 4}, nil)
+				projectRepo.EXPECT().GetByCourse(gomock.Any(), 4, params).Return(page, nil)
+			}
+
+			service := New(courseRepo, mockRepo.NewMockCourseMemberRepository(ctrl), projectRepo, mockRepo.NewMockMilestoneRepository(ctrl), mockService.NewMockAccessService(ctrl))
+			result, err := service.GetCourseProjects(context.Background(), 4, params)
+
+			assert.ErrorIs(t, err, tt.expectedErr)
+			assert.Equal(t, tt.expectedPage, result)
+		})
+	}
+}
+
+func TestGetOverdueMilestones(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	params := query.Params{Filters: map[string]any{"section_id": 3}}
+	page := &query.Page[milestone.Milestone]{Items: []milestone.Milestone{{ID: 8, Status: milestone.StatusPlanned}}, Total: 1}
+	now := time.Date(2026, 5, 10, 15, 0, 0, 0, time.UTC)
+
+	courseRepo := mockRepo.NewMockCourseRepository(ctrl)
+	courseRepo.EXPECT().GetByID(gomock.Any(), 4).Return(&course.Course{ID: 4}, nil)
+	milestoneRepo := mockRepo.NewMockMilestoneRepository(ctrl)
+	milestoneRepo.EXPECT().
+		GetOverdueByCourse(gomock.Any(), 4, time.Date(2026, 5, 10, 0, 0, 0, 0, time.UTC), params).
+		Return(page, nil)
+
+	service := New(courseRepo, mockRepo.NewMockCourseMemberRepository(ctrl), mockRepo.NewMockProjectRepository(ctrl), milestoneRepo, mockService.NewMockAccessService(ctrl))
+	service.(*Service).now = func() time.Time { return now }
+
+	result, err := service.GetOverdueMilestones(context.Background(), 4, params)
+
+	assert.NoError(t, err)
+	assert.Equal(t, page, result)
+}
@@ -3,22 +3,26 @@ package deliverable
 import (
 	"context"
 	"softpharos/internal/core/domain/deliverable"
+	"softpharos/internal/core/domain/project_member"
+	"softpharos/internal/core/domain/query"
 	"softpharos/internal/core/ports/repository"
 	"softpharos/internal/core/ports/services"
 )
 
 type Service struct {
 	deliverableRepo repository.DeliverableRepository
+	accessService   services.AccessService
 }
 
-func New(deliverableRepo repository.DeliverableRepository) services.DeliverableService {
+func New(deliverableRepo repository.DeliverableRepository, accessService services.AccessService) services.DeliverableService {
 	return &Service{
 		deliverableRepo: deliverableRepo,
+		accessService:   accessService,
 	}
 }
 
-func (s *Service) GetAllDeliverables(ctx context.Context) ([]deliverable.Deliverable, error) {
-	return s.deliverableRepo.GetAll(ctx)
+func (s *Service) GetAllDeliverables(ctx context.Context, params query.Params) (*query.Page[deliverable.Deliverable], error) {
+	return s.deliverableRepo.GetAll(ctx, params)
 }
 
 func (s *Service) GetDeliverableByID(ctx context.Context, id int) (*deliverable.Deliverable, error) {
@@ -30,13 +34,46 @@ func (s *Service) GetDeliverablesByMilestoneID(ctx context.Context, milestoneID
 }
 
 func (s *Service) CreateDeliverable(ctx context.Context, d *deliverable.Deliverable) error {
+	if err := s.accessService.RequireMilestoneAbility(ctx, d.MilestoneID, project_member.AbilityUploadDeliverables); err != nil {
+		return err
+	}
 	return s.deliverableRepo.Create(ctx, d)
 }
 
 func (s *Service) UpdateDeliverable(ctx context.Context, d *deliverable.Deliverable) error {
+	if err := s.accessService.RequireMilestoneAbility(ctx, d.MilestoneID, project_member.AbilityUploadDeliverables); err != nil {
+		return err
+	}
 	return s.deliverableRepo.Update(ctx, d)
 }
 
 func (s *Service) DeleteDeliverable(ctx context.Context, id int) error {
+	existing, err := s.deliverableRepo.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if err := s.accessService.RequireMilestoneAbility(ctx, existing.MilestoneID, project_member.AbilityUploadDeliverables); err != nil {
+		return err
+	}
 	return s.deliverableRepo.Delete(ctx, id)
 }
+
+func (s *Service) GetTrashedDeliverablesByMilestoneID(ctx context.Context, milestoneID int) ([]deliverable.Deliverable, error) {
+	if err := s.accessService.RequireMilestoneAbility(ctx, milestoneID, project_member.AbilityUploadDeliverables); err != nil {
+		return nil, err
+	}
+	return s.deliverableRepo.GetTrashedByMilestoneID(ctx, milestoneID)
+}
+
+// RestoreDeliverable saca un entregable de la papelera. Exige la misma
+// habilidad que borrarlo y que el hito siga vigente.
+func (s *Service) RestoreDeliverable(ctx context.Context, id int) error {
+	existing, err := s.deliverableRepo.GetTrashedByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if err := s.accessService.RequireMilestoneAbility(ctx, existing.MilestoneID, project_member.AbilityUploadDeliverables); err != nil {
+		return err
+	}
+	return s.deliverableRepo.Restore(ctx, id)
+}
@@ -3,7 +3,12 @@ package deliverable
 import (
 	"context"
 	"softpharos/internal/core/domain/deliverable"
+	"softpharos/internal/core/domain/project_member"
+	"softpharos/internal/core/domain/query"
+	"softpharos/internal/core/errs"
+	"softpharos/internal/core/ports/services"
 	mockRepo "softpharos/mocks/core/ports/repository"
+	mockService "softpharos/mocks/core/ports/services"
 	"testing"
 	"time"
 
@@ -19,15 +24,15 @@ func TestGetAllDeliverables(t *testing.T) {
 	now := time.Now()
 
 	mockRepo := mockRepo.NewMockDeliverableRepository(ctrl)
-	mockRepo.EXPECT().GetAll(gomock.Any()).Return([]deliverable.Deliverable{
+	mockRepo.EXPECT().GetAll(gomock.Any(), gomock.Any()).Return(&query.Page[deliverable.Deliverable]{Items: []deliverable.Deliverable{
 		{ID: 1, MilestoneID: 1, URL: "http://example.com", Type: &typeVal, CreatedAt: now},
-	}, nil)
+	}}, nil)
 
-	service := New(mockRepo)
-	result, err := service.GetAllDeliverables(context.Background())
+	service := New(mockRepo, mockService.NewMockAccessService(ctrl))
+	result, err := service.GetAllDeliverables(context.Background(), query.Params{})
 
 	assert.NoError(t, err)
-	assert.Len(t, result, 1)
+	assert.Len(t, result.Items, 1)
 }
 
 func TestGetDeliverableByID(t *testing.T) {
@@ -40,7 +45,7 @@ func TestGetDeliverableByID(t *testing.T) {
 	mockRepo := mockRepo.NewMockDeliverableRepository(ctrl)
 	mockRepo.EXPECT().GetByID(gomock.Any(), 1).Return(&deliverable.Deliverable{ID: 1, MilestoneID: 1, URL: "http://example.com", Type: &typeVal, CreatedAt: now}, nil)
 
-	service := New(mockRepo)
+	service := New(mockRepo, mockService.NewMockAccessService(ctrl))
 	result, err := service.GetDeliverableByID(context.Background(), 1)
 
 	assert.NoError(t, err)
@@ -54,7 +59,7 @@ func TestGetDeliverablesByMilestoneID(t *testing.T) {
 	mockRepo := mockRepo.NewMockDeliverableRepository(ctrl)
 	mockRepo.EXPECT().GetByMilestoneID(gomock.Any(), 1).Return([]deliverable.Deliverable{{ID: 1, MilestoneID: 1, URL: "http://example.com"}}, nil)
 
-	service := New(mockRepo)
+	service := New(mockRepo, mockService.NewMockAccessService(ctrl))
 	result, err := service.GetDeliverablesByMilestoneID(context.Background(), 1)
 
 	assert.NoError(t, err)
@@ -68,12 +73,30 @@ func TestCreateDeliverable(t *testing.T) {
 	mockRepo := mockRepo.NewMockDeliverableRepository(ctrl)
 	mockRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
 
-	service := New(mockRepo)
+	mockAccess := mockService.NewMockAccessService(ctrl)
+	mockAccess.EXPECT().RequireMilestoneAbility(gomock.Any(), 1, project_member.AbilityUploadDeliverables).Return(nil)
+
+	service := New(mockRepo, mockAccess)
 	err := service.CreateDeliverable(context.Background(), &deliverable.Deliverable{MilestoneID: 1, URL: "http://example.com"})
 
 	assert.NoError(t, err)
 }
 
+func TestCreateDeliverable_Forbidden(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mockRepo.NewMockDeliverableRepository(ctrl)
+
+	mockAccess := mockService.NewMockAccessService(ctrl)
+	mockAccess.EXPECT().RequireMilestoneAbility(gomock.Any(), 1, project_member.AbilityUploadDeliverables).Return(services.ErrForbidden)
+
+	service := New(mockRepo, mockAccess)
+	err := service.CreateDeliverable(context.Background(), &deliverable.Deliverable{MilestoneID: 1, URL: "http://example.com"})
+
+	assert.ErrorIs(t, err, services.ErrForbidden)
+}
+
 func TestUpdateDeliverable(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -81,7 +104,10 @@ func TestUpdateDeliverable(t *testing.T) {
 	mockRepo := mockRepo.NewMockDeliverableRepository(ctrl)
 	mockRepo.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
 
-	service := New(mockRepo)
+	mockAccess := mockService.NewMockAccessService(ctrl)
+	mockAccess.EXPECT().RequireMilestoneAbility(gomock.Any(), 1, project_member.AbilityUploadDeliverables).Return(nil)
+
+	service := New(mockRepo, mockAccess)
 	err := service.UpdateDeliverable(context.Background(), &deliverable.Deliverable{ID: 1, MilestoneID: 1, URL: "http://example.com"})
 
 	assert.NoError(t, err)
@@ -92,10 +118,97 @@ func TestDeleteDeliverable(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockRepo := mockRepo.NewMockDeliverableRepository(ctrl)
+	mockRepo.EXPECT().GetByID(gomock.Any(), 1).Return(&deliverable.Deliverable{ID: 1, MilestoneID: 2, URL: "http://example.com"}, nil)
 	mockRepo.EXPECT().Delete(gomock.Any(), 1).Return(nil)
 
-	service := New(mockRepo)
+	mockAccess := mockService.NewMockAccessService(ctrl)
+	mockAccess.EXPECT().RequireMilestoneAbility(gomock.Any(), 2, project_member.AbilityUploadDeliverables).Return(nil)
+
+	service := New(mockRepo, mockAccess)
 	err := service.DeleteDeliverable(context.Background(), 1)
 
 	assert.NoError(t, err)
 }
+
+func TestDeleteDeliverable_Forbidden(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mockRepo.NewMockDeliverableRepository(ctrl)
+	mockRepo.EXPECT().GetByID(gomock.Any(), 1).Return(&deliverable.Deliverable{ID: 1, MilestoneID: 2, URL: "http://example.com"}, nil)
+
+	mockAccess := mockService.NewMockAccessService(ctrl)
+	mockAccess.EXPECT().RequireMilestoneAbility(gomock.Any(), 2, project_member.AbilityUploadDeliverables).Return(services.ErrForbidden)
+
+	service := New(mockRepo, mockAccess)
+	err := service.DeleteDeliverable(context.Background(), 1)
+
+	assert.ErrorIs(t, err, services.ErrForbidden)
+}
+
+func TestGetTrashedDeliverablesByMilestoneID(t *testing.T) {
+	tests := []struct {
+		name        string
+		accessErr   error
+		expectedErr error
+	}{
+		{name: "lista los entregables borrados del milestone"},
+		{name: "rechaza a quien no puede subir entregables", accessErr: services.ErrForbidden, expectedErr: services.ErrForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			trashed := []deliverable.Deliverable{{ID: 1, MilestoneID: 3, URL: "http://example.com"}}
+			mockRepo := mockRepo.NewMockDeliverableRepository(ctrl)
+			mockAccess := mockService.NewMockAccessService(ctrl)
+			mockAccess.EXPECT().RequireMilestoneAbility(gomock.Any(), 3, project_member.AbilityUploadDeliverables).Return(tt.accessErr)
+			if tt.accessErr == nil {
+				mockRepo.EXPECT().GetTrashedByMilestoneID(gomock.Any(), 3).Return(trashed, nil)
+			}
+
+			service := New(mockRepo, mockAccess)
+			result, err := service.GetTrashedDeliverablesByMilestoneID(context.Background(), 3)
+
+			assert.ErrorIs(t, err, tt.expectedErr)
+			if tt.expectedErr == nil {
+				assert.Equal(t, trashed, result)
+			}
+		})
+	}
+}
+
+func TestRestoreDeliverable(t *testing.T) {
+	tests := []struct {
+		name        string
+		accessErr   error
+		restoreErr  error
+		expectedErr error
+	}{
+		{name: "recupera el entregable"},
+		{name: "rechaza a quien no puede subir entregables", accessErr: services.ErrForbidden, expectedErr: services.ErrForbidden},
+		{name: "rechaza recuperar si el milestone sigue en la papelera", restoreErr: errs.Conflict("El milestone está en la papelera; restáuralo primero"), expectedErr: errs.ErrConflict},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mockRepo.NewMockDeliverableRepository(ctrl)
+			mockRepo.EXPECT().GetTrashedByID(gomock.Any(), 1).Return(&deliverable.Deliverable{ID: 1, MilestoneID: 3}, nil)
+			mockAccess := mockService.NewMockAccessService(ctrl)
+			mockAccess.EXPECT().RequireMilestoneAbility(gomock.Any(), 3, project_member.AbilityUploadDeliverables).Return(tt.accessErr)
+			if tt.accessErr == nil {
+				mockRepo.EXPECT().Restore(gomock.Any(), 1).Return(tt.restoreErr)
+			}
+
+			service := New(mockRepo, mockAccess)
+			err := service.RestoreDeliverable(context.Background(), 1)
+
+			assert.ErrorIs(t, err, tt.expectedErr)
+		})
+	}
+}
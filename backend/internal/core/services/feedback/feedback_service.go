@@ -2,23 +2,28 @@ package feedback
 
 import (
 	"context"
+	"slices"
 	"softpharos/internal/core/domain/feedback"
+	"softpharos/internal/core/domain/identity"
+	"softpharos/internal/core/domain/query"
 	"softpharos/internal/core/ports/repository"
 	"softpharos/internal/core/ports/services"
 )
 
 type Service struct {
-	feedbackRepo repository.FeedbackRepository
+	feedbackRepo  repository.FeedbackRepository
+	accessService services.AccessService
 }
 
-func New(feedbackRepo repository.FeedbackRepository) services.FeedbackService {
+func New(feedbackRepo repository.FeedbackRepository, accessService services.AccessService) services.FeedbackService {
 	return &Service{
-		feedbackRepo: feedbackRepo,
+		feedbackRepo:  feedbackRepo,
+		accessService: accessService,
 	}
 }
 
-func (s *Service) GetAllFeedbacks(ctx context.Context) ([]feedback.Feedback, error) {
-	return s.feedbackRepo.GetAll(ctx)
+func (s *Service) GetAllFeedbacks(ctx context.Context, params query.Params) (*query.Page[feedback.Feedback], error) {
+	return s.feedbackRepo.GetAll(ctx, params)
 }
 
 func (s *Service) GetFeedbackByID(ctx context.Context, id int) (*feedback.Feedback, error) {
@@ -33,10 +38,64 @@ func (s *Service) CreateFeedback(ctx context.Context, f *feedback.Feedback) erro
 	return s.feedbackRepo.Create(ctx, f)
 }
 
+// UpdateFeedback solo permite editar al profesor que escribió la retroalimentación o a un administrador
 func (s *Service) UpdateFeedback(ctx context.Context, f *feedback.Feedback) error {
+	existing, err := s.requireAuthor(ctx, f.ID)
+	if err != nil {
+		return err
+	}
+
+	f.ProfessorID = existing.ProfessorID
 	return s.feedbackRepo.Update(ctx, f)
 }
 
 func (s *Service) DeleteFeedback(ctx context.Context, id int) error {
+	if _, err := s.requireAuthor(ctx, id); err != nil {
+		return err
+	}
 	return s.feedbackRepo.Delete(ctx, id)
 }
+
+// GetTrashedFeedbacksByMilestoneID lista la retroalimentación borrada del
+// hito: la propia, o toda para un administrador
+func (s *Service) GetTrashedFeedbacksByMilestoneID(ctx context.Context, milestoneID int) ([]feedback.Feedback, error) {
+	id, ok := identity.FromContext(ctx)
+	if !ok {
+		return nil, services.ErrForbidden
+	}
+
+	feedbacks, err := s.feedbackRepo.GetTrashedByMilestoneID(ctx, milestoneID)
+	if err != nil {
+		return nil, err
+	}
+	if id.IsAdmin() {
+		return feedbacks, nil
+	}
+	return slices.DeleteFunc(feedbacks, func(f feedback.Feedback) bool { return f.ProfessorID != id.UserID }), nil
+}
+
+// RestoreFeedback saca una retroalimentación de la papelera. Solo el profesor
+// que la escribió o un administrador pueden hacerlo, y el hito debe seguir
+// vigente.
+func (s *Service) RestoreFeedback(ctx context.Context, id int) error {
+	existing, err := s.feedbackRepo.GetTrashedByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if err := s.accessService.RequireAuthor(ctx, existing.ProfessorID); err != nil {
+		return err
+	}
+	return s.feedbackRepo.Restore(ctx, id)
+}
+
+// requireAuthor carga la retroalimentación y verifica que el usuario autenticado sea el profesor que la escribió
+func (s *Service) requireAuthor(ctx context.Context, id int) (*feedback.Feedback, error) {
+	existing, err := s.feedbackRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.accessService.RequireAuthor(ctx, existing.ProfessorID); err != nil {
+		return nil, err
+	}
+	return existing, nil
+}
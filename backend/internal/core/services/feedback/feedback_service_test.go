@@ -2,8 +2,15 @@ package feedback
 
 import (
 	"context"
+	"slices"
 	"softpharos/internal/core/domain/feedback"
+	"softpharos/internal/core/domain/identity"
+	"softpharos/internal/core/domain/query"
+	"softpharos/internal/core/domain/role"
+	"softpharos/internal/core/errs"
+	"softpharos/internal/core/ports/services"
 	mockRepo "softpharos/mocks/core/ports/repository"
+	mockService "softpharos/mocks/core/ports/services"
 	"testing"
 	"time"
 
@@ -18,15 +25,15 @@ func TestGetAllFeedbacks(t *testing.T) {
 	now := time.Now()
 
 	mockRepo := mockRepo.NewMockFeedbackRepository(ctrl)
-	mockRepo.EXPECT().GetAll(gomock.Any()).Return([]feedback.Feedback{
+	mockRepo.EXPECT().GetAll(gomock.Any(), gomock.Any()).Return(&query.Page[feedback.Feedback]{Items: []feedback.Feedback{
 		{ID: 1, MilestoneID: 1, ProfessorID: 1, Content: "Good work", CreatedAt: now},
-	}, nil)
+	}}, nil)
 
-	service := New(mockRepo)
-	result, err := service.GetAllFeedbacks(context.Background())
+	service := New(mockRepo, nil)
+	result, err := service.GetAllFeedbacks(context.Background(), query.Params{})
 
 	assert.NoError(t, err)
-	assert.Len(t, result, 1)
+	assert.Len(t, result.Items, 1)
 }
 
 func TestGetFeedbackByID(t *testing.T) {
@@ -38,7 +45,7 @@ func TestGetFeedbackByID(t *testing.T) {
 	mockRepo := mockRepo.NewMockFeedbackRepository(ctrl)
 	mockRepo.EXPECT().GetByID(gomock.Any(), 1).Return(&feedback.Feedback{ID: 1, MilestoneID: 1, ProfessorID: 1, Content: "Good work", CreatedAt: now}, nil)
 
-	service := New(mockRepo)
+	service := New(mockRepo, nil)
 	result, err := service.GetFeedbackByID(context.Background(), 1)
 
 	assert.NoError(t, err)
@@ -52,7 +59,7 @@ func TestGetFeedbacksByMilestoneID(t *testing.T) {
 	mockRepo := mockRepo.NewMockFeedbackRepository(ctrl)
 	mockRepo.EXPECT().GetByMilestoneID(gomock.Any(), 1).Return([]feedback.Feedback{{ID: 1, MilestoneID: 1, ProfessorID: 1, Content: "Good"}}, nil)
 
-	service := New(mockRepo)
+	service := New(mockRepo, nil)
 	result, err := service.GetFeedbacksByMilestoneID(context.Background(), 1)
 
 	assert.NoError(t, err)
@@ -66,34 +73,160 @@ func TestCreateFeedback(t *testing.T) {
 	mockRepo := mockRepo.NewMockFeedbackRepository(ctrl)
 	mockRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
 
-	service := New(mockRepo)
+	service := New(mockRepo, nil)
 	err := service.CreateFeedback(context.Background(), &feedback.Feedback{MilestoneID: 1, ProfessorID: 1, Content: "Good"})
 
 	assert.NoError(t, err)
 }
 
 func TestUpdateFeedback(t *testing.T) {
-	ctrl := gomock.NewController(t)
-	defer ctrl.Finish()
-
-	mockRepo := mockRepo.NewMockFeedbackRepository(ctrl)
-	mockRepo.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
-
-	service := New(mockRepo)
-	err := service.UpdateFeedback(context.Background(), &feedback.Feedback{ID: 1, MilestoneID: 1, ProfessorID: 1, Content: "Good"})
-
-	assert.NoError(t, err)
+	tests := []struct {
+		name        string
+		accessErr   error
+		expectedErr error
+	}{
+		{name: "el profesor edita su retroalimentación"},
+		{name: "rechaza a otro profesor", accessErr: services.ErrNotAuthor, expectedErr: services.ErrNotAuthor},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mockRepo.NewMockFeedbackRepository(ctrl)
+			mockRepo.EXPECT().GetByID(gomock.Any(), 1).Return(&feedback.Feedback{ID: 1, MilestoneID: 1, ProfessorID: 3}, nil)
+			mockAccess := mockService.NewMockAccessService(ctrl)
+			mockAccess.EXPECT().RequireAuthor(gomock.Any(), 3).Return(tt.accessErr)
+			if tt.expectedErr == nil {
+				mockRepo.EXPECT().
+					Update(gomock.Any(), gomock.Cond(func(f *feedback.Feedback) bool { return f.ProfessorID == 3 })).
+					Return(nil)
+			}
+
+			service := New(mockRepo, mockAccess)
+			err := service.UpdateFeedback(context.Background(), &feedback.Feedback{ID: 1, MilestoneID: 1, ProfessorID: 8, Content: "Good"})
+
+			assert.ErrorIs(t, err, tt.expectedErr)
+		})
+	}
 }
 
 func TestDeleteFeedback(t *testing.T) {
-	ctrl := gomock.NewController(t)
-	defer ctrl.Finish()
-
-	mockRepo := mockRepo.NewMockFeedbackRepository(ctrl)
-	mockRepo.EXPECT().Delete(gomock.Any(), 1).Return(nil)
+	tests := []struct {
+		name        string
+		accessErr   error
+		expectedErr error
+	}{
+		{name: "el profesor borra su retroalimentación"},
+		{name: "rechaza a otro profesor", accessErr: services.ErrNotAuthor, expectedErr: services.ErrNotAuthor},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mockRepo.NewMockFeedbackRepository(ctrl)
+			mockRepo.EXPECT().GetByID(gomock.Any(), 1).Return(&feedback.Feedback{ID: 1, ProfessorID: 3}, nil)
+			mockAccess := mockService.NewMockAccessService(ctrl)
+			mockAccess.EXPECT().RequireAuthor(gomock.Any(), 3).Return(tt.accessErr)
+			if tt.expectedErr == nil {
+				mockRepo.EXPECT().Delete(gomock.Any(), 1).Return(nil)
+			}
+
+			service := New(mockRepo, mockAccess)
+			err := service.DeleteFeedback(context.Background(), 1)
+
+			assert.ErrorIs(t, err, tt.expectedErr)
+		})
+	}
+}
 
-	service := New(mockRepo)
-	err := service.DeleteFeedback(context.Background(), 1)
+func TestGetTrashedFeedbacksByMilestoneID(t *testing.T) {
+	trashed := []feedback.Feedback{{ID: 1, MilestoneID: 3, ProfessorID: 7}, {ID: 2, MilestoneID: 3, ProfessorID: 8}}
+
+	tests := []struct {
+		name        string
+		ctx         context.Context
+		expectedIDs []int
+		expectedErr error
+	}{
+		{
+			name:        "quien no es administrador solo ve lo que escribió",
+			ctx:         identity.NewContext(context.Background(), &identity.Identity{UserID: 7, Role: role.Student}),
+			expectedIDs: []int{1},
+		},
+		{
+			name:        "un administrador ve todo lo borrado del milestone",
+			ctx:         identity.NewContext(context.Background(), &identity.Identity{UserID: 1, Role: role.Admin}),
+			expectedIDs: []int{1, 2},
+		},
+		{
+			name:        "retorna forbidden sin identidad",
+			ctx:         context.Background(),
+			expectedErr: services.ErrForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mockRepo.NewMockFeedbackRepository(ctrl)
+			if tt.expectedErr == nil {
+				mockRepo.EXPECT().GetTrashedByMilestoneID(gomock.Any(), 3).Return(slices.Clone(trashed), nil)
+			}
+
+			service := New(mockRepo, mockService.NewMockAccessService(ctrl))
+			result, err := service.GetTrashedFeedbacksByMilestoneID(tt.ctx, 3)
+
+			assert.ErrorIs(t, err, tt.expectedErr)
+			var ids []int
+			for _, item := range result {
+				ids = append(ids, item.ID)
+			}
+			assert.Equal(t, tt.expectedIDs, ids)
+		})
+	}
+}
 
-	assert.NoError(t, err)
+func TestRestoreFeedback(t *testing.T) {
+	tests := []struct {
+		name        string
+		trashedErr  error
+		accessErr   error
+		restoreErr  error
+		expectedErr error
+	}{
+		{name: "el profesor recupera lo que borró"},
+		{name: "retorna not found si no está en la papelera", trashedErr: errs.NotFound("Recurso no encontrado"), expectedErr: errs.ErrNotFound},
+		{name: "rechaza a quien no es el profesor", accessErr: services.ErrNotAuthor, expectedErr: services.ErrNotAuthor},
+		{name: "rechaza recuperar si el milestone sigue en la papelera", restoreErr: errs.Conflict("El milestone está en la papelera; restáuralo primero"), expectedErr: errs.ErrConflict},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mockRepo.NewMockFeedbackRepository(ctrl)
+			mockAccess := mockService.NewMockAccessService(ctrl)
+			if tt.trashedErr != nil {
+				mockRepo.EXPECT().GetTrashedByID(gomock.Any(), 1).Return(nil, tt.trashedErr)
+			} else {
+				mockRepo.EXPECT().GetTrashedByID(gomock.Any(), 1).Return(&feedback.Feedback{ID: 1, MilestoneID: 3, ProfessorID: 4}, nil)
+				mockAccess.EXPECT().RequireAuthor(gomock.Any(), 4).Return(tt.accessErr)
+				if tt.accessErr == nil {
+					mockRepo.EXPECT().Restore(gomock.Any(), 1).Return(tt.restoreErr)
+				}
+			}
+
+			service := New(mockRepo, mockAccess)
+			err := service.RestoreFeedback(context.Background(), 1)
+
+			assert.ErrorIs(t, err, tt.expectedErr)
+		})
+	}
 }
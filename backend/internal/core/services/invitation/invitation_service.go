@@ -0,0 +1,221 @@
+package invitation
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"softpharos/internal/auth"
+	"softpharos/internal/core/domain/identity"
+	"softpharos/internal/core/domain/invitation"
+	"softpharos/internal/core/domain/project_member"
+	"softpharos/internal/core/errs"
+	"softpharos/internal/core/ports/repository"
+	"softpharos/internal/core/ports/services"
+)
+
+type Service struct {
+	invitationRepo    repository.InvitationRepository
+	userRepo          repository.UserRepository
+	projectMemberRepo repository.ProjectMemberRepository
+	accessService     services.AccessService
+	unitOfWork        repository.UnitOfWork
+	now               func() time.Time
+}
+
+func New(
+	invitationRepo repository.InvitationRepository,
+	userRepo repository.UserRepository,
+	projectMemberRepo repository.ProjectMemberRepository,
+	accessService services.AccessService,
+	unitOfWork repository.UnitOfWork,
+) services.InvitationService {
+	return &Service{
+		invitationRepo:    invitationRepo,
+		userRepo:          userRepo,
+		projectMemberRepo: projectMemberRepo,
+		accessService:     accessService,
+		unitOfWork:        unitOfWork,
+		now:               time.Now,
+	}
+}
+
+// CreateInvitation invita a un email a unirse al proyecto. Invitan quienes
+// gestionan miembros, y solo un owner puede invitar a otro owner; el
+// invitado puede no tener cuenta todavía.
+func (s *Service) CreateInvitation(ctx context.Context, inv *invitation.Invitation) (string, error) {
+	if inv.Role == "" {
+		inv.Role = project_member.RoleContributor
+	}
+	if !project_member.IsValidRole(inv.Role) {
+		return "", services.ErrInvalidProjectRole
+	}
+	if err := s.requireInviter(ctx, inv.ProjectID, inv.Role); err != nil {
+		return "", err
+	}
+	caller, _ := identity.FromContext(ctx)
+
+	inv.Email = strings.ToLower(strings.TrimSpace(inv.Email))
+
+	invitee, err := s.userRepo.GetByEmail(ctx, inv.Email)
+	if err != nil && !errors.Is(err, errs.ErrNotFound) {
+		return "", err
+	}
+	if err == nil {
+		isMember, err := s.projectMemberRepo.IsMember(ctx, inv.ProjectID, invitee.ID)
+		if err != nil {
+			return "", err
+		}
+		if isMember {
+			return "", services.ErrAlreadyProjectMember
+		}
+	}
+
+	token, err := auth.GenerateInvitationToken()
+	if err != nil {
+		return "", err
+	}
+
+	inv.TokenHash = auth.HashInvitationToken(token)
+	inv.InvitedBy = &caller.UserID
+	inv.Status = invitation.StatusPending
+	inv.ExpiresAt = s.now().Add(invitation.TTL)
+	if err := s.invitationRepo.Create(ctx, inv); err != nil {
+		return "", err
+	}
+
+	return token, nil
+}
+
+func (s *Service) GetInvitationsByProjectID(ctx context.Context, projectID int) ([]invitation.Invitation, error) {
+	if err := s.accessService.RequireProjectAbility(ctx, projectID, project_member.AbilityManageMembers); err != nil {
+		return nil, err
+	}
+	return s.invitationRepo.GetByProjectID(ctx, projectID)
+}
+
+func (s *Service) AcceptInvitation(ctx context.Context, token string) (*project_member.ProjectMember, error) {
+	return s.accept(ctx, s.byToken(token))
+}
+
+func (s *Service) DeclineInvitation(ctx context.Context, token string) error {
+	return s.decline(ctx, s.byToken(token))
+}
+
+func (s *Service) GetMyInvitations(ctx context.Context) ([]invitation.Invitation, error) {
+	caller, ok := identity.FromContext(ctx)
+	if !ok {
+		return nil, services.ErrForbidden
+	}
+
+	me, err := s.userRepo.GetByID(ctx, caller.UserID)
+	if err != nil {
+		return nil, err
+	}
+	return s.invitationRepo.GetPendingByEmail(ctx, strings.ToLower(me.Email), s.now())
+}
+
+func (s *Service) AcceptInvitationByID(ctx context.Context, id int) (*project_member.ProjectMember, error) {
+	return s.accept(ctx, s.byID(id))
+}
+
+func (s *Service) DeclineInvitationByID(ctx context.Context, id int) error {
+	return s.decline(ctx, s.byID(id))
+}
+
+// invitationLoader carga la invitación que se va a responder
+type invitationLoader func(ctx context.Context) (*invitation.Invitation, error)
+
+func (s *Service) byToken(token string) invitationLoader {
+	return func(ctx context.Context) (*invitation.Invitation, error) {
+		return s.invitationRepo.GetByTokenHash(ctx, auth.HashInvitationToken(token))
+	}
+}
+
+func (s *Service) byID(id int) invitationLoader {
+	return func(ctx context.Context) (*invitation.Invitation, error) {
+		return s.invitationRepo.GetByID(ctx, id)
+	}
+}
+
+// accept cierra la invitación y crea la membresía en la misma transacción;
+// si la membresía falla la invitación sigue pendiente
+func (s *Service) accept(ctx context.Context, load invitationLoader) (*project_member.ProjectMember, error) {
+	inv, userID, err := s.openInvitation(ctx, load)
+	if err != nil {
+		return nil, err
+	}
+
+	var member *project_member.ProjectMember
+	err = s.unitOfWork.Do(ctx, func(repos repository.Repositories) error {
+		accepted, err := repos.Invitations.Respond(ctx, inv.ID, invitation.StatusAccepted, s.now())
+		if err != nil {
+			return err
+		}
+		if !accepted {
+			return services.ErrInvitationClosed
+		}
+
+		member = inv.Member(userID)
+		return repos.ProjectMembers.Create(ctx, member)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return member, nil
+}
+
+func (s *Service) decline(ctx context.Context, load invitationLoader) error {
+	inv, _, err := s.openInvitation(ctx, load)
+	if err != nil {
+		return err
+	}
+
+	declined, err := s.invitationRepo.Respond(ctx, inv.ID, invitation.StatusDeclined, s.now())
+	if err != nil {
+		return err
+	}
+	if !declined {
+		return services.ErrInvitationClosed
+	}
+	return nil
+}
+
+// openInvitation carga la invitación y comprueba que siga pendiente y que el
+// usuario autenticado sea el invitado
+func (s *Service) openInvitation(ctx context.Context, load invitationLoader) (*invitation.Invitation, int, error) {
+	caller, ok := identity.FromContext(ctx)
+	if !ok {
+		return nil, 0, services.ErrForbidden
+	}
+
+	inv, err := load(ctx)
+	if errors.Is(err, errs.ErrNotFound) {
+		return nil, 0, services.ErrInvitationNotFound
+	}
+	if err != nil {
+		return nil, 0, err
+	}
+
+	invitee, err := s.userRepo.GetByID(ctx, caller.UserID)
+	if err != nil {
+		return nil, 0, err
+	}
+	if !strings.EqualFold(invitee.Email, inv.Email) {
+		return nil, 0, services.ErrInvitationForAnotherEmail
+	}
+	if !inv.IsPending(s.now()) {
+		return nil, 0, services.ErrInvitationClosed
+	}
+
+	return inv, invitee.ID, nil
+}
+
+func (s *Service) requireInviter(ctx context.Context, projectID int, role string) error {
+	if role == project_member.RoleOwner {
+		return s.accessService.RequireProjectOwner(ctx, projectID)
+	}
+	return s.accessService.RequireProjectAbility(ctx, projectID, project_member.AbilityManageMembers)
+}
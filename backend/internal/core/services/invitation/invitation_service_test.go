@@ -0,0 +1,353 @@
+package invitation
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"softpharos/internal/auth"
+	"softpharos/internal/core/domain/identity"
+	"softpharos/internal/core/domain/invitation"
+	"softpharos/internal/core/domain/project_member"
+	"softpharos/internal/core/domain/role"
+	"softpharos/internal/core/domain/user"
+	"softpharos/internal/core/errs"
+	"softpharos/internal/core/ports/repository"
+	"softpharos/internal/core/ports/services"
+	mockRepo "softpharos/mocks/core/ports/repository"
+	mockService "softpharos/mocks/core/ports/services"
+)
+
+type mocks struct {
+	invitation *mockRepo.MockInvitationRepository
+	user       *mockRepo.MockUserRepository
+	member     *mockRepo.MockProjectMemberRepository
+	access     *mockService.MockAccessService
+	txMember   *mockRepo.MockProjectMemberRepository
+	txInvites  *mockRepo.MockInvitationRepository
+}
+
+var now = time.Date(2025, 5, 10, 12, 0, 0, 0, time.UTC)
+
+func newTestService(ctrl *gomock.Controller) (*Service, mocks) {
+	m := mocks{
+		invitation: mockRepo.NewMockInvitationRepository(ctrl),
+		user:       mockRepo.NewMockUserRepository(ctrl),
+		member:     mockRepo.NewMockProjectMemberRepository(ctrl),
+		access:     mockService.NewMockAccessService(ctrl),
+		txMember:   mockRepo.NewMockProjectMemberRepository(ctrl),
+		txInvites:  mockRepo.NewMockInvitationRepository(ctrl),
+	}
+
+	unitOfWork := mockRepo.NewMockUnitOfWork(ctrl)
+	unitOfWork.EXPECT().
+		Do(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, fn func(repository.Repositories) error) error {
+			return fn(repository.Repositories{Invitations: m.txInvites, ProjectMembers: m.txMember})
+		}).
+		AnyTimes()
+
+	service := New(m.invitation, m.user, m.member, m.access, unitOfWork).(*Service)
+	service.now = func() time.Time { return now }
+	return service, m
+}
+
+func contextAs(userID int) context.Context {
+	return identity.NewContext(context.Background(), &identity.Identity{UserID: userID, Role: role.Student})
+}
+
+func TestCreateInvitation(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tests := []struct {
+		name        string
+		role        string
+		mockSetup   func(m mocks)
+		expectedErr error
+	}{
+		{
+			name: "crea la invitación para un email sin cuenta",
+			mockSetup: func(m mocks) {
+				m.access.EXPECT().RequireProjectAbility(gomock.Any(), 10, project_member.AbilityManageMembers).Return(nil)
+				m.user.EXPECT().GetByEmail(gomock.Any(), "nuevo@unal.edu.co").Return(nil, errs.NotFound("no encontrado"))
+				m.invitation.EXPECT().Create(gomock.Any(), gomock.Cond(func(x *invitation.Invitation) bool {
+					return x.Status == invitation.StatusPending &&
+						x.Role == project_member.RoleContributor &&
+						*x.InvitedBy == 1 &&
+						x.ExpiresAt.Equal(now.Add(invitation.TTL)) &&
+						x.TokenHash != ""
+				})).Return(nil)
+			},
+		},
+		{
+			name: "rechaza invitar a quien ya es miembro",
+			mockSetup: func(m mocks) {
+				m.access.EXPECT().RequireProjectAbility(gomock.Any(), 10, project_member.AbilityManageMembers).Return(nil)
+				m.user.EXPECT().GetByEmail(gomock.Any(), "nuevo@unal.edu.co").Return(&user.User{ID: 5}, nil)
+				m.member.EXPECT().IsMember(gomock.Any(), 10, 5).Return(true, nil)
+			},
+			expectedErr: services.ErrAlreadyProjectMember,
+		},
+		{
+			name: "retorna forbidden cuando el usuario no gestiona miembros",
+			mockSetup: func(m mocks) {
+				m.access.EXPECT().RequireProjectAbility(gomock.Any(), 10, project_member.AbilityManageMembers).Return(services.ErrForbidden)
+			},
+			expectedErr: services.ErrForbidden,
+		},
+		{
+			name: "exige ser owner para invitar a otro owner",
+			role: project_member.RoleOwner,
+			mockSetup: func(m mocks) {
+				m.access.EXPECT().RequireProjectOwner(gomock.Any(), 10).Return(services.ErrForbidden)
+			},
+			expectedErr: services.ErrForbidden,
+		},
+		{
+			name:        "rechaza un rol que no es de proyecto",
+			role:        "Developer",
+			mockSetup:   func(m mocks) {},
+			expectedErr: services.ErrInvalidProjectRole,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, m := newTestService(ctrl)
+			tt.mockSetup(m)
+
+			inv := &invitation.Invitation{ProjectID: 10, Email: "  Nuevo@UNAL.edu.co ", Role: tt.role}
+			token, err := service.CreateInvitation(contextAs(1), inv)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				assert.Empty(t, token)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, "nuevo@unal.edu.co", inv.Email)
+				assert.Equal(t, auth.HashInvitationToken(token), inv.TokenHash)
+			}
+		})
+	}
+}
+
+func TestAcceptInvitation(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	hash := auth.HashInvitationToken("token")
+	pending := func() *invitation.Invitation {
+		return &invitation.Invitation{ID: 3, ProjectID: 10, Email: "invitado@unal.edu.co", Role: project_member.RoleContributor, Status: invitation.StatusPending, ExpiresAt: now.Add(time.Hour)}
+	}
+
+	tests := []struct {
+		name        string
+		mockSetup   func(m mocks)
+		expectedErr error
+	}{
+		{
+			name: "acepta la invitación y crea la membresía",
+			mockSetup: func(m mocks) {
+				m.invitation.EXPECT().GetByTokenHash(gomock.Any(), hash).Return(pending(), nil)
+				m.user.EXPECT().GetByID(gomock.Any(), 7).Return(&user.User{ID: 7, Email: "Invitado@unal.edu.co"}, nil)
+				m.txInvites.EXPECT().Respond(gomock.Any(), 3, invitation.StatusAccepted, now).Return(true, nil)
+				m.txMember.EXPECT().Create(gomock.Any(), gomock.Cond(func(x *project_member.ProjectMember) bool {
+					return x.ProjectID == 10 && x.UserID == 7 && x.Role == project_member.RoleContributor
+				})).Return(nil)
+			},
+		},
+		{
+			name: "rechaza a un usuario con otro email",
+			mockSetup: func(m mocks) {
+				m.invitation.EXPECT().GetByTokenHash(gomock.Any(), hash).Return(pending(), nil)
+				m.user.EXPECT().GetByID(gomock.Any(), 7).Return(&user.User{ID: 7, Email: "otro@unal.edu.co"}, nil)
+			},
+			expectedErr: services.ErrInvitationForAnotherEmail,
+		},
+		{
+			name: "rechaza una invitación expirada",
+			mockSetup: func(m mocks) {
+				expired := pending()
+				expired.ExpiresAt = now.Add(-time.Minute)
+				m.invitation.EXPECT().GetByTokenHash(gomock.Any(), hash).Return(expired, nil)
+				m.user.EXPECT().GetByID(gomock.Any(), 7).Return(&user.User{ID: 7, Email: "invitado@unal.edu.co"}, nil)
+			},
+			expectedErr: services.ErrInvitationClosed,
+		},
+		{
+			name: "rechaza una invitación que otra petición ya respondió",
+			mockSetup: func(m mocks) {
+				m.invitation.EXPECT().GetByTokenHash(gomock.Any(), hash).Return(pending(), nil)
+				m.user.EXPECT().GetByID(gomock.Any(), 7).Return(&user.User{ID: 7, Email: "invitado@unal.edu.co"}, nil)
+				m.txInvites.EXPECT().Respond(gomock.Any(), 3, invitation.StatusAccepted, now).Return(false, nil)
+			},
+			expectedErr: services.ErrInvitationClosed,
+		},
+		{
+			name: "retorna not found para un token desconocido",
+			mockSetup: func(m mocks) {
+				m.invitation.EXPECT().GetByTokenHash(gomock.Any(), hash).Return(nil, errs.NotFound("no encontrado"))
+			},
+			expectedErr: services.ErrInvitationNotFound,
+		},
+		{
+			name: "propaga el error al crear la membresía",
+			mockSetup: func(m mocks) {
+				m.invitation.EXPECT().GetByTokenHash(gomock.Any(), hash).Return(pending(), nil)
+				m.user.EXPECT().GetByID(gomock.Any(), 7).Return(&user.User{ID: 7, Email: "invitado@unal.edu.co"}, nil)
+				m.txInvites.EXPECT().Respond(gomock.Any(), 3, invitation.StatusAccepted, now).Return(true, nil)
+				m.txMember.EXPECT().Create(gomock.Any(), gomock.Any()).Return(errors.New("database error"))
+			},
+			expectedErr: errors.New("database error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, m := newTestService(ctrl)
+			tt.mockSetup(m)
+
+			member, err := service.AcceptInvitation(contextAs(7), "token")
+
+			if tt.expectedErr != nil {
+				assert.EqualError(t, err, tt.expectedErr.Error())
+				assert.Nil(t, member)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, 7, member.UserID)
+			}
+		})
+	}
+}
+
+func TestDeclineInvitation(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	service, m := newTestService(ctrl)
+	m.invitation.EXPECT().GetByTokenHash(gomock.Any(), auth.HashInvitationToken("token")).
+		Return(&invitation.Invitation{ID: 3, Email: "invitado@unal.edu.co", Status: invitation.StatusPending, ExpiresAt: now.Add(time.Hour)}, nil)
+	m.user.EXPECT().GetByID(gomock.Any(), 7).Return(&user.User{ID: 7, Email: "invitado@unal.edu.co"}, nil)
+	m.invitation.EXPECT().Respond(gomock.Any(), 3, invitation.StatusDeclined, now).Return(true, nil)
+
+	err := service.DeclineInvitation(contextAs(7), "token")
+
+	assert.NoError(t, err)
+}
+
+func TestGetMyInvitations(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	pending := []invitation.Invitation{{ID: 3, ProjectID: 10, Email: "invitado@unal.edu.co", Status: invitation.StatusPending, ExpiresAt: now.Add(time.Hour)}}
+
+	tests := []struct {
+		name        string
+		ctx         context.Context
+		mockSetup   func(m mocks)
+		expected    []invitation.Invitation
+		expectedErr error
+	}{
+		{
+			name: "lista las invitaciones pendientes para el email del usuario",
+			ctx:  contextAs(7),
+			mockSetup: func(m mocks) {
+				m.user.EXPECT().GetByID(gomock.Any(), 7).Return(&user.User{ID: 7, Email: "Invitado@unal.edu.co"}, nil)
+				m.invitation.EXPECT().GetPendingByEmail(gomock.Any(), "invitado@unal.edu.co", now).Return(pending, nil)
+			},
+			expected: pending,
+		},
+		{
+			name:        "retorna forbidden sin identidad",
+			ctx:         context.Background(),
+			mockSetup:   func(m mocks) {},
+			expectedErr: services.ErrForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, m := newTestService(ctrl)
+			tt.mockSetup(m)
+
+			result, err := service.GetMyInvitations(tt.ctx)
+
+			assert.ErrorIs(t, err, tt.expectedErr)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func TestAcceptInvitationByID(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	pending := func() *invitation.Invitation {
+		return &invitation.Invitation{ID: 3, ProjectID: 10, Email: "invitado@unal.edu.co", Role: project_member.RoleContributor, Status: invitation.StatusPending, ExpiresAt: now.Add(time.Hour)}
+	}
+
+	tests := []struct {
+		name        string
+		mockSetup   func(m mocks)
+		expectedErr error
+	}{
+		{
+			name: "acepta la invitación por ID y crea la membresía",
+			mockSetup: func(m mocks) {
+				m.invitation.EXPECT().GetByID(gomock.Any(), 3).Return(pending(), nil)
+				m.user.EXPECT().GetByID(gomock.Any(), 7).Return(&user.User{ID: 7, Email: "invitado@unal.edu.co"}, nil)
+				m.txInvites.EXPECT().Respond(gomock.Any(), 3, invitation.StatusAccepted, now).Return(true, nil)
+				m.txMember.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+			},
+		},
+		{
+			name: "rechaza la invitación de otro email",
+			mockSetup: func(m mocks) {
+				m.invitation.EXPECT().GetByID(gomock.Any(), 3).Return(pending(), nil)
+				m.user.EXPECT().GetByID(gomock.Any(), 7).Return(&user.User{ID: 7, Email: "otro@unal.edu.co"}, nil)
+			},
+			expectedErr: services.ErrInvitationForAnotherEmail,
+		},
+		{
+			name: "retorna not found para un ID desconocido",
+			mockSetup: func(m mocks) {
+				m.invitation.EXPECT().GetByID(gomock.Any(), 3).Return(nil, errs.NotFound("no encontrado"))
+			},
+			expectedErr: services.ErrInvitationNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, m := newTestService(ctrl)
+			tt.mockSetup(m)
+
+			member, err := service.AcceptInvitationByID(contextAs(7), 3)
+
+			assert.ErrorIs(t, err, tt.expectedErr)
+			if tt.expectedErr == nil {
+				assert.Equal(t, 7, member.UserID)
+			}
+		})
+	}
+}
+
+func TestDeclineInvitationByID(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	service, m := newTestService(ctrl)
+	m.invitation.EXPECT().GetByID(gomock.Any(), 3).
+		Return(&invitation.Invitation{ID: 3, Email: "invitado@unal.edu.co", Status: invitation.StatusPending, ExpiresAt: now.Add(time.Hour)}, nil)
+	m.user.EXPECT().GetByID(gomock.Any(), 7).Return(&user.User{ID: 7, Email: "invitado@unal.edu.co"}, nil)
+	m.invitation.EXPECT().Respond(gomock.Any(), 3, invitation.StatusDeclined, now).Return(true, nil)
+
+	err := service.DeclineInvitationByID(contextAs(7), 3)
+
+	assert.NoError(t, err)
+}
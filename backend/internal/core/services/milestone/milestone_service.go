@@ -2,41 +2,312 @@ package milestone
 
 import (
 	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"softpharos/internal/core/domain/identity"
 	"softpharos/internal/core/domain/milestone"
+	"softpharos/internal/core/domain/milestone_detail"
+	"softpharos/internal/core/domain/project_member"
+	"softpharos/internal/core/domain/query"
 	"softpharos/internal/core/ports/repository"
 	"softpharos/internal/core/ports/services"
 )
 
 type Service struct {
 	milestoneRepo repository.MilestoneRepository
+	termRepo      repository.TermRepository
+	accessService services.AccessService
+	now           func() time.Time
 }
 
-func New(milestoneRepo repository.MilestoneRepository) services.MilestoneService {
+func New(milestoneRepo repository.MilestoneRepository, termRepo repository.TermRepository, accessService services.AccessService) services.MilestoneService {
 	return &Service{
 		milestoneRepo: milestoneRepo,
+		termRepo:      termRepo,
+		accessService: accessService,
+		now:           time.Now,
 	}
 }
 
-func (s *Service) GetAllMilestones(ctx context.Context) ([]milestone.Milestone, error) {
-	return s.milestoneRepo.GetAll(ctx)
+func (s *Service) GetAllMilestones(ctx context.Context, params query.Params) (*query.Page[milestone.Milestone], error) {
+	page, err := s.milestoneRepo.GetAll(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.resolveDates(ctx, page.Items); err != nil {
+		return nil, err
+	}
+	if err := s.attachCounts(ctx, page.Items); err != nil {
+		return nil, err
+	}
+	return page, nil
 }
 
 func (s *Service) GetMilestoneByID(ctx context.Context, id int) (*milestone.Milestone, error) {
-	return s.milestoneRepo.GetByID(ctx, id)
+	m, err := s.milestoneRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	resolved := []milestone.Milestone{*m}
+	if err := s.resolveDates(ctx, resolved); err != nil {
+		return nil, err
+	}
+	return &resolved[0], nil
 }
 
 func (s *Service) GetMilestonesByProjectID(ctx context.Context, projectID int) ([]milestone.Milestone, error) {
-	return s.milestoneRepo.GetByProjectID(ctx, projectID)
+	milestones, err := s.milestoneRepo.GetByProjectID(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.resolveDates(ctx, milestones); err != nil {
+		return nil, err
+	}
+	if err := s.attachCounts(ctx, milestones); err != nil {
+		return nil, err
+	}
+	return milestones, nil
+}
+
+// GetMilestoneDetail rechaza toda la lectura si alguna relación de includes no
+// está permitida; las repetidas se cargan una sola vez
+func (s *Service) GetMilestoneDetail(ctx context.Context, id int, includes []string) (*milestone_detail.Detail, error) {
+	var unique []string
+	for _, include := range includes {
+		if !milestone_detail.IsValidInclude(include) {
+			return nil, fmt.Errorf("%w: '%s' no se puede incluir", services.ErrInvalidInclude, include)
+		}
+		if !slices.Contains(unique, include) {
+			unique = append(unique, include)
+		}
+	}
+
+	detail, err := s.milestoneRepo.GetDetail(ctx, id, unique)
+	if err != nil {
+		return nil, err
+	}
+
+	resolved := []milestone.Milestone{detail.Milestone}
+	if err := s.resolveDates(ctx, resolved); err != nil {
+		return nil, err
+	}
+	detail.Milestone = resolved[0]
+	return detail, nil
 }
 
 func (s *Service) CreateMilestone(ctx context.Context, m *milestone.Milestone) error {
-	return s.milestoneRepo.Create(ctx, m)
+	if err := s.accessService.RequireProjectAbility(ctx, m.ProjectID, project_member.AbilityEditMilestones); err != nil {
+		return err
+	}
+	if err := s.checkClassWeek(ctx, m); err != nil {
+		return err
+	}
+	m.Status, m.StatusChangedAt = milestone.StatusPlanned, nil
+	if err := s.milestoneRepo.Create(ctx, m); err != nil {
+		return err
+	}
+	m.Overdue = m.IsOverdue(milestone.Today(s.now()))
+	return nil
 }
 
 func (s *Service) UpdateMilestone(ctx context.Context, m *milestone.Milestone) error {
-	return s.milestoneRepo.Update(ctx, m)
+	if err := s.accessService.RequireMilestoneAbility(ctx, m.ID, project_member.AbilityEditMilestones); err != nil {
+		return err
+	}
+	if err := s.checkClassWeek(ctx, m); err != nil {
+		return err
+	}
+	if err := s.milestoneRepo.Update(ctx, m); err != nil {
+		return err
+	}
+	m.Overdue = m.IsOverdue(milestone.Today(s.now()))
+	return nil
 }
 
 func (s *Service) DeleteMilestone(ctx context.Context, id int) error {
+	if err := s.accessService.RequireMilestoneAbility(ctx, id, project_member.AbilityEditMilestones); err != nil {
+		return err
+	}
 	return s.milestoneRepo.Delete(ctx, id)
 }
+
+func (s *Service) GetTrashedMilestonesByProjectID(ctx context.Context, projectID int) ([]milestone.Milestone, error) {
+	if err := s.accessService.RequireProjectOwner(ctx, projectID); err != nil {
+		return nil, err
+	}
+	milestones, err := s.milestoneRepo.GetTrashedByProjectID(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.resolveDates(ctx, milestones); err != nil {
+		return nil, err
+	}
+	return milestones, nil
+}
+
+// RestoreMilestone saca un hito de la papelera. Solo el dueño del proyecto o
+// un administrador pueden hacerlo, y el proyecto debe seguir vigente.
+func (s *Service) RestoreMilestone(ctx context.Context, id int) error {
+	m, err := s.milestoneRepo.GetTrashedByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if err := s.accessService.RequireProjectOwner(ctx, m.ProjectID); err != nil {
+		return err
+	}
+	return s.milestoneRepo.Restore(ctx, id)
+}
+
+func (s *Service) GetOverdueMilestonesByProjectID(ctx context.Context, projectID int) ([]milestone.Milestone, error) {
+	milestones, err := s.milestoneRepo.GetOverdueByProjectID(ctx, projectID, milestone.Today(s.now()))
+	if err != nil {
+		return nil, err
+	}
+	if err := s.resolveDates(ctx, milestones); err != nil {
+		return nil, err
+	}
+	return milestones, nil
+}
+
+// TransitionMilestone avanza el hito al estado status. Iniciarlo requiere poder
+// editar los hitos del proyecto y entregarlo poder subir entregables; revisarlo
+// queda para los profesores de la sección del proyecto. El cambio solo se aplica
+// si nadie cambió el estado entre la lectura y la escritura.
+func (s *Service) TransitionMilestone(ctx context.Context, id int, status string) (*milestone.Milestone, error) {
+	m, err := s.milestoneRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !m.CanTransitionTo(status) {
+		return nil, fmt.Errorf("%w: el hito está en estado %s", services.ErrInvalidStatusTransition, m.Status)
+	}
+	if err := s.requireTransition(ctx, m, status); err != nil {
+		return nil, err
+	}
+
+	changedAt := s.now()
+	changed, err := s.milestoneRepo.UpdateStatus(ctx, id, m.Status, status, changedAt)
+	if err != nil {
+		return nil, err
+	}
+	if !changed {
+		return nil, services.ErrInvalidStatusTransition
+	}
+	m.Status, m.StatusChangedAt = status, &changedAt
+
+	resolved := []milestone.Milestone{*m}
+	if err := s.resolveDates(ctx, resolved); err != nil {
+		return nil, err
+	}
+	return &resolved[0], nil
+}
+
+func (s *Service) requireTransition(ctx context.Context, m *milestone.Milestone, status string) error {
+	switch status {
+	case milestone.StatusInProgress:
+		return s.accessService.RequireProjectAbility(ctx, m.ProjectID, project_member.AbilityEditMilestones)
+	case milestone.StatusSubmitted:
+		return s.accessService.RequireProjectAbility(ctx, m.ProjectID, project_member.AbilityUploadDeliverables)
+	}
+	// Sin sección no hay profesores asignados que puedan revisarlo
+	if m.Project == nil || m.Project.SectionID == nil {
+		if id, ok := identity.FromContext(ctx); ok && id.IsAdmin() {
+			return nil
+		}
+		return services.ErrReviewWithoutSection
+	}
+	return s.accessService.RequireSectionProfessor(ctx, *m.Project.SectionID)
+}
+
+// checkClassWeek rechaza una semana de clase que no exista en el calendario
+// del periodo del proyecto y, si existe, completa sus fechas. Los proyectos
+// sin sección no tienen calendario, así que solo se exige que sea positiva.
+func (s *Service) checkClassWeek(ctx context.Context, m *milestone.Milestone) error {
+	m.WeekStart, m.WeekEnd = nil, nil
+	if m.ClassWeek == nil {
+		return nil
+	}
+	if *m.ClassWeek < 1 {
+		return services.ErrInvalidClassWeek
+	}
+
+	terms, err := s.termRepo.GetByProjects(ctx, []int{m.ProjectID})
+	if err != nil {
+		return err
+	}
+	t := terms[m.ProjectID]
+	if t == nil {
+		return nil
+	}
+
+	week, ok := t.Week(*m.ClassWeek)
+	if !ok {
+		return fmt.Errorf("%w: el periodo %s tiene %d semanas de clase", services.ErrInvalidClassWeek, t.Code, t.ClassWeeks())
+	}
+	m.WeekStart, m.WeekEnd = &week.Start, &week.End
+	return nil
+}
+
+// resolveDates marca los hitos vencidos a la fecha del servicio y completa las
+// fechas de su semana de clase con una sola consulta de periodos para todos
+// sus proyectos
+func (s *Service) resolveDates(ctx context.Context, milestones []milestone.Milestone) error {
+	today := milestone.Today(s.now())
+	for i := range milestones {
+		milestones[i].Overdue = milestones[i].IsOverdue(today)
+	}
+
+	var projectIDs []int
+	seen := make(map[int]bool)
+	for _, m := range milestones {
+		if m.ClassWeek != nil && !seen[m.ProjectID] {
+			seen[m.ProjectID] = true
+			projectIDs = append(projectIDs, m.ProjectID)
+		}
+	}
+	if len(projectIDs) == 0 {
+		return nil
+	}
+
+	terms, err := s.termRepo.GetByProjects(ctx, projectIDs)
+	if err != nil {
+		return err
+	}
+
+	for i := range milestones {
+		m := &milestones[i]
+		t := terms[m.ProjectID]
+		if m.ClassWeek == nil || t == nil {
+			continue
+		}
+		if week, ok := t.Week(*m.ClassWeek); ok {
+			m.WeekStart, m.WeekEnd = &week.Start, &week.End
+		}
+	}
+	return nil
+}
+
+// attachCounts completa el resumen de contenido de los hitos de un listado con
+// una consulta por tipo de contenido para toda la página
+func (s *Service) attachCounts(ctx context.Context, milestones []milestone.Milestone) error {
+	if len(milestones) == 0 {
+		return nil
+	}
+
+	ids := make([]int, len(milestones))
+	for i, m := range milestones {
+		ids[i] = m.ID
+	}
+
+	counts, err := s.milestoneRepo.GetCounts(ctx, ids)
+	if err != nil {
+		return err
+	}
+	for i := range milestones {
+		milestones[i].Counts = counts[milestones[i].ID]
+	}
+	return nil
+}
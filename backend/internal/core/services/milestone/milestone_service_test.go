@@ -3,8 +3,18 @@ package milestone
 import (
 	"context"
 	"errors"
+	"softpharos/internal/core/domain/comment"
+	"softpharos/internal/core/domain/identity"
 	"softpharos/internal/core/domain/milestone"
+	"softpharos/internal/core/domain/milestone_detail"
+	"softpharos/internal/core/domain/project"
+	"softpharos/internal/core/domain/project_member"
+	"softpharos/internal/core/domain/query"
+	"softpharos/internal/core/domain/role"
+	"softpharos/internal/core/domain/term"
+	"softpharos/internal/core/ports/services"
 	mockRepo "softpharos/mocks/core/ports/repository"
+	mockService "softpharos/mocks/core/ports/services"
 	"testing"
 	"time"
 
@@ -19,6 +29,7 @@ func TestGetAllMilestones(t *testing.T) {
 	title1 := "Milestone 1"
 	title2 := "Milestone 2"
 	now := time.Now()
+	counts := &milestone.Counts{Comments: 2, Reactions: map[string]int{"like": 1}, HasFeedback: true}
 
 	tests := []struct {
 		name               string
@@ -30,15 +41,18 @@ func TestGetAllMilestones(t *testing.T) {
 			name: "retorna milestones exitosamente",
 			mockSetup: func(m *mockRepo.MockMilestoneRepository) {
 				m.EXPECT().
-					GetAll(gomock.Any()).
-					Return([]milestone.Milestone{
+					GetAll(gomock.Any(), gomock.Any()).
+					Return(&query.Page[milestone.Milestone]{Items: []milestone.Milestone{
 						{ID: 1, ProjectID: 1, Title: &title1, CreatedAt: now},
 						{ID: 2, ProjectID: 1, Title: &title2, CreatedAt: now},
-					}, nil)
+					}}, nil)
+				m.EXPECT().
+					GetCounts(gomock.Any(), []int{1, 2}).
+					Return(map[int]*milestone.Counts{1: counts, 2: {Reactions: map[string]int{}}}, nil)
 			},
 			expectedMilestones: []milestone.Milestone{
-				{ID: 1, ProjectID: 1, Title: &title1, CreatedAt: now},
-				{ID: 2, ProjectID: 1, Title: &title2, CreatedAt: now},
+				{ID: 1, ProjectID: 1, Title: &title1, CreatedAt: now, Counts: counts},
+				{ID: 2, ProjectID: 1, Title: &title2, CreatedAt: now, Counts: &milestone.Counts{Reactions: map[string]int{}}},
 			},
 			expectedErr: nil,
 		},
@@ -46,10 +60,10 @@ func TestGetAllMilestones(t *testing.T) {
 			name: "retorna error cuando el repositorio falla",
 			mockSetup: func(m *mockRepo.MockMilestoneRepository) {
 				m.EXPECT().
-					GetAll(gomock.Any()).
-					Return([]milestone.Milestone{}, errors.New("database error"))
+					GetAll(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("database error"))
 			},
-			expectedMilestones: []milestone.Milestone{},
+			expectedMilestones: nil,
 			expectedErr:        errors.New("database error"),
 		},
 	}
@@ -59,12 +73,16 @@ func TestGetAllMilestones(t *testing.T) {
 			mockRepository := mockRepo.NewMockMilestoneRepository(ctrl)
 			tt.mockSetup(mockRepository)
 
-			service := New(mockRepository)
+			service := New(mockRepository, mockRepo.NewMockTermRepository(ctrl), mockService.NewMockAccessService(ctrl))
 			ctx := context.Background()
 
-			result, err := service.GetAllMilestones(ctx)
+			result, err := service.GetAllMilestones(ctx, query.Params{})
 
-			assert.Equal(t, tt.expectedMilestones, result)
+			if tt.expectedErr == nil {
+				assert.Equal(t, tt.expectedMilestones, result.Items)
+			} else {
+				assert.Nil(t, result)
+			}
 			if tt.expectedErr != nil {
 				assert.EqualError(t, err, tt.expectedErr.Error())
 			} else {
@@ -117,7 +135,7 @@ func TestGetMilestoneByID(t *testing.T) {
 			mockRepository := mockRepo.NewMockMilestoneRepository(ctrl)
 			tt.mockSetup(mockRepository)
 
-			service := New(mockRepository)
+			service := New(mockRepository, mockRepo.NewMockTermRepository(ctrl), mockService.NewMockAccessService(ctrl))
 			ctx := context.Background()
 
 			result, err := service.GetMilestoneByID(ctx, tt.milestoneID)
@@ -157,10 +175,13 @@ func TestGetMilestonesByProjectID(t *testing.T) {
 						{ID: 1, ProjectID: 1, Title: &title1, CreatedAt: now},
 						{ID: 2, ProjectID: 1, Title: &title2, CreatedAt: now},
 					}, nil)
+				m.EXPECT().
+					GetCounts(gomock.Any(), []int{1, 2}).
+					Return(map[int]*milestone.Counts{1: {Deliverables: 1}, 2: {}}, nil)
 			},
 			expectedMilestones: []milestone.Milestone{
-				{ID: 1, ProjectID: 1, Title: &title1, CreatedAt: now},
-				{ID: 2, ProjectID: 1, Title: &title2, CreatedAt: now},
+				{ID: 1, ProjectID: 1, Title: &title1, CreatedAt: now, Counts: &milestone.Counts{Deliverables: 1}},
+				{ID: 2, ProjectID: 1, Title: &title2, CreatedAt: now, Counts: &milestone.Counts{}},
 			},
 			expectedErr: nil,
 		},
@@ -172,7 +193,7 @@ func TestGetMilestonesByProjectID(t *testing.T) {
 					GetByProjectID(gomock.Any(), 1).
 					Return([]milestone.Milestone{}, errors.New("database error"))
 			},
-			expectedMilestones: []milestone.Milestone{},
+			expectedMilestones: nil,
 			expectedErr:        errors.New("database error"),
 		},
 	}
@@ -182,7 +203,7 @@ func TestGetMilestonesByProjectID(t *testing.T) {
 			mockRepository := mockRepo.NewMockMilestoneRepository(ctrl)
 			tt.mockSetup(mockRepository)
 
-			service := New(mockRepository)
+			service := New(mockRepository, mockRepo.NewMockTermRepository(ctrl), mockService.NewMockAccessService(ctrl))
 			ctx := context.Background()
 
 			result, err := service.GetMilestonesByProjectID(ctx, tt.projectID)
@@ -206,6 +227,7 @@ func TestCreateMilestone(t *testing.T) {
 	tests := []struct {
 		name        string
 		milestone   *milestone.Milestone
+		accessErr   error
 		mockSetup   func(*mockRepo.MockMilestoneRepository)
 		expectedErr error
 	}{
@@ -229,6 +251,13 @@ func TestCreateMilestone(t *testing.T) {
 			},
 			expectedErr: errors.New("database error"),
 		},
+		{
+			name:        "retorna forbidden cuando el usuario no es miembro del proyecto",
+			milestone:   &milestone.Milestone{ProjectID: 1, Title: &title},
+			accessErr:   services.ErrForbidden,
+			mockSetup:   func(m *mockRepo.MockMilestoneRepository) {},
+			expectedErr: services.ErrForbidden,
+		},
 	}
 
 	for _, tt := range tests {
@@ -236,7 +265,12 @@ func TestCreateMilestone(t *testing.T) {
 			mockRepository := mockRepo.NewMockMilestoneRepository(ctrl)
 			tt.mockSetup(mockRepository)
 
-			service := New(mockRepository)
+			mockAccess := mockService.NewMockAccessService(ctrl)
+			mockAccess.EXPECT().
+				RequireProjectAbility(gomock.Any(), tt.milestone.ProjectID, project_member.AbilityEditMilestones).
+				Return(tt.accessErr)
+
+			service := New(mockRepository, mockRepo.NewMockTermRepository(ctrl), mockAccess)
 			ctx := context.Background()
 
 			err := service.CreateMilestone(ctx, tt.milestone)
@@ -245,11 +279,110 @@ func TestCreateMilestone(t *testing.T) {
 				assert.EqualError(t, err, tt.expectedErr.Error())
 			} else {
 				assert.NoError(t, err)
+				assert.Equal(t, milestone.StatusPlanned, tt.milestone.Status)
+			}
+		})
+	}
+}
+
+func TestCreateMilestoneClassWeek(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	start := time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)
+	calendar := &term.Term{Code: "2026-1", StartDate: start, EndDate: start.AddDate(0, 0, 27)}
+	week := func(n int) *int { return &n }
+
+	tests := []struct {
+		name          string
+		classWeek     *int
+		terms         map[int]*term.Term
+		expectLookup  bool
+		expectCreate  bool
+		expectedStart *time.Time
+		expectedErr   error
+	}{
+		{
+			name:          "completa las fechas de la semana",
+			classWeek:     week(2),
+			terms:         map[int]*term.Term{1: calendar},
+			expectLookup:  true,
+			expectCreate:  true,
+			expectedStart: func() *time.Time { d := start.AddDate(0, 0, 7); return &d }(),
+		},
+		{
+			name:         "rechaza una semana posterior al periodo",
+			classWeek:    week(5),
+			terms:        map[int]*term.Term{1: calendar},
+			expectLookup: true,
+			expectedErr:  services.ErrInvalidClassWeek,
+		},
+		{
+			name:         "acepta la semana si el proyecto no tiene periodo",
+			classWeek:    week(30),
+			terms:        map[int]*term.Term{},
+			expectLookup: true,
+			expectCreate: true,
+		},
+		{
+			name:        "rechaza la semana cero sin consultar el periodo",
+			classWeek:   week(0),
+			expectedErr: services.ErrInvalidClassWeek,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := &milestone.Milestone{ProjectID: 1, ClassWeek: tt.classWeek}
+
+			mockRepository := mockRepo.NewMockMilestoneRepository(ctrl)
+			if tt.expectCreate {
+				mockRepository.EXPECT().Create(gomock.Any(), m).Return(nil)
+			}
+			termRepo := mockRepo.NewMockTermRepository(ctrl)
+			if tt.expectLookup {
+				termRepo.EXPECT().GetByProjects(gomock.Any(), []int{1}).Return(tt.terms, nil)
 			}
+			mockAccess := mockService.NewMockAccessService(ctrl)
+			mockAccess.EXPECT().RequireProjectAbility(gomock.Any(), 1, project_member.AbilityEditMilestones).Return(nil)
+
+			err := New(mockRepository, termRepo, mockAccess).CreateMilestone(context.Background(), m)
+
+			assert.ErrorIs(t, err, tt.expectedErr)
+			assert.Equal(t, tt.expectedStart, m.WeekStart)
 		})
 	}
 }
 
+func TestGetMilestonesByProjectIDResolvesWeeks(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	start := time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)
+	calendar := &term.Term{Code: "2026-1", StartDate: start, EndDate: start.AddDate(0, 3, 0)}
+	first, third := 1, 3
+
+	mockRepository := mockRepo.NewMockMilestoneRepository(ctrl)
+	mockRepository.EXPECT().GetByProjectID(gomock.Any(), 1).Return([]milestone.Milestone{
+		{ID: 1, ProjectID: 1, ClassWeek: &first},
+		{ID: 2, ProjectID: 1},
+		{ID: 3, ProjectID: 1, ClassWeek: &third},
+	}, nil)
+	mockRepository.EXPECT().GetCounts(gomock.Any(), []int{1, 2, 3}).Return(map[int]*milestone.Counts{}, nil)
+	termRepo := mockRepo.NewMockTermRepository(ctrl)
+	termRepo.EXPECT().GetByProjects(gomock.Any(), []int{1}).Return(map[int]*term.Term{1: calendar}, nil).Times(1)
+
+	result, err := New(mockRepository, termRepo, mockService.NewMockAccessService(ctrl)).GetMilestonesByProjectID(context.Background(), 1)
+
+	assert.NoError(t, err)
+	if assert.Len(t, result, 3) {
+		assert.Equal(t, start, *result[0].WeekStart)
+		assert.Equal(t, start.AddDate(0, 0, 6), *result[0].WeekEnd)
+		assert.Nil(t, result[1].WeekStart)
+		assert.Equal(t, start.AddDate(0, 0, 14), *result[2].WeekStart)
+	}
+}
+
 func TestUpdateMilestone(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -259,6 +392,7 @@ func TestUpdateMilestone(t *testing.T) {
 	tests := []struct {
 		name        string
 		milestone   *milestone.Milestone
+		accessErr   error
 		mockSetup   func(*mockRepo.MockMilestoneRepository)
 		expectedErr error
 	}{
@@ -282,6 +416,13 @@ func TestUpdateMilestone(t *testing.T) {
 			},
 			expectedErr: errors.New("database error"),
 		},
+		{
+			name:        "retorna forbidden cuando el usuario no es miembro del proyecto",
+			milestone:   &milestone.Milestone{ID: 1, ProjectID: 1, Title: &title},
+			accessErr:   services.ErrForbidden,
+			mockSetup:   func(m *mockRepo.MockMilestoneRepository) {},
+			expectedErr: services.ErrForbidden,
+		},
 	}
 
 	for _, tt := range tests {
@@ -289,7 +430,12 @@ func TestUpdateMilestone(t *testing.T) {
 			mockRepository := mockRepo.NewMockMilestoneRepository(ctrl)
 			tt.mockSetup(mockRepository)
 
-			service := New(mockRepository)
+			mockAccess := mockService.NewMockAccessService(ctrl)
+			mockAccess.EXPECT().
+				RequireMilestoneAbility(gomock.Any(), tt.milestone.ID, project_member.AbilityEditMilestones).
+				Return(tt.accessErr)
+
+			service := New(mockRepository, mockRepo.NewMockTermRepository(ctrl), mockAccess)
 			ctx := context.Background()
 
 			err := service.UpdateMilestone(ctx, tt.milestone)
@@ -310,6 +456,7 @@ func TestDeleteMilestone(t *testing.T) {
 	tests := []struct {
 		name        string
 		milestoneID int
+		accessErr   error
 		mockSetup   func(*mockRepo.MockMilestoneRepository)
 		expectedErr error
 	}{
@@ -333,6 +480,13 @@ func TestDeleteMilestone(t *testing.T) {
 			},
 			expectedErr: errors.New("database error"),
 		},
+		{
+			name:        "retorna forbidden cuando el usuario no es miembro del proyecto",
+			milestoneID: 1,
+			accessErr:   services.ErrForbidden,
+			mockSetup:   func(m *mockRepo.MockMilestoneRepository) {},
+			expectedErr: services.ErrForbidden,
+		},
 	}
 
 	for _, tt := range tests {
@@ -340,7 +494,12 @@ func TestDeleteMilestone(t *testing.T) {
 			mockRepository := mockRepo.NewMockMilestoneRepository(ctrl)
 			tt.mockSetup(mockRepository)
 
-			service := New(mockRepository)
+			mockAccess := mockService.NewMockAccessService(ctrl)
+			mockAccess.EXPECT().
+				RequireMilestoneAbility(gomock.Any(), tt.milestoneID, project_member.AbilityEditMilestones).
+				Return(tt.accessErr)
+
+			service := New(mockRepository, mockRepo.NewMockTermRepository(ctrl), mockAccess)
 			ctx := context.Background()
 
 			err := service.DeleteMilestone(ctx, tt.milestoneID)
@@ -353,3 +512,267 @@ func TestDeleteMilestone(t *testing.T) {
 		})
 	}
 }
+
+func TestRestoreMilestone(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	deletedAt := time.Now()
+	trashed := &milestone.Milestone{ID: 3, ProjectID: 1, DeletedAt: &deletedAt}
+
+	tests := []struct {
+		name        string
+		mockSetup   func(*mockRepo.MockMilestoneRepository, *mockService.MockAccessService)
+		expectedErr error
+	}{
+		{
+			name: "restaura milestone exitosamente",
+			mockSetup: func(m *mockRepo.MockMilestoneRepository, a *mockService.MockAccessService) {
+				m.EXPECT().GetTrashedByID(gomock.Any(), 3).Return(trashed, nil)
+				a.EXPECT().RequireProjectOwner(gomock.Any(), 1).Return(nil)
+				m.EXPECT().Restore(gomock.Any(), 3).Return(nil)
+			},
+		},
+		{
+			name: "retorna forbidden cuando el usuario no es dueño del proyecto",
+			mockSetup: func(m *mockRepo.MockMilestoneRepository, a *mockService.MockAccessService) {
+				m.EXPECT().GetTrashedByID(gomock.Any(), 3).Return(trashed, nil)
+				a.EXPECT().RequireProjectOwner(gomock.Any(), 1).Return(services.ErrForbidden)
+			},
+			expectedErr: services.ErrForbidden,
+		},
+		{
+			name: "retorna error cuando el milestone no está en la papelera",
+			mockSetup: func(m *mockRepo.MockMilestoneRepository, a *mockService.MockAccessService) {
+				m.EXPECT().GetTrashedByID(gomock.Any(), 3).Return(nil, errors.New("not found"))
+			},
+			expectedErr: errors.New("not found"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepository := mockRepo.NewMockMilestoneRepository(ctrl)
+			mockAccess := mockService.NewMockAccessService(ctrl)
+			tt.mockSetup(mockRepository, mockAccess)
+
+			service := New(mockRepository, mockRepo.NewMockTermRepository(ctrl), mockAccess)
+
+			err := service.RestoreMilestone(context.Background(), 3)
+
+			if tt.expectedErr != nil {
+				assert.EqualError(t, err, tt.expectedErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestTransitionMilestone(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	now := time.Date(2026, 5, 10, 15, 0, 0, 0, time.UTC)
+	sectionID := 3
+	forbidden := errors.New("forbidden")
+
+	tests := []struct {
+		name        string
+		ctx         context.Context
+		current     milestone.Milestone
+		status      string
+		accessSetup func(*mockService.MockAccessService)
+		expectWrite bool
+		changed     bool
+		expectedErr error
+	}{
+		{
+			name:    "inicia un hito planeado",
+			current: milestone.Milestone{ID: 4, ProjectID: 1, Status: milestone.StatusPlanned},
+			status:  milestone.StatusInProgress,
+			accessSetup: func(m *mockService.MockAccessService) {
+				m.EXPECT().RequireProjectAbility(gomock.Any(), 1, project_member.AbilityEditMilestones).Return(nil)
+			},
+			expectWrite: true,
+			changed:     true,
+		},
+		{
+			name:    "entrega un hito con permiso de subir entregables",
+			current: milestone.Milestone{ID: 4, ProjectID: 1, Status: milestone.StatusInProgress},
+			status:  milestone.StatusSubmitted,
+			accessSetup: func(m *mockService.MockAccessService) {
+				m.EXPECT().RequireProjectAbility(gomock.Any(), 1, project_member.AbilityUploadDeliverables).Return(nil)
+			},
+			expectWrite: true,
+			changed:     true,
+		},
+		{
+			name:    "la revisión exige ser profesor de la sección",
+			current: milestone.Milestone{ID: 4, ProjectID: 1, Project: &project.Project{ID: 1, SectionID: &sectionID}, Status: milestone.StatusSubmitted},
+			status:  milestone.StatusReviewed,
+			accessSetup: func(m *mockService.MockAccessService) {
+				m.EXPECT().RequireSectionProfessor(gomock.Any(), sectionID).Return(forbidden)
+			},
+			expectedErr: forbidden,
+		},
+		{
+			name:        "un administrador revisa un hito de un proyecto sin sección",
+			ctx:         identity.NewContext(context.Background(), &identity.Identity{UserID: 1, Role: role.Admin}),
+			current:     milestone.Milestone{ID: 4, ProjectID: 1, Project: &project.Project{ID: 1}, Status: milestone.StatusSubmitted},
+			status:      milestone.StatusReviewed,
+			expectWrite: true,
+			changed:     true,
+		},
+		{
+			name:        "un profesor no revisa un hito de un proyecto sin sección",
+			ctx:         identity.NewContext(context.Background(), &identity.Identity{UserID: 8, Role: role.Professor}),
+			current:     milestone.Milestone{ID: 4, ProjectID: 1, Project: &project.Project{ID: 1}, Status: milestone.StatusSubmitted},
+			status:      milestone.StatusReviewed,
+			expectedErr: services.ErrReviewWithoutSection,
+		},
+		{
+			name:        "rechaza saltarse un estado",
+			current:     milestone.Milestone{ID: 4, ProjectID: 1, Status: milestone.StatusPlanned},
+			status:      milestone.StatusSubmitted,
+			expectedErr: services.ErrInvalidStatusTransition,
+		},
+		{
+			name:        "rechaza volver a un estado anterior",
+			current:     milestone.Milestone{ID: 4, ProjectID: 1, Status: milestone.StatusReviewed},
+			status:      milestone.StatusInProgress,
+			expectedErr: services.ErrInvalidStatusTransition,
+		},
+		{
+			name:    "rechaza el cambio si otro usuario cambió el estado antes",
+			current: milestone.Milestone{ID: 4, ProjectID: 1, Status: milestone.StatusPlanned},
+			status:  milestone.StatusInProgress,
+			accessSetup: func(m *mockService.MockAccessService) {
+				m.EXPECT().RequireProjectAbility(gomock.Any(), 1, project_member.AbilityEditMilestones).Return(nil)
+			},
+			expectWrite: true,
+			expectedErr: services.ErrInvalidStatusTransition,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			current := tt.current
+			mockRepository := mockRepo.NewMockMilestoneRepository(ctrl)
+			mockRepository.EXPECT().GetByID(gomock.Any(), 4).Return(&current, nil)
+			if tt.expectWrite {
+				mockRepository.EXPECT().UpdateStatus(gomock.Any(), 4, tt.current.Status, tt.status, now).Return(tt.changed, nil)
+			}
+			mockAccess := mockService.NewMockAccessService(ctrl)
+			if tt.accessSetup != nil {
+				tt.accessSetup(mockAccess)
+			}
+
+			service := New(mockRepository, mockRepo.NewMockTermRepository(ctrl), mockAccess)
+			service.(*Service).now = func() time.Time { return now }
+
+			ctx := tt.ctx
+			if ctx == nil {
+				ctx = context.Background()
+			}
+			result, err := service.TransitionMilestone(ctx, 4, tt.status)
+
+			assert.ErrorIs(t, err, tt.expectedErr)
+			if tt.expectedErr == nil {
+				assert.Equal(t, tt.status, result.Status)
+				assert.Equal(t, now, *result.StatusChangedAt)
+			}
+		})
+	}
+}
+
+func TestGetOverdueMilestonesByProjectID(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	now := time.Date(2026, 5, 10, 23, 0, 0, 0, time.UTC)
+	dueDate := time.Date(2026, 5, 9, 0, 0, 0, 0, time.UTC)
+	overdue := []milestone.Milestone{{ID: 2, ProjectID: 1, DueDate: &dueDate, Status: milestone.StatusInProgress}}
+
+	mockRepository := mockRepo.NewMockMilestoneRepository(ctrl)
+	mockRepository.EXPECT().
+		GetOverdueByProjectID(gomock.Any(), 1, time.Date(2026, 5, 10, 0, 0, 0, 0, time.UTC)).
+		Return(overdue, nil)
+
+	service := New(mockRepository, mockRepo.NewMockTermRepository(ctrl), mockService.NewMockAccessService(ctrl))
+	service.(*Service).now = func() time.Time { return now }
+
+	result, err := service.GetOverdueMilestonesByProjectID(context.Background(), 1)
+
+	assert.NoError(t, err)
+	if assert.Len(t, result, 1) {
+		assert.True(t, result[0].Overdue)
+	}
+}
+
+func TestGetMilestonesByProjectIDMarksOverdue(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// A las 23:00 del 10 de mayo, un hito que vence ese día aún no está vencido
+	now := time.Date(2026, 5, 10, 23, 0, 0, 0, time.UTC)
+	yesterday := time.Date(2026, 5, 9, 0, 0, 0, 0, time.UTC)
+	today := time.Date(2026, 5, 10, 0, 0, 0, 0, time.UTC)
+
+	mockRepository := mockRepo.NewMockMilestoneRepository(ctrl)
+	mockRepository.EXPECT().GetByProjectID(gomock.Any(), 1).Return([]milestone.Milestone{
+		{ID: 1, ProjectID: 1, DueDate: &yesterday, Status: milestone.StatusInProgress},
+		{ID: 2, ProjectID: 1, DueDate: &yesterday, Status: milestone.StatusSubmitted},
+		{ID: 3, ProjectID: 1, DueDate: &today, Status: milestone.StatusPlanned},
+		{ID: 4, ProjectID: 1, Status: milestone.StatusPlanned},
+	}, nil)
+	mockRepository.EXPECT().GetCounts(gomock.Any(), []int{1, 2, 3, 4}).Return(map[int]*milestone.Counts{}, nil)
+
+	service := New(mockRepository, mockRepo.NewMockTermRepository(ctrl), mockService.NewMockAccessService(ctrl))
+	service.(*Service).now = func() time.Time { return now }
+
+	result, err := service.GetMilestonesByProjectID(context.Background(), 1)
+
+	assert.NoError(t, err)
+	if assert.Len(t, result, 4) {
+		assert.True(t, result[0].Overdue)
+		assert.False(t, result[1].Overdue)
+		assert.False(t, result[2].Overdue)
+		assert.False(t, result[3].Overdue)
+	}
+}
+
+func TestGetMilestoneDetail(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	detail := &milestone_detail.Detail{Milestone: milestone.Milestone{ID: 4, ProjectID: 1}, Comments: []comment.Comment{}}
+
+	tests := []struct {
+		name        string
+		includes    []string
+		expected    []string
+		expectedErr error
+	}{
+		{name: "carga las relaciones pedidas una sola vez", includes: []string{"comments", "reactions", "comments"}, expected: []string{"comments", "reactions"}},
+		{name: "sin includes solo carga el hito", includes: nil, expected: nil},
+		{name: "rechaza una relación fuera de la lista permitida", includes: []string{"comments", "project"}, expectedErr: services.ErrInvalidInclude},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepository := mockRepo.NewMockMilestoneRepository(ctrl)
+			if tt.expectedErr == nil {
+				mockRepository.EXPECT().GetDetail(gomock.Any(), 4, tt.expected).Return(detail, nil)
+			}
+
+			service := New(mockRepository, mockRepo.NewMockTermRepository(ctrl), mockService.NewMockAccessService(ctrl))
+			result, err := service.GetMilestoneDetail(context.Background(), 4, tt.includes)
+
+			assert.ErrorIs(t, err, tt.expectedErr)
+			if tt.expectedErr == nil {
+				assert.Equal(t, detail, result)
+			}
+		})
+	}
+}
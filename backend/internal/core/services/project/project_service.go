@@ -2,23 +2,43 @@ package project
 
 import (
 	"context"
+	"errors"
+	"time"
+
+	"softpharos/internal/core/domain/identity"
 	"softpharos/internal/core/domain/project"
+	"softpharos/internal/core/domain/project_member"
+	"softpharos/internal/core/domain/query"
+	"softpharos/internal/core/errs"
 	"softpharos/internal/core/ports/repository"
 	"softpharos/internal/core/ports/services"
 )
 
 type Service struct {
-	projectRepo repository.ProjectRepository
+	projectRepo       repository.ProjectRepository
+	projectMemberRepo repository.ProjectMemberRepository
+	accessService     services.AccessService
+	unitOfWork        repository.UnitOfWork
+	now               func() time.Time
 }
 
-func New(projectRepo repository.ProjectRepository) services.ProjectService {
+func New(
+	projectRepo repository.ProjectRepository,
+	projectMemberRepo repository.ProjectMemberRepository,
+	accessService services.AccessService,
+	unitOfWork repository.UnitOfWork,
+) services.ProjectService {
 	return &Service{
-		projectRepo: projectRepo,
+		projectRepo:       projectRepo,
+		projectMemberRepo: projectMemberRepo,
+		accessService:     accessService,
+		unitOfWork:        unitOfWork,
+		now:               time.Now,
 	}
 }
 
-func (s *Service) GetAllProjects(ctx context.Context) ([]project.Project, error) {
-	return s.projectRepo.GetAll(ctx)
+func (s *Service) GetAllProjects(ctx context.Context, params query.Params) (*query.Page[project.Project], error) {
+	return s.projectRepo.GetAll(ctx, params)
 }
 
 func (s *Service) GetProjectByID(ctx context.Context, id int) (*project.Project, error) {
@@ -29,14 +49,169 @@ func (s *Service) GetProjectsByOwner(ctx context.Context, ownerID int) ([]projec
 	return s.projectRepo.GetByOwner(ctx, ownerID)
 }
 
+// GetMyProjects lista los proyectos del usuario autenticado, propios o en
+// los que es miembro, con su rol en cada uno
+func (s *Service) GetMyProjects(ctx context.Context, params query.Params) (*query.Page[project.MemberProject], error) {
+	caller, ok := identity.FromContext(ctx)
+	if !ok {
+		return nil, services.ErrForbidden
+	}
+
+	if status, ok := params.Filters["status"]; ok && status != project.StatusActive && status != project.StatusArchived {
+		return nil, services.ErrInvalidProjectStatus
+	}
+	if role, ok := params.Filters["role"].(string); ok && !project_member.IsValidRole(role) {
+		return nil, services.ErrInvalidProjectRole
+	}
+
+	return s.projectRepo.GetByMember(ctx, caller.UserID, params)
+}
+
+// CreateProject crea el proyecto y registra a su creador como miembro owner
+// en la misma transacción. Solo los inscritos en una sección pueden
+// vincularle el proyecto.
 func (s *Service) CreateProject(ctx context.Context, proj *project.Project) error {
-	return s.projectRepo.Create(ctx, proj)
+	if proj.SectionID != nil {
+		if err := s.accessService.RequireSectionMember(ctx, *proj.SectionID); err != nil {
+			return err
+		}
+	}
+
+	return s.unitOfWork.Do(ctx, func(repos repository.Repositories) error {
+		if err := repos.Projects.Create(ctx, proj); err != nil {
+			return err
+		}
+
+		return repos.ProjectMembers.Create(ctx, &project_member.ProjectMember{
+			ProjectID: proj.ID,
+			UserID:    proj.CreatedBy,
+			Role:      project_member.RoleOwner,
+		})
+	})
 }
 
+// UpdateProject actualiza el proyecto si el rol del usuario lo permite (owner o
+// maintainer); si cambia de sección, el usuario debe estar inscrito en la nueva
 func (s *Service) UpdateProject(ctx context.Context, proj *project.Project) error {
+	if err := s.accessService.RequireProjectAbility(ctx, proj.ID, project_member.AbilityEditProject); err != nil {
+		return err
+	}
+
+	if proj.SectionID != nil {
+		current, err := s.projectRepo.GetByID(ctx, proj.ID)
+		if err != nil {
+			return err
+		}
+		if current.SectionID == nil || *current.SectionID != *proj.SectionID {
+			if err := s.accessService.RequireSectionMember(ctx, *proj.SectionID); err != nil {
+				return err
+			}
+		}
+	}
+
 	return s.projectRepo.Update(ctx, proj)
 }
 
 func (s *Service) DeleteProject(ctx context.Context, id int) error {
+	if err := s.accessService.RequireProjectOwner(ctx, id); err != nil {
+		return err
+	}
 	return s.projectRepo.Delete(ctx, id)
 }
+
+// SetProjectArchived archiva o reactiva el proyecto; solo sus owners pueden hacerlo
+func (s *Service) SetProjectArchived(ctx context.Context, id int, archived bool) error {
+	if err := s.accessService.RequireProjectOwner(ctx, id); err != nil {
+		return err
+	}
+
+	var at *time.Time
+	if archived {
+		now := s.now()
+		at = &now
+	}
+	return s.projectRepo.SetArchivedAt(ctx, id, at)
+}
+
+// TransferOwnership hace owner al miembro indicado y lo registra como owner
+// principal del proyecto. Quien transfiere pasa a maintainer; un
+// administrador que no es miembro solo promueve al nuevo owner.
+func (s *Service) TransferOwnership(ctx context.Context, projectID int, newOwnerID int) error {
+	if err := s.accessService.RequireProjectOwner(ctx, projectID); err != nil {
+		return err
+	}
+	caller, _ := identity.FromContext(ctx)
+
+	return s.unitOfWork.Do(ctx, func(repos repository.Repositories) error {
+		newOwner, err := repos.ProjectMembers.GetByProjectAndUser(ctx, projectID, newOwnerID)
+		if errors.Is(err, errs.ErrNotFound) {
+			return services.ErrNotProjectMember
+		}
+		if err != nil {
+			return err
+		}
+		if !newOwner.IsOwner() {
+			if err := repos.ProjectMembers.UpdateRole(ctx, newOwner.ID, project_member.RoleOwner); err != nil {
+				return err
+			}
+		}
+		if err := repos.Projects.UpdateOwner(ctx, projectID, newOwnerID); err != nil {
+			return err
+		}
+
+		if caller.UserID == newOwnerID {
+			return nil
+		}
+		previous, err := repos.ProjectMembers.GetByProjectAndUser(ctx, projectID, caller.UserID)
+		if errors.Is(err, errs.ErrNotFound) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if !previous.IsOwner() {
+			return nil
+		}
+		return repos.ProjectMembers.UpdateRole(ctx, previous.ID, project_member.RoleMaintainer)
+	})
+}
+
+// GetTrashedProjects lista la papelera: los administradores ven todos los
+// proyectos borrados y el resto solo aquellos en los que es owner, que son
+// los mismos que RestoreProject le deja restaurar.
+func (s *Service) GetTrashedProjects(ctx context.Context, params query.Params) (*query.Page[project.Project], error) {
+	id, ok := identity.FromContext(ctx)
+	if !ok {
+		return nil, services.ErrForbidden
+	}
+
+	if !id.IsAdmin() {
+		return s.projectRepo.GetTrashedByOwner(ctx, id.UserID, params)
+	}
+	return s.projectRepo.GetTrashed(ctx, params)
+}
+
+// RestoreProject saca un proyecto de la papelera. AccessService no ve los
+// proyectos borrados, así que la propiedad se valida sobre el proyecto ya
+// cargado.
+func (s *Service) RestoreProject(ctx context.Context, id int) error {
+	if _, err := s.projectRepo.GetTrashedByID(ctx, id); err != nil {
+		return err
+	}
+
+	caller, ok := identity.FromContext(ctx)
+	if !ok {
+		return services.ErrForbidden
+	}
+	if !caller.IsAdmin() {
+		member, err := s.projectMemberRepo.GetByProjectAndUser(ctx, id, caller.UserID)
+		if err != nil && !errors.Is(err, errs.ErrNotFound) {
+			return err
+		}
+		if !member.IsOwner() {
+			return services.ErrForbidden
+		}
+	}
+
+	return s.projectRepo.Restore(ctx, id)
+}
@@ -3,8 +3,16 @@ package project
 import (
 	"context"
 	"errors"
+	"softpharos/internal/core/domain/identity"
 	"softpharos/internal/core/domain/project"
+	"softpharos/internal/core/domain/project_member"
+	"softpharos/internal/core/domain/query"
+	"softpharos/internal/core/domain/role"
+	"softpharos/internal/core/errs"
+	"softpharos/internal/core/ports/repository"
+	"softpharos/internal/core/ports/services"
 	mockRepo "softpharos/mocks/core/ports/repository"
+	mockService "softpharos/mocks/core/ports/services"
 	"testing"
 	"time"
 
@@ -30,11 +38,11 @@ func TestGetAllProjects(t *testing.T) {
 			name: "retorna proyectos exitosamente",
 			mockSetup: func(m *mockRepo.MockProjectRepository) {
 				m.EXPECT().
-					GetAll(gomock.Any()).
-					Return([]project.Project{
+					GetAll(gomock.Any(), gomock.Any()).
+					Return(&query.Page[project.Project]{Items: []project.Project{
 						{ID: 1, Name: &name1, CreatedBy: 1, CreatedAt: now},
 						{ID: 2, Name: &name2, CreatedBy: 2, CreatedAt: now},
-					}, nil)
+					}}, nil)
 			},
 			expectedProjs: []project.Project{
 				{ID: 1, Name: &name1, CreatedBy: 1, CreatedAt: now},
@@ -46,8 +54,8 @@ func TestGetAllProjects(t *testing.T) {
 			name: "retorna error cuando el repositorio falla",
 			mockSetup: func(m *mockRepo.MockProjectRepository) {
 				m.EXPECT().
-					GetAll(gomock.Any()).
-					Return([]project.Project{}, errors.New("database error"))
+					GetAll(gomock.Any(), gomock.Any()).
+					Return(&query.Page[project.Project]{Items: []project.Project{}}, errors.New("database error"))
 			},
 			expectedProjs: []project.Project{},
 			expectedErr:   errors.New("database error"),
@@ -59,12 +67,12 @@ func TestGetAllProjects(t *testing.T) {
 			mockRepository := mockRepo.NewMockProjectRepository(ctrl)
 			tt.mockSetup(mockRepository)
 
-			service := New(mockRepository)
+			service := New(mockRepository, mockRepo.NewMockProjectMemberRepository(ctrl), mockService.NewMockAccessService(ctrl), mockRepo.NewMockUnitOfWork(ctrl))
 			ctx := context.Background()
 
-			result, err := service.GetAllProjects(ctx)
+			result, err := service.GetAllProjects(ctx, query.Params{})
 
-			assert.Equal(t, tt.expectedProjs, result)
+			assert.Equal(t, tt.expectedProjs, result.Items)
 			if tt.expectedErr != nil {
 				assert.EqualError(t, err, tt.expectedErr.Error())
 			} else {
@@ -117,7 +125,7 @@ func TestGetProjectByID(t *testing.T) {
 			mockRepository := mockRepo.NewMockProjectRepository(ctrl)
 			tt.mockSetup(mockRepository)
 
-			service := New(mockRepository)
+			service := New(mockRepository, mockRepo.NewMockProjectMemberRepository(ctrl), mockService.NewMockAccessService(ctrl), mockRepo.NewMockUnitOfWork(ctrl))
 			ctx := context.Background()
 
 			result, err := service.GetProjectByID(ctx, tt.projectID)
@@ -193,7 +201,7 @@ func TestGetProjectsByOwner(t *testing.T) {
 			mockRepository := mockRepo.NewMockProjectRepository(ctrl)
 			tt.mockSetup(mockRepository)
 
-			service := New(mockRepository)
+			service := New(mockRepository, mockRepo.NewMockProjectMemberRepository(ctrl), mockService.NewMockAccessService(ctrl), mockRepo.NewMockUnitOfWork(ctrl))
 			ctx := context.Background()
 
 			result, err := service.GetProjectsByOwner(ctx, tt.ownerID)
@@ -217,24 +225,46 @@ func TestCreateProject(t *testing.T) {
 	tests := []struct {
 		name        string
 		project     *project.Project
-		mockSetup   func(*mockRepo.MockProjectRepository)
+		mockSetup   func(*mockRepo.MockProjectRepository, *mockRepo.MockProjectMemberRepository)
 		expectedErr error
 	}{
 		{
-			name:    "crea proyecto exitosamente",
+			name:    "crea proyecto y registra al creador como owner",
 			project: &project.Project{Name: &name, CreatedBy: 1},
-			mockSetup: func(m *mockRepo.MockProjectRepository) {
-				m.EXPECT().
+			mockSetup: func(p *mockRepo.MockProjectRepository, pm *mockRepo.MockProjectMemberRepository) {
+				p.EXPECT().
 					Create(gomock.Any(), gomock.Any()).
-					Return(nil)
+					DoAndReturn(func(_ context.Context, proj *project.Project) error {
+						proj.ID = 10
+						return nil
+					})
+				pm.EXPECT().
+					Create(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, member *project_member.ProjectMember) error {
+						assert.Equal(t, 10, member.ProjectID)
+						assert.Equal(t, 1, member.UserID)
+						assert.Equal(t, project_member.RoleOwner, member.Role)
+						return nil
+					})
 			},
 			expectedErr: nil,
 		},
 		{
 			name:    "retorna error cuando el repositorio falla",
 			project: &project.Project{Name: &name, CreatedBy: 1},
-			mockSetup: func(m *mockRepo.MockProjectRepository) {
-				m.EXPECT().
+			mockSetup: func(p *mockRepo.MockProjectRepository, pm *mockRepo.MockProjectMemberRepository) {
+				p.EXPECT().
+					Create(gomock.Any(), gomock.Any()).
+					Return(errors.New("database error"))
+			},
+			expectedErr: errors.New("database error"),
+		},
+		{
+			name:    "retorna error cuando falla el registro del owner",
+			project: &project.Project{Name: &name, CreatedBy: 1},
+			mockSetup: func(p *mockRepo.MockProjectRepository, pm *mockRepo.MockProjectMemberRepository) {
+				p.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+				pm.EXPECT().
 					Create(gomock.Any(), gomock.Any()).
 					Return(errors.New("database error"))
 			},
@@ -244,10 +274,18 @@ func TestCreateProject(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			mockRepository := mockRepo.NewMockProjectRepository(ctrl)
-			tt.mockSetup(mockRepository)
-
-			service := New(mockRepository)
+			txProjects := mockRepo.NewMockProjectRepository(ctrl)
+			txMembers := mockRepo.NewMockProjectMemberRepository(ctrl)
+			tt.mockSetup(txProjects, txMembers)
+
+			mockUnitOfWork := mockRepo.NewMockUnitOfWork(ctrl)
+			mockUnitOfWork.EXPECT().
+				Do(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, fn func(repository.Repositories) error) error {
+					return fn(repository.Repositories{Projects: txProjects, ProjectMembers: txMembers})
+				})
+
+			service := New(mockRepo.NewMockProjectRepository(ctrl), mockRepo.NewMockProjectMemberRepository(ctrl), mockService.NewMockAccessService(ctrl), mockUnitOfWork)
 			ctx := context.Background()
 
 			err := service.CreateProject(ctx, tt.project)
@@ -270,6 +308,7 @@ func TestUpdateProject(t *testing.T) {
 	tests := []struct {
 		name        string
 		project     *project.Project
+		accessErr   error
 		mockSetup   func(*mockRepo.MockProjectRepository)
 		expectedErr error
 	}{
@@ -293,6 +332,13 @@ func TestUpdateProject(t *testing.T) {
 			},
 			expectedErr: errors.New("database error"),
 		},
+		{
+			name:        "retorna forbidden cuando el usuario no puede editar el proyecto",
+			project:     &project.Project{ID: 1, Name: &name, CreatedBy: 1},
+			accessErr:   services.ErrForbidden,
+			mockSetup:   func(m *mockRepo.MockProjectRepository) {},
+			expectedErr: services.ErrForbidden,
+		},
 	}
 
 	for _, tt := range tests {
@@ -300,7 +346,12 @@ func TestUpdateProject(t *testing.T) {
 			mockRepository := mockRepo.NewMockProjectRepository(ctrl)
 			tt.mockSetup(mockRepository)
 
-			service := New(mockRepository)
+			mockAccess := mockService.NewMockAccessService(ctrl)
+			mockAccess.EXPECT().
+				RequireProjectAbility(gomock.Any(), tt.project.ID, project_member.AbilityEditProject).
+				Return(tt.accessErr)
+
+			service := New(mockRepository, mockRepo.NewMockProjectMemberRepository(ctrl), mockAccess, mockRepo.NewMockUnitOfWork(ctrl))
 			ctx := context.Background()
 
 			err := service.UpdateProject(ctx, tt.project)
@@ -314,6 +365,72 @@ func TestUpdateProject(t *testing.T) {
 	}
 }
 
+func TestCreateProjectInSection(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	name := "Proyecto del curso"
+	sectionID := 4
+
+	mockAccess := mockService.NewMockAccessService(ctrl)
+	mockAccess.EXPECT().RequireSectionMember(gomock.Any(), sectionID).Return(services.ErrSectionForbidden)
+
+	service := New(mockRepo.NewMockProjectRepository(ctrl), mockRepo.NewMockProjectMemberRepository(ctrl), mockAccess, mockRepo.NewMockUnitOfWork(ctrl))
+
+	err := service.CreateProject(context.Background(), &project.Project{Name: &name, CreatedBy: 1, SectionID: &sectionID})
+
+	assert.ErrorIs(t, err, services.ErrSectionForbidden)
+}
+
+func TestUpdateProjectSection(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	current, other := 4, 5
+
+	tests := []struct {
+		name          string
+		newSection    int
+		requireAccess bool
+		accessErr     error
+		expectUpdate  bool
+		expectedErr   error
+	}{
+		{name: "no exige inscripción si la sección no cambia", newSection: current, expectUpdate: true},
+		{name: "exige inscripción en la nueva sección", newSection: other, requireAccess: true, expectUpdate: true},
+		{name: "rechaza mover el proyecto a una sección ajena", newSection: other, requireAccess: true, accessErr: services.ErrSectionForbidden, expectedErr: services.ErrSectionForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sectionID := tt.newSection
+			proj := &project.Project{ID: 1, CreatedBy: 1, SectionID: &sectionID}
+
+			mockRepository := mockRepo.NewMockProjectRepository(ctrl)
+			mockRepository.EXPECT().GetByID(gomock.Any(), 1).Return(&project.Project{ID: 1, SectionID: &current}, nil)
+			if tt.expectUpdate {
+				mockRepository.EXPECT().Update(gomock.Any(), proj).Return(nil)
+			}
+
+			mockAccess := mockService.NewMockAccessService(ctrl)
+			mockAccess.EXPECT().RequireProjectAbility(gomock.Any(), 1, project_member.AbilityEditProject).Return(nil)
+			if tt.requireAccess {
+				mockAccess.EXPECT().RequireSectionMember(gomock.Any(), tt.newSection).Return(tt.accessErr)
+			}
+
+			service := New(mockRepository, mockRepo.NewMockProjectMemberRepository(ctrl), mockAccess, mockRepo.NewMockUnitOfWork(ctrl))
+
+			err := service.UpdateProject(context.Background(), proj)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestDeleteProject(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -321,6 +438,7 @@ func TestDeleteProject(t *testing.T) {
 	tests := []struct {
 		name        string
 		projectID   int
+		accessErr   error
 		mockSetup   func(*mockRepo.MockProjectRepository)
 		expectedErr error
 	}{
@@ -344,6 +462,13 @@ func TestDeleteProject(t *testing.T) {
 			},
 			expectedErr: errors.New("database error"),
 		},
+		{
+			name:        "retorna forbidden cuando el usuario no es el creador",
+			projectID:   1,
+			accessErr:   services.ErrForbidden,
+			mockSetup:   func(m *mockRepo.MockProjectRepository) {},
+			expectedErr: services.ErrForbidden,
+		},
 	}
 
 	for _, tt := range tests {
@@ -351,7 +476,12 @@ func TestDeleteProject(t *testing.T) {
 			mockRepository := mockRepo.NewMockProjectRepository(ctrl)
 			tt.mockSetup(mockRepository)
 
-			service := New(mockRepository)
+			mockAccess := mockService.NewMockAccessService(ctrl)
+			mockAccess.EXPECT().
+				RequireProjectOwner(gomock.Any(), tt.projectID).
+				Return(tt.accessErr)
+
+			service := New(mockRepository, mockRepo.NewMockProjectMemberRepository(ctrl), mockAccess, mockRepo.NewMockUnitOfWork(ctrl))
 			ctx := context.Background()
 
 			err := service.DeleteProject(ctx, tt.projectID)
@@ -364,3 +494,343 @@ func TestDeleteProject(t *testing.T) {
 		})
 	}
 }
+
+func TestGetTrashedProjects(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	page := &query.Page[project.Project]{Items: []project.Project{{ID: 1}}, Total: 1}
+
+	tests := []struct {
+		name        string
+		ctx         context.Context
+		mockSetup   func(*mockRepo.MockProjectRepository)
+		expectedErr error
+	}{
+		{
+			name: "un estudiante solo ve los proyectos borrados de los que es owner",
+			ctx:  identity.NewContext(context.Background(), &identity.Identity{UserID: 7, Role: role.Student}),
+			mockSetup: func(m *mockRepo.MockProjectRepository) {
+				m.EXPECT().GetTrashedByOwner(gomock.Any(), 7, gomock.Any()).Return(page, nil)
+			},
+		},
+		{
+			name: "un administrador ve todos los proyectos borrados",
+			ctx:  identity.NewContext(context.Background(), &identity.Identity{UserID: 1, Role: role.Admin}),
+			mockSetup: func(m *mockRepo.MockProjectRepository) {
+				m.EXPECT().GetTrashed(gomock.Any(), gomock.Any()).Return(page, nil)
+			},
+		},
+		{
+			name:        "retorna forbidden sin identidad",
+			ctx:         context.Background(),
+			mockSetup:   func(m *mockRepo.MockProjectRepository) {},
+			expectedErr: services.ErrForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepository := mockRepo.NewMockProjectRepository(ctrl)
+			tt.mockSetup(mockRepository)
+
+			service := New(mockRepository, mockRepo.NewMockProjectMemberRepository(ctrl), mockService.NewMockAccessService(ctrl), mockRepo.NewMockUnitOfWork(ctrl))
+
+			result, err := service.GetTrashedProjects(tt.ctx, query.Params{Filters: map[string]any{}})
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, page, result)
+			}
+		})
+	}
+}
+
+func TestRestoreProject(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	deletedAt := time.Now()
+	trashed := &project.Project{ID: 1, CreatedBy: 7, DeletedAt: &deletedAt}
+	owner := project_member.RoleOwner
+	member := project_member.RoleMaintainer
+
+	tests := []struct {
+		name        string
+		caller      *identity.Identity
+		mockSetup   func(*mockRepo.MockProjectRepository, *mockRepo.MockProjectMemberRepository)
+		expectedErr error
+	}{
+		{
+			name:   "un owner restaura su proyecto",
+			caller: &identity.Identity{UserID: 7, Role: role.Student},
+			mockSetup: func(m *mockRepo.MockProjectRepository, pm *mockRepo.MockProjectMemberRepository) {
+				m.EXPECT().GetTrashedByID(gomock.Any(), 1).Return(trashed, nil)
+				pm.EXPECT().GetByProjectAndUser(gomock.Any(), 1, 7).Return(&project_member.ProjectMember{Role: owner}, nil)
+				m.EXPECT().Restore(gomock.Any(), 1).Return(nil)
+			},
+		},
+		{
+			name:   "un administrador restaura cualquier proyecto",
+			caller: &identity.Identity{UserID: 1, Role: role.Admin},
+			mockSetup: func(m *mockRepo.MockProjectRepository, pm *mockRepo.MockProjectMemberRepository) {
+				m.EXPECT().GetTrashedByID(gomock.Any(), 1).Return(trashed, nil)
+				m.EXPECT().Restore(gomock.Any(), 1).Return(nil)
+			},
+		},
+		{
+			name:   "retorna forbidden cuando el usuario no es owner",
+			caller: &identity.Identity{UserID: 8, Role: role.Student},
+			mockSetup: func(m *mockRepo.MockProjectRepository, pm *mockRepo.MockProjectMemberRepository) {
+				m.EXPECT().GetTrashedByID(gomock.Any(), 1).Return(trashed, nil)
+				pm.EXPECT().GetByProjectAndUser(gomock.Any(), 1, 8).Return(&project_member.ProjectMember{Role: member}, nil)
+			},
+			expectedErr: services.ErrForbidden,
+		},
+		{
+			name:   "retorna forbidden cuando el usuario no es miembro",
+			caller: &identity.Identity{UserID: 9, Role: role.Student},
+			mockSetup: func(m *mockRepo.MockProjectRepository, pm *mockRepo.MockProjectMemberRepository) {
+				m.EXPECT().GetTrashedByID(gomock.Any(), 1).Return(trashed, nil)
+				pm.EXPECT().GetByProjectAndUser(gomock.Any(), 1, 9).Return(nil, errs.NotFound("no encontrado"))
+			},
+			expectedErr: services.ErrForbidden,
+		},
+		{
+			name:   "retorna error cuando el proyecto no está en la papelera",
+			caller: &identity.Identity{UserID: 7, Role: role.Student},
+			mockSetup: func(m *mockRepo.MockProjectRepository, pm *mockRepo.MockProjectMemberRepository) {
+				m.EXPECT().GetTrashedByID(gomock.Any(), 1).Return(nil, errors.New("not found"))
+			},
+			expectedErr: errors.New("not found"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepository := mockRepo.NewMockProjectRepository(ctrl)
+			mockMembers := mockRepo.NewMockProjectMemberRepository(ctrl)
+			tt.mockSetup(mockRepository, mockMembers)
+
+			service := New(mockRepository, mockMembers, mockService.NewMockAccessService(ctrl), mockRepo.NewMockUnitOfWork(ctrl))
+			ctx := identity.NewContext(context.Background(), tt.caller)
+
+			err := service.RestoreProject(ctx, 1)
+
+			if tt.expectedErr != nil {
+				assert.EqualError(t, err, tt.expectedErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestTransferOwnership(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	owner := project_member.RoleOwner
+	member := project_member.RoleMaintainer
+
+	tests := []struct {
+		name        string
+		caller      *identity.Identity
+		accessErr   error
+		mockSetup   func(*mockRepo.MockProjectRepository, *mockRepo.MockProjectMemberRepository)
+		expectedErr error
+	}{
+		{
+			name:   "promueve al nuevo owner y deja como miembro a quien transfiere",
+			caller: &identity.Identity{UserID: 7, Role: role.Student},
+			mockSetup: func(p *mockRepo.MockProjectRepository, pm *mockRepo.MockProjectMemberRepository) {
+				pm.EXPECT().GetByProjectAndUser(gomock.Any(), 1, 8).Return(&project_member.ProjectMember{ID: 20, Role: member}, nil)
+				pm.EXPECT().UpdateRole(gomock.Any(), 20, project_member.RoleOwner).Return(nil)
+				p.EXPECT().UpdateOwner(gomock.Any(), 1, 8).Return(nil)
+				pm.EXPECT().GetByProjectAndUser(gomock.Any(), 1, 7).Return(&project_member.ProjectMember{ID: 10, Role: owner}, nil)
+				pm.EXPECT().UpdateRole(gomock.Any(), 10, project_member.RoleMaintainer).Return(nil)
+			},
+		},
+		{
+			name:   "un administrador que no es miembro solo promueve",
+			caller: &identity.Identity{UserID: 1, Role: role.Admin},
+			mockSetup: func(p *mockRepo.MockProjectRepository, pm *mockRepo.MockProjectMemberRepository) {
+				pm.EXPECT().GetByProjectAndUser(gomock.Any(), 1, 8).Return(&project_member.ProjectMember{ID: 20, Role: member}, nil)
+				pm.EXPECT().UpdateRole(gomock.Any(), 20, project_member.RoleOwner).Return(nil)
+				p.EXPECT().UpdateOwner(gomock.Any(), 1, 8).Return(nil)
+				pm.EXPECT().GetByProjectAndUser(gomock.Any(), 1, 1).Return(nil, errs.NotFound("no encontrado"))
+			},
+		},
+		{
+			name:   "retorna validación cuando el destinatario no es miembro",
+			caller: &identity.Identity{UserID: 7, Role: role.Student},
+			mockSetup: func(p *mockRepo.MockProjectRepository, pm *mockRepo.MockProjectMemberRepository) {
+				pm.EXPECT().GetByProjectAndUser(gomock.Any(), 1, 8).Return(nil, errs.NotFound("no encontrado"))
+			},
+			expectedErr: services.ErrNotProjectMember,
+		},
+		{
+			name:        "retorna forbidden cuando el usuario no es owner",
+			caller:      &identity.Identity{UserID: 9, Role: role.Student},
+			accessErr:   services.ErrForbidden,
+			mockSetup:   func(p *mockRepo.MockProjectRepository, pm *mockRepo.MockProjectMemberRepository) {},
+			expectedErr: services.ErrForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			txProjects := mockRepo.NewMockProjectRepository(ctrl)
+			txMembers := mockRepo.NewMockProjectMemberRepository(ctrl)
+			tt.mockSetup(txProjects, txMembers)
+
+			mockAccess := mockService.NewMockAccessService(ctrl)
+			mockAccess.EXPECT().RequireProjectOwner(gomock.Any(), 1).Return(tt.accessErr)
+
+			mockUnitOfWork := mockRepo.NewMockUnitOfWork(ctrl)
+			mockUnitOfWork.EXPECT().
+				Do(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, fn func(repository.Repositories) error) error {
+					return fn(repository.Repositories{Projects: txProjects, ProjectMembers: txMembers})
+				}).
+				AnyTimes()
+
+			service := New(mockRepo.NewMockProjectRepository(ctrl), mockRepo.NewMockProjectMemberRepository(ctrl), mockAccess, mockUnitOfWork)
+			ctx := identity.NewContext(context.Background(), tt.caller)
+
+			err := service.TransferOwnership(ctx, 1, 8)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestGetMyProjects(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	page := &query.Page[project.MemberProject]{
+		Items: []project.MemberProject{{Project: project.Project{ID: 3}, Role: project_member.RoleContributor}},
+		Total: 1,
+	}
+	student := identity.NewContext(context.Background(), &identity.Identity{UserID: 7, Role: role.Student})
+
+	tests := []struct {
+		name        string
+		ctx         context.Context
+		filters     map[string]any
+		expectQuery bool
+		expectedErr error
+	}{
+		{
+			name:        "lista los proyectos del usuario autenticado",
+			ctx:         student,
+			filters:     map[string]any{"status": project.StatusArchived, "role": project_member.RoleOwner},
+			expectQuery: true,
+		},
+		{
+			name:        "rechaza un estado desconocido",
+			ctx:         student,
+			filters:     map[string]any{"status": "deleted"},
+			expectedErr: services.ErrInvalidProjectStatus,
+		},
+		{
+			name:        "rechaza un rol que no es de proyecto",
+			ctx:         student,
+			filters:     map[string]any{"role": "member"},
+			expectedErr: services.ErrInvalidProjectRole,
+		},
+		{
+			name:        "retorna forbidden sin identidad",
+			ctx:         context.Background(),
+			expectedErr: services.ErrForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepository := mockRepo.NewMockProjectRepository(ctrl)
+			params := query.Params{Filters: tt.filters}
+			if tt.expectQuery {
+				mockRepository.EXPECT().GetByMember(gomock.Any(), 7, params).Return(page, nil)
+			}
+
+			service := New(mockRepository, mockRepo.NewMockProjectMemberRepository(ctrl), mockService.NewMockAccessService(ctrl), mockRepo.NewMockUnitOfWork(ctrl))
+
+			result, err := service.GetMyProjects(tt.ctx, params)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, page, result)
+			}
+		})
+	}
+}
+
+func TestSetProjectArchived(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		archived    bool
+		mockSetup   func(*mockRepo.MockProjectRepository, *mockService.MockAccessService)
+		expectedErr error
+	}{
+		{
+			name:     "archiva el proyecto con la fecha actual",
+			archived: true,
+			mockSetup: func(m *mockRepo.MockProjectRepository, a *mockService.MockAccessService) {
+				a.EXPECT().RequireProjectOwner(gomock.Any(), 1).Return(nil)
+				m.EXPECT().SetArchivedAt(gomock.Any(), 1, &now).Return(nil)
+			},
+		},
+		{
+			name:     "reactiva el proyecto",
+			archived: false,
+			mockSetup: func(m *mockRepo.MockProjectRepository, a *mockService.MockAccessService) {
+				a.EXPECT().RequireProjectOwner(gomock.Any(), 1).Return(nil)
+				m.EXPECT().SetArchivedAt(gomock.Any(), 1, nil).Return(nil)
+			},
+		},
+		{
+			name:     "retorna forbidden cuando el usuario no es owner",
+			archived: true,
+			mockSetup: func(m *mockRepo.MockProjectRepository, a *mockService.MockAccessService) {
+				a.EXPECT().RequireProjectOwner(gomock.Any(), 1).Return(services.ErrForbidden)
+			},
+			expectedErr: services.ErrForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepository := mockRepo.NewMockProjectRepository(ctrl)
+			mockAccess := mockService.NewMockAccessService(ctrl)
+			tt.mockSetup(mockRepository, mockAccess)
+
+			service := New(mockRepository, mockRepo.NewMockProjectMemberRepository(ctrl), mockAccess, mockRepo.NewMockUnitOfWork(ctrl)).(*Service)
+			service.now = func() time.Time { return now }
+
+			err := service.SetProjectArchived(context.Background(), 1, tt.archived)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
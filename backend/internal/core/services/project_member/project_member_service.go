@@ -3,22 +3,25 @@ package project_member
 import (
 	"context"
 	"softpharos/internal/core/domain/project_member"
+	"softpharos/internal/core/domain/query"
 	"softpharos/internal/core/ports/repository"
 	"softpharos/internal/core/ports/services"
 )
 
 type Service struct {
 	projectMemberRepo repository.ProjectMemberRepository
+	accessService     services.AccessService
 }
 
-func New(projectMemberRepo repository.ProjectMemberRepository) services.ProjectMemberService {
+func New(projectMemberRepo repository.ProjectMemberRepository, accessService services.AccessService) services.ProjectMemberService {
 	return &Service{
 		projectMemberRepo: projectMemberRepo,
+		accessService:     accessService,
 	}
 }
 
-func (s *Service) GetAllProjectMembers(ctx context.Context) ([]project_member.ProjectMember, error) {
-	return s.projectMemberRepo.GetAll(ctx)
+func (s *Service) GetAllProjectMembers(ctx context.Context, params query.Params) (*query.Page[project_member.ProjectMember], error) {
+	return s.projectMemberRepo.GetAll(ctx, params)
 }
 
 func (s *Service) GetProjectMemberByID(ctx context.Context, id int) (*project_member.ProjectMember, error) {
@@ -29,14 +32,74 @@ func (s *Service) GetProjectMembersByProjectID(ctx context.Context, projectID in
 	return s.projectMemberRepo.GetByProjectID(ctx, projectID)
 }
 
+// CreateProjectMember agrega un miembro al proyecto con el rol indicado o,
+// si no se indica, como contributor. Solo un owner puede agregar a otro owner.
 func (s *Service) CreateProjectMember(ctx context.Context, pm *project_member.ProjectMember) error {
+	if pm.Role == "" {
+		pm.Role = project_member.RoleContributor
+	}
+	if !project_member.IsValidRole(pm.Role) {
+		return services.ErrInvalidProjectRole
+	}
+	if err := s.requireRoleChange(ctx, pm.ProjectID, pm.IsOwner()); err != nil {
+		return err
+	}
 	return s.projectMemberRepo.Create(ctx, pm)
 }
 
+// UpdateProjectMember actualiza un miembro. Dar o quitar el rol owner
+// requiere ser owner, y no se puede quitar al último owner del proyecto.
 func (s *Service) UpdateProjectMember(ctx context.Context, pm *project_member.ProjectMember) error {
+	if !project_member.IsValidRole(pm.Role) {
+		return services.ErrInvalidProjectRole
+	}
+	current, err := s.projectMemberRepo.GetByID(ctx, pm.ID)
+	if err != nil {
+		return err
+	}
+	if err := s.requireRoleChange(ctx, current.ProjectID, current.IsOwner() || pm.IsOwner()); err != nil {
+		return err
+	}
+	if current.IsOwner() && !pm.IsOwner() {
+		if err := s.requireAnotherOwner(ctx, current.ProjectID); err != nil {
+			return err
+		}
+	}
 	return s.projectMemberRepo.Update(ctx, pm)
 }
 
 func (s *Service) DeleteProjectMember(ctx context.Context, id int) error {
+	existing, err := s.projectMemberRepo.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if err := s.requireRoleChange(ctx, existing.ProjectID, existing.IsOwner()); err != nil {
+		return err
+	}
+	if existing.IsOwner() {
+		if err := s.requireAnotherOwner(ctx, existing.ProjectID); err != nil {
+			return err
+		}
+	}
 	return s.projectMemberRepo.Delete(ctx, id)
 }
+
+// requireRoleChange exige ser owner cuando la operación involucra a un owner y
+// poder gestionar miembros en los demás casos
+func (s *Service) requireRoleChange(ctx context.Context, projectID int, involvesOwner bool) error {
+	if involvesOwner {
+		return s.accessService.RequireProjectOwner(ctx, projectID)
+	}
+	return s.accessService.RequireProjectAbility(ctx, projectID, project_member.AbilityManageMembers)
+}
+
+func (s *Service) requireAnotherOwner(ctx context.Context, projectID int) error {
+	owners, err := s.projectMemberRepo.CountByRole(ctx, projectID, project_member.RoleOwner)
+	if err != nil {
+		return err
+	}
+	if owners <= 1 {
+		return services.ErrLastOwner
+	}
+	return nil
+}
@@ -3,7 +3,10 @@ package project_member
 import (
 	"context"
 	"softpharos/internal/core/domain/project_member"
+	"softpharos/internal/core/domain/query"
+	"softpharos/internal/core/ports/services"
 	mockRepo "softpharos/mocks/core/ports/repository"
+	mockService "softpharos/mocks/core/ports/services"
 	"testing"
 	"time"
 
@@ -15,32 +18,32 @@ func TestGetAllProjectMembers(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
-	role := "Developer"
+	role := project_member.RoleContributor
 	now := time.Now()
 
 	mockRepo := mockRepo.NewMockProjectMemberRepository(ctrl)
-	mockRepo.EXPECT().GetAll(gomock.Any()).Return([]project_member.ProjectMember{
-		{ID: 1, ProjectID: 1, UserID: 1, Role: &role, JoinedAt: now},
-	}, nil)
+	mockRepo.EXPECT().GetAll(gomock.Any(), gomock.Any()).Return(&query.Page[project_member.ProjectMember]{Items: []project_member.ProjectMember{
+		{ID: 1, ProjectID: 1, UserID: 1, Role: role, JoinedAt: now},
+	}}, nil)
 
-	service := New(mockRepo)
-	result, err := service.GetAllProjectMembers(context.Background())
+	service := New(mockRepo, mockService.NewMockAccessService(ctrl))
+	result, err := service.GetAllProjectMembers(context.Background(), query.Params{})
 
 	assert.NoError(t, err)
-	assert.Len(t, result, 1)
+	assert.Len(t, result.Items, 1)
 }
 
 func TestGetProjectMemberByID(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
-	role := "Developer"
+	role := project_member.RoleContributor
 	now := time.Now()
 
 	mockRepo := mockRepo.NewMockProjectMemberRepository(ctrl)
-	mockRepo.EXPECT().GetByID(gomock.Any(), 1).Return(&project_member.ProjectMember{ID: 1, ProjectID: 1, UserID: 1, Role: &role, JoinedAt: now}, nil)
+	mockRepo.EXPECT().GetByID(gomock.Any(), 1).Return(&project_member.ProjectMember{ID: 1, ProjectID: 1, UserID: 1, Role: role, JoinedAt: now}, nil)
 
-	service := New(mockRepo)
+	service := New(mockRepo, mockService.NewMockAccessService(ctrl))
 	result, err := service.GetProjectMemberByID(context.Background(), 1)
 
 	assert.NoError(t, err)
@@ -54,7 +57,7 @@ func TestGetProjectMembersByProjectID(t *testing.T) {
 	mockRepo := mockRepo.NewMockProjectMemberRepository(ctrl)
 	mockRepo.EXPECT().GetByProjectID(gomock.Any(), 1).Return([]project_member.ProjectMember{{ID: 1, ProjectID: 1, UserID: 1}}, nil)
 
-	service := New(mockRepo)
+	service := New(mockRepo, mockService.NewMockAccessService(ctrl))
 	result, err := service.GetProjectMembersByProjectID(context.Background(), 1)
 
 	assert.NoError(t, err)
@@ -66,23 +69,61 @@ func TestCreateProjectMember(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockRepo := mockRepo.NewMockProjectMemberRepository(ctrl)
-	mockRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+	mockRepo.EXPECT().Create(gomock.Any(), gomock.Cond(func(x *project_member.ProjectMember) bool {
+		return x.Role == project_member.RoleContributor
+	})).Return(nil)
+
+	mockAccess := mockService.NewMockAccessService(ctrl)
+	mockAccess.EXPECT().RequireProjectAbility(gomock.Any(), 1, project_member.AbilityManageMembers).Return(nil)
 
-	service := New(mockRepo)
+	service := New(mockRepo, mockAccess)
 	err := service.CreateProjectMember(context.Background(), &project_member.ProjectMember{ProjectID: 1, UserID: 1})
 
 	assert.NoError(t, err)
 }
 
+func TestCreateProjectMember_Forbidden(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mockRepo.NewMockProjectMemberRepository(ctrl)
+
+	mockAccess := mockService.NewMockAccessService(ctrl)
+	mockAccess.EXPECT().RequireProjectAbility(gomock.Any(), 1, project_member.AbilityManageMembers).Return(services.ErrForbidden)
+
+	service := New(mockRepo, mockAccess)
+	err := service.CreateProjectMember(context.Background(), &project_member.ProjectMember{ProjectID: 1, UserID: 2})
+
+	assert.ErrorIs(t, err, services.ErrForbidden)
+}
+
+func TestProjectMember_InvalidRole(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	service := New(mockRepo.NewMockProjectMemberRepository(ctrl), mockService.NewMockAccessService(ctrl))
+
+	err := service.CreateProjectMember(context.Background(), &project_member.ProjectMember{ProjectID: 1, UserID: 2, Role: "Developer"})
+	assert.ErrorIs(t, err, services.ErrInvalidProjectRole)
+
+	err = service.UpdateProjectMember(context.Background(), &project_member.ProjectMember{ID: 1, ProjectID: 1, UserID: 2, Role: ""})
+	assert.ErrorIs(t, err, services.ErrInvalidProjectRole)
+}
+
 func TestUpdateProjectMember(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
 	mockRepo := mockRepo.NewMockProjectMemberRepository(ctrl)
+	mockRepo.EXPECT().GetByID(gomock.Any(), 1).
+		Return(&project_member.ProjectMember{ID: 1, ProjectID: 1, UserID: 1, Role: project_member.RoleContributor}, nil)
 	mockRepo.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
 
-	service := New(mockRepo)
-	err := service.UpdateProjectMember(context.Background(), &project_member.ProjectMember{ID: 1, ProjectID: 1, UserID: 1})
+	mockAccess := mockService.NewMockAccessService(ctrl)
+	mockAccess.EXPECT().RequireProjectAbility(gomock.Any(), 1, project_member.AbilityManageMembers).Return(nil)
+
+	service := New(mockRepo, mockAccess)
+	err := service.UpdateProjectMember(context.Background(), &project_member.ProjectMember{ID: 1, ProjectID: 1, UserID: 1, Role: project_member.RoleMaintainer})
 
 	assert.NoError(t, err)
 }
@@ -92,10 +133,104 @@ func TestDeleteProjectMember(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockRepo := mockRepo.NewMockProjectMemberRepository(ctrl)
+	mockRepo.EXPECT().GetByID(gomock.Any(), 1).Return(&project_member.ProjectMember{ID: 1, ProjectID: 3, UserID: 1}, nil)
 	mockRepo.EXPECT().Delete(gomock.Any(), 1).Return(nil)
 
-	service := New(mockRepo)
+	mockAccess := mockService.NewMockAccessService(ctrl)
+	mockAccess.EXPECT().RequireProjectAbility(gomock.Any(), 3, project_member.AbilityManageMembers).Return(nil)
+
+	service := New(mockRepo, mockAccess)
 	err := service.DeleteProjectMember(context.Background(), 1)
 
 	assert.NoError(t, err)
 }
+
+func TestDeleteProjectMember_Forbidden(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mockRepo.NewMockProjectMemberRepository(ctrl)
+	mockRepo.EXPECT().GetByID(gomock.Any(), 1).Return(&project_member.ProjectMember{ID: 1, ProjectID: 3, UserID: 1}, nil)
+
+	mockAccess := mockService.NewMockAccessService(ctrl)
+	mockAccess.EXPECT().RequireProjectAbility(gomock.Any(), 3, project_member.AbilityManageMembers).Return(services.ErrForbidden)
+
+	service := New(mockRepo, mockAccess)
+	err := service.DeleteProjectMember(context.Background(), 1)
+
+	assert.ErrorIs(t, err, services.ErrForbidden)
+}
+
+func TestCreateProjectMember_OwnerRequiresOwner(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	owner := project_member.RoleOwner
+	mockRepo := mockRepo.NewMockProjectMemberRepository(ctrl)
+
+	mockAccess := mockService.NewMockAccessService(ctrl)
+	mockAccess.EXPECT().RequireProjectOwner(gomock.Any(), 1).Return(services.ErrForbidden)
+
+	service := New(mockRepo, mockAccess)
+	err := service.CreateProjectMember(context.Background(), &project_member.ProjectMember{ProjectID: 1, UserID: 2, Role: owner})
+
+	assert.ErrorIs(t, err, services.ErrForbidden)
+}
+
+func TestUpdateProjectMember_LastOwner(t *testing.T) {
+	owner := project_member.RoleOwner
+	member := project_member.RoleMaintainer
+
+	tests := []struct {
+		name        string
+		owners      int64
+		expectedErr error
+	}{
+		{name: "permite quitar el rol owner cuando queda otro owner", owners: 2},
+		{name: "rechaza quitar el rol al último owner", owners: 1, expectedErr: services.ErrLastOwner},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mockRepo.NewMockProjectMemberRepository(ctrl)
+			mockRepo.EXPECT().GetByID(gomock.Any(), 1).Return(&project_member.ProjectMember{ID: 1, ProjectID: 3, UserID: 1, Role: owner}, nil)
+			mockRepo.EXPECT().CountByRole(gomock.Any(), 3, project_member.RoleOwner).Return(tt.owners, nil)
+			if tt.expectedErr == nil {
+				mockRepo.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
+			}
+
+			mockAccess := mockService.NewMockAccessService(ctrl)
+			mockAccess.EXPECT().RequireProjectOwner(gomock.Any(), 3).Return(nil)
+
+			service := New(mockRepo, mockAccess)
+			err := service.UpdateProjectMember(context.Background(), &project_member.ProjectMember{ID: 1, ProjectID: 3, UserID: 1, Role: member})
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestDeleteProjectMember_LastOwner(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	owner := project_member.RoleOwner
+	mockRepo := mockRepo.NewMockProjectMemberRepository(ctrl)
+	mockRepo.EXPECT().GetByID(gomock.Any(), 1).Return(&project_member.ProjectMember{ID: 1, ProjectID: 3, UserID: 1, Role: owner}, nil)
+	mockRepo.EXPECT().CountByRole(gomock.Any(), 3, project_member.RoleOwner).Return(int64(1), nil)
+
+	mockAccess := mockService.NewMockAccessService(ctrl)
+	mockAccess.EXPECT().RequireProjectOwner(gomock.Any(), 3).Return(nil)
+
+	service := New(mockRepo, mockAccess)
+	err := service.DeleteProjectMember(context.Background(), 1)
+
+	assert.ErrorIs(t, err, services.ErrLastOwner)
+}
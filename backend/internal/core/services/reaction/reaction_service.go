@@ -2,23 +2,36 @@ package reaction
 
 import (
 	"context"
+	"fmt"
+	"softpharos/internal/core/domain/query"
 	"softpharos/internal/core/domain/reaction"
 	"softpharos/internal/core/ports/repository"
 	"softpharos/internal/core/ports/services"
 )
 
 type Service struct {
-	reactionRepo repository.ReactionRepository
+	reactionRepo  repository.ReactionRepository
+	milestoneRepo repository.MilestoneRepository
+	accessService services.AccessService
+	types         []string
 }
 
-func New(reactionRepo repository.ReactionRepository) services.ReactionService {
+// New recibe el catálogo de tipos de reacción; si está vacío usa reaction.DefaultTypes
+func New(reactionRepo repository.ReactionRepository, milestoneRepo repository.MilestoneRepository, accessService services.AccessService, types []string) services.ReactionService {
+	if len(types) == 0 {
+		types = reaction.DefaultTypes
+	}
+
 	return &Service{
-		reactionRepo: reactionRepo,
+		reactionRepo:  reactionRepo,
+		milestoneRepo: milestoneRepo,
+		accessService: accessService,
+		types:         types,
 	}
 }
 
-func (s *Service) GetAllReactions(ctx context.Context) ([]reaction.Reaction, error) {
-	return s.reactionRepo.GetAll(ctx)
+func (s *Service) GetAllReactions(ctx context.Context, params query.Params) (*query.Page[reaction.Reaction], error) {
+	return s.reactionRepo.GetAll(ctx, params)
 }
 
 func (s *Service) GetReactionByID(ctx context.Context, id int) (*reaction.Reaction, error) {
@@ -30,13 +43,94 @@ func (s *Service) GetReactionsByMilestoneID(ctx context.Context, milestoneID int
 }
 
 func (s *Service) CreateReaction(ctx context.Context, r *reaction.Reaction) error {
+	if err := s.checkType(r.Type); err != nil {
+		return err
+	}
 	return s.reactionRepo.Create(ctx, r)
 }
 
+// UpdateReaction solo permite cambiar el tipo al autor de la reacción o a un administrador
 func (s *Service) UpdateReaction(ctx context.Context, r *reaction.Reaction) error {
+	if err := s.checkType(r.Type); err != nil {
+		return err
+	}
+
+	existing, err := s.requireAuthor(ctx, r.ID)
+	if err != nil {
+		return err
+	}
+
+	r.UserID = existing.UserID
 	return s.reactionRepo.Update(ctx, r)
 }
 
 func (s *Service) DeleteReaction(ctx context.Context, id int) error {
+	if _, err := s.requireAuthor(ctx, id); err != nil {
+		return err
+	}
 	return s.reactionRepo.Delete(ctx, id)
 }
+
+// requireAuthor carga la reacción y verifica que el usuario autenticado sea quien reaccionó
+func (s *Service) requireAuthor(ctx context.Context, id int) (*reaction.Reaction, error) {
+	existing, err := s.reactionRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.accessService.RequireAuthor(ctx, existing.UserID); err != nil {
+		return nil, err
+	}
+	return existing, nil
+}
+
+func (s *Service) GetReactionTypes() []string {
+	return s.types
+}
+
+func (s *Service) GetReactionSummary(ctx context.Context, milestoneID int, userID int) ([]reaction.TypeCount, error) {
+	if _, err := s.milestoneRepo.GetByID(ctx, milestoneID); err != nil {
+		return nil, err
+	}
+	return s.summary(ctx, milestoneID, userID)
+}
+
+// SetReaction deja la reacción del usuario en el estado pedido: repetir la
+// misma llamada no cambia nada, así que no hace falta conocer el ID de la reacción
+func (s *Service) SetReaction(ctx context.Context, milestoneID int, userID int, reactionType string, reacted bool) ([]reaction.TypeCount, error) {
+	if err := s.checkType(&reactionType); err != nil {
+		return nil, err
+	}
+	if _, err := s.milestoneRepo.GetByID(ctx, milestoneID); err != nil {
+		return nil, err
+	}
+
+	var err error
+	if reacted {
+		err = s.reactionRepo.AddForUser(ctx, &reaction.Reaction{MilestoneID: milestoneID, UserID: userID, Type: &reactionType})
+	} else {
+		err = s.reactionRepo.RemoveForUser(ctx, milestoneID, userID, reactionType)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return s.summary(ctx, milestoneID, userID)
+}
+
+func (s *Service) summary(ctx context.Context, milestoneID int, userID int) ([]reaction.TypeCount, error) {
+	counts, err := s.reactionRepo.CountByMilestone(ctx, milestoneID, userID)
+	if err != nil {
+		return nil, err
+	}
+	return reaction.Summary(s.types, counts), nil
+}
+
+func (s *Service) checkType(reactionType *string) error {
+	if reactionType == nil {
+		return services.ErrInvalidReactionType
+	}
+	if !reaction.IsValidType(s.types, *reactionType) {
+		return fmt.Errorf("%w: '%s' no está en el catálogo", services.ErrInvalidReactionType, *reactionType)
+	}
+	return nil
+}
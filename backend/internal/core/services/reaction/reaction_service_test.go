@@ -2,8 +2,13 @@ package reaction
 
 import (
 	"context"
+	"softpharos/internal/core/domain/milestone"
+	"softpharos/internal/core/domain/query"
 	"softpharos/internal/core/domain/reaction"
+	"softpharos/internal/core/errs"
+	"softpharos/internal/core/ports/services"
 	mockRepo "softpharos/mocks/core/ports/repository"
+	mockService "softpharos/mocks/core/ports/services"
 	"testing"
 	"time"
 
@@ -19,15 +24,15 @@ func TestGetAllReactions(t *testing.T) {
 	now := time.Now()
 
 	mockRepo := mockRepo.NewMockReactionRepository(ctrl)
-	mockRepo.EXPECT().GetAll(gomock.Any()).Return([]reaction.Reaction{
+	mockRepo.EXPECT().GetAll(gomock.Any(), gomock.Any()).Return(&query.Page[reaction.Reaction]{Items: []reaction.Reaction{
 		{ID: 1, MilestoneID: 1, UserID: 1, Type: &reactionType, CreatedAt: now},
-	}, nil)
+	}}, nil)
 
-	service := New(mockRepo)
-	result, err := service.GetAllReactions(context.Background())
+	service := New(mockRepo, nil, nil, nil)
+	result, err := service.GetAllReactions(context.Background(), query.Params{})
 
 	assert.NoError(t, err)
-	assert.Len(t, result, 1)
+	assert.Len(t, result.Items, 1)
 }
 
 func TestGetReactionByID(t *testing.T) {
@@ -40,7 +45,7 @@ func TestGetReactionByID(t *testing.T) {
 	mockRepo := mockRepo.NewMockReactionRepository(ctrl)
 	mockRepo.EXPECT().GetByID(gomock.Any(), 1).Return(&reaction.Reaction{ID: 1, MilestoneID: 1, UserID: 1, Type: &reactionType, CreatedAt: now}, nil)
 
-	service := New(mockRepo)
+	service := New(mockRepo, nil, nil, nil)
 	result, err := service.GetReactionByID(context.Background(), 1)
 
 	assert.NoError(t, err)
@@ -54,7 +59,7 @@ func TestGetReactionsByMilestoneID(t *testing.T) {
 	mockRepo := mockRepo.NewMockReactionRepository(ctrl)
 	mockRepo.EXPECT().GetByMilestoneID(gomock.Any(), 1).Return([]reaction.Reaction{{ID: 1, MilestoneID: 1, UserID: 1}}, nil)
 
-	service := New(mockRepo)
+	service := New(mockRepo, nil, nil, nil)
 	result, err := service.GetReactionsByMilestoneID(context.Background(), 1)
 
 	assert.NoError(t, err)
@@ -65,37 +70,201 @@ func TestCreateReaction(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
+	reactionType := "like"
 	mockRepo := mockRepo.NewMockReactionRepository(ctrl)
 	mockRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
 
-	service := New(mockRepo)
-	err := service.CreateReaction(context.Background(), &reaction.Reaction{MilestoneID: 1, UserID: 1})
+	service := New(mockRepo, nil, nil, nil)
+	err := service.CreateReaction(context.Background(), &reaction.Reaction{MilestoneID: 1, UserID: 1, Type: &reactionType})
 
 	assert.NoError(t, err)
 }
 
 func TestUpdateReaction(t *testing.T) {
+	reactionType := "love"
+
+	tests := []struct {
+		name        string
+		accessErr   error
+		expectedErr error
+	}{
+		{name: "el autor cambia el tipo de su reacción"},
+		{name: "rechaza a quien no reaccionó", accessErr: services.ErrNotAuthor, expectedErr: services.ErrNotAuthor},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mockRepo.NewMockReactionRepository(ctrl)
+			mockRepo.EXPECT().GetByID(gomock.Any(), 1).Return(&reaction.Reaction{ID: 1, MilestoneID: 1, UserID: 4}, nil)
+			mockAccess := mockService.NewMockAccessService(ctrl)
+			mockAccess.EXPECT().RequireAuthor(gomock.Any(), 4).Return(tt.accessErr)
+			if tt.expectedErr == nil {
+				mockRepo.EXPECT().
+					Update(gomock.Any(), gomock.Cond(func(r *reaction.Reaction) bool { return r.UserID == 4 })).
+					Return(nil)
+			}
+
+			service := New(mockRepo, nil, mockAccess, nil)
+			err := service.UpdateReaction(context.Background(), &reaction.Reaction{ID: 1, MilestoneID: 1, UserID: 9, Type: &reactionType})
+
+			assert.ErrorIs(t, err, tt.expectedErr)
+		})
+	}
+}
+
+func TestDeleteReaction(t *testing.T) {
+	tests := []struct {
+		name        string
+		accessErr   error
+		expectedErr error
+	}{
+		{name: "el autor retira su reacción"},
+		{name: "rechaza a quien no reaccionó", accessErr: services.ErrNotAuthor, expectedErr: services.ErrNotAuthor},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mockRepo.NewMockReactionRepository(ctrl)
+			mockRepo.EXPECT().GetByID(gomock.Any(), 1).Return(&reaction.Reaction{ID: 1, UserID: 4}, nil)
+			mockAccess := mockService.NewMockAccessService(ctrl)
+			mockAccess.EXPECT().RequireAuthor(gomock.Any(), 4).Return(tt.accessErr)
+			if tt.expectedErr == nil {
+				mockRepo.EXPECT().Delete(gomock.Any(), 1).Return(nil)
+			}
+
+			service := New(mockRepo, nil, mockAccess, nil)
+			err := service.DeleteReaction(context.Background(), 1)
+
+			assert.ErrorIs(t, err, tt.expectedErr)
+		})
+	}
+}
+
+func TestCreateReaction_TipoInvalido(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
-	mockRepo := mockRepo.NewMockReactionRepository(ctrl)
-	mockRepo.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
+	unknown := "angry"
+	tests := []struct {
+		name         string
+		reactionType *string
+	}{
+		{"sin tipo", nil},
+		{"fuera del catálogo", &unknown},
+	}
 
-	service := New(mockRepo)
-	err := service.UpdateReaction(context.Background(), &reaction.Reaction{ID: 1, MilestoneID: 1, UserID: 1})
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := New(mockRepo.NewMockReactionRepository(ctrl), nil, nil, nil)
+			err := service.CreateReaction(context.Background(), &reaction.Reaction{MilestoneID: 1, UserID: 1, Type: tt.reactionType})
 
-	assert.NoError(t, err)
+			assert.ErrorIs(t, err, services.ErrInvalidReactionType)
+		})
+	}
 }
 
-func TestDeleteReaction(t *testing.T) {
+func TestNew_CatalogoConfigurado(t *testing.T) {
+	assert.Equal(t, reaction.DefaultTypes, New(nil, nil, nil, nil).GetReactionTypes())
+	assert.Equal(t, []string{"up", "down"}, New(nil, nil, nil, []string{"up", "down"}).GetReactionTypes())
+}
+
+func TestSetReaction(t *testing.T) {
+	counts := map[string]reaction.TypeCount{"like": {Type: "like", Count: 2, Reacted: true}}
+
+	tests := []struct {
+		name         string
+		reactionType string
+		reacted      bool
+		setup        func(reactions *mockRepo.MockReactionRepository, milestones *mockRepo.MockMilestoneRepository)
+		wantErr      error
+	}{
+		{
+			name:         "agrega la reacción",
+			reactionType: "like",
+			reacted:      true,
+			setup: func(reactions *mockRepo.MockReactionRepository, milestones *mockRepo.MockMilestoneRepository) {
+				milestones.EXPECT().GetByID(gomock.Any(), 1).Return(&milestone.Milestone{ID: 1}, nil)
+				reactions.EXPECT().AddForUser(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, r *reaction.Reaction) error {
+					assert.Equal(t, 1, r.MilestoneID)
+					assert.Equal(t, 7, r.UserID)
+					assert.Equal(t, "like", *r.Type)
+					return nil
+				})
+				reactions.EXPECT().CountByMilestone(gomock.Any(), 1, 7).Return(counts, nil)
+			},
+		},
+		{
+			name:         "retira la reacción",
+			reactionType: "like",
+			reacted:      false,
+			setup: func(reactions *mockRepo.MockReactionRepository, milestones *mockRepo.MockMilestoneRepository) {
+				milestones.EXPECT().GetByID(gomock.Any(), 1).Return(&milestone.Milestone{ID: 1}, nil)
+				reactions.EXPECT().RemoveForUser(gomock.Any(), 1, 7, "like").Return(nil)
+				reactions.EXPECT().CountByMilestone(gomock.Any(), 1, 7).Return(counts, nil)
+			},
+		},
+		{
+			name:         "tipo fuera del catálogo",
+			reactionType: "angry",
+			reacted:      true,
+			setup:        func(*mockRepo.MockReactionRepository, *mockRepo.MockMilestoneRepository) {},
+			wantErr:      services.ErrInvalidReactionType,
+		},
+		{
+			name:         "hito inexistente",
+			reactionType: "like",
+			reacted:      true,
+			setup: func(reactions *mockRepo.MockReactionRepository, milestones *mockRepo.MockMilestoneRepository) {
+				milestones.EXPECT().GetByID(gomock.Any(), 1).Return(nil, errs.NotFound("milestone no encontrado"))
+			},
+			wantErr: errs.ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			reactions := mockRepo.NewMockReactionRepository(ctrl)
+			milestones := mockRepo.NewMockMilestoneRepository(ctrl)
+			tt.setup(reactions, milestones)
+
+			service := New(reactions, milestones, nil, nil)
+			result, err := service.SetReaction(context.Background(), 1, 7, tt.reactionType, tt.reacted)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Nil(t, result)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Len(t, result, len(reaction.DefaultTypes))
+			assert.Equal(t, reaction.TypeCount{Type: "like", Count: 2, Reacted: true}, result[0])
+		})
+	}
+}
+
+func TestGetReactionSummary(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
-	mockRepo := mockRepo.NewMockReactionRepository(ctrl)
-	mockRepo.EXPECT().Delete(gomock.Any(), 1).Return(nil)
+	reactions := mockRepo.NewMockReactionRepository(ctrl)
+	milestones := mockRepo.NewMockMilestoneRepository(ctrl)
+	milestones.EXPECT().GetByID(gomock.Any(), 1).Return(&milestone.Milestone{ID: 1}, nil)
+	reactions.EXPECT().CountByMilestone(gomock.Any(), 1, 7).Return(map[string]reaction.TypeCount{
+		"down": {Type: "down", Count: 1},
+	}, nil)
 
-	service := New(mockRepo)
-	err := service.DeleteReaction(context.Background(), 1)
+	service := New(reactions, milestones, nil, []string{"up", "down"})
+	result, err := service.GetReactionSummary(context.Background(), 1, 7)
 
 	assert.NoError(t, err)
+	assert.Equal(t, []reaction.TypeCount{{Type: "up"}, {Type: "down", Count: 1}}, result)
 }
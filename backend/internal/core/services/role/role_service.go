@@ -2,23 +2,29 @@ package role
 
 import (
 	"context"
+	"encoding/json"
+	"softpharos/internal/core/domain/audit"
+	"softpharos/internal/core/domain/identity"
+	"softpharos/internal/core/domain/query"
 	"softpharos/internal/core/domain/role"
 	"softpharos/internal/core/ports/repository"
 	"softpharos/internal/core/ports/services"
 )
 
 type Service struct {
-	roleRepo repository.RoleRepository
+	roleRepo   repository.RoleRepository
+	unitOfWork repository.UnitOfWork
 }
 
-func New(roleRepo repository.RoleRepository) services.RoleService {
+func New(roleRepo repository.RoleRepository, unitOfWork repository.UnitOfWork) services.RoleService {
 	return &Service{
-		roleRepo: roleRepo,
+		roleRepo:   roleRepo,
+		unitOfWork: unitOfWork,
 	}
 }
 
-func (s *Service) GetAllRoles(ctx context.Context) ([]role.Role, error) {
-	return s.roleRepo.GetAll(ctx)
+func (s *Service) GetAllRoles(ctx context.Context, params query.Params) (*query.Page[role.Role], error) {
+	return s.roleRepo.GetAll(ctx, params)
 }
 
 func (s *Service) GetRoleByID(ctx context.Context, id int) (*role.Role, error) {
@@ -28,3 +34,66 @@ func (s *Service) GetRoleByID(ctx context.Context, id int) (*role.Role, error) {
 func (s *Service) GetRoleByName(ctx context.Context, name string) (*role.Role, error) {
 	return s.roleRepo.GetByName(ctx, name)
 }
+
+func (s *Service) CreateRole(ctx context.Context, r *role.Role) error {
+	return s.unitOfWork.Do(ctx, func(repos repository.Repositories) error {
+		if err := repos.Roles.Create(ctx, r); err != nil {
+			return err
+		}
+
+		return record(ctx, repos.AuditLog, audit.ActionRoleCreated, r.ID, map[string]any{"name": r.Name})
+	})
+}
+
+func (s *Service) UpdateRole(ctx context.Context, r *role.Role) error {
+	existing, err := s.roleRepo.GetByID(ctx, r.ID)
+	if err != nil {
+		return err
+	}
+
+	if existing.Name != r.Name && role.IsSystem(existing.Name) {
+		return services.ErrProtectedRole
+	}
+
+	return s.unitOfWork.Do(ctx, func(repos repository.Repositories) error {
+		if err := repos.Roles.Update(ctx, r); err != nil {
+			return err
+		}
+
+		return record(ctx, repos.AuditLog, audit.ActionRoleUpdated, r.ID, map[string]any{"from": existing.Name, "to": r.Name})
+	})
+}
+
+func (s *Service) DeleteRole(ctx context.Context, id int) error {
+	existing, err := s.roleRepo.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if role.IsSystem(existing.Name) {
+		return services.ErrProtectedRole
+	}
+
+	return s.unitOfWork.Do(ctx, func(repos repository.Repositories) error {
+		if err := repos.Roles.Delete(ctx, id); err != nil {
+			return err
+		}
+
+		return record(ctx, repos.AuditLog, audit.ActionRoleDeleted, id, map[string]any{"name": existing.Name})
+	})
+}
+
+// record registra la acción en la auditoría con el usuario autenticado como
+// actor; se llama dentro de la misma transacción que el cambio del rol
+func record(ctx context.Context, auditRepo repository.AuditLogRepository, action string, roleID int, details map[string]any) error {
+	entry := &audit.Entry{Action: action, Entity: "role", EntityID: roleID}
+	if id, ok := identity.FromContext(ctx); ok {
+		entry.ActorID = &id.UserID
+	}
+	if encoded, err := json.Marshal(details); err == nil {
+		detailsStr := string(encoded)
+		entry.Details = &detailsStr
+	}
+
+	return auditRepo.Create(ctx, entry)
+}
@@ -3,7 +3,12 @@ package role
 import (
 	"context"
 	"errors"
+	"softpharos/internal/core/domain/audit"
+	"softpharos/internal/core/domain/identity"
+	"softpharos/internal/core/domain/query"
 	"softpharos/internal/core/domain/role"
+	"softpharos/internal/core/ports/repository"
+	"softpharos/internal/core/ports/services"
 	mockRepo "softpharos/mocks/core/ports/repository"
 	"testing"
 	"time"
@@ -12,6 +17,18 @@ import (
 	"go.uber.org/mock/gomock"
 )
 
+// unitOfWork simula la transacción ejecutando fn con los repositorios dados
+func unitOfWork(ctrl *gomock.Controller, repos repository.Repositories) *mockRepo.MockUnitOfWork {
+	uow := mockRepo.NewMockUnitOfWork(ctrl)
+	uow.EXPECT().
+		Do(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, fn func(repository.Repositories) error) error {
+			return fn(repos)
+		}).
+		AnyTimes()
+	return uow
+}
+
 func TestGetAllRoles(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -30,11 +47,11 @@ func TestGetAllRoles(t *testing.T) {
 			name: "retorna roles exitosamente",
 			mockSetup: func(m *mockRepo.MockRoleRepository) {
 				m.EXPECT().
-					GetAll(gomock.Any()).
-					Return([]role.Role{
+					GetAll(gomock.Any(), gomock.Any()).
+					Return(&query.Page[role.Role]{Items: []role.Role{
 						{ID: 1, Name: "Admin", Description: &desc1, CreatedAt: now},
 						{ID: 2, Name: "Developer", Description: &desc2, CreatedAt: now},
-					}, nil)
+					}}, nil)
 			},
 			expectedRoles: []role.Role{
 				{ID: 1, Name: "Admin", Description: &desc1, CreatedAt: now},
@@ -46,8 +63,8 @@ func TestGetAllRoles(t *testing.T) {
 			name: "retorna error cuando el repositorio falla",
 			mockSetup: func(m *mockRepo.MockRoleRepository) {
 				m.EXPECT().
-					GetAll(gomock.Any()).
-					Return([]role.Role{}, errors.New("database error"))
+					GetAll(gomock.Any(), gomock.Any()).
+					Return(&query.Page[role.Role]{Items: []role.Role{}}, errors.New("database error"))
 			},
 			expectedRoles: []role.Role{},
 			expectedErr:   errors.New("database error"),
@@ -59,12 +76,12 @@ func TestGetAllRoles(t *testing.T) {
 			mockRepository := mockRepo.NewMockRoleRepository(ctrl)
 			tt.mockSetup(mockRepository)
 
-			service := New(mockRepository)
+			service := New(mockRepository, nil)
 			ctx := context.Background()
 
-			result, err := service.GetAllRoles(ctx)
+			result, err := service.GetAllRoles(ctx, query.Params{})
 
-			assert.Equal(t, tt.expectedRoles, result)
+			assert.Equal(t, tt.expectedRoles, result.Items)
 			if tt.expectedErr != nil {
 				assert.EqualError(t, err, tt.expectedErr.Error())
 			} else {
@@ -117,7 +134,7 @@ func TestGetRoleByID(t *testing.T) {
 			mockRepository := mockRepo.NewMockRoleRepository(ctrl)
 			tt.mockSetup(mockRepository)
 
-			service := New(mockRepository)
+			service := New(mockRepository, nil)
 			ctx := context.Background()
 
 			result, err := service.GetRoleByID(ctx, tt.roleID)
@@ -175,7 +192,7 @@ func TestGetRoleByName(t *testing.T) {
 			mockRepository := mockRepo.NewMockRoleRepository(ctrl)
 			tt.mockSetup(mockRepository)
 
-			service := New(mockRepository)
+			service := New(mockRepository, nil)
 			ctx := context.Background()
 
 			result, err := service.GetRoleByName(ctx, tt.roleName)
@@ -189,3 +206,174 @@ func TestGetRoleByName(t *testing.T) {
 		})
 	}
 }
+
+func TestCreateRole(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tests := []struct {
+		name        string
+		mockSetup   func(*mockRepo.MockRoleRepository, *mockRepo.MockAuditLogRepository)
+		expectedErr error
+	}{
+		{
+			name: "crea el rol y lo audita con el actor",
+			mockSetup: func(r *mockRepo.MockRoleRepository, a *mockRepo.MockAuditLogRepository) {
+				r.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, rl *role.Role) error {
+					rl.ID = 4
+					return nil
+				})
+				a.EXPECT().Create(gomock.Any(), gomock.Cond(func(e *audit.Entry) bool {
+					return e.Action == audit.ActionRoleCreated && e.EntityID == 4 && e.ActorID != nil && *e.ActorID == 1
+				})).Return(nil)
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "retorna el error de la auditoría para revertir la creación",
+			mockSetup: func(r *mockRepo.MockRoleRepository, a *mockRepo.MockAuditLogRepository) {
+				r.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+				a.EXPECT().Create(gomock.Any(), gomock.Any()).Return(errors.New("audit error"))
+			},
+			expectedErr: errors.New("audit error"),
+		},
+		{
+			name: "no audita si el repositorio falla",
+			mockSetup: func(r *mockRepo.MockRoleRepository, a *mockRepo.MockAuditLogRepository) {
+				r.EXPECT().Create(gomock.Any(), gomock.Any()).Return(errors.New("database error"))
+			},
+			expectedErr: errors.New("database error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			roleRepository := mockRepo.NewMockRoleRepository(ctrl)
+			auditRepository := mockRepo.NewMockAuditLogRepository(ctrl)
+			tt.mockSetup(roleRepository, auditRepository)
+
+			service := New(roleRepository, unitOfWork(ctrl, repository.Repositories{Roles: roleRepository, AuditLog: auditRepository}))
+			ctx := identity.NewContext(context.Background(), &identity.Identity{UserID: 1, Role: role.Admin})
+
+			err := service.CreateRole(ctx, &role.Role{Name: "assistant"})
+
+			if tt.expectedErr != nil {
+				assert.EqualError(t, err, tt.expectedErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestUpdateRole(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tests := []struct {
+		name        string
+		input       *role.Role
+		mockSetup   func(*mockRepo.MockRoleRepository, *mockRepo.MockAuditLogRepository)
+		expectedErr error
+	}{
+		{
+			name:  "actualiza un rol personalizado",
+			input: &role.Role{ID: 4, Name: "tutor"},
+			mockSetup: func(r *mockRepo.MockRoleRepository, a *mockRepo.MockAuditLogRepository) {
+				r.EXPECT().GetByID(gomock.Any(), 4).Return(&role.Role{ID: 4, Name: "assistant"}, nil)
+				r.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
+				a.EXPECT().Create(gomock.Any(), gomock.Cond(func(e *audit.Entry) bool {
+					return e.Action == audit.ActionRoleUpdated && e.EntityID == 4
+				})).Return(nil)
+			},
+			expectedErr: nil,
+		},
+		{
+			name:  "permite cambiar la descripción de un rol del sistema",
+			input: &role.Role{ID: 1, Name: role.Admin},
+			mockSetup: func(r *mockRepo.MockRoleRepository, a *mockRepo.MockAuditLogRepository) {
+				r.EXPECT().GetByID(gomock.Any(), 1).Return(&role.Role{ID: 1, Name: role.Admin}, nil)
+				r.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
+				a.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+			},
+			expectedErr: nil,
+		},
+		{
+			name:  "rechaza renombrar un rol del sistema",
+			input: &role.Role{ID: 1, Name: "superadmin"},
+			mockSetup: func(r *mockRepo.MockRoleRepository, a *mockRepo.MockAuditLogRepository) {
+				r.EXPECT().GetByID(gomock.Any(), 1).Return(&role.Role{ID: 1, Name: role.Admin}, nil)
+			},
+			expectedErr: services.ErrProtectedRole,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			roleRepository := mockRepo.NewMockRoleRepository(ctrl)
+			auditRepository := mockRepo.NewMockAuditLogRepository(ctrl)
+			tt.mockSetup(roleRepository, auditRepository)
+
+			service := New(roleRepository, unitOfWork(ctrl, repository.Repositories{Roles: roleRepository, AuditLog: auditRepository}))
+
+			err := service.UpdateRole(context.Background(), tt.input)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestDeleteRole(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tests := []struct {
+		name        string
+		id          int
+		mockSetup   func(*mockRepo.MockRoleRepository, *mockRepo.MockAuditLogRepository)
+		expectedErr error
+	}{
+		{
+			name: "elimina un rol personalizado",
+			id:   4,
+			mockSetup: func(r *mockRepo.MockRoleRepository, a *mockRepo.MockAuditLogRepository) {
+				r.EXPECT().GetByID(gomock.Any(), 4).Return(&role.Role{ID: 4, Name: "assistant"}, nil)
+				r.EXPECT().Delete(gomock.Any(), 4).Return(nil)
+				a.EXPECT().Create(gomock.Any(), gomock.Cond(func(e *audit.Entry) bool {
+					return e.Action == audit.ActionRoleDeleted && e.EntityID == 4
+				})).Return(nil)
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "rechaza eliminar un rol del sistema",
+			id:   3,
+			mockSetup: func(r *mockRepo.MockRoleRepository, a *mockRepo.MockAuditLogRepository) {
+				r.EXPECT().GetByID(gomock.Any(), 3).Return(&role.Role{ID: 3, Name: role.Student}, nil)
+			},
+			expectedErr: services.ErrProtectedRole,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			roleRepository := mockRepo.NewMockRoleRepository(ctrl)
+			auditRepository := mockRepo.NewMockAuditLogRepository(ctrl)
+			tt.mockSetup(roleRepository, auditRepository)
+
+			service := New(roleRepository, unitOfWork(ctrl, repository.Repositories{Roles: roleRepository, AuditLog: auditRepository}))
+
+			err := service.DeleteRole(context.Background(), tt.id)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,142 @@
+package signup_rule
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"softpharos/internal/core/domain/identity"
+	"softpharos/internal/core/domain/query"
+	"softpharos/internal/core/domain/role"
+	"softpharos/internal/core/domain/signup_rule"
+	"softpharos/internal/core/errs"
+	"softpharos/internal/core/ports/repository"
+	"softpharos/internal/core/ports/services"
+)
+
+type Service struct {
+	ruleRepo       repository.SignupRuleRepository
+	roleRepo       repository.RoleRepository
+	allowedDomains []string
+}
+
+// New crea el servicio de reglas de registro. allowedDomains es la lista de
+// dominios que pueden iniciar sesión además de los que tienen una regla; si
+// está vacía y no hay reglas que restrinjan, se acepta cualquier dominio.
+func New(ruleRepo repository.SignupRuleRepository, roleRepo repository.RoleRepository, allowedDomains []string) services.SignupRuleService {
+	normalized := make([]string, 0, len(allowedDomains))
+	for _, domain := range allowedDomains {
+		if domain = normalizePattern(domain); domain != "" {
+			normalized = append(normalized, domain)
+		}
+	}
+
+	return &Service{
+		ruleRepo:       ruleRepo,
+		roleRepo:       roleRepo,
+		allowedDomains: normalized,
+	}
+}
+
+func (s *Service) GetAllSignupRules(ctx context.Context, params query.Params) (*query.Page[signup_rule.SignupRule], error) {
+	return s.ruleRepo.GetAll(ctx, params)
+}
+
+func (s *Service) GetSignupRuleByID(ctx context.Context, id int) (*signup_rule.SignupRule, error) {
+	return s.ruleRepo.GetByID(ctx, id)
+}
+
+func (s *Service) CreateSignupRule(ctx context.Context, rule *signup_rule.SignupRule) error {
+	if err := s.validate(ctx, rule); err != nil {
+		return err
+	}
+	return s.ruleRepo.Create(ctx, rule)
+}
+
+func (s *Service) UpdateSignupRule(ctx context.Context, rule *signup_rule.SignupRule) error {
+	if err := s.validate(ctx, rule); err != nil {
+		return err
+	}
+	return s.ruleRepo.Update(ctx, rule)
+}
+
+func (s *Service) DeleteSignupRule(ctx context.Context, id int) error {
+	return s.ruleRepo.Delete(ctx, id)
+}
+
+// MatchAccount decide con el dominio alojado (hd) que certifica el proveedor,
+// no con el dominio del email: una cuenta personal puede tener cualquier
+// dirección. El email solo se usa para las reglas por email exacto.
+func (s *Service) MatchAccount(ctx context.Context, account *identity.ProviderIdentity) (*signup_rule.SignupRule, error) {
+	email := strings.ToLower(account.Email)
+	hostedDomain := normalizePattern(account.HostedDomain)
+
+	patterns := []string{email}
+	if hostedDomain != "" {
+		patterns = append(patterns, hostedDomain)
+	}
+
+	rules, err := s.ruleRepo.GetByPatterns(ctx, patterns)
+	if err != nil {
+		return nil, err
+	}
+
+	var match *signup_rule.SignupRule
+	for i := range rules {
+		switch {
+		case rules[i].IsEmail() && rules[i].Pattern == email:
+			match = &rules[i]
+		case !rules[i].IsEmail() && hostedDomain != "" && rules[i].Pattern == hostedDomain && match == nil:
+			match = &rules[i]
+		}
+	}
+
+	if match == nil && len(s.allowedDomains) > 0 && !slices.Contains(s.allowedDomains, hostedDomain) {
+		return nil, services.ErrDomainNotAllowed
+	}
+
+	return match, nil
+}
+
+// validate normaliza el patrón de la regla y verifica que el rol exista y
+// tenga permisos en la API
+func (s *Service) validate(ctx context.Context, rule *signup_rule.SignupRule) error {
+	rule.Pattern = normalizePattern(rule.Pattern)
+	if !validPattern(rule.Pattern) {
+		return fmt.Errorf("%w: el patrón debe ser un email o un dominio", services.ErrInvalidSignupRule)
+	}
+
+	r, err := s.roleRepo.GetByID(ctx, rule.RoleID)
+	if errors.Is(err, errs.ErrNotFound) {
+		return fmt.Errorf("%w: el rol %d no existe", services.ErrInvalidSignupRule, rule.RoleID)
+	}
+	if err != nil {
+		return err
+	}
+	if !role.IsSystem(r.Name) {
+		return fmt.Errorf("%w: el rol %s no tiene permisos en la API", services.ErrInvalidSignupRule, r.Name)
+	}
+
+	return nil
+}
+
+func normalizePattern(pattern string) string {
+	return strings.TrimPrefix(strings.ToLower(strings.TrimSpace(pattern)), "@")
+}
+
+func validPattern(pattern string) bool {
+	if pattern == "" || strings.ContainsAny(pattern, " \t/") {
+		return false
+	}
+
+	local, domain, isEmail := strings.Cut(pattern, "@")
+	if !isEmail {
+		domain = local
+	} else if local == "" || strings.Contains(domain, "@") {
+		return false
+	}
+
+	return strings.Contains(domain, ".") && !strings.HasPrefix(domain, ".") && !strings.HasSuffix(domain, ".")
+}
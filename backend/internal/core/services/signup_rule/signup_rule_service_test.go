@@ -0,0 +1,240 @@
+package signup_rule
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"softpharos/internal/core/domain/identity"
+	"softpharos/internal/core/domain/role"
+	"softpharos/internal/core/domain/signup_rule"
+	"softpharos/internal/core/errs"
+	"softpharos/internal/core/ports/services"
+	mockRepo "softpharos/mocks/core/ports/repository"
+)
+
+func TestMatchAccount(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	domainRule := signup_rule.SignupRule{ID: 1, Pattern: "unal.edu.co", RoleID: 2}
+	emailRule := signup_rule.SignupRule{ID: 2, Pattern: "decano@unal.edu.co", RoleID: 1}
+	taRule := signup_rule.SignupRule{ID: 3, Pattern: "udea.edu.co", RoleID: 2}
+
+	tests := []struct {
+		name           string
+		email          string
+		hostedDomain   string
+		allowedDomains []string
+		rules          []signup_rule.SignupRule
+		expected       *signup_rule.SignupRule
+		expectedErr    error
+	}{
+		{
+			name:           "acepta un dominio permitido sin reglas",
+			email:          "estudiante@unal.edu.co",
+			hostedDomain:   "unal.edu.co",
+			allowedDomains: []string{"unal.edu.co"},
+			expected:       nil,
+		},
+		{
+			name:           "rechaza un dominio no permitido",
+			email:          "alguien@gmail.com",
+			allowedDomains: []string{"unal.edu.co", "udea.edu.co"},
+			expectedErr:    services.ErrDomainNotAllowed,
+		},
+		{
+			name:           "retorna la regla del dominio",
+			email:          "profesor@unal.edu.co",
+			hostedDomain:   "unal.edu.co",
+			allowedDomains: []string{"unal.edu.co"},
+			rules:          []signup_rule.SignupRule{domainRule},
+			expected:       &domainRule,
+		},
+		{
+			name:           "la regla por email tiene prioridad sobre la del dominio",
+			email:          "Decano@UNAL.edu.co",
+			hostedDomain:   "unal.edu.co",
+			allowedDomains: []string{"unal.edu.co"},
+			rules:          []signup_rule.SignupRule{emailRule, domainRule},
+			expected:       &emailRule,
+		},
+		{
+			name:           "una regla permite un dominio fuera de la lista",
+			email:          "monitor@udea.edu.co",
+			hostedDomain:   "udea.edu.co",
+			allowedDomains: []string{"unal.edu.co"},
+			rules:          []signup_rule.SignupRule{taRule},
+			expected:       &taRule,
+		},
+		{
+			name:           "rechaza una cuenta sin dominio alojado aunque el email sea de un dominio permitido",
+			email:          "estudiante@unal.edu.co",
+			allowedDomains: []string{"unal.edu.co"},
+			expectedErr:    services.ErrDomainNotAllowed,
+		},
+		{
+			name:           "rechaza un dominio alojado distinto al del email",
+			email:          "estudiante@unal.edu.co",
+			hostedDomain:   "otra.org",
+			allowedDomains: []string{"unal.edu.co"},
+			expectedErr:    services.ErrDomainNotAllowed,
+		},
+		{
+			name:           "una regla de dominio no aplica a una cuenta sin dominio alojado",
+			email:          "profesor@unal.edu.co",
+			allowedDomains: []string{"unal.edu.co"},
+			rules:          []signup_rule.SignupRule{domainRule},
+			expectedErr:    services.ErrDomainNotAllowed,
+		},
+		{
+			name:           "una regla por email permite una cuenta sin dominio alojado",
+			email:          "decano@unal.edu.co",
+			allowedDomains: []string{"unal.edu.co"},
+			rules:          []signup_rule.SignupRule{emailRule},
+			expected:       &emailRule,
+		},
+		{
+			name:     "acepta cualquier dominio cuando la lista está vacía",
+			email:    "alguien@gmail.com",
+			expected: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ruleRepo := mockRepo.NewMockSignupRuleRepository(ctrl)
+			ruleRepo.EXPECT().GetByPatterns(gomock.Any(), gomock.Any()).Return(tt.rules, nil)
+
+			service := New(ruleRepo, mockRepo.NewMockRoleRepository(ctrl), tt.allowedDomains)
+			result, err := service.MatchAccount(context.Background(), &identity.ProviderIdentity{Email: tt.email, HostedDomain: tt.hostedDomain})
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestMatchAccount_LooksUpEmailAndDomain(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ruleRepo := mockRepo.NewMockSignupRuleRepository(ctrl)
+	ruleRepo.EXPECT().GetByPatterns(gomock.Any(), []string{"ta@udea.edu.co", "udea.edu.co"}).Return(nil, nil)
+	ruleRepo.EXPECT().GetByPatterns(gomock.Any(), []string{"ta@udea.edu.co"}).Return(nil, nil)
+
+	service := New(ruleRepo, mockRepo.NewMockRoleRepository(ctrl), nil)
+	_, err := service.MatchAccount(context.Background(), &identity.ProviderIdentity{Email: "TA@udea.edu.co", HostedDomain: "UDEA.edu.co"})
+	assert.NoError(t, err)
+
+	// Sin dominio alojado solo se buscan reglas por email
+	_, err = service.MatchAccount(context.Background(), &identity.ProviderIdentity{Email: "TA@udea.edu.co"})
+	assert.NoError(t, err)
+}
+
+func TestCreateSignupRule(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tests := []struct {
+		name            string
+		pattern         string
+		mockSetup       func(*mockRepo.MockSignupRuleRepository, *mockRepo.MockRoleRepository)
+		expectedPattern string
+		expectedErr     error
+	}{
+		{
+			name:    "crea una regla por dominio normalizando el patrón",
+			pattern: " @UNAL.edu.co ",
+			mockSetup: func(r *mockRepo.MockSignupRuleRepository, roles *mockRepo.MockRoleRepository) {
+				roles.EXPECT().GetByID(gomock.Any(), 2).Return(&role.Role{ID: 2, Name: role.Professor}, nil)
+				r.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+			},
+			expectedPattern: "unal.edu.co",
+		},
+		{
+			name:    "crea una regla por email",
+			pattern: "decano@unal.edu.co",
+			mockSetup: func(r *mockRepo.MockSignupRuleRepository, roles *mockRepo.MockRoleRepository) {
+				roles.EXPECT().GetByID(gomock.Any(), 2).Return(&role.Role{ID: 2, Name: role.Professor}, nil)
+				r.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+			},
+			expectedPattern: "decano@unal.edu.co",
+		},
+		{
+			name:        "rechaza un patrón sin dominio válido",
+			pattern:     "localhost",
+			mockSetup:   func(r *mockRepo.MockSignupRuleRepository, roles *mockRepo.MockRoleRepository) {},
+			expectedErr: services.ErrInvalidSignupRule,
+		},
+		{
+			name:        "rechaza un email sin usuario",
+			pattern:     "@@unal.edu.co",
+			mockSetup:   func(r *mockRepo.MockSignupRuleRepository, roles *mockRepo.MockRoleRepository) {},
+			expectedErr: services.ErrInvalidSignupRule,
+		},
+		{
+			name:    "rechaza un rol inexistente",
+			pattern: "unal.edu.co",
+			mockSetup: func(r *mockRepo.MockSignupRuleRepository, roles *mockRepo.MockRoleRepository) {
+				roles.EXPECT().GetByID(gomock.Any(), 2).Return(nil, errs.NotFound("no encontrado"))
+			},
+			expectedErr: services.ErrInvalidSignupRule,
+		},
+		{
+			name:    "rechaza un rol sin permisos en la API",
+			pattern: "unal.edu.co",
+			mockSetup: func(r *mockRepo.MockSignupRuleRepository, roles *mockRepo.MockRoleRepository) {
+				roles.EXPECT().GetByID(gomock.Any(), 2).Return(&role.Role{ID: 2, Name: "monitor"}, nil)
+			},
+			expectedErr: services.ErrInvalidSignupRule,
+		},
+		{
+			name:    "retorna error cuando falla la base de datos",
+			pattern: "unal.edu.co",
+			mockSetup: func(r *mockRepo.MockSignupRuleRepository, roles *mockRepo.MockRoleRepository) {
+				roles.EXPECT().GetByID(gomock.Any(), 2).Return(&role.Role{ID: 2, Name: role.Professor}, nil)
+				r.EXPECT().Create(gomock.Any(), gomock.Any()).Return(errors.New("database error"))
+			},
+			expectedErr: errors.New("database error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ruleRepo := mockRepo.NewMockSignupRuleRepository(ctrl)
+			roleRepo := mockRepo.NewMockRoleRepository(ctrl)
+			tt.mockSetup(ruleRepo, roleRepo)
+
+			rule := &signup_rule.SignupRule{Pattern: tt.pattern, RoleID: 2}
+			err := New(ruleRepo, roleRepo, nil).CreateSignupRule(context.Background(), rule)
+
+			if tt.expectedErr != nil {
+				assert.ErrorContains(t, err, tt.expectedErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedPattern, rule.Pattern)
+			}
+		})
+	}
+}
+
+func TestDeleteSignupRule(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ruleRepo := mockRepo.NewMockSignupRuleRepository(ctrl)
+	ruleRepo.EXPECT().Delete(gomock.Any(), 1).Return(nil)
+
+	err := New(ruleRepo, mockRepo.NewMockRoleRepository(ctrl), nil).DeleteSignupRule(context.Background(), 1)
+
+	assert.NoError(t, err)
+}
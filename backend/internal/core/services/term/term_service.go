@@ -0,0 +1,67 @@
+package term
+
+import (
+	"context"
+
+	"softpharos/internal/core/domain/query"
+	"softpharos/internal/core/domain/term"
+	"softpharos/internal/core/ports/repository"
+	"softpharos/internal/core/ports/services"
+)
+
+type Service struct {
+	termRepo repository.TermRepository
+}
+
+func New(termRepo repository.TermRepository) services.TermService {
+	return &Service{termRepo: termRepo}
+}
+
+func (s *Service) GetAllTerms(ctx context.Context, params query.Params) (*query.Page[term.Term], error) {
+	return s.termRepo.GetAll(ctx, params)
+}
+
+func (s *Service) GetTermByID(ctx context.Context, id int) (*term.Term, error) {
+	return s.termRepo.GetByID(ctx, id)
+}
+
+func (s *Service) CreateTerm(ctx context.Context, t *term.Term) error {
+	if err := validate(t); err != nil {
+		return err
+	}
+	return s.termRepo.Create(ctx, t)
+}
+
+func (s *Service) UpdateTerm(ctx context.Context, t *term.Term) error {
+	if err := validate(t); err != nil {
+		return err
+	}
+	return s.termRepo.Update(ctx, t)
+}
+
+// DeleteTerm elimina el periodo; falla con conflicto si aún tiene secciones
+func (s *Service) DeleteTerm(ctx context.Context, id int) error {
+	return s.termRepo.Delete(ctx, id)
+}
+
+// validate revisa las fechas del periodo y de sus recesos. Sin duración de
+// semana se asume la semana de siete días.
+func validate(t *term.Term) error {
+	if !t.EndDate.After(t.StartDate) {
+		return services.ErrInvalidTerm
+	}
+
+	if t.WeekLength == 0 {
+		t.WeekLength = term.DefaultWeekLength
+	}
+	if t.WeekLength < 0 {
+		return services.ErrInvalidWeekLength
+	}
+
+	for _, b := range t.Breaks {
+		if b.EndDate.Before(b.StartDate) || b.StartDate.Before(t.StartDate) || b.EndDate.After(t.EndDate) {
+			return services.ErrInvalidTermBreak
+		}
+	}
+	return nil
+}
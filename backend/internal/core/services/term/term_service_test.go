@@ -0,0 +1,84 @@
+package term
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"softpharos/internal/core/domain/term"
+	"softpharos/internal/core/ports/services"
+	mockRepo "softpharos/mocks/core/ports/repository"
+)
+
+func TestCreateTerm(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	start := time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name         string
+		term         *term.Term
+		expectCreate bool
+		expectedErr  error
+	}{
+		{
+			name:         "crea el periodo",
+			term:         &term.Term{Code: "2026-1", StartDate: start, EndDate: start.AddDate(0, 4, 0)},
+			expectCreate: true,
+		},
+		{
+			name:        "rechaza un periodo que termina antes de comenzar",
+			term:        &term.Term{Code: "2026-1", StartDate: start, EndDate: start.AddDate(0, 0, -1)},
+			expectedErr: services.ErrInvalidTerm,
+		},
+		{
+			name:         "asume semanas de siete días",
+			term:         &term.Term{Code: "2026-1", StartDate: start, EndDate: start.AddDate(0, 4, 0), WeekLength: 0},
+			expectCreate: true,
+		},
+		{
+			name:        "rechaza una duración de semana negativa",
+			term:        &term.Term{Code: "2026-1", StartDate: start, EndDate: start.AddDate(0, 4, 0), WeekLength: -7},
+			expectedErr: services.ErrInvalidWeekLength,
+		},
+		{
+			name: "rechaza un receso fuera del periodo",
+			term: &term.Term{Code: "2026-1", StartDate: start, EndDate: start.AddDate(0, 4, 0), Breaks: []term.Break{
+				{Name: "Vacaciones", StartDate: start.AddDate(0, 5, 0), EndDate: start.AddDate(0, 5, 6)},
+			}},
+			expectedErr: services.ErrInvalidTermBreak,
+		},
+		{
+			name: "rechaza un receso que termina antes de comenzar",
+			term: &term.Term{Code: "2026-1", StartDate: start, EndDate: start.AddDate(0, 4, 0), Breaks: []term.Break{
+				{Name: "Receso", StartDate: start.AddDate(0, 1, 6), EndDate: start.AddDate(0, 1, 0)},
+			}},
+			expectedErr: services.ErrInvalidTermBreak,
+		},
+		{
+			name:        "rechaza un periodo sin duración",
+			term:        &term.Term{Code: "2026-1", StartDate: start, EndDate: start},
+			expectedErr: services.ErrInvalidTerm,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			termRepo := mockRepo.NewMockTermRepository(ctrl)
+			if tt.expectCreate {
+				termRepo.EXPECT().Create(gomock.Any(), tt.term).Return(nil)
+			}
+
+			err := New(termRepo).CreateTerm(context.Background(), tt.term)
+
+			assert.ErrorIs(t, err, tt.expectedErr)
+			if err == nil {
+				assert.Equal(t, term.DefaultWeekLength, tt.term.WeekLength)
+			}
+		})
+	}
+}
@@ -0,0 +1,31 @@
+package timeline
+
+import (
+	"context"
+
+	"softpharos/internal/core/domain/query"
+	"softpharos/internal/core/domain/timeline"
+	"softpharos/internal/core/ports/repository"
+	"softpharos/internal/core/ports/services"
+)
+
+type Service struct {
+	projectRepo  repository.ProjectRepository
+	timelineRepo repository.TimelineRepository
+}
+
+func New(projectRepo repository.ProjectRepository, timelineRepo repository.TimelineRepository) services.TimelineService {
+	return &Service{
+		projectRepo:  projectRepo,
+		timelineRepo: timelineRepo,
+	}
+}
+
+// GetProjectTimeline responde not found si el proyecto no existe o está en la
+// papelera, en lugar de una línea de tiempo vacía
+func (s *Service) GetProjectTimeline(ctx context.Context, projectID int, params query.Params) (*query.Page[timeline.Event], error) {
+	if _, err := s.projectRepo.GetByID(ctx, projectID); err != nil {
+		return nil, err
+	}
+	return s.timelineRepo.GetByProjectID(ctx, projectID, params)
+}
@@ -0,0 +1,53 @@
+package timeline
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"softpharos/internal/core/domain/project"
+	"softpharos/internal/core/domain/query"
+	"softpharos/internal/core/domain/timeline"
+	"softpharos/internal/core/errs"
+	mockRepo "softpharos/mocks/core/ports/repository"
+)
+
+func TestGetProjectTimeline(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	notFound := errs.NotFound("proyecto no encontrado")
+	params := query.Params{Filters: map[string]any{"kind": timeline.KindComment}}
+	page := &query.Page[timeline.Event]{Items: []timeline.Event{{Kind: timeline.KindComment, ID: 9, MilestoneID: 4}}, Total: 1}
+
+	tests := []struct {
+		name         string
+		projectErr   error
+		expectedPage *query.Page[timeline.Event]
+		expectedErr  error
+	}{
+		{name: "lista los eventos del proyecto", expectedPage: page},
+		{name: "retorna not found cuando el proyecto no existe", projectErr: notFound, expectedErr: notFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			projectRepo := mockRepo.NewMockProjectRepository(ctrl)
+			timelineRepo := mockRepo.NewMockTimelineRepository(ctrl)
+			if tt.projectErr != nil {
+				projectRepo.EXPECT().GetByID(gomock.Any(), 3).Return(nil, tt.projectErr)
+			} else {
+				projectRepo.EXPECT().GetByID(gomock.Any(), 3).Return(&project.Project{ID: 3}, nil)
+				timelineRepo.EXPECT().GetByProjectID(gomock.Any(), 3, params).Return(page, nil)
+			}
+
+			service := New(projectRepo, timelineRepo)
+			result, err := service.GetProjectTimeline(context.Background(), 3, params)
+
+			assert.ErrorIs(t, err, tt.expectedErr)
+			assert.Equal(t, tt.expectedPage, result)
+		})
+	}
+}
@@ -0,0 +1,35 @@
+package trash
+
+import (
+	"context"
+	"time"
+
+	"softpharos/internal/core/ports/repository"
+	"softpharos/internal/core/ports/services"
+)
+
+// DefaultRetention es el tiempo que se conserva un elemento en la papelera
+const DefaultRetention = 30 * 24 * time.Hour
+
+type Service struct {
+	trashRepo repository.TrashRepository
+	retention time.Duration
+	now       func() time.Time
+}
+
+func New(trashRepo repository.TrashRepository, retention time.Duration) services.TrashService {
+	if retention <= 0 {
+		retention = DefaultRetention
+	}
+
+	return &Service{
+		trashRepo: trashRepo,
+		retention: retention,
+		now:       time.Now,
+	}
+}
+
+// PurgeExpired calcula el corte en UTC, la zona con que se marca deleted_at
+func (s *Service) PurgeExpired(ctx context.Context) (int64, error) {
+	return s.trashRepo.Purge(ctx, s.now().UTC().Add(-s.retention))
+}
@@ -0,0 +1,86 @@
+package trash
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	mockRepo "softpharos/mocks/core/ports/repository"
+)
+
+func TestPurgeExpired(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	now := time.Date(2025, 4, 30, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name           string
+		retention      time.Duration
+		expectedBefore time.Time
+		purgeErr       error
+	}{
+		{
+			name:           "purga lo borrado antes de la retención configurada",
+			retention:      7 * 24 * time.Hour,
+			expectedBefore: time.Date(2025, 4, 23, 12, 0, 0, 0, time.UTC),
+		},
+		{
+			name:           "usa la retención por defecto cuando no se configura",
+			expectedBefore: time.Date(2025, 3, 31, 12, 0, 0, 0, time.UTC),
+		},
+		{
+			name:           "retorna error cuando el repositorio falla",
+			retention:      24 * time.Hour,
+			expectedBefore: time.Date(2025, 4, 29, 12, 0, 0, 0, time.UTC),
+			purgeErr:       errors.New("database error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepository := mockRepo.NewMockTrashRepository(ctrl)
+			mockRepository.EXPECT().
+				Purge(gomock.Any(), tt.expectedBefore).
+				Return(int64(4), tt.purgeErr)
+
+			service := New(mockRepository, tt.retention).(*Service)
+			service.now = func() time.Time { return now }
+
+			purged, err := service.PurgeExpired(context.Background())
+
+			if tt.purgeErr != nil {
+				assert.ErrorIs(t, err, tt.purgeErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, int64(4), purged)
+			}
+		})
+	}
+}
+
+func TestPurgeExpiredUsesUTC(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// deleted_at se marca en UTC, así que el corte también debe ir en UTC
+	// aunque el reloj del servidor use otra zona horaria
+	bogota := time.FixedZone("COT", -5*60*60)
+	now := time.Date(2025, 4, 30, 21, 0, 0, 0, bogota)
+
+	mockRepository := mockRepo.NewMockTrashRepository(ctrl)
+	mockRepository.EXPECT().
+		Purge(gomock.Any(), time.Date(2025, 4, 30, 2, 0, 0, 0, time.UTC)).
+		Return(int64(0), nil)
+
+	service := New(mockRepository, 24*time.Hour).(*Service)
+	service.now = func() time.Time { return now }
+
+	_, err := service.PurgeExpired(context.Background())
+
+	assert.NoError(t, err)
+}
@@ -2,23 +2,41 @@ package user
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
+	"softpharos/internal/core/domain/audit"
+	"softpharos/internal/core/domain/identity"
+	"softpharos/internal/core/domain/query"
+	"softpharos/internal/core/domain/role"
 	"softpharos/internal/core/domain/user"
+	"softpharos/internal/core/errs"
 	"softpharos/internal/core/ports/repository"
 	"softpharos/internal/core/ports/services"
+	"time"
 )
 
 type Service struct {
-	userRepo repository.UserRepository
+	userRepo   repository.UserRepository
+	roleRepo   repository.RoleRepository
+	unitOfWork repository.UnitOfWork
+	now        func() time.Time
 }
 
-func New(userRepo repository.UserRepository) services.UserService {
+func New(
+	userRepo repository.UserRepository,
+	roleRepo repository.RoleRepository,
+	unitOfWork repository.UnitOfWork,
+) services.UserService {
 	return &Service{
-		userRepo: userRepo,
+		userRepo:   userRepo,
+		roleRepo:   roleRepo,
+		unitOfWork: unitOfWork,
+		now:        time.Now,
 	}
 }
 
-func (s *Service) GetAllUsers(ctx context.Context) ([]user.User, error) {
-	return s.userRepo.GetAll(ctx)
+func (s *Service) GetAllUsers(ctx context.Context, params query.Params) (*query.Page[user.User], error) {
+	return s.userRepo.GetAll(ctx, params)
 }
 
 func (s *Service) GetUserByID(ctx context.Context, id int) (*user.User, error) {
@@ -40,3 +58,67 @@ func (s *Service) UpdateUser(ctx context.Context, usr *user.User) error {
 func (s *Service) DeleteUser(ctx context.Context, id int) error {
 	return s.userRepo.Delete(ctx, id)
 }
+
+func (s *Service) ChangeUserRole(ctx context.Context, id int, roleID int) (*user.User, error) {
+	actor, ok := identity.FromContext(ctx)
+	if !ok {
+		return nil, services.ErrForbidden
+	}
+	if actor.UserID == id {
+		return nil, services.ErrSelfRoleChange
+	}
+
+	usr, err := s.userRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	newRole, err := s.roleRepo.GetByID(ctx, roleID)
+	if errors.Is(err, errs.ErrNotFound) {
+		return nil, services.ErrRoleNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !role.IsSystem(newRole.Name) {
+		return nil, services.ErrRoleWithoutPermissions
+	}
+
+	if usr.RoleID == roleID {
+		return usr, nil
+	}
+
+	// El cambio, la revocación y la auditoría se confirman juntos: un rol
+	// nunca cambia sin dejar registro
+	previousRoleID := usr.RoleID
+	err = s.unitOfWork.Do(ctx, func(repos repository.Repositories) error {
+		if err := repos.Users.UpdateRole(ctx, id, roleID); err != nil {
+			return err
+		}
+
+		// Los access tokens vigentes llevan el rol anterior; el cliente obtiene
+		// uno nuevo con /auth/refresh, que vuelve a leer el rol del usuario.
+		// El corte no se trunca: los tokens emitidos en el mismo segundo que el
+		// cambio pueden llevar el rol anterior y también se rechazan
+		if err := repos.RevokedTokens.RevokeUserTokens(ctx, id, s.now().UTC()); err != nil {
+			return err
+		}
+
+		details, _ := json.Marshal(map[string]int{"from_role_id": previousRoleID, "to_role_id": roleID})
+		detailsStr := string(details)
+		return repos.AuditLog.Create(ctx, &audit.Entry{
+			ActorID:  &actor.UserID,
+			Action:   audit.ActionUserRoleChanged,
+			Entity:   "user",
+			EntityID: id,
+			Details:  &detailsStr,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	usr.RoleID = roleID
+	usr.Role = newRole
+	return usr, nil
+}
@@ -3,7 +3,14 @@ package user
 import (
 	"context"
 	"errors"
+	"softpharos/internal/core/domain/audit"
+	"softpharos/internal/core/domain/identity"
+	"softpharos/internal/core/domain/query"
+	"softpharos/internal/core/domain/role"
 	"softpharos/internal/core/domain/user"
+	"softpharos/internal/core/errs"
+	"softpharos/internal/core/ports/repository"
+	"softpharos/internal/core/ports/services"
 	mockRepo "softpharos/mocks/core/ports/repository"
 	"testing"
 	"time"
@@ -30,11 +37,11 @@ func TestGetAllUsers(t *testing.T) {
 			name: "retorna usuarios exitosamente",
 			mockSetup: func(m *mockRepo.MockUserRepository) {
 				m.EXPECT().
-					GetAll(gomock.Any()).
-					Return([]user.User{
+					GetAll(gomock.Any(), gomock.Any()).
+					Return(&query.Page[user.User]{Items: []user.User{
 						{ID: 1, Name: &name1, Email: "user1@example.com", RoleID: 1, CreatedAt: now},
 						{ID: 2, Name: &name2, Email: "user2@example.com", RoleID: 2, CreatedAt: now},
-					}, nil)
+					}}, nil)
 			},
 			expectedUsers: []user.User{
 				{ID: 1, Name: &name1, Email: "user1@example.com", RoleID: 1, CreatedAt: now},
@@ -46,8 +53,8 @@ func TestGetAllUsers(t *testing.T) {
 			name: "retorna error cuando el repositorio falla",
 			mockSetup: func(m *mockRepo.MockUserRepository) {
 				m.EXPECT().
-					GetAll(gomock.Any()).
-					Return([]user.User{}, errors.New("database error"))
+					GetAll(gomock.Any(), gomock.Any()).
+					Return(&query.Page[user.User]{Items: []user.User{}}, errors.New("database error"))
 			},
 			expectedUsers: []user.User{},
 			expectedErr:   errors.New("database error"),
@@ -59,12 +66,12 @@ func TestGetAllUsers(t *testing.T) {
 			mockRepository := mockRepo.NewMockUserRepository(ctrl)
 			tt.mockSetup(mockRepository)
 
-			service := New(mockRepository)
+			service := New(mockRepository, nil, nil)
 			ctx := context.Background()
 
-			result, err := service.GetAllUsers(ctx)
+			result, err := service.GetAllUsers(ctx, query.Params{})
 
-			assert.Equal(t, tt.expectedUsers, result)
+			assert.Equal(t, tt.expectedUsers, result.Items)
 			if tt.expectedErr != nil {
 				assert.EqualError(t, err, tt.expectedErr.Error())
 			} else {
@@ -117,7 +124,7 @@ func TestGetUserByID(t *testing.T) {
 			mockRepository := mockRepo.NewMockUserRepository(ctrl)
 			tt.mockSetup(mockRepository)
 
-			service := New(mockRepository)
+			service := New(mockRepository, nil, nil)
 			ctx := context.Background()
 
 			result, err := service.GetUserByID(ctx, tt.userID)
@@ -176,7 +183,7 @@ func TestGetUserByEmail(t *testing.T) {
 			mockRepository := mockRepo.NewMockUserRepository(ctrl)
 			tt.mockSetup(mockRepository)
 
-			service := New(mockRepository)
+			service := New(mockRepository, nil, nil)
 			ctx := context.Background()
 
 			result, err := service.GetUserByEmail(ctx, tt.email)
@@ -230,7 +237,7 @@ func TestCreateUser(t *testing.T) {
 			mockRepository := mockRepo.NewMockUserRepository(ctrl)
 			tt.mockSetup(mockRepository)
 
-			service := New(mockRepository)
+			service := New(mockRepository, nil, nil)
 			ctx := context.Background()
 
 			err := service.CreateUser(ctx, tt.user)
@@ -283,7 +290,7 @@ func TestUpdateUser(t *testing.T) {
 			mockRepository := mockRepo.NewMockUserRepository(ctrl)
 			tt.mockSetup(mockRepository)
 
-			service := New(mockRepository)
+			service := New(mockRepository, nil, nil)
 			ctx := context.Background()
 
 			err := service.UpdateUser(ctx, tt.user)
@@ -334,7 +341,7 @@ func TestDeleteUser(t *testing.T) {
 			mockRepository := mockRepo.NewMockUserRepository(ctrl)
 			tt.mockSetup(mockRepository)
 
-			service := New(mockRepository)
+			service := New(mockRepository, nil, nil)
 			ctx := context.Background()
 
 			err := service.DeleteUser(ctx, tt.userID)
@@ -347,3 +354,139 @@ func TestDeleteUser(t *testing.T) {
 		})
 	}
 }
+
+func TestChangeUserRole(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	adminCtx := identity.NewContext(context.Background(), &identity.Identity{UserID: 1, Role: role.Admin})
+	auditErr := errors.New("audit error")
+	// El corte conserva la fracción de segundo para rechazar también los
+	// tokens emitidos en el mismo segundo que el cambio
+	changedAt := time.Date(2026, 5, 10, 12, 0, 0, 500_000_000, time.UTC)
+
+	tests := []struct {
+		name         string
+		ctx          context.Context
+		userID       int
+		roleID       int
+		mockSetup    func(*mockRepo.MockUserRepository, *mockRepo.MockRoleRepository, *mockRepo.MockRevokedTokenRepository, *mockRepo.MockAuditLogRepository)
+		expectedRole int
+		expectedErr  error
+	}{
+		{
+			name:   "cambia el rol, revoca los tokens y audita",
+			ctx:    adminCtx,
+			userID: 5,
+			roleID: 2,
+			mockSetup: func(u *mockRepo.MockUserRepository, r *mockRepo.MockRoleRepository, rt *mockRepo.MockRevokedTokenRepository, a *mockRepo.MockAuditLogRepository) {
+				u.EXPECT().GetByID(gomock.Any(), 5).Return(&user.User{ID: 5, RoleID: 3}, nil)
+				r.EXPECT().GetByID(gomock.Any(), 2).Return(&role.Role{ID: 2, Name: role.Professor}, nil)
+				u.EXPECT().UpdateRole(gomock.Any(), 5, 2).Return(nil)
+				rt.EXPECT().RevokeUserTokens(gomock.Any(), 5, changedAt).Return(nil)
+				a.EXPECT().Create(gomock.Any(), gomock.Cond(func(e *audit.Entry) bool {
+					return e.Action == audit.ActionUserRoleChanged && e.EntityID == 5 &&
+						*e.ActorID == 1 && *e.Details == `{"from_role_id":3,"to_role_id":2}`
+				})).Return(nil)
+			},
+			expectedRole: 2,
+		},
+		{
+			name:   "retorna el error de la auditoría para revertir el cambio",
+			ctx:    adminCtx,
+			userID: 5,
+			roleID: 2,
+			mockSetup: func(u *mockRepo.MockUserRepository, r *mockRepo.MockRoleRepository, rt *mockRepo.MockRevokedTokenRepository, a *mockRepo.MockAuditLogRepository) {
+				u.EXPECT().GetByID(gomock.Any(), 5).Return(&user.User{ID: 5, RoleID: 3}, nil)
+				r.EXPECT().GetByID(gomock.Any(), 2).Return(&role.Role{ID: 2, Name: role.Professor}, nil)
+				u.EXPECT().UpdateRole(gomock.Any(), 5, 2).Return(nil)
+				rt.EXPECT().RevokeUserTokens(gomock.Any(), 5, gomock.Any()).Return(nil)
+				a.EXPECT().Create(gomock.Any(), gomock.Any()).Return(auditErr)
+			},
+			expectedErr: auditErr,
+		},
+		{
+			name:   "no hace nada si el rol no cambia",
+			ctx:    adminCtx,
+			userID: 5,
+			roleID: 3,
+			mockSetup: func(u *mockRepo.MockUserRepository, r *mockRepo.MockRoleRepository, rt *mockRepo.MockRevokedTokenRepository, a *mockRepo.MockAuditLogRepository) {
+				u.EXPECT().GetByID(gomock.Any(), 5).Return(&user.User{ID: 5, RoleID: 3}, nil)
+				r.EXPECT().GetByID(gomock.Any(), 3).Return(&role.Role{ID: 3, Name: role.Student}, nil)
+			},
+			expectedRole: 3,
+		},
+		{
+			name:   "rechaza un rol sin permisos en la API",
+			ctx:    adminCtx,
+			userID: 5,
+			roleID: 7,
+			mockSetup: func(u *mockRepo.MockUserRepository, r *mockRepo.MockRoleRepository, rt *mockRepo.MockRevokedTokenRepository, a *mockRepo.MockAuditLogRepository) {
+				u.EXPECT().GetByID(gomock.Any(), 5).Return(&user.User{ID: 5, RoleID: 3}, nil)
+				r.EXPECT().GetByID(gomock.Any(), 7).Return(&role.Role{ID: 7, Name: "monitor"}, nil)
+			},
+			expectedErr: services.ErrRoleWithoutPermissions,
+		},
+		{
+			name:   "rechaza cambiar el propio rol",
+			ctx:    adminCtx,
+			userID: 1,
+			roleID: 3,
+			mockSetup: func(u *mockRepo.MockUserRepository, r *mockRepo.MockRoleRepository, rt *mockRepo.MockRevokedTokenRepository, a *mockRepo.MockAuditLogRepository) {
+			},
+			expectedErr: services.ErrSelfRoleChange,
+		},
+		{
+			name:   "rechaza un rol inexistente",
+			ctx:    adminCtx,
+			userID: 5,
+			roleID: 99,
+			mockSetup: func(u *mockRepo.MockUserRepository, r *mockRepo.MockRoleRepository, rt *mockRepo.MockRevokedTokenRepository, a *mockRepo.MockAuditLogRepository) {
+				u.EXPECT().GetByID(gomock.Any(), 5).Return(&user.User{ID: 5, RoleID: 3}, nil)
+				r.EXPECT().GetByID(gomock.Any(), 99).Return(nil, errs.NotFound("no encontrado"))
+			},
+			expectedErr: services.ErrRoleNotFound,
+		},
+		{
+			name:   "rechaza sin usuario autenticado",
+			ctx:    context.Background(),
+			userID: 5,
+			roleID: 2,
+			mockSetup: func(u *mockRepo.MockUserRepository, r *mockRepo.MockRoleRepository, rt *mockRepo.MockRevokedTokenRepository, a *mockRepo.MockAuditLogRepository) {
+			},
+			expectedErr: services.ErrForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			userRepository := mockRepo.NewMockUserRepository(ctrl)
+			roleRepository := mockRepo.NewMockRoleRepository(ctrl)
+			revokedTokenRepository := mockRepo.NewMockRevokedTokenRepository(ctrl)
+			auditRepository := mockRepo.NewMockAuditLogRepository(ctrl)
+			tt.mockSetup(userRepository, roleRepository, revokedTokenRepository, auditRepository)
+
+			// Dentro de la transacción se usan los mismos repositorios simulados
+			mockUnitOfWork := mockRepo.NewMockUnitOfWork(ctrl)
+			mockUnitOfWork.EXPECT().
+				Do(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, fn func(repository.Repositories) error) error {
+					return fn(repository.Repositories{Users: userRepository, RevokedTokens: revokedTokenRepository, AuditLog: auditRepository})
+				}).
+				AnyTimes()
+
+			service := New(userRepository, roleRepository, mockUnitOfWork)
+			service.(*Service).now = func() time.Time { return changedAt }
+
+			result, err := service.ChangeUserRole(tt.ctx, tt.userID, tt.roleID)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedRole, result.RoleID)
+			}
+		})
+	}
+}
@@ -0,0 +1,91 @@
+package databases
+
+import (
+	"errors"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"gorm.io/gorm"
+
+	"softpharos/internal/core/errs"
+)
+
+// Códigos SQLSTATE de Postgres que se traducen a errores del núcleo
+const (
+	pgUniqueViolation     = "23505"
+	pgForeignKeyViolation = "23503"
+)
+
+// constraintErrors da el error para el cliente de cada restricción con una
+// causa conocida. Las llaves foráneas con ON DELETE RESTRICT fallan al borrar
+// el registro referenciado; las que tienen CASCADE solo fallan al insertar
+// con un padre inexistente.
+var constraintErrors = map[string]error{
+	"role_name_key":                             errs.Conflict("Ya existe un rol con ese nombre"),
+	"user_email_key":                            errs.Conflict("Ya existe un usuario con ese email"),
+	"signup_rule_pattern_key":                   errs.Conflict("Ya existe una regla para ese patrón"),
+	"project_member_project_id_user_id_key":     errs.Conflict("El usuario ya es miembro del proyecto"),
+	"reaction_milestone_id_user_id_type_key":    errs.Conflict("El usuario ya reaccionó con ese tipo"),
+	"project_invitation_pending_key":            errs.Conflict("Ya hay una invitación pendiente para ese email"),
+	"term_code_key":                             errs.Conflict("Ya existe un periodo con ese código"),
+	"course_code_key":                           errs.Conflict("Ya existe una asignatura con ese código"),
+	"course_section_course_id_term_id_name_key": errs.Conflict("Ya existe una sección con ese nombre en el periodo"),
+	"course_member_section_id_user_id_key":      errs.Conflict("El usuario ya está inscrito en la sección"),
+
+	"user_role_id_fkey":             errs.Conflict("El rol está asignado a uno o más usuarios"),
+	"signup_rule_role_id_fkey":      errs.Conflict("El rol está asignado a una o más reglas de registro"),
+	"project_created_by_fkey":       errs.Conflict("El usuario es creador de uno o más proyectos"),
+	"feedback_professor_id_fkey":    errs.Conflict("El usuario es autor de retroalimentación"),
+	"course_section_course_id_fkey": errs.Conflict("La asignatura tiene secciones"),
+	"course_section_term_id_fkey":   errs.Conflict("El periodo tiene secciones"),
+
+	"project_member_project_id_fkey":     errs.Validation("El proyecto no existe", map[string]string{"project_id": "no existe"}),
+	"project_member_user_id_fkey":        errs.Validation("El usuario no existe", map[string]string{"user_id": "no existe"}),
+	"milestone_project_id_fkey":          errs.Validation("El proyecto no existe", map[string]string{"project_id": "no existe"}),
+	"deliverable_milestone_id_fkey":      errs.Validation("El hito no existe", map[string]string{"milestone_id": "no existe"}),
+	"feedback_milestone_id_fkey":         errs.Validation("El hito no existe", map[string]string{"milestone_id": "no existe"}),
+	"comment_milestone_id_fkey":          errs.Validation("El hito no existe", map[string]string{"milestone_id": "no existe"}),
+	"reaction_milestone_id_fkey":         errs.Validation("El hito no existe", map[string]string{"milestone_id": "no existe"}),
+	"project_invitation_project_id_fkey": errs.Validation("El proyecto no existe", map[string]string{"project_id": "no existe"}),
+	"course_member_section_id_fkey":      errs.Validation("La sección no existe", map[string]string{"section_id": "no existe"}),
+	"course_member_user_id_fkey":         errs.Validation("El usuario no existe", map[string]string{"user_id": "no existe"}),
+	"project_section_id_fkey":            errs.Validation("La sección no existe", map[string]string{"section_id": "no existe"}),
+	"term_break_term_id_fkey":            errs.Validation("El periodo no existe", map[string]string{"term_id": "no existe"}),
+
+	"project_member_role_check":     errs.Validation("Rol de proyecto inválido", map[string]string{"role": "no es un rol de proyecto"}),
+	"project_invitation_role_check": errs.Validation("Rol de proyecto inválido", map[string]string{"role": "no es un rol de proyecto"}),
+	"course_member_role_check":      errs.Validation("Rol de sección inválido", map[string]string{"role": "debe ser professor o student"}),
+	"term_dates_check":              errs.Validation("Fechas del periodo inválidas", map[string]string{"end_date": "debe ser posterior a start_date"}),
+	"milestone_status_check":        errs.Validation("Estado de hito inválido", map[string]string{"status": "debe ser planned, in_progress, submitted o reviewed"}),
+	"term_week_length_check":        errs.Validation("Duración de semana inválida", map[string]string{"week_length": "debe ser mayor que 0"}),
+	"term_break_dates_check":        errs.Validation("Fechas del receso inválidas", map[string]string{"breaks": "end_date no puede ser anterior a start_date"}),
+}
+
+// TranslateError convierte los errores de GORM y de Postgres en errores del
+// núcleo para que el resto de capas no dependa de la base de datos ni
+// exponga mensajes de SQL.
+func TranslateError(err error) error {
+	if err == nil {
+		return nil
+	}
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return errs.NotFound("Recurso no encontrado")
+	}
+
+	var pgErr *pgconn.PgError
+	if !errors.As(err, &pgErr) {
+		return err
+	}
+
+	if known, ok := constraintErrors[pgErr.ConstraintName]; ok {
+		return known
+	}
+
+	switch pgErr.Code {
+	case pgUniqueViolation:
+		return errs.Conflict("Ya existe un registro con esos datos")
+	case pgForeignKeyViolation:
+		return errs.Conflict("El registro referencia o es referenciado por otro registro")
+	default:
+		return err
+	}
+}
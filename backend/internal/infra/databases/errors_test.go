@@ -0,0 +1,74 @@
+package databases
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+
+	"softpharos/internal/core/errs"
+)
+
+func TestTranslateError(t *testing.T) {
+	dbErr := errors.New("connection refused")
+	syntaxErr := &pgconn.PgError{Code: "42601", Message: "syntax error"}
+
+	tests := []struct {
+		name            string
+		err             error
+		expected        error
+		expectedMessage string
+	}{
+		{name: "nil se mantiene", err: nil, expected: nil},
+		{name: "registro no encontrado", err: gorm.ErrRecordNotFound, expected: errs.ErrNotFound},
+		{
+			name:            "restricción única conocida",
+			err:             &pgconn.PgError{Code: "23505", ConstraintName: "project_member_project_id_user_id_key"},
+			expected:        errs.ErrConflict,
+			expectedMessage: "El usuario ya es miembro del proyecto",
+		},
+		{
+			name:            "restricción única desconocida",
+			err:             &pgconn.PgError{Code: "23505", ConstraintName: "otra_key"},
+			expected:        errs.ErrConflict,
+			expectedMessage: "Ya existe un registro con esos datos",
+		},
+		{
+			name:            "borrado restringido por una llave foránea",
+			err:             fmt.Errorf("delete: %w", &pgconn.PgError{Code: "23503", ConstraintName: "user_role_id_fkey"}),
+			expected:        errs.ErrConflict,
+			expectedMessage: "El rol está asignado a uno o más usuarios",
+		},
+		{
+			name:            "inserción con un padre inexistente",
+			err:             &pgconn.PgError{Code: "23503", ConstraintName: "milestone_project_id_fkey"},
+			expected:        errs.ErrValidation,
+			expectedMessage: "El proyecto no existe",
+		},
+		{
+			name:            "llave foránea desconocida",
+			err:             &pgconn.PgError{Code: "23503", ConstraintName: "otra_fkey"},
+			expected:        errs.ErrConflict,
+			expectedMessage: "El registro referencia o es referenciado por otro registro",
+		},
+		{name: "otros errores de Postgres no se traducen", err: syntaxErr, expected: syntaxErr},
+		{name: "otros errores no se traducen", err: dbErr, expected: dbErr},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := TranslateError(tt.err)
+			if tt.expected == nil {
+				assert.NoError(t, result)
+				return
+			}
+			assert.ErrorIs(t, result, tt.expected)
+			if tt.expectedMessage != "" {
+				assert.EqualError(t, result, tt.expectedMessage)
+			}
+		})
+	}
+}
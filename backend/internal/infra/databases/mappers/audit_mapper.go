@@ -0,0 +1,22 @@
+package mappers
+
+import (
+	"softpharos/internal/core/domain/audit"
+	"softpharos/internal/infra/databases/models"
+)
+
+func AuditEntryToModel(domain *audit.Entry) *models.AuditLogModel {
+	if domain == nil {
+		return nil
+	}
+
+	return &models.AuditLogModel{
+		ID:        domain.ID,
+		ActorID:   domain.ActorID,
+		Action:    domain.Action,
+		Entity:    domain.Entity,
+		EntityID:  domain.EntityID,
+		Details:   domain.Details,
+		CreatedAt: domain.CreatedAt,
+	}
+}
@@ -0,0 +1,115 @@
+package mappers
+
+import (
+	"softpharos/internal/core/domain/course"
+	"softpharos/internal/infra/databases/models"
+)
+
+func CourseToDomain(model *models.CourseModel) *course.Course {
+	if model == nil {
+		return nil
+	}
+
+	return &course.Course{
+		ID:        model.ID,
+		Code:      model.Code,
+		Name:      model.Name,
+		CreatedAt: model.CreatedAt,
+	}
+}
+
+func CourseToModel(domain *course.Course) *models.CourseModel {
+	if domain == nil {
+		return nil
+	}
+
+	return &models.CourseModel{
+		ID:        domain.ID,
+		Code:      domain.Code,
+		Name:      domain.Name,
+		CreatedAt: domain.CreatedAt,
+	}
+}
+
+func CourseListToDomain(modelList []models.CourseModel) []course.Course {
+	domainList := make([]course.Course, len(modelList))
+	for i, model := range modelList {
+		domainList[i] = *CourseToDomain(&model)
+	}
+	return domainList
+}
+
+func CourseSectionToDomain(model *models.CourseSectionModel) *course.Section {
+	if model == nil {
+		return nil
+	}
+
+	return &course.Section{
+		ID:        model.ID,
+		CourseID:  model.CourseID,
+		Course:    CourseToDomain(model.Course),
+		TermID:    model.TermID,
+		Term:      TermToDomain(model.Term),
+		Name:      model.Name,
+		CreatedAt: model.CreatedAt,
+	}
+}
+
+func CourseSectionToModel(domain *course.Section) *models.CourseSectionModel {
+	if domain == nil {
+		return nil
+	}
+
+	return &models.CourseSectionModel{
+		ID:        domain.ID,
+		CourseID:  domain.CourseID,
+		TermID:    domain.TermID,
+		Name:      domain.Name,
+		CreatedAt: domain.CreatedAt,
+	}
+}
+
+func CourseSectionListToDomain(modelList []models.CourseSectionModel) []course.Section {
+	domainList := make([]course.Section, len(modelList))
+	for i, model := range modelList {
+		domainList[i] = *CourseSectionToDomain(&model)
+	}
+	return domainList
+}
+
+func CourseMemberToDomain(model *models.CourseMemberModel) *course.Member {
+	if model == nil {
+		return nil
+	}
+
+	return &course.Member{
+		ID:        model.ID,
+		SectionID: model.SectionID,
+		UserID:    model.UserID,
+		User:      UserToDomain(model.User),
+		Role:      model.Role,
+		JoinedAt:  model.JoinedAt,
+	}
+}
+
+func CourseMemberToModel(domain *course.Member) *models.CourseMemberModel {
+	if domain == nil {
+		return nil
+	}
+
+	return &models.CourseMemberModel{
+		ID:        domain.ID,
+		SectionID: domain.SectionID,
+		UserID:    domain.UserID,
+		Role:      domain.Role,
+		JoinedAt:  domain.JoinedAt,
+	}
+}
+
+func CourseMemberListToDomain(modelList []models.CourseMemberModel) []course.Member {
+	domainList := make([]course.Member, len(modelList))
+	for i, model := range modelList {
+		domainList[i] = *CourseMemberToDomain(&model)
+	}
+	return domainList
+}
@@ -0,0 +1,65 @@
+package mappers
+
+import (
+	"softpharos/internal/core/domain/course"
+	"softpharos/internal/core/domain/term"
+	"softpharos/internal/core/domain/user"
+	"softpharos/internal/infra/databases/models"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCourseSectionToDomain(t *testing.T) {
+	now := time.Now()
+
+	result := CourseSectionToDomain(&models.CourseSectionModel{
+		ID:        3,
+		CourseID:  1,
+		Course:    &models.CourseModel{ID: 1, Code: "2016701", Name: "Ingeniería de Software"},
+		TermID:    2,
+		Term:      &models.TermModel{ID: 2, Code: "2026-2"},
+		Name:      "Grupo 1",
+		CreatedAt: now,
+	})
+
+	assert.Equal(t, &course.Section{
+		ID:        3,
+		CourseID:  1,
+		Course:    &course.Course{ID: 1, Code: "2016701", Name: "Ingeniería de Software"},
+		TermID:    2,
+		Term:      &term.Term{ID: 2, Code: "2026-2"},
+		Name:      "Grupo 1",
+		CreatedAt: now,
+	}, result)
+	assert.Nil(t, CourseSectionToDomain(nil))
+}
+
+func TestCourseSectionToModel(t *testing.T) {
+	result := CourseSectionToModel(&course.Section{
+		ID:       3,
+		CourseID: 1,
+		Course:   &course.Course{ID: 1},
+		TermID:   2,
+		Name:     "Grupo 1",
+	})
+
+	// Las relaciones no se copian para que GORM no intente guardarlas
+	assert.Equal(t, &models.CourseSectionModel{ID: 3, CourseID: 1, TermID: 2, Name: "Grupo 1"}, result)
+	assert.Nil(t, CourseSectionToModel(nil))
+}
+
+func TestCourseMemberListToDomain(t *testing.T) {
+	name := "Ana"
+
+	result := CourseMemberListToDomain([]models.CourseMemberModel{
+		{ID: 1, SectionID: 3, UserID: 5, User: &models.UserModel{ID: 5, Name: &name}, Role: course.RoleProfessor},
+		{ID: 2, SectionID: 3, UserID: 6, Role: course.RoleStudent},
+	})
+
+	assert.Equal(t, []course.Member{
+		{ID: 1, SectionID: 3, UserID: 5, User: &user.User{ID: 5, Name: &name}, Role: course.RoleProfessor},
+		{ID: 2, SectionID: 3, UserID: 6, Role: course.RoleStudent},
+	}, result)
+}
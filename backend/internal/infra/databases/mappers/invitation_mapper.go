@@ -0,0 +1,53 @@
+package mappers
+
+import (
+	"softpharos/internal/core/domain/invitation"
+	"softpharos/internal/infra/databases/models"
+)
+
+func InvitationToDomain(model *models.ProjectInvitationModel) *invitation.Invitation {
+	if model == nil {
+		return nil
+	}
+
+	return &invitation.Invitation{
+		ID:          model.ID,
+		ProjectID:   model.ProjectID,
+		Project:     ProjectToDomain(model.Project),
+		Email:       model.Email,
+		Role:        model.Role,
+		TokenHash:   model.TokenHash,
+		InvitedBy:   model.InvitedBy,
+		Status:      model.Status,
+		ExpiresAt:   model.ExpiresAt,
+		RespondedAt: model.RespondedAt,
+		CreatedAt:   model.CreatedAt,
+	}
+}
+
+func InvitationToModel(domain *invitation.Invitation) *models.ProjectInvitationModel {
+	if domain == nil {
+		return nil
+	}
+
+	return &models.ProjectInvitationModel{
+		ID:          domain.ID,
+		ProjectID:   domain.ProjectID,
+		Email:       domain.Email,
+		Role:        domain.Role,
+		TokenHash:   domain.TokenHash,
+		InvitedBy:   domain.InvitedBy,
+		Status:      domain.Status,
+		ExpiresAt:   domain.ExpiresAt,
+		RespondedAt: domain.RespondedAt,
+		CreatedAt:   domain.CreatedAt,
+	}
+}
+
+func InvitationListToDomain(modelList []models.ProjectInvitationModel) []invitation.Invitation {
+	domainList := make([]invitation.Invitation, len(modelList))
+	for i, model := range modelList {
+		domainList[i] = *InvitationToDomain(&model)
+	}
+	return domainList
+}
@@ -0,0 +1,46 @@
+package mappers
+
+import (
+	"softpharos/internal/core/domain/invitation"
+	"softpharos/internal/infra/databases/models"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInvitationMappers(t *testing.T) {
+	now := time.Now()
+	invitedBy := 3
+	respondedAt := now.Add(time.Hour)
+
+	domain := &invitation.Invitation{
+		ID:          1,
+		ProjectID:   2,
+		Email:       "invitado@unal.edu.co",
+		Role:        "member",
+		TokenHash:   "hash",
+		InvitedBy:   &invitedBy,
+		Status:      invitation.StatusAccepted,
+		ExpiresAt:   now.Add(invitation.TTL),
+		RespondedAt: &respondedAt,
+		CreatedAt:   now,
+	}
+
+	assert.Equal(t, domain, InvitationToDomain(InvitationToModel(domain)))
+	assert.Nil(t, InvitationToDomain(nil))
+	assert.Nil(t, InvitationToModel(nil))
+}
+
+func TestInvitationListToDomain(t *testing.T) {
+	name := "Proyecto"
+	result := InvitationListToDomain([]models.ProjectInvitationModel{
+		{ID: 1, ProjectID: 2, Email: "a@unal.edu.co", Project: &models.ProjectModel{ID: 2, Name: &name}},
+		{ID: 2, ProjectID: 2, Email: "b@unal.edu.co"},
+	})
+
+	assert.Len(t, result, 2)
+	assert.Equal(t, "a@unal.edu.co", result[0].Email)
+	assert.Equal(t, &name, result[0].Project.Name)
+	assert.Nil(t, result[1].Project)
+}
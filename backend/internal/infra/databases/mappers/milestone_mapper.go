@@ -11,13 +11,17 @@ func MilestoneToDomain(model *models.MilestoneModel) *milestone.Milestone {
 	}
 
 	return &milestone.Milestone{
-		ID:          model.ID,
-		ProjectID:   model.ProjectID,
-		Project:     ProjectToDomain(model.Project),
-		Title:       model.Title,
-		Description: model.Description,
-		ClassWeek:   model.ClassWeek,
-		CreatedAt:   model.CreatedAt,
+		ID:              model.ID,
+		ProjectID:       model.ProjectID,
+		Project:         ProjectToDomain(model.Project),
+		Title:           model.Title,
+		Description:     model.Description,
+		ClassWeek:       model.ClassWeek,
+		DueDate:         model.DueDate,
+		Status:          model.Status,
+		StatusChangedAt: model.StatusChangedAt,
+		CreatedAt:       model.CreatedAt,
+		DeletedAt:       deletedAtToDomain(model.DeletedAt),
 	}
 }
 
@@ -27,13 +31,17 @@ func MilestoneToModel(domain *milestone.Milestone) *models.MilestoneModel {
 	}
 
 	return &models.MilestoneModel{
-		ID:          domain.ID,
-		ProjectID:   domain.ProjectID,
-		Project:     ProjectToModel(domain.Project),
-		Title:       domain.Title,
-		Description: domain.Description,
-		ClassWeek:   domain.ClassWeek,
-		CreatedAt:   domain.CreatedAt,
+		ID:              domain.ID,
+		ProjectID:       domain.ProjectID,
+		Project:         ProjectToModel(domain.Project),
+		Title:           domain.Title,
+		Description:     domain.Description,
+		ClassWeek:       domain.ClassWeek,
+		DueDate:         domain.DueDate,
+		Status:          domain.Status,
+		StatusChangedAt: domain.StatusChangedAt,
+		CreatedAt:       domain.CreatedAt,
+		DeletedAt:       deletedAtToModel(domain.DeletedAt),
 	}
 }
 
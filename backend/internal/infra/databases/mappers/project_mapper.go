@@ -1,6 +1,10 @@
 package mappers
 
 import (
+	"time"
+
+	"gorm.io/gorm"
+
 	"softpharos/internal/core/domain/project"
 	"softpharos/internal/infra/databases/models"
 )
@@ -11,13 +15,16 @@ func ProjectToDomain(model *models.ProjectModel) *project.Project {
 	}
 
 	return &project.Project{
-		ID:        model.ID,
-		Name:      model.Name,
-		Objective: model.Objective,
-		CreatedBy: model.CreatedBy,
-		Owner:     UserToDomain(model.Owner),
-		CreatedAt: model.CreatedAt,
-		UpdatedAt: model.UpdatedAt,
+		ID:         model.ID,
+		Name:       model.Name,
+		Objective:  model.Objective,
+		CreatedBy:  model.CreatedBy,
+		Owner:      UserToDomain(model.Owner),
+		SectionID:  model.SectionID,
+		CreatedAt:  model.CreatedAt,
+		UpdatedAt:  model.UpdatedAt,
+		ArchivedAt: model.ArchivedAt,
+		DeletedAt:  deletedAtToDomain(model.DeletedAt),
 	}
 }
 
@@ -27,13 +34,16 @@ func ProjectToModel(domain *project.Project) *models.ProjectModel {
 	}
 
 	return &models.ProjectModel{
-		ID:        domain.ID,
-		Name:      domain.Name,
-		Objective: domain.Objective,
-		CreatedBy: domain.CreatedBy,
-		Owner:     UserToModel(domain.Owner),
-		CreatedAt: domain.CreatedAt,
-		UpdatedAt: domain.UpdatedAt,
+		ID:         domain.ID,
+		Name:       domain.Name,
+		Objective:  domain.Objective,
+		CreatedBy:  domain.CreatedBy,
+		Owner:      UserToModel(domain.Owner),
+		SectionID:  domain.SectionID,
+		CreatedAt:  domain.CreatedAt,
+		UpdatedAt:  domain.UpdatedAt,
+		ArchivedAt: domain.ArchivedAt,
+		DeletedAt:  deletedAtToModel(domain.DeletedAt),
 	}
 }
 
@@ -44,3 +54,17 @@ func ProjectListToDomain(modelList []models.ProjectModel) []project.Project {
 	}
 	return domainList
 }
+
+func deletedAtToDomain(deletedAt gorm.DeletedAt) *time.Time {
+	if !deletedAt.Valid {
+		return nil
+	}
+	return &deletedAt.Time
+}
+
+func deletedAtToModel(deletedAt *time.Time) gorm.DeletedAt {
+	if deletedAt == nil {
+		return gorm.DeletedAt{}
+	}
+	return gorm.DeletedAt{Time: *deletedAt, Valid: true}
+}
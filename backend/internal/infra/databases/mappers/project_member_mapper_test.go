@@ -10,7 +10,7 @@ import (
 )
 
 func TestProjectMemberToDomain(t *testing.T) {
-	role := "Developer"
+	role := project_member.RoleContributor
 	now := time.Now()
 
 	tests := []struct {
@@ -24,14 +24,14 @@ func TestProjectMemberToDomain(t *testing.T) {
 				ID:        1,
 				ProjectID: 1,
 				UserID:    1,
-				Role:      &role,
+				Role:      role,
 				JoinedAt:  now,
 			},
 			expected: &project_member.ProjectMember{
 				ID:        1,
 				ProjectID: 1,
 				UserID:    1,
-				Role:      &role,
+				Role:      role,
 				JoinedAt:  now,
 			},
 		},
@@ -51,7 +51,7 @@ func TestProjectMemberToDomain(t *testing.T) {
 }
 
 func TestProjectMemberToModel(t *testing.T) {
-	role := "Developer"
+	role := project_member.RoleContributor
 	now := time.Now()
 
 	tests := []struct {
@@ -65,14 +65,14 @@ func TestProjectMemberToModel(t *testing.T) {
 				ID:        1,
 				ProjectID: 1,
 				UserID:    1,
-				Role:      &role,
+				Role:      role,
 				JoinedAt:  now,
 			},
 			expected: &models.ProjectMemberModel{
 				ID:        1,
 				ProjectID: 1,
 				UserID:    1,
-				Role:      &role,
+				Role:      role,
 				JoinedAt:  now,
 			},
 		},
@@ -92,7 +92,7 @@ func TestProjectMemberToModel(t *testing.T) {
 }
 
 func TestProjectMemberListToDomain(t *testing.T) {
-	role := "Developer"
+	role := project_member.RoleContributor
 
 	tests := []struct {
 		name     string
@@ -102,12 +102,12 @@ func TestProjectMemberListToDomain(t *testing.T) {
 		{
 			name: "convierte lista de modelos a dominios",
 			input: []models.ProjectMemberModel{
-				{ID: 1, ProjectID: 1, UserID: 1, Role: &role},
-				{ID: 2, ProjectID: 1, UserID: 2, Role: &role},
+				{ID: 1, ProjectID: 1, UserID: 1, Role: role},
+				{ID: 2, ProjectID: 1, UserID: 2, Role: role},
 			},
 			expected: []project_member.ProjectMember{
-				{ID: 1, ProjectID: 1, UserID: 1, Role: &role},
-				{ID: 2, ProjectID: 1, UserID: 2, Role: &role},
+				{ID: 1, ProjectID: 1, UserID: 1, Role: role},
+				{ID: 2, ProjectID: 1, UserID: 2, Role: role},
 			},
 		},
 		{
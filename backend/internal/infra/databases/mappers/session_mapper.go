@@ -0,0 +1,48 @@
+package mappers
+
+import (
+	"softpharos/internal/core/domain/session"
+	"softpharos/internal/infra/databases/models"
+)
+
+func RefreshTokenToDomain(model *models.RefreshTokenModel) *session.RefreshToken {
+	if model == nil {
+		return nil
+	}
+
+	return &session.RefreshToken{
+		ID:        model.ID,
+		UserID:    model.UserID,
+		TokenHash: model.TokenHash,
+		ExpiresAt: model.ExpiresAt,
+		RevokedAt: model.RevokedAt,
+		CreatedAt: model.CreatedAt,
+	}
+}
+
+func RefreshTokenToModel(domain *session.RefreshToken) *models.RefreshTokenModel {
+	if domain == nil {
+		return nil
+	}
+
+	return &models.RefreshTokenModel{
+		ID:        domain.ID,
+		UserID:    domain.UserID,
+		TokenHash: domain.TokenHash,
+		ExpiresAt: domain.ExpiresAt,
+		RevokedAt: domain.RevokedAt,
+		CreatedAt: domain.CreatedAt,
+	}
+}
+
+func RevokedTokenToModel(domain *session.RevokedToken) *models.RevokedTokenModel {
+	if domain == nil {
+		return nil
+	}
+
+	return &models.RevokedTokenModel{
+		JTI:       domain.JTI,
+		ExpiresAt: domain.ExpiresAt,
+		RevokedAt: domain.RevokedAt,
+	}
+}
@@ -0,0 +1,37 @@
+package mappers
+
+import (
+	"softpharos/internal/core/domain/session"
+	"softpharos/internal/infra/databases/models"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRefreshTokenMappers(t *testing.T) {
+	now := time.Now()
+	revokedAt := now.Add(time.Minute)
+
+	domain := &session.RefreshToken{
+		ID:        1,
+		UserID:    2,
+		TokenHash: "hash",
+		ExpiresAt: now.Add(time.Hour),
+		RevokedAt: &revokedAt,
+		CreatedAt: now,
+	}
+
+	assert.Equal(t, domain, RefreshTokenToDomain(RefreshTokenToModel(domain)))
+	assert.Nil(t, RefreshTokenToDomain(nil))
+	assert.Nil(t, RefreshTokenToModel(nil))
+}
+
+func TestRevokedTokenToModel(t *testing.T) {
+	now := time.Now()
+
+	result := RevokedTokenToModel(&session.RevokedToken{JTI: "jti-1", ExpiresAt: now, RevokedAt: now})
+
+	assert.Equal(t, &models.RevokedTokenModel{JTI: "jti-1", ExpiresAt: now, RevokedAt: now}, result)
+	assert.Nil(t, RevokedTokenToModel(nil))
+}
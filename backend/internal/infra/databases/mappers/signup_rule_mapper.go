@@ -0,0 +1,41 @@
+package mappers
+
+import (
+	"softpharos/internal/core/domain/signup_rule"
+	"softpharos/internal/infra/databases/models"
+)
+
+func SignupRuleToDomain(model *models.SignupRuleModel) *signup_rule.SignupRule {
+	if model == nil {
+		return nil
+	}
+
+	return &signup_rule.SignupRule{
+		ID:        model.ID,
+		Pattern:   model.Pattern,
+		RoleID:    model.RoleID,
+		Role:      RoleToDomain(model.Role),
+		CreatedAt: model.CreatedAt,
+	}
+}
+
+func SignupRuleToModel(domain *signup_rule.SignupRule) *models.SignupRuleModel {
+	if domain == nil {
+		return nil
+	}
+
+	return &models.SignupRuleModel{
+		ID:        domain.ID,
+		Pattern:   domain.Pattern,
+		RoleID:    domain.RoleID,
+		CreatedAt: domain.CreatedAt,
+	}
+}
+
+func SignupRuleListToDomain(modelList []models.SignupRuleModel) []signup_rule.SignupRule {
+	domainList := make([]signup_rule.SignupRule, len(modelList))
+	for i, model := range modelList {
+		domainList[i] = *SignupRuleToDomain(&model)
+	}
+	return domainList
+}
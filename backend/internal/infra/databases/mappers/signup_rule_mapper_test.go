@@ -0,0 +1,39 @@
+package mappers
+
+import (
+	"softpharos/internal/core/domain/role"
+	"softpharos/internal/core/domain/signup_rule"
+	"softpharos/internal/infra/databases/models"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSignupRuleToDomain(t *testing.T) {
+	now := time.Now()
+
+	result := SignupRuleToDomain(&models.SignupRuleModel{
+		ID:        1,
+		Pattern:   "unal.edu.co",
+		RoleID:    2,
+		Role:      &models.RoleModel{ID: 2, Name: "professor"},
+		CreatedAt: now,
+	})
+
+	assert.Equal(t, &signup_rule.SignupRule{
+		ID:        1,
+		Pattern:   "unal.edu.co",
+		RoleID:    2,
+		Role:      &role.Role{ID: 2, Name: "professor"},
+		CreatedAt: now,
+	}, result)
+	assert.Nil(t, SignupRuleToDomain(nil))
+}
+
+func TestSignupRuleToModel(t *testing.T) {
+	result := SignupRuleToModel(&signup_rule.SignupRule{ID: 1, Pattern: "decano@unal.edu.co", RoleID: 1})
+
+	assert.Equal(t, &models.SignupRuleModel{ID: 1, Pattern: "decano@unal.edu.co", RoleID: 1}, result)
+	assert.Nil(t, SignupRuleToModel(nil))
+}
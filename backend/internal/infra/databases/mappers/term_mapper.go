@@ -0,0 +1,75 @@
+package mappers
+
+import (
+	"softpharos/internal/core/domain/term"
+	"softpharos/internal/infra/databases/models"
+)
+
+func TermToDomain(model *models.TermModel) *term.Term {
+	if model == nil {
+		return nil
+	}
+
+	domain := &term.Term{
+		ID:         model.ID,
+		Code:       model.Code,
+		StartDate:  model.StartDate,
+		EndDate:    model.EndDate,
+		WeekLength: model.WeekLength,
+		CreatedAt:  model.CreatedAt,
+	}
+
+	if len(model.Breaks) > 0 {
+		domain.Breaks = make([]term.Break, len(model.Breaks))
+		for i, b := range model.Breaks {
+			domain.Breaks[i] = term.Break{
+				ID:        b.ID,
+				TermID:    b.TermID,
+				Name:      b.Name,
+				StartDate: b.StartDate,
+				EndDate:   b.EndDate,
+			}
+		}
+	}
+
+	return domain
+}
+
+// TermToModel convierte el periodo junto con sus recesos, que se guardan con él
+func TermToModel(domain *term.Term) *models.TermModel {
+	if domain == nil {
+		return nil
+	}
+
+	model := &models.TermModel{
+		ID:         domain.ID,
+		Code:       domain.Code,
+		StartDate:  domain.StartDate,
+		EndDate:    domain.EndDate,
+		WeekLength: domain.WeekLength,
+		CreatedAt:  domain.CreatedAt,
+	}
+
+	if len(domain.Breaks) > 0 {
+		model.Breaks = make([]models.TermBreakModel, len(domain.Breaks))
+		for i, b := range domain.Breaks {
+			model.Breaks[i] = models.TermBreakModel{
+				ID:        b.ID,
+				TermID:    domain.ID,
+				Name:      b.Name,
+				StartDate: b.StartDate,
+				EndDate:   b.EndDate,
+			}
+		}
+	}
+
+	return model
+}
+
+func TermListToDomain(modelList []models.TermModel) []term.Term {
+	domainList := make([]term.Term, len(modelList))
+	for i, model := range modelList {
+		domainList[i] = *TermToDomain(&model)
+	}
+	return domainList
+}
@@ -0,0 +1,53 @@
+package mappers
+
+import (
+	"softpharos/internal/core/domain/term"
+	"softpharos/internal/infra/databases/models"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTermToDomain(t *testing.T) {
+	start := time.Date(2026, 8, 3, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2026, 11, 27, 0, 0, 0, 0, time.UTC)
+
+	result := TermToDomain(&models.TermModel{ID: 1, Code: "2026-2", StartDate: start, EndDate: end})
+
+	assert.Equal(t, &term.Term{ID: 1, Code: "2026-2", StartDate: start, EndDate: end}, result)
+	assert.Nil(t, TermToDomain(nil))
+}
+
+func TestTermToModel(t *testing.T) {
+	start := time.Date(2026, 8, 3, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2026, 11, 27, 0, 0, 0, 0, time.UTC)
+
+	result := TermToModel(&term.Term{ID: 1, Code: "2026-2", StartDate: start, EndDate: end})
+
+	assert.Equal(t, &models.TermModel{ID: 1, Code: "2026-2", StartDate: start, EndDate: end}, result)
+	assert.Nil(t, TermToModel(nil))
+}
+
+func TestTermBreaksRoundTrip(t *testing.T) {
+	start := time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2026, 7, 3, 0, 0, 0, 0, time.UTC)
+	domain := &term.Term{
+		ID:         1,
+		Code:       "2026-1",
+		StartDate:  start,
+		EndDate:    end,
+		WeekLength: 7,
+		Breaks: []term.Break{
+			{ID: 3, TermID: 1, Name: "Semana santa", StartDate: start.AddDate(0, 0, 28), EndDate: start.AddDate(0, 0, 34)},
+		},
+	}
+
+	model := TermToModel(domain)
+
+	if assert.Len(t, model.Breaks, 1) {
+		assert.Equal(t, 1, model.Breaks[0].TermID)
+		assert.Equal(t, "Semana santa", model.Breaks[0].Name)
+	}
+	assert.Equal(t, domain, TermToDomain(model))
+}
@@ -0,0 +1,100 @@
+// Package migrations versiona el esquema de la base de datos. Cada migración
+// es un par de archivos sql/NNNN_nombre.up.sql y sql/NNNN_nombre.down.sql que
+// se embeben en el binario; las versiones aplicadas se registran en la tabla
+// schema_migrations.
+package migrations
+
+import (
+	"embed"
+	"fmt"
+	"io/fs"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+//go:embed sql/*.sql
+var embedded embed.FS
+
+type Migration struct {
+	Version int
+	Name    string
+	Up      string
+	Down    string
+}
+
+// Load lee las migraciones de fsys ordenadas por versión. Exige que cada
+// versión tenga su archivo up y su archivo down.
+func Load(fsys fs.FS) ([]Migration, error) {
+	files, err := fs.Glob(fsys, "*.sql")
+	if err != nil {
+		return nil, err
+	}
+
+	byVersion := make(map[int]*Migration)
+	for _, file := range files {
+		version, name, direction, err := parseFileName(file)
+		if err != nil {
+			return nil, err
+		}
+
+		content, err := fs.ReadFile(fsys, file)
+		if err != nil {
+			return nil, err
+		}
+
+		m, ok := byVersion[version]
+		if !ok {
+			m = &Migration{Version: version, Name: name}
+			byVersion[version] = m
+		} else if m.Name != name {
+			return nil, fmt.Errorf("la versión %d tiene dos nombres: %s y %s", version, m.Name, name)
+		}
+
+		if direction == "up" {
+			m.Up = string(content)
+		} else {
+			m.Down = string(content)
+		}
+	}
+
+	migrations := make([]Migration, 0, len(byVersion))
+	for _, m := range byVersion {
+		if m.Up == "" || m.Down == "" {
+			return nil, fmt.Errorf("la migración %04d_%s debe tener archivos up y down", m.Version, m.Name)
+		}
+		migrations = append(migrations, *m)
+	}
+	sort.Slice(migrations, func(i, j int) bool {
+		return migrations[i].Version < migrations[j].Version
+	})
+
+	return migrations, nil
+}
+
+// parseFileName separa "0001_init.up.sql" en versión, nombre y dirección
+func parseFileName(file string) (int, string, string, error) {
+	base := strings.TrimSuffix(path.Base(file), ".sql")
+
+	rest, direction, ok := cutLast(base, ".")
+	if !ok || (direction != "up" && direction != "down") {
+		return 0, "", "", fmt.Errorf("archivo de migración inválido %q: debe terminar en .up.sql o .down.sql", file)
+	}
+
+	rawVersion, name, ok := strings.Cut(rest, "_")
+	version, err := strconv.Atoi(rawVersion)
+	if !ok || err != nil || version <= 0 || name == "" {
+		return 0, "", "", fmt.Errorf("archivo de migración inválido %q: debe llamarse NNNN_nombre", file)
+	}
+
+	return version, name, direction, nil
+}
+
+func cutLast(s, sep string) (string, string, bool) {
+	i := strings.LastIndex(s, sep)
+	if i < 0 {
+		return s, "", false
+	}
+	return s[:i], s[i+len(sep):], true
+}
@@ -0,0 +1,92 @@
+package migrations
+
+import (
+	"io/fs"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoad(t *testing.T) {
+	tests := []struct {
+		name             string
+		files            fstest.MapFS
+		expectedVersions []int
+		expectError      bool
+	}{
+		{
+			name: "ordena las migraciones por versión",
+			files: fstest.MapFS{
+				"0002_indices.up.sql":   {Data: []byte("CREATE INDEX a ON b (c);")},
+				"0002_indices.down.sql": {Data: []byte("DROP INDEX a;")},
+				"0001_init.up.sql":      {Data: []byte("CREATE TABLE b (c int);")},
+				"0001_init.down.sql":    {Data: []byte("DROP TABLE b;")},
+			},
+			expectedVersions: []int{1, 2},
+		},
+		{
+			name: "rechaza una migración sin archivo down",
+			files: fstest.MapFS{
+				"0001_init.up.sql": {Data: []byte("CREATE TABLE b (c int);")},
+			},
+			expectError: true,
+		},
+		{
+			name: "rechaza nombres sin versión",
+			files: fstest.MapFS{
+				"init.up.sql":   {Data: []byte("SELECT 1;")},
+				"init.down.sql": {Data: []byte("SELECT 1;")},
+			},
+			expectError: true,
+		},
+		{
+			name: "rechaza archivos sin dirección",
+			files: fstest.MapFS{
+				"0001_init.sql": {Data: []byte("SELECT 1;")},
+			},
+			expectError: true,
+		},
+		{
+			name: "rechaza una versión con dos nombres",
+			files: fstest.MapFS{
+				"0001_init.up.sql":   {Data: []byte("SELECT 1;")},
+				"0001_otro.down.sql": {Data: []byte("SELECT 1;")},
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			migrations, err := Load(tt.files)
+
+			if tt.expectError {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			versions := make([]int, 0, len(migrations))
+			for _, m := range migrations {
+				versions = append(versions, m.Version)
+			}
+			assert.Equal(t, tt.expectedVersions, versions)
+		})
+	}
+}
+
+func TestEmbeddedMigrations(t *testing.T) {
+	sqlFiles, err := fs.Sub(embedded, "sql")
+	assert.NoError(t, err)
+
+	migrations, err := Load(sqlFiles)
+
+	assert.NoError(t, err)
+	assert.NotEmpty(t, migrations)
+	assert.Equal(t, 1, migrations[0].Version)
+	assert.Equal(t, "init", migrations[0].Name)
+	for i, m := range migrations {
+		assert.Equal(t, i+1, m.Version, "las versiones deben ser consecutivas")
+	}
+}
@@ -0,0 +1,154 @@
+package migrations
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"time"
+
+	"gorm.io/gorm"
+
+	"softpharos/internal/infra/databases"
+)
+
+const createVersionTable = `CREATE TABLE IF NOT EXISTS "schema_migrations" (
+  "version" integer PRIMARY KEY,
+  "name" varchar NOT NULL,
+  "applied_at" timestamp NOT NULL
+)`
+
+type Migrator struct {
+	db         *gorm.DB
+	migrations []Migration
+}
+
+// Status indica si una migración está aplicada y desde cuándo
+type Status struct {
+	Migration
+	AppliedAt *time.Time
+}
+
+type appliedVersion struct {
+	Version   int
+	AppliedAt time.Time
+}
+
+// New crea un migrador con las migraciones embebidas en el binario
+func New(client *databases.Client) (*Migrator, error) {
+	sqlFiles, err := fs.Sub(embedded, "sql")
+	if err != nil {
+		return nil, err
+	}
+	return NewFromFS(client, sqlFiles)
+}
+
+// NewFromFS crea un migrador con las migraciones de fsys
+func NewFromFS(client *databases.Client, fsys fs.FS) (*Migrator, error) {
+	migrations, err := Load(fsys)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Migrator{
+		db:         client.DB,
+		migrations: migrations,
+	}, nil
+}
+
+// Up aplica en orden las migraciones pendientes y devuelve las aplicadas.
+// Cada migración corre en su propia transacción junto con su registro en
+// schema_migrations, así que un fallo no deja versiones a medias.
+func (m *Migrator) Up(ctx context.Context) ([]Migration, error) {
+	applied, err := m.appliedVersions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var done []Migration
+	for _, migration := range m.migrations {
+		if _, ok := applied[migration.Version]; ok {
+			continue
+		}
+
+		err := m.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+			if err := tx.Exec(migration.Up).Error; err != nil {
+				return err
+			}
+			return tx.Exec(`INSERT INTO "schema_migrations" ("version", "name", "applied_at") VALUES (?, ?, ?)`,
+				migration.Version, migration.Name, time.Now().UTC()).Error
+		})
+		if err != nil {
+			return done, fmt.Errorf("error al aplicar la migración %04d_%s: %w", migration.Version, migration.Name, err)
+		}
+		done = append(done, migration)
+	}
+
+	return done, nil
+}
+
+// Down revierte las últimas steps migraciones aplicadas, de la más reciente
+// a la más antigua, y devuelve las revertidas.
+func (m *Migrator) Down(ctx context.Context, steps int) ([]Migration, error) {
+	applied, err := m.appliedVersions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var done []Migration
+	for i := len(m.migrations) - 1; i >= 0 && len(done) < steps; i-- {
+		migration := m.migrations[i]
+		if _, ok := applied[migration.Version]; !ok {
+			continue
+		}
+
+		err := m.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+			if err := tx.Exec(migration.Down).Error; err != nil {
+				return err
+			}
+			return tx.Exec(`DELETE FROM "schema_migrations" WHERE "version" = ?`, migration.Version).Error
+		})
+		if err != nil {
+			return done, fmt.Errorf("error al revertir la migración %04d_%s: %w", migration.Version, migration.Name, err)
+		}
+		done = append(done, migration)
+	}
+
+	return done, nil
+}
+
+// Status lista todas las migraciones conocidas con su estado
+func (m *Migrator) Status(ctx context.Context) ([]Status, error) {
+	applied, err := m.appliedVersions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	statuses := make([]Status, 0, len(m.migrations))
+	for _, migration := range m.migrations {
+		status := Status{Migration: migration}
+		if appliedAt, ok := applied[migration.Version]; ok {
+			status.AppliedAt = &appliedAt
+		}
+		statuses = append(statuses, status)
+	}
+
+	return statuses, nil
+}
+
+func (m *Migrator) appliedVersions(ctx context.Context) (map[int]time.Time, error) {
+	db := m.db.WithContext(ctx)
+	if err := db.Exec(createVersionTable).Error; err != nil {
+		return nil, fmt.Errorf("error al crear schema_migrations: %w", err)
+	}
+
+	var rows []appliedVersion
+	if err := db.Raw(`SELECT "version", "applied_at" FROM "schema_migrations"`).Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	applied := make(map[int]time.Time, len(rows))
+	for _, row := range rows {
+		applied[row.Version] = row.AppliedAt
+	}
+	return applied, nil
+}
@@ -0,0 +1,146 @@
+package migrations
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+	"testing/fstest"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+
+	"softpharos/internal/infra/databases"
+)
+
+var testFiles = fstest.MapFS{
+	"0001_init.up.sql":     {Data: []byte(`CREATE TABLE "a" ("id" integer);`)},
+	"0001_init.down.sql":   {Data: []byte(`DROP TABLE "a";`)},
+	"0002_column.up.sql":   {Data: []byte(`ALTER TABLE "a" ADD COLUMN "b" integer;`)},
+	"0002_column.down.sql": {Data: []byte(`ALTER TABLE "a" DROP COLUMN "b";`)},
+}
+
+func setupMigrator(t *testing.T) (*Migrator, sqlmock.Sqlmock) {
+	sqlDB, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to create mock db: %v", err)
+	}
+	t.Cleanup(func() { sqlDB.Close() })
+
+	db, err := gorm.Open(postgres.New(postgres.Config{Conn: sqlDB, DriverName: "postgres"}), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("failed to open gorm db: %v", err)
+	}
+
+	migrator, err := NewFromFS(&databases.Client{DB: db}, testFiles)
+	if err != nil {
+		t.Fatalf("failed to load migrations: %v", err)
+	}
+	return migrator, mock
+}
+
+func expectApplied(mock sqlmock.Sqlmock, versions ...int) {
+	mock.ExpectExec(regexp.QuoteMeta(`CREATE TABLE IF NOT EXISTS "schema_migrations"`)).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	rows := sqlmock.NewRows([]string{"version", "applied_at"})
+	for _, version := range versions {
+		rows.AddRow(version, time.Now())
+	}
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT "version", "applied_at" FROM "schema_migrations"`)).
+		WillReturnRows(rows)
+}
+
+func TestMigratorUp(t *testing.T) {
+	tests := []struct {
+		name            string
+		mockSetup       func(sqlmock.Sqlmock)
+		expectedApplied int
+		expectError     bool
+	}{
+		{
+			name: "aplica solo las migraciones pendientes",
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				expectApplied(mock, 1)
+				mock.ExpectBegin()
+				mock.ExpectExec(regexp.QuoteMeta(`ALTER TABLE "a" ADD COLUMN "b" integer;`)).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO "schema_migrations"`)).
+					WithArgs(2, "column", sqlmock.AnyArg()).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+			expectedApplied: 1,
+		},
+		{
+			name: "no hace nada si el esquema está al día",
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				expectApplied(mock, 1, 2)
+			},
+			expectedApplied: 0,
+		},
+		{
+			name: "revierte la transacción y se detiene cuando una migración falla",
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				expectApplied(mock)
+				mock.ExpectBegin()
+				mock.ExpectExec(regexp.QuoteMeta(`CREATE TABLE "a"`)).
+					WillReturnError(errors.New("syntax error"))
+				mock.ExpectRollback()
+			},
+			expectedApplied: 0,
+			expectError:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			migrator, mock := setupMigrator(t)
+			tt.mockSetup(mock)
+
+			applied, err := migrator.Up(context.Background())
+
+			if tt.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Len(t, applied, tt.expectedApplied)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestMigratorDown(t *testing.T) {
+	migrator, mock := setupMigrator(t)
+	expectApplied(mock, 1, 2)
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(`ALTER TABLE "a" DROP COLUMN "b";`)).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM "schema_migrations" WHERE "version" = $1`)).
+		WithArgs(2).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	reverted, err := migrator.Down(context.Background(), 1)
+
+	assert.NoError(t, err)
+	assert.Len(t, reverted, 1)
+	assert.Equal(t, 2, reverted[0].Version)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestMigratorStatus(t *testing.T) {
+	migrator, mock := setupMigrator(t)
+	expectApplied(mock, 1)
+
+	statuses, err := migrator.Status(context.Background())
+
+	assert.NoError(t, err)
+	assert.Len(t, statuses, 2)
+	assert.NotNil(t, statuses[0].AppliedAt)
+	assert.Nil(t, statuses[1].AppliedAt)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
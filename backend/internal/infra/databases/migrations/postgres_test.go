@@ -0,0 +1,59 @@
+package migrations
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+
+	"softpharos/internal/infra/databases"
+)
+
+// TestMigrationsOnPostgres aplica y revierte todas las migraciones embebidas
+// contra una base real. Necesita TEST_DATABASE_DSN apuntando a una base vacía
+// y desechable, por ejemplo:
+//
+//	TEST_DATABASE_DSN="host=localhost user=softpharos password=... dbname=softpharos_test sslmode=disable"
+func TestMigrationsOnPostgres(t *testing.T) {
+	dsn := os.Getenv("TEST_DATABASE_DSN")
+	if dsn == "" {
+		t.Skip("TEST_DATABASE_DSN no está configurado")
+	}
+
+	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{})
+	require.NoError(t, err)
+	sqlDB, err := db.DB()
+	require.NoError(t, err)
+	defer sqlDB.Close()
+
+	migrator, err := New(&databases.Client{DB: db})
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	applied, err := migrator.Up(ctx)
+	require.NoError(t, err)
+	assert.Len(t, applied, len(migrator.migrations))
+
+	statuses, err := migrator.Status(ctx)
+	require.NoError(t, err)
+	for _, status := range statuses {
+		assert.NotNil(t, status.AppliedAt, "la migración %04d_%s debería estar aplicada", status.Version, status.Name)
+	}
+
+	// Revertir todo y volver a aplicar comprueba que los archivos down dejan
+	// la base como estaba
+	reverted, err := migrator.Down(ctx, len(migrator.migrations))
+	require.NoError(t, err)
+	assert.Len(t, reverted, len(migrator.migrations))
+
+	applied, err = migrator.Up(ctx)
+	require.NoError(t, err)
+	assert.Len(t, applied, len(migrator.migrations))
+
+	_, err = migrator.Down(ctx, len(migrator.migrations))
+	require.NoError(t, err)
+}
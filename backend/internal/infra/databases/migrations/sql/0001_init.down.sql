@@ -0,0 +1,9 @@
+DROP TABLE IF EXISTS "reaction";
+DROP TABLE IF EXISTS "comment";
+DROP TABLE IF EXISTS "feedback";
+DROP TABLE IF EXISTS "deliverable";
+DROP TABLE IF EXISTS "milestone";
+DROP TABLE IF EXISTS "project_member";
+DROP TABLE IF EXISTS "project";
+DROP TABLE IF EXISTS "user";
+DROP TABLE IF EXISTS "role";
@@ -0,0 +1,24 @@
+-- Refresh tokens rotativos y revocación de access tokens.
+CREATE TABLE "refresh_token" (
+  "id" INTEGER GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+  "user_id" integer NOT NULL,
+  "token_hash" varchar UNIQUE NOT NULL,
+  "expires_at" timestamp NOT NULL,
+  "revoked_at" timestamp,
+  "created_at" timestamp
+);
+
+CREATE TABLE "revoked_token" (
+  "jti" varchar PRIMARY KEY,
+  "expires_at" timestamp NOT NULL,
+  "revoked_at" timestamp
+);
+
+CREATE TABLE "revoked_user_token" (
+  "user_id" integer PRIMARY KEY,
+  "revoked_before" timestamp NOT NULL
+);
+
+ALTER TABLE "refresh_token" ADD FOREIGN KEY ("user_id") REFERENCES "user" ("id");
+
+ALTER TABLE "revoked_user_token" ADD FOREIGN KEY ("user_id") REFERENCES "user" ("id");
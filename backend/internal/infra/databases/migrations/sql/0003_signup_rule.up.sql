@@ -0,0 +1,9 @@
+-- Reglas que asignan un rol al registrarse según el correo o su dominio.
+CREATE TABLE "signup_rule" (
+  "id" INTEGER GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+  "pattern" varchar UNIQUE NOT NULL,
+  "role_id" integer NOT NULL,
+  "created_at" timestamp
+);
+
+ALTER TABLE "signup_rule" ADD FOREIGN KEY ("role_id") REFERENCES "role" ("id");
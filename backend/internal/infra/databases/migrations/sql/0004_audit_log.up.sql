@@ -0,0 +1,12 @@
+-- Historial de acciones administrativas (cambios de rol, etc.).
+CREATE TABLE "audit_log" (
+  "id" INTEGER GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+  "actor_id" integer,
+  "action" varchar NOT NULL,
+  "entity" varchar NOT NULL,
+  "entity_id" integer NOT NULL,
+  "details" text,
+  "created_at" timestamp
+);
+
+ALTER TABLE "audit_log" ADD FOREIGN KEY ("actor_id") REFERENCES "user" ("id");
@@ -0,0 +1,53 @@
+DROP INDEX IF EXISTS "user_role_id_idx", "signup_rule_role_id_idx", "project_created_by_idx", "project_member_user_id_idx", "milestone_project_id_idx", "deliverable_milestone_id_idx", "feedback_milestone_id_idx", "feedback_professor_id_idx", "comment_milestone_id_idx", "comment_user_id_idx", "reaction_user_id_idx", "refresh_token_user_id_idx", "audit_log_actor_id_idx", "audit_log_entity_idx";
+
+ALTER TABLE "user" DROP CONSTRAINT "user_role_id_fkey",
+  ADD CONSTRAINT "user_role_id_fkey" FOREIGN KEY ("role_id") REFERENCES "role" ("id");
+
+ALTER TABLE "signup_rule" DROP CONSTRAINT "signup_rule_role_id_fkey",
+  ADD CONSTRAINT "signup_rule_role_id_fkey" FOREIGN KEY ("role_id") REFERENCES "role" ("id");
+
+ALTER TABLE "project" DROP CONSTRAINT "project_created_by_fkey",
+  ADD CONSTRAINT "project_created_by_fkey" FOREIGN KEY ("created_by") REFERENCES "user" ("id");
+
+ALTER TABLE "project_member" DROP CONSTRAINT "project_member_project_id_fkey",
+  ADD CONSTRAINT "project_member_project_id_fkey" FOREIGN KEY ("project_id") REFERENCES "project" ("id");
+
+ALTER TABLE "project_member" DROP CONSTRAINT "project_member_user_id_fkey",
+  ADD CONSTRAINT "project_member_user_id_fkey" FOREIGN KEY ("user_id") REFERENCES "user" ("id");
+
+ALTER TABLE "milestone" DROP CONSTRAINT "milestone_project_id_fkey",
+  ADD CONSTRAINT "milestone_project_id_fkey" FOREIGN KEY ("project_id") REFERENCES "project" ("id");
+
+ALTER TABLE "deliverable" DROP CONSTRAINT "deliverable_milestone_id_fkey",
+  ADD CONSTRAINT "deliverable_milestone_id_fkey" FOREIGN KEY ("milestone_id") REFERENCES "milestone" ("id");
+
+ALTER TABLE "feedback" DROP CONSTRAINT "feedback_milestone_id_fkey",
+  ADD CONSTRAINT "feedback_milestone_id_fkey" FOREIGN KEY ("milestone_id") REFERENCES "milestone" ("id");
+
+ALTER TABLE "feedback" DROP CONSTRAINT "feedback_professor_id_fkey",
+  ADD CONSTRAINT "feedback_professor_id_fkey" FOREIGN KEY ("professor_id") REFERENCES "user" ("id");
+
+ALTER TABLE "comment" DROP CONSTRAINT "comment_milestone_id_fkey",
+  ADD CONSTRAINT "comment_milestone_id_fkey" FOREIGN KEY ("milestone_id") REFERENCES "milestone" ("id");
+
+ALTER TABLE "comment" DROP CONSTRAINT "comment_user_id_fkey",
+  ADD CONSTRAINT "comment_user_id_fkey" FOREIGN KEY ("user_id") REFERENCES "user" ("id");
+
+ALTER TABLE "reaction" DROP CONSTRAINT "reaction_milestone_id_fkey",
+  ADD CONSTRAINT "reaction_milestone_id_fkey" FOREIGN KEY ("milestone_id") REFERENCES "milestone" ("id");
+
+ALTER TABLE "reaction" DROP CONSTRAINT "reaction_user_id_fkey",
+  ADD CONSTRAINT "reaction_user_id_fkey" FOREIGN KEY ("user_id") REFERENCES "user" ("id");
+
+ALTER TABLE "refresh_token" DROP CONSTRAINT "refresh_token_user_id_fkey",
+  ADD CONSTRAINT "refresh_token_user_id_fkey" FOREIGN KEY ("user_id") REFERENCES "user" ("id");
+
+ALTER TABLE "revoked_user_token" DROP CONSTRAINT "revoked_user_token_user_id_fkey",
+  ADD CONSTRAINT "revoked_user_token_user_id_fkey" FOREIGN KEY ("user_id") REFERENCES "user" ("id");
+
+ALTER TABLE "audit_log" DROP CONSTRAINT "audit_log_actor_id_fkey",
+  ADD CONSTRAINT "audit_log_actor_id_fkey" FOREIGN KEY ("actor_id") REFERENCES "user" ("id");
+
+ALTER TABLE "reaction" DROP CONSTRAINT "reaction_milestone_id_user_id_type_key";
+
+ALTER TABLE "project_member" DROP CONSTRAINT "project_member_project_id_user_id_key";
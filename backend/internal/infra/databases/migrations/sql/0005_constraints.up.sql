@@ -0,0 +1,88 @@
+-- Restricciones e índices que reflejan las reglas del dominio.
+
+-- Unicidad: un usuario es miembro de un proyecto una sola vez y reacciona
+-- una sola vez con cada tipo. Se eliminan primero los duplicados que
+-- existieran, conservando el registro más antiguo.
+DELETE FROM "project_member" a
+  USING "project_member" b
+  WHERE a."project_id" = b."project_id" AND a."user_id" = b."user_id" AND a."id" > b."id";
+
+DELETE FROM "reaction" a
+  USING "reaction" b
+  WHERE a."milestone_id" = b."milestone_id" AND a."user_id" = b."user_id"
+    AND a."type" IS NOT DISTINCT FROM b."type" AND a."id" > b."id";
+
+ALTER TABLE "project_member"
+  ADD CONSTRAINT "project_member_project_id_user_id_key" UNIQUE ("project_id", "user_id");
+
+ALTER TABLE "reaction"
+  ADD CONSTRAINT "reaction_milestone_id_user_id_type_key" UNIQUE ("milestone_id", "user_id", "type");
+
+-- ON DELETE: lo que cuelga de un proyecto o de un hito se borra con él; los
+-- roles y los usuarios con contenido propio no se pueden borrar mientras
+-- estén referenciados; el historial de auditoría sobrevive a su autor.
+ALTER TABLE "user" DROP CONSTRAINT "user_role_id_fkey",
+  ADD CONSTRAINT "user_role_id_fkey" FOREIGN KEY ("role_id") REFERENCES "role" ("id") ON DELETE RESTRICT;
+
+ALTER TABLE "signup_rule" DROP CONSTRAINT "signup_rule_role_id_fkey",
+  ADD CONSTRAINT "signup_rule_role_id_fkey" FOREIGN KEY ("role_id") REFERENCES "role" ("id") ON DELETE RESTRICT;
+
+ALTER TABLE "project" DROP CONSTRAINT "project_created_by_fkey",
+  ADD CONSTRAINT "project_created_by_fkey" FOREIGN KEY ("created_by") REFERENCES "user" ("id") ON DELETE RESTRICT;
+
+ALTER TABLE "project_member" DROP CONSTRAINT "project_member_project_id_fkey",
+  ADD CONSTRAINT "project_member_project_id_fkey" FOREIGN KEY ("project_id") REFERENCES "project" ("id") ON DELETE CASCADE;
+
+ALTER TABLE "project_member" DROP CONSTRAINT "project_member_user_id_fkey",
+  ADD CONSTRAINT "project_member_user_id_fkey" FOREIGN KEY ("user_id") REFERENCES "user" ("id") ON DELETE CASCADE;
+
+ALTER TABLE "milestone" DROP CONSTRAINT "milestone_project_id_fkey",
+  ADD CONSTRAINT "milestone_project_id_fkey" FOREIGN KEY ("project_id") REFERENCES "project" ("id") ON DELETE CASCADE;
+
+ALTER TABLE "deliverable" DROP CONSTRAINT "deliverable_milestone_id_fkey",
+  ADD CONSTRAINT "deliverable_milestone_id_fkey" FOREIGN KEY ("milestone_id") REFERENCES "milestone" ("id") ON DELETE CASCADE;
+
+ALTER TABLE "feedback" DROP CONSTRAINT "feedback_milestone_id_fkey",
+  ADD CONSTRAINT "feedback_milestone_id_fkey" FOREIGN KEY ("milestone_id") REFERENCES "milestone" ("id") ON DELETE CASCADE;
+
+ALTER TABLE "feedback" DROP CONSTRAINT "feedback_professor_id_fkey",
+  ADD CONSTRAINT "feedback_professor_id_fkey" FOREIGN KEY ("professor_id") REFERENCES "user" ("id") ON DELETE RESTRICT;
+
+ALTER TABLE "comment" DROP CONSTRAINT "comment_milestone_id_fkey",
+  ADD CONSTRAINT "comment_milestone_id_fkey" FOREIGN KEY ("milestone_id") REFERENCES "milestone" ("id") ON DELETE CASCADE;
+
+ALTER TABLE "comment" DROP CONSTRAINT "comment_user_id_fkey",
+  ADD CONSTRAINT "comment_user_id_fkey" FOREIGN KEY ("user_id") REFERENCES "user" ("id") ON DELETE CASCADE;
+
+ALTER TABLE "reaction" DROP CONSTRAINT "reaction_milestone_id_fkey",
+  ADD CONSTRAINT "reaction_milestone_id_fkey" FOREIGN KEY ("milestone_id") REFERENCES "milestone" ("id") ON DELETE CASCADE;
+
+ALTER TABLE "reaction" DROP CONSTRAINT "reaction_user_id_fkey",
+  ADD CONSTRAINT "reaction_user_id_fkey" FOREIGN KEY ("user_id") REFERENCES "user" ("id") ON DELETE CASCADE;
+
+ALTER TABLE "refresh_token" DROP CONSTRAINT "refresh_token_user_id_fkey",
+  ADD CONSTRAINT "refresh_token_user_id_fkey" FOREIGN KEY ("user_id") REFERENCES "user" ("id") ON DELETE CASCADE;
+
+ALTER TABLE "revoked_user_token" DROP CONSTRAINT "revoked_user_token_user_id_fkey",
+  ADD CONSTRAINT "revoked_user_token_user_id_fkey" FOREIGN KEY ("user_id") REFERENCES "user" ("id") ON DELETE CASCADE;
+
+ALTER TABLE "audit_log" DROP CONSTRAINT "audit_log_actor_id_fkey",
+  ADD CONSTRAINT "audit_log_actor_id_fkey" FOREIGN KEY ("actor_id") REFERENCES "user" ("id") ON DELETE SET NULL;
+
+-- Índices sobre las llaves foráneas por las que filtran los GetBy*ID. Las
+-- que ya encabezan un índice único (project_member.project_id,
+-- reaction.milestone_id) no necesitan uno propio.
+CREATE INDEX "user_role_id_idx" ON "user" ("role_id");
+CREATE INDEX "signup_rule_role_id_idx" ON "signup_rule" ("role_id");
+CREATE INDEX "project_created_by_idx" ON "project" ("created_by");
+CREATE INDEX "project_member_user_id_idx" ON "project_member" ("user_id");
+CREATE INDEX "milestone_project_id_idx" ON "milestone" ("project_id");
+CREATE INDEX "deliverable_milestone_id_idx" ON "deliverable" ("milestone_id");
+CREATE INDEX "feedback_milestone_id_idx" ON "feedback" ("milestone_id");
+CREATE INDEX "feedback_professor_id_idx" ON "feedback" ("professor_id");
+CREATE INDEX "comment_milestone_id_idx" ON "comment" ("milestone_id");
+CREATE INDEX "comment_user_id_idx" ON "comment" ("user_id");
+CREATE INDEX "reaction_user_id_idx" ON "reaction" ("user_id");
+CREATE INDEX "refresh_token_user_id_idx" ON "refresh_token" ("user_id");
+CREATE INDEX "audit_log_actor_id_idx" ON "audit_log" ("actor_id");
+CREATE INDEX "audit_log_entity_idx" ON "audit_log" ("entity", "entity_id");
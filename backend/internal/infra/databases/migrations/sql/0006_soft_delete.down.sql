@@ -0,0 +1,13 @@
+-- Las filas que estaban en la papelera se eliminan definitivamente antes de
+-- quitar la columna; de lo contrario reaparecerían como vigentes.
+DELETE FROM "comment" WHERE "deleted_at" IS NOT NULL;
+DELETE FROM "feedback" WHERE "deleted_at" IS NOT NULL;
+DELETE FROM "deliverable" WHERE "deleted_at" IS NOT NULL;
+DELETE FROM "milestone" WHERE "deleted_at" IS NOT NULL;
+DELETE FROM "project" WHERE "deleted_at" IS NOT NULL;
+
+ALTER TABLE "comment" DROP COLUMN "deleted_at";
+ALTER TABLE "feedback" DROP COLUMN "deleted_at";
+ALTER TABLE "deliverable" DROP COLUMN "deleted_at";
+ALTER TABLE "milestone" DROP COLUMN "deleted_at";
+ALTER TABLE "project" DROP COLUMN "deleted_at";
@@ -0,0 +1,14 @@
+-- Papelera: los proyectos, hitos y el contenido de los hitos se marcan con
+-- deleted_at en lugar de borrarse. Los índices parciales sirven al listado
+-- de la papelera y a la purga, que solo miran las filas borradas.
+ALTER TABLE "project" ADD COLUMN "deleted_at" timestamp;
+ALTER TABLE "milestone" ADD COLUMN "deleted_at" timestamp;
+ALTER TABLE "deliverable" ADD COLUMN "deleted_at" timestamp;
+ALTER TABLE "feedback" ADD COLUMN "deleted_at" timestamp;
+ALTER TABLE "comment" ADD COLUMN "deleted_at" timestamp;
+
+CREATE INDEX "project_deleted_at_idx" ON "project" ("deleted_at") WHERE "deleted_at" IS NOT NULL;
+CREATE INDEX "milestone_deleted_at_idx" ON "milestone" ("deleted_at") WHERE "deleted_at" IS NOT NULL;
+CREATE INDEX "deliverable_deleted_at_idx" ON "deliverable" ("deleted_at") WHERE "deleted_at" IS NOT NULL;
+CREATE INDEX "feedback_deleted_at_idx" ON "feedback" ("deleted_at") WHERE "deleted_at" IS NOT NULL;
+CREATE INDEX "comment_deleted_at_idx" ON "comment" ("deleted_at") WHERE "deleted_at" IS NOT NULL;
@@ -0,0 +1,3 @@
+-- Los owners agregados no se distinguen de los registrados por la API, así
+-- que se conservan; solo se quita el índice.
+DROP INDEX "project_member_owner_idx";
@@ -0,0 +1,19 @@
+-- Hasta ahora el creador de un proyecto no quedaba registrado como miembro.
+-- Se le agrega como owner, o se corrige su rol si ya era miembro.
+UPDATE "project_member" pm
+SET "role" = 'owner'
+FROM "project" p
+WHERE pm."project_id" = p."id"
+  AND pm."user_id" = p."created_by"
+  AND pm."role" IS DISTINCT FROM 'owner';
+
+INSERT INTO "project_member" ("project_id", "user_id", "role", "joined_at")
+SELECT p."id", p."created_by", 'owner', p."created_at"
+FROM "project" p
+WHERE NOT EXISTS (
+  SELECT 1 FROM "project_member" pm
+  WHERE pm."project_id" = p."id" AND pm."user_id" = p."created_by"
+);
+
+-- Los permisos de owner y la regla de al menos un owner consultan por rol
+CREATE INDEX "project_member_owner_idx" ON "project_member" ("project_id") WHERE "role" = 'owner';
@@ -0,0 +1,23 @@
+CREATE TABLE "project_invitation" (
+  "id" INTEGER GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+  "project_id" integer NOT NULL,
+  "email" varchar NOT NULL,
+  "role" varchar NOT NULL,
+  "token_hash" varchar NOT NULL,
+  "invited_by" integer,
+  "status" varchar NOT NULL DEFAULT 'pending',
+  "expires_at" timestamp NOT NULL,
+  "responded_at" timestamp,
+  "created_at" timestamp,
+  CONSTRAINT "project_invitation_token_hash_key" UNIQUE ("token_hash"),
+  CONSTRAINT "project_invitation_status_check" CHECK ("status" IN ('pending', 'accepted', 'declined')),
+  CONSTRAINT "project_invitation_project_id_fkey" FOREIGN KEY ("project_id") REFERENCES "project" ("id") ON DELETE CASCADE,
+  -- La invitación sobrevive a quien la envió
+  CONSTRAINT "project_invitation_invited_by_fkey" FOREIGN KEY ("invited_by") REFERENCES "user" ("id") ON DELETE SET NULL
+);
+
+-- Un email tiene a lo sumo una invitación pendiente por proyecto; el email se
+-- guarda en minúsculas
+CREATE UNIQUE INDEX "project_invitation_pending_key" ON "project_invitation" ("project_id", "email") WHERE "status" = 'pending';
+CREATE INDEX "project_invitation_email_idx" ON "project_invitation" ("email") WHERE "status" = 'pending';
+CREATE INDEX "project_invitation_invited_by_idx" ON "project_invitation" ("invited_by");
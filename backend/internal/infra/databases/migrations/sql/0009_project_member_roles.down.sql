@@ -0,0 +1,11 @@
+ALTER TABLE "project_invitation" DROP CONSTRAINT "project_invitation_role_check";
+
+ALTER TABLE "project_member"
+  DROP CONSTRAINT "project_member_role_check",
+  ALTER COLUMN "role" DROP NOT NULL,
+  ALTER COLUMN "role" DROP DEFAULT;
+
+-- Antes solo existían owner y member: contributor vuelve a ser member, y
+-- maintainer y viewer, que no tenían equivalente, también quedan como member
+UPDATE "project_member" SET "role" = 'member' WHERE "role" IN ('maintainer', 'contributor', 'viewer');
+UPDATE "project_invitation" SET "role" = 'member' WHERE "role" IN ('maintainer', 'contributor', 'viewer');
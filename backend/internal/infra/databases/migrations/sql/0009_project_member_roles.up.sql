@@ -0,0 +1,24 @@
+-- El rol de un miembro pasa a ser uno de owner, maintainer, contributor o
+-- viewer. En el esquema anterior 'member' era un miembro sin gestión del
+-- proyecto, así que pasa a contributor, el rol con menos permisos que aún
+-- puede aportar; cualquier otra etiqueta libre, o la ausencia de rol, también
+-- se trata como contributor.
+UPDATE "project_member" SET "role" = 'contributor' WHERE "role" = 'member';
+
+UPDATE "project_member"
+SET "role" = 'contributor'
+WHERE "role" IS NULL
+   OR "role" NOT IN ('owner', 'maintainer', 'contributor', 'viewer');
+
+ALTER TABLE "project_member"
+  ALTER COLUMN "role" SET DEFAULT 'contributor',
+  ALTER COLUMN "role" SET NOT NULL,
+  ADD CONSTRAINT "project_member_role_check" CHECK ("role" IN ('owner', 'maintainer', 'contributor', 'viewer'));
+
+-- Las invitaciones crean la membresía con su rol, así que siguen el mismo catálogo
+UPDATE "project_invitation"
+SET "role" = 'contributor'
+WHERE "role" NOT IN ('owner', 'maintainer', 'contributor', 'viewer');
+
+ALTER TABLE "project_invitation"
+  ADD CONSTRAINT "project_invitation_role_check" CHECK ("role" IN ('owner', 'maintainer', 'contributor', 'viewer'));
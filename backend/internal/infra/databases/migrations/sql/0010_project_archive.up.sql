@@ -0,0 +1,2 @@
+-- Un proyecto archivado deja de estar activo sin ir a la papelera
+ALTER TABLE "project" ADD COLUMN "archived_at" timestamp;
@@ -0,0 +1,6 @@
+ALTER TABLE "project" DROP COLUMN "section_id";
+
+DROP TABLE "course_member";
+DROP TABLE "course_section";
+DROP TABLE "course";
+DROP TABLE "term";
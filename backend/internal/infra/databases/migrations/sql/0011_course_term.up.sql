@@ -0,0 +1,53 @@
+CREATE TABLE "term" (
+  "id" INTEGER GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+  "code" varchar NOT NULL,
+  "start_date" date NOT NULL,
+  "end_date" date NOT NULL,
+  "created_at" timestamp,
+  CONSTRAINT "term_code_key" UNIQUE ("code"),
+  CONSTRAINT "term_dates_check" CHECK ("end_date" > "start_date")
+);
+
+CREATE TABLE "course" (
+  "id" INTEGER GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+  "code" varchar NOT NULL,
+  "name" varchar NOT NULL,
+  "created_at" timestamp,
+  CONSTRAINT "course_code_key" UNIQUE ("code")
+);
+
+-- Una sección es la asignatura dictada en un periodo. Ni la asignatura ni el
+-- periodo se pueden borrar mientras tengan secciones.
+CREATE TABLE "course_section" (
+  "id" INTEGER GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+  "course_id" integer NOT NULL,
+  "term_id" integer NOT NULL,
+  "name" varchar NOT NULL,
+  "created_at" timestamp,
+  CONSTRAINT "course_section_course_id_term_id_name_key" UNIQUE ("course_id", "term_id", "name"),
+  CONSTRAINT "course_section_course_id_fkey" FOREIGN KEY ("course_id") REFERENCES "course" ("id") ON DELETE RESTRICT,
+  CONSTRAINT "course_section_term_id_fkey" FOREIGN KEY ("term_id") REFERENCES "term" ("id") ON DELETE RESTRICT
+);
+
+CREATE INDEX "course_section_term_id_idx" ON "course_section" ("term_id");
+
+CREATE TABLE "course_member" (
+  "id" INTEGER GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+  "section_id" integer NOT NULL,
+  "user_id" integer NOT NULL,
+  "role" varchar NOT NULL,
+  "joined_at" timestamp,
+  CONSTRAINT "course_member_section_id_user_id_key" UNIQUE ("section_id", "user_id"),
+  CONSTRAINT "course_member_role_check" CHECK ("role" IN ('professor', 'student')),
+  CONSTRAINT "course_member_section_id_fkey" FOREIGN KEY ("section_id") REFERENCES "course_section" ("id") ON DELETE CASCADE,
+  CONSTRAINT "course_member_user_id_fkey" FOREIGN KEY ("user_id") REFERENCES "user" ("id") ON DELETE CASCADE
+);
+
+CREATE INDEX "course_member_user_id_idx" ON "course_member" ("user_id");
+
+-- Borrar una sección no borra sus proyectos, solo los desvincula
+ALTER TABLE "project"
+  ADD COLUMN "section_id" integer,
+  ADD CONSTRAINT "project_section_id_fkey" FOREIGN KEY ("section_id") REFERENCES "course_section" ("id") ON DELETE SET NULL;
+
+CREATE INDEX "project_section_id_idx" ON "project" ("section_id");
@@ -0,0 +1,3 @@
+DROP TABLE "term_break";
+
+ALTER TABLE "term" DROP COLUMN "week_length";
@@ -0,0 +1,17 @@
+-- Calendario del periodo: duración de la semana de clase y recesos. Los
+-- bloques de week_length días cubiertos por un receso no cuentan como semana.
+ALTER TABLE "term"
+  ADD COLUMN "week_length" integer NOT NULL DEFAULT 7,
+  ADD CONSTRAINT "term_week_length_check" CHECK ("week_length" > 0);
+
+CREATE TABLE "term_break" (
+  "id" INTEGER GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+  "term_id" integer NOT NULL,
+  "name" varchar NOT NULL,
+  "start_date" date NOT NULL,
+  "end_date" date NOT NULL,
+  CONSTRAINT "term_break_dates_check" CHECK ("end_date" >= "start_date"),
+  CONSTRAINT "term_break_term_id_fkey" FOREIGN KEY ("term_id") REFERENCES "term" ("id") ON DELETE CASCADE
+);
+
+CREATE INDEX "term_break_term_id_idx" ON "term_break" ("term_id");
@@ -0,0 +1,6 @@
+DROP INDEX "milestone_pending_due_date_idx";
+
+ALTER TABLE "milestone"
+  DROP COLUMN "status_changed_at",
+  DROP COLUMN "status",
+  DROP COLUMN "due_date";
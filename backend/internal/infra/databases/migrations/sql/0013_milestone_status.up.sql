@@ -0,0 +1,10 @@
+-- Fecha límite y estado de cada hito. Los hitos existentes quedan planeados.
+ALTER TABLE "milestone"
+  ADD COLUMN "due_date" date,
+  ADD COLUMN "status" varchar NOT NULL DEFAULT 'planned',
+  ADD COLUMN "status_changed_at" timestamp,
+  ADD CONSTRAINT "milestone_status_check" CHECK ("status" IN ('planned', 'in_progress', 'submitted', 'reviewed'));
+
+-- Consulta de hitos vencidos: solo interesan los que aún no se entregan
+CREATE INDEX "milestone_pending_due_date_idx" ON "milestone" ("due_date")
+  WHERE "status" IN ('planned', 'in_progress') AND "deleted_at" IS NULL;
@@ -0,0 +1 @@
+ALTER TABLE "reaction" ALTER COLUMN "type" DROP NOT NULL;
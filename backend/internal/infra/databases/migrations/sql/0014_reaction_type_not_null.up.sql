@@ -0,0 +1,5 @@
+-- Toda reacción tiene un tipo del catálogo. Sin tipo, la restricción única
+-- no impedía repetir la misma reacción, así que se descartan las que no lo tienen.
+DELETE FROM "reaction" WHERE "type" IS NULL;
+
+ALTER TABLE "reaction" ALTER COLUMN "type" SET NOT NULL;
@@ -0,0 +1,18 @@
+package models
+
+import "time"
+
+type AuditLogModel struct {
+	ID        int        `gorm:"primaryKey;autoIncrement"`
+	ActorID   *int       `gorm:"column:actor_id"`
+	Actor     *UserModel `gorm:"foreignKey:ActorID"`
+	Action    string     `gorm:"not null"`
+	Entity    string     `gorm:"not null"`
+	EntityID  int        `gorm:"not null"`
+	Details   *string    `gorm:"type:text"`
+	CreatedAt time.Time  `gorm:"autoCreateTime"`
+}
+
+func (AuditLogModel) TableName() string {
+	return "audit_log"
+}
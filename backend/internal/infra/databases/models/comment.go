@@ -1,6 +1,10 @@
 package models
 
-import "time"
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
 
 type CommentModel struct {
 	ID          int             `gorm:"primaryKey;autoIncrement"`
@@ -10,6 +14,7 @@ type CommentModel struct {
 	User        *UserModel      `gorm:"foreignKey:UserID"`
 	Content     *string         `gorm:"type:text"`
 	CreatedAt   time.Time       `gorm:"autoCreateTime"`
+	DeletedAt   gorm.DeletedAt  `gorm:"index"`
 }
 
 func (CommentModel) TableName() string {
@@ -0,0 +1,41 @@
+package models
+
+import "time"
+
+type CourseModel struct {
+	ID        int       `gorm:"primaryKey;autoIncrement"`
+	Code      string    `gorm:"unique;not null"`
+	Name      string    `gorm:"not null"`
+	CreatedAt time.Time `gorm:"autoCreateTime"`
+}
+
+func (CourseModel) TableName() string {
+	return "course"
+}
+
+type CourseSectionModel struct {
+	ID        int          `gorm:"primaryKey;autoIncrement"`
+	CourseID  int          `gorm:"not null"`
+	Course    *CourseModel `gorm:"foreignKey:CourseID"`
+	TermID    int          `gorm:"not null"`
+	Term      *TermModel   `gorm:"foreignKey:TermID"`
+	Name      string       `gorm:"not null"`
+	CreatedAt time.Time    `gorm:"autoCreateTime"`
+}
+
+func (CourseSectionModel) TableName() string {
+	return "course_section"
+}
+
+type CourseMemberModel struct {
+	ID        int        `gorm:"primaryKey;autoIncrement"`
+	SectionID int        `gorm:"not null"`
+	UserID    int        `gorm:"not null"`
+	User      *UserModel `gorm:"foreignKey:UserID"`
+	Role      string     `gorm:"not null"`
+	JoinedAt  time.Time  `gorm:"autoCreateTime"`
+}
+
+func (CourseMemberModel) TableName() string {
+	return "course_member"
+}
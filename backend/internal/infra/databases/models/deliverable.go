@@ -1,6 +1,10 @@
 package models
 
-import "time"
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
 
 type DeliverableModel struct {
 	ID          int             `gorm:"primaryKey;autoIncrement"`
@@ -9,6 +13,7 @@ type DeliverableModel struct {
 	URL         string          `gorm:"type:text;not null"`
 	Type        *string         `gorm:"type:varchar"`
 	CreatedAt   time.Time       `gorm:"autoCreateTime"`
+	DeletedAt   gorm.DeletedAt  `gorm:"index"`
 }
 
 func (DeliverableModel) TableName() string {
@@ -1,6 +1,10 @@
 package models
 
-import "time"
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
 
 type FeedbackModel struct {
 	ID          int             `gorm:"primaryKey;autoIncrement"`
@@ -10,6 +14,7 @@ type FeedbackModel struct {
 	Professor   *UserModel      `gorm:"foreignKey:ProfessorID"`
 	Content     string          `gorm:"type:text;not null"`
 	CreatedAt   time.Time       `gorm:"autoCreateTime"`
+	DeletedAt   gorm.DeletedAt  `gorm:"index"`
 }
 
 func (FeedbackModel) TableName() string {
@@ -1,15 +1,28 @@
 package models
 
-import "time"
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
 
 type MilestoneModel struct {
-	ID          int           `gorm:"primaryKey;autoIncrement"`
-	ProjectID   int           `gorm:"not null"`
-	Project     *ProjectModel `gorm:"foreignKey:ProjectID"`
-	Title       *string       `gorm:"type:varchar"`
-	Description *string       `gorm:"type:text"`
-	ClassWeek   *int          `gorm:"type:integer"`
-	CreatedAt   time.Time     `gorm:"autoCreateTime"`
+	ID              int           `gorm:"primaryKey;autoIncrement"`
+	ProjectID       int           `gorm:"not null"`
+	Project         *ProjectModel `gorm:"foreignKey:ProjectID"`
+	Title           *string       `gorm:"type:varchar"`
+	Description     *string       `gorm:"type:text"`
+	ClassWeek       *int          `gorm:"type:integer"`
+	DueDate         *time.Time    `gorm:"type:date"`
+	Status          string        `gorm:"type:varchar;not null;default:planned"`
+	StatusChangedAt *time.Time
+	CreatedAt       time.Time      `gorm:"autoCreateTime"`
+	DeletedAt       gorm.DeletedAt `gorm:"index"`
+	// Solo se cargan al pedir el detalle del hito
+	Deliverables []DeliverableModel `gorm:"foreignKey:MilestoneID"`
+	Feedback     []FeedbackModel    `gorm:"foreignKey:MilestoneID"`
+	Comments     []CommentModel     `gorm:"foreignKey:MilestoneID"`
+	Reactions    []ReactionModel    `gorm:"foreignKey:MilestoneID"`
 }
 
 func (MilestoneModel) TableName() string {
@@ -21,6 +21,17 @@ func TestAllModelsTableNames(t *testing.T) {
 		{"Feedback", FeedbackModel{}, "feedback"},
 		{"ProjectMember", ProjectMemberModel{}, "project_member"},
 		{"Reaction", ReactionModel{}, "reaction"},
+		{"RefreshToken", RefreshTokenModel{}, "refresh_token"},
+		{"ProjectInvitation", ProjectInvitationModel{}, "project_invitation"},
+		{"RevokedToken", RevokedTokenModel{}, "revoked_token"},
+		{"RevokedUserToken", RevokedUserTokenModel{}, "revoked_user_token"},
+		{"AuditLog", AuditLogModel{}, "audit_log"},
+		{"SignupRule", SignupRuleModel{}, "signup_rule"},
+		{"Term", TermModel{}, "term"},
+		{"TermBreak", TermBreakModel{}, "term_break"},
+		{"Course", CourseModel{}, "course"},
+		{"CourseSection", CourseSectionModel{}, "course_section"},
+		{"CourseMember", CourseMemberModel{}, "course_member"},
 	}
 
 	for _, tt := range tests {
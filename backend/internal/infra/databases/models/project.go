@@ -1,15 +1,22 @@
 package models
 
-import "time"
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
 
 type ProjectModel struct {
-	ID        int        `gorm:"primaryKey;autoIncrement"`
-	Name      *string    `gorm:"type:varchar"`
-	Objective *string    `gorm:"type:text"`
-	CreatedBy int        `gorm:"not null"`
-	Owner     *UserModel `gorm:"foreignKey:CreatedBy"`
-	CreatedAt time.Time  `gorm:"autoCreateTime"`
-	UpdatedAt time.Time  `gorm:"autoUpdateTime"`
+	ID         int        `gorm:"primaryKey;autoIncrement"`
+	Name       *string    `gorm:"type:varchar"`
+	Objective  *string    `gorm:"type:text"`
+	CreatedBy  int        `gorm:"not null"`
+	Owner      *UserModel `gorm:"foreignKey:CreatedBy"`
+	SectionID  *int
+	CreatedAt  time.Time `gorm:"autoCreateTime"`
+	UpdatedAt  time.Time `gorm:"autoUpdateTime"`
+	ArchivedAt *time.Time
+	DeletedAt  gorm.DeletedAt `gorm:"index"`
 }
 
 func (ProjectModel) TableName() string {
@@ -0,0 +1,21 @@
+package models
+
+import "time"
+
+type ProjectInvitationModel struct {
+	ID          int           `gorm:"primaryKey;autoIncrement"`
+	ProjectID   int           `gorm:"not null"`
+	Project     *ProjectModel `gorm:"foreignKey:ProjectID"`
+	Email       string        `gorm:"not null"`
+	Role        string        `gorm:"not null"`
+	TokenHash   string        `gorm:"unique;not null"`
+	InvitedBy   *int
+	Status      string    `gorm:"not null;default:pending"`
+	ExpiresAt   time.Time `gorm:"not null"`
+	RespondedAt *time.Time
+	CreatedAt   time.Time `gorm:"autoCreateTime"`
+}
+
+func (ProjectInvitationModel) TableName() string {
+	return "project_invitation"
+}
@@ -8,7 +8,7 @@ type ProjectMemberModel struct {
 	Project   *ProjectModel `gorm:"foreignKey:ProjectID"`
 	UserID    int           `gorm:"not null"`
 	User      *UserModel    `gorm:"foreignKey:UserID"`
-	Role      *string       `gorm:"type:varchar"`
+	Role      string        `gorm:"type:varchar;not null"`
 	JoinedAt  time.Time     `gorm:"autoCreateTime"`
 }
 
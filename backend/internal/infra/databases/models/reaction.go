@@ -8,7 +8,7 @@ type ReactionModel struct {
 	Milestone   *MilestoneModel `gorm:"foreignKey:MilestoneID"`
 	UserID      int             `gorm:"not null"`
 	User        *UserModel      `gorm:"foreignKey:UserID"`
-	Type        *string         `gorm:"type:varchar"`
+	Type        *string         `gorm:"type:varchar;not null"`
 	CreatedAt   time.Time       `gorm:"autoCreateTime"`
 }
 
@@ -0,0 +1,17 @@
+package models
+
+import "time"
+
+type RefreshTokenModel struct {
+	ID        int        `gorm:"primaryKey;autoIncrement"`
+	UserID    int        `gorm:"not null"`
+	User      *UserModel `gorm:"foreignKey:UserID"`
+	TokenHash string     `gorm:"unique;not null"`
+	ExpiresAt time.Time  `gorm:"not null"`
+	RevokedAt *time.Time
+	CreatedAt time.Time `gorm:"autoCreateTime"`
+}
+
+func (RefreshTokenModel) TableName() string {
+	return "refresh_token"
+}
@@ -0,0 +1,24 @@
+package models
+
+import "time"
+
+type RevokedTokenModel struct {
+	JTI       string    `gorm:"column:jti;primaryKey"`
+	ExpiresAt time.Time `gorm:"not null"`
+	RevokedAt time.Time `gorm:"autoCreateTime"`
+}
+
+func (RevokedTokenModel) TableName() string {
+	return "revoked_token"
+}
+
+// RevokedUserTokenModel invalida todos los access tokens de un usuario
+// emitidos antes de RevokedBefore
+type RevokedUserTokenModel struct {
+	UserID        int       `gorm:"primaryKey;autoIncrement:false"`
+	RevokedBefore time.Time `gorm:"not null"`
+}
+
+func (RevokedUserTokenModel) TableName() string {
+	return "revoked_user_token"
+}
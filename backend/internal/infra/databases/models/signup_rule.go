@@ -0,0 +1,15 @@
+package models
+
+import "time"
+
+type SignupRuleModel struct {
+	ID        int        `gorm:"primaryKey;autoIncrement"`
+	Pattern   string     `gorm:"unique;not null"`
+	RoleID    int        `gorm:"not null"`
+	Role      *RoleModel `gorm:"foreignKey:RoleID"`
+	CreatedAt time.Time  `gorm:"autoCreateTime"`
+}
+
+func (SignupRuleModel) TableName() string {
+	return "signup_rule"
+}
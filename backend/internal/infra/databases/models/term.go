@@ -0,0 +1,29 @@
+package models
+
+import "time"
+
+type TermModel struct {
+	ID         int              `gorm:"primaryKey;autoIncrement"`
+	Code       string           `gorm:"unique;not null"`
+	StartDate  time.Time        `gorm:"type:date;not null"`
+	EndDate    time.Time        `gorm:"type:date;not null"`
+	WeekLength int              `gorm:"not null;default:7"`
+	Breaks     []TermBreakModel `gorm:"foreignKey:TermID"`
+	CreatedAt  time.Time        `gorm:"autoCreateTime"`
+}
+
+func (TermModel) TableName() string {
+	return "term"
+}
+
+type TermBreakModel struct {
+	ID        int       `gorm:"primaryKey;autoIncrement"`
+	TermID    int       `gorm:"not null"`
+	Name      string    `gorm:"not null"`
+	StartDate time.Time `gorm:"type:date;not null"`
+	EndDate   time.Time `gorm:"type:date;not null"`
+}
+
+func (TermBreakModel) TableName() string {
+	return "term_break"
+}
@@ -0,0 +1,66 @@
+package databases
+
+import (
+	"sort"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"softpharos/internal/core/domain/query"
+)
+
+// Filter aplica los filtros por igualdad de params. Los nombres de columna
+// se citan con clause.Column, así que no se interpolan en el SQL.
+func Filter(params query.Params) func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		fields := make([]string, 0, len(params.Filters))
+		for field := range params.Filters {
+			fields = append(fields, field)
+		}
+		sort.Strings(fields)
+
+		for _, field := range fields {
+			db = db.Where(clause.Eq{Column: clause.Column{Name: field}, Value: params.Filters[field]})
+		}
+		return db
+	}
+}
+
+// Paginate aplica el orden y la página de params. Siempre desempata por id
+// para que las páginas sean estables.
+func Paginate(params query.Params) func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		params = params.Normalize()
+
+		sortedByID := false
+		for _, s := range params.Sort {
+			db = db.Order(clause.OrderByColumn{Column: clause.Column{Name: s.Field}, Desc: s.Desc})
+			sortedByID = sortedByID || s.Field == "id"
+		}
+		if !sortedByID {
+			db = db.Order(clause.OrderByColumn{Column: clause.Column{Name: "id"}})
+		}
+
+		return db.Offset(params.Offset()).Limit(params.PageSize)
+	}
+}
+
+// PaginateJoined es Paginate para consultas con JOIN, en las que la tabla
+// principal comparte nombres de columna (como id) con las unidas. Cada campo
+// se ubica en table salvo que columns indique su columna calificada.
+func PaginateJoined(params query.Params, table string, columns map[string]string) func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		params = params.Normalize()
+
+		for _, s := range params.Sort {
+			column := clause.Column{Table: table, Name: s.Field}
+			if qualified, ok := columns[s.Field]; ok {
+				column = clause.Column{Name: qualified}
+			}
+			db = db.Order(clause.OrderByColumn{Column: column, Desc: s.Desc})
+		}
+		db = db.Order(clause.OrderByColumn{Column: clause.Column{Table: table, Name: "id"}})
+
+		return db.Offset(params.Offset()).Limit(params.PageSize)
+	}
+}
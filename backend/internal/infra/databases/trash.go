@@ -0,0 +1,87 @@
+package databases
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+
+	"softpharos/internal/core/errs"
+	"softpharos/internal/infra/databases/models"
+)
+
+// ErrMilestoneTrashed indica que el contenido no se puede recuperar porque su
+// hito sigue en la papelera
+var ErrMilestoneTrashed = errs.Conflict("El milestone está en la papelera; restáuralo primero")
+
+// milestoneContent son los modelos que cuelgan de un hito y lo acompañan a
+// la papelera
+var milestoneContent = []any{&models.DeliverableModel{}, &models.FeedbackModel{}, &models.CommentModel{}}
+
+// TrashedAt es la marca que comparten las filas enviadas a la papelera en una
+// misma operación. Va en UTC, como el corte con que se purga la papelera,
+// porque deleted_at es un timestamp sin zona horaria. Se trunca a
+// microsegundos, la precisión de timestamp en Postgres, para poder
+// compararla después con lo guardado.
+func TrashedAt(db *gorm.DB) time.Time {
+	return db.NowFunc().UTC().Truncate(time.Microsecond)
+}
+
+// TrashMilestones envía a la papelera los hitos vigentes que cumplen la
+// condición y su contenido vigente, todos con la marca at. Devuelve cuántos
+// hitos se borraron.
+func TrashMilestones(tx *gorm.DB, at time.Time, condition string, args ...any) (int64, error) {
+	milestoneIDs := tx.Model(&models.MilestoneModel{}).Select("id").Where(condition, args...)
+	for _, content := range milestoneContent {
+		if err := tx.Model(content).Where("milestone_id IN (?)", milestoneIDs).UpdateColumn("deleted_at", at).Error; err != nil {
+			return 0, err
+		}
+	}
+
+	result := tx.Model(&models.MilestoneModel{}).Where(condition, args...).UpdateColumn("deleted_at", at)
+	return result.RowsAffected, result.Error
+}
+
+// RestoreMilestones saca de la papelera los hitos que cumplen la condición y
+// se borraron con la marca at, junto con el contenido borrado en esa misma
+// operación. Lo que se había borrado antes por separado sigue en la papelera.
+func RestoreMilestones(tx *gorm.DB, at time.Time, condition string, args ...any) error {
+	milestoneIDs := tx.Unscoped().Model(&models.MilestoneModel{}).Select("id").
+		Where(condition, args...).Where("deleted_at = ?", at)
+	for _, content := range milestoneContent {
+		err := tx.Unscoped().Model(content).
+			Where("milestone_id IN (?) AND deleted_at = ?", milestoneIDs, at).
+			UpdateColumn("deleted_at", nil).Error
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Unscoped().Model(&models.MilestoneModel{}).
+		Where(condition, args...).Where("deleted_at = ?", at).
+		UpdateColumn("deleted_at", nil).Error
+}
+
+// RestoreMilestoneContent saca de la papelera la fila id de model, un
+// entregable, retroalimentación o comentario, siempre que su hito siga
+// vigente
+func RestoreMilestoneContent(db *gorm.DB, model any, id int) error {
+	result := db.Unscoped().Model(model).
+		Where("id = ? AND deleted_at IS NOT NULL", id).
+		Where("milestone_id IN (?)", db.Model(&models.MilestoneModel{}).Select("id")).
+		UpdateColumn("deleted_at", nil)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected > 0 {
+		return nil
+	}
+
+	var trashed int64
+	if err := db.Unscoped().Model(model).Where("id = ? AND deleted_at IS NOT NULL", id).Count(&trashed).Error; err != nil {
+		return err
+	}
+	if trashed > 0 {
+		return ErrMilestoneTrashed
+	}
+	return gorm.ErrRecordNotFound
+}
@@ -0,0 +1,22 @@
+package databases
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func TestTrashedAt(t *testing.T) {
+	bogota := time.FixedZone("COT", -5*60*60)
+	now := time.Date(2026, 5, 10, 19, 30, 0, 123456789, bogota)
+	db := &gorm.DB{Config: &gorm.Config{NowFunc: func() time.Time { return now }}}
+
+	at := TrashedAt(db)
+
+	// Con la hora local de la base de datos, la marca queda en UTC para
+	// coincidir con el corte de la purga
+	assert.Equal(t, time.UTC, at.Location())
+	assert.Equal(t, time.Date(2026, 5, 11, 0, 30, 0, 123456000, time.UTC), at)
+}
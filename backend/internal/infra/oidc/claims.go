@@ -0,0 +1,28 @@
+package oidc
+
+import (
+	"github.com/golang-jwt/jwt/v5"
+
+	"softpharos/internal/core/domain/identity"
+)
+
+// idTokenClaims son los claims estándar de un ID token OIDC
+type idTokenClaims struct {
+	Email         string `json:"email"`
+	EmailVerified bool   `json:"email_verified"`
+	Name          string `json:"name"`
+	Picture       string `json:"picture"`
+	HD            string `json:"hd,omitempty"`
+	jwt.RegisteredClaims
+}
+
+func (c *idTokenClaims) toIdentity() *identity.ProviderIdentity {
+	return &identity.ProviderIdentity{
+		Subject:       c.Subject,
+		Email:         c.Email,
+		EmailVerified: c.EmailVerified,
+		Name:          c.Name,
+		Picture:       c.Picture,
+		HostedDomain:  c.HD,
+	}
+}
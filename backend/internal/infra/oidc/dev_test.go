@@ -0,0 +1,46 @@
+package oidc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"softpharos/internal/core/domain/identity"
+)
+
+func TestDevProvider(t *testing.T) {
+	id := &identity.ProviderIdentity{Subject: "dev-1", Email: "dev@unal.edu.co", EmailVerified: true, Name: "Dev"}
+
+	valid, _ := SignDevToken("dev-secret", id, time.Hour)
+	otherSecret, _ := SignDevToken("otro-secreto", id, time.Hour)
+	expired, _ := SignDevToken("dev-secret", id, -time.Minute)
+
+	tests := []struct {
+		name        string
+		token       string
+		expectError bool
+	}{
+		{name: "acepta token firmado con el secreto local", token: valid},
+		{name: "rechaza token firmado con otro secreto", token: otherSecret, expectError: true},
+		{name: "rechaza token expirado", token: expired, expectError: true},
+		{name: "rechaza token malformado", token: "no-es-un-jwt", expectError: true},
+	}
+
+	provider := NewDevProvider("dev-secret")
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := provider.Verify(context.Background(), tt.token)
+
+			if tt.expectError {
+				assert.Error(t, err)
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, id, result)
+			}
+		})
+	}
+}
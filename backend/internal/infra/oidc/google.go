@@ -0,0 +1,39 @@
+package oidc
+
+import (
+	"context"
+
+	"softpharos/internal/core/domain/identity"
+	"softpharos/internal/core/ports/providers"
+)
+
+const googleJWKSURL = "https://www.googleapis.com/oauth2/v3/certs"
+
+// googleIssuers son los valores de iss que Google usa en sus ID tokens
+var googleIssuers = []string{"accounts.google.com", "https://accounts.google.com"}
+
+// GoogleProvider verifica ID tokens de Google localmente contra las llaves
+// públicas de Google, sin llamar al endpoint tokeninfo en cada inicio de
+// sesión. Los dominios permitidos se validan en el servicio de autenticación.
+type GoogleProvider struct {
+	verifier providers.IdentityProvider
+}
+
+// NewGoogleProvider crea el adaptador de Google para el client ID de la aplicación
+func NewGoogleProvider(clientID string) providers.IdentityProvider {
+	return newGoogleProvider(googleJWKSURL, clientID)
+}
+
+func newGoogleProvider(jwksURL, clientID string) *GoogleProvider {
+	return &GoogleProvider{
+		verifier: NewJWKSProvider(JWKSConfig{
+			JWKSURL:  jwksURL,
+			Issuers:  googleIssuers,
+			Audience: clientID,
+		}),
+	}
+}
+
+func (p *GoogleProvider) Verify(ctx context.Context, idToken string) (*identity.ProviderIdentity, error) {
+	return p.verifier.Verify(ctx, idToken)
+}
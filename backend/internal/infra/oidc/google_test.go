@@ -0,0 +1,59 @@
+package oidc
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"testing"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+)
+
+func googleClaims(issuer, hd string) *idTokenClaims {
+	c := validClaims()
+	c.Issuer = issuer
+	c.HD = hd
+	return c
+}
+
+func TestGoogleProvider(t *testing.T) {
+	key, _ := rsa.GenerateKey(rand.Reader, 2048)
+	server := stubJWKS(t, "google-key", key)
+
+	tests := []struct {
+		name        string
+		claims      *idTokenClaims
+		expectError bool
+	}{
+		{name: "acepta token emitido por Google", claims: googleClaims("https://accounts.google.com", "unal.edu.co")},
+		{name: "acepta el emisor sin esquema", claims: googleClaims("accounts.google.com", "unal.edu.co")},
+		{name: "rechaza emisor distinto a Google", claims: googleClaims(testIssuer, "unal.edu.co"), expectError: true},
+		{
+			name: "rechaza token emitido para otro client ID",
+			claims: func() *idTokenClaims {
+				c := googleClaims("https://accounts.google.com", "unal.edu.co")
+				c.Audience = jwt.ClaimStrings{"otro-client-id"}
+				return c
+			}(),
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			provider := newGoogleProvider(server.URL, testAudience)
+
+			result, err := provider.Verify(context.Background(), signRS256(t, key, "google-key", tt.claims))
+
+			if tt.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, "user-123", result.Subject)
+				assert.True(t, result.EmailVerified)
+				assert.Equal(t, "unal.edu.co", result.HostedDomain)
+			}
+		})
+	}
+}
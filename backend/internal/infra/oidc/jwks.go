@@ -0,0 +1,229 @@
+package oidc
+
+import (
+	"context"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+
+	"softpharos/internal/core/domain/identity"
+	"softpharos/internal/core/ports/providers"
+)
+
+const (
+	// defaultKeysTTL se usa cuando el JWKS no indica Cache-Control max-age
+	defaultKeysTTL = time.Hour
+	// minRefreshInterval limita las descargas provocadas por kids desconocidos
+	minRefreshInterval = time.Minute
+)
+
+// JWKSConfig configura un proveedor OIDC que valida ID tokens RS256 localmente
+type JWKSConfig struct {
+	JWKSURL  string
+	Issuers  []string
+	Audience string
+	// HTTPClient es opcional; por defecto se usa un cliente con timeout
+	HTTPClient *http.Client
+}
+
+// ParseIssuers separa una lista de emisores por coma, sin espacios ni
+// entradas vacías
+func ParseIssuers(raw string) []string {
+	var issuers []string
+	for _, issuer := range strings.Split(raw, ",") {
+		if issuer = strings.TrimSpace(issuer); issuer != "" {
+			issuers = append(issuers, issuer)
+		}
+	}
+	return issuers
+}
+
+// Validate rechaza una configuración incompleta, que aceptaría tokens de
+// cualquier emisor o audiencia
+func (c JWKSConfig) Validate() error {
+	switch {
+	case c.JWKSURL == "":
+		return errors.New("OIDC_JWKS_URL no está configurado")
+	case len(c.Issuers) == 0 || slices.Contains(c.Issuers, ""):
+		return errors.New("OIDC_ISSUER no está configurado")
+	case c.Audience == "":
+		return errors.New("OIDC_AUDIENCE no está configurado")
+	}
+	return nil
+}
+
+// JWKSProvider verifica la firma de los ID tokens con las llaves públicas
+// publicadas por el proveedor en su endpoint JWKS
+type JWKSProvider struct {
+	config     JWKSConfig
+	httpClient *http.Client
+
+	mu        sync.RWMutex
+	keys      map[string]*rsa.PublicKey
+	fetchedAt time.Time
+	expiresAt time.Time
+}
+
+func NewJWKSProvider(config JWKSConfig) providers.IdentityProvider {
+	client := config.HTTPClient
+	if client == nil {
+		client = &http.Client{Timeout: 10 * time.Second}
+	}
+
+	return &JWKSProvider{
+		config:     config,
+		httpClient: client,
+	}
+}
+
+func (p *JWKSProvider) Verify(ctx context.Context, idToken string) (*identity.ProviderIdentity, error) {
+	claims := &idTokenClaims{}
+	_, err := jwt.ParseWithClaims(idToken, claims, func(token *jwt.Token) (interface{}, error) {
+		kid, _ := token.Header["kid"].(string)
+		return p.key(ctx, kid)
+	},
+		jwt.WithValidMethods([]string{jwt.SigningMethodRS256.Alg()}),
+		jwt.WithAudience(p.config.Audience),
+		jwt.WithExpirationRequired(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("token inválido: %w", err)
+	}
+
+	if claims.Issuer == "" || !slices.Contains(p.config.Issuers, claims.Issuer) {
+		return nil, fmt.Errorf("token inválido: emisor %q no permitido", claims.Issuer)
+	}
+
+	return claims.toIdentity(), nil
+}
+
+// key retorna la llave pública con el kid indicado. Si la caché expiró se
+// descarga el JWKS de nuevo; un kid desconocido también fuerza la descarga
+// (rotación de llaves), como máximo una vez por minRefreshInterval.
+func (p *JWKSProvider) key(ctx context.Context, kid string) (*rsa.PublicKey, error) {
+	now := time.Now()
+
+	p.mu.RLock()
+	key, ok := p.keys[kid]
+	fresh := now.Before(p.expiresAt)
+	recent := now.Sub(p.fetchedAt) < minRefreshInterval
+	p.mu.RUnlock()
+
+	if ok && fresh {
+		return key, nil
+	}
+	if !ok && fresh && recent {
+		return nil, fmt.Errorf("llave %q no encontrada en el JWKS", kid)
+	}
+
+	if err := p.refresh(ctx); err != nil {
+		return nil, err
+	}
+
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	if key, ok := p.keys[kid]; ok {
+		return key, nil
+	}
+	return nil, fmt.Errorf("llave %q no encontrada en el JWKS", kid)
+}
+
+type jsonWebKey struct {
+	Kid string `json:"kid"`
+	Kty string `json:"kty"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+}
+
+func (p *JWKSProvider) refresh(ctx context.Context) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.config.JWKSURL, nil)
+	if err != nil {
+		return fmt.Errorf("error creando request: %w", err)
+	}
+
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("error descargando el JWKS: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("error descargando el JWKS: status %d", resp.StatusCode)
+	}
+
+	var body struct {
+		Keys []jsonWebKey `json:"keys"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return fmt.Errorf("error decodificando el JWKS: %w", err)
+	}
+
+	keys := make(map[string]*rsa.PublicKey, len(body.Keys))
+	for _, jwk := range body.Keys {
+		if jwk.Kty != "RSA" {
+			continue
+		}
+		key, err := parseRSAKey(jwk)
+		if err != nil {
+			return err
+		}
+		keys[jwk.Kid] = key
+	}
+
+	now := time.Now()
+	p.mu.Lock()
+	p.keys = keys
+	p.fetchedAt = now
+	p.expiresAt = now.Add(cacheMaxAge(resp.Header.Get("Cache-Control")))
+	p.mu.Unlock()
+
+	return nil
+}
+
+// cacheMaxAge extrae max-age del header Cache-Control del JWKS
+func cacheMaxAge(header string) time.Duration {
+	for _, directive := range strings.Split(header, ",") {
+		value, found := strings.CutPrefix(strings.TrimSpace(directive), "max-age=")
+		if !found {
+			continue
+		}
+		seconds, err := strconv.Atoi(value)
+		if err != nil || seconds < 0 {
+			break
+		}
+		return time.Duration(seconds) * time.Second
+	}
+	return defaultKeysTTL
+}
+
+func parseRSAKey(jwk jsonWebKey) (*rsa.PublicKey, error) {
+	n, err := base64.RawURLEncoding.DecodeString(jwk.N)
+	if err != nil {
+		return nil, fmt.Errorf("llave %q inválida: %w", jwk.Kid, err)
+	}
+	e, err := base64.RawURLEncoding.DecodeString(jwk.E)
+	if err != nil {
+		return nil, fmt.Errorf("llave %q inválida: %w", jwk.Kid, err)
+	}
+
+	exponent := new(big.Int).SetBytes(e)
+	if !exponent.IsInt64() || exponent.Int64() > int64(^uint32(0)>>1) {
+		return nil, errors.New("exponente RSA inválido")
+	}
+
+	return &rsa.PublicKey{
+		N: new(big.Int).SetBytes(n),
+		E: int(exponent.Int64()),
+	}, nil
+}
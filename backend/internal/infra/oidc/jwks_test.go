@@ -0,0 +1,301 @@
+package oidc
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	testIssuer   = "https://issuer.example.com"
+	testAudience = "softpharos-client"
+)
+
+// jwksServer es un endpoint JWKS de prueba que cuenta las descargas
+type jwksServer struct {
+	*httptest.Server
+	keys         map[string]*rsa.PrivateKey
+	cacheControl string
+	fetches      int
+}
+
+func stubJWKS(t *testing.T, kid string, key *rsa.PrivateKey) *jwksServer {
+	t.Helper()
+
+	s := &jwksServer{keys: map[string]*rsa.PrivateKey{kid: key}}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.fetches++
+
+		jwks := []map[string]string{}
+		for kid, key := range s.keys {
+			jwks = append(jwks, map[string]string{
+				"kid": kid,
+				"kty": "RSA",
+				"alg": "RS256",
+				"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+				"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+			})
+		}
+
+		if s.cacheControl != "" {
+			w.Header().Set("Cache-Control", s.cacheControl)
+		}
+		json.NewEncoder(w).Encode(map[string]any{"keys": jwks})
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+func signRS256(t *testing.T, key *rsa.PrivateKey, kid string, claims *idTokenClaims) string {
+	t.Helper()
+
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token.Header["kid"] = kid
+	signed, err := token.SignedString(key)
+	if err != nil {
+		t.Fatalf("error firmando token: %v", err)
+	}
+	return signed
+}
+
+func validClaims() *idTokenClaims {
+	return &idTokenClaims{
+		Email:         "test@unal.edu.co",
+		EmailVerified: true,
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   "user-123",
+			Issuer:    testIssuer,
+			Audience:  jwt.ClaimStrings{testAudience},
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+		},
+	}
+}
+
+func TestJWKSProvider(t *testing.T) {
+	key, _ := rsa.GenerateKey(rand.Reader, 2048)
+	otherKey, _ := rsa.GenerateKey(rand.Reader, 2048)
+
+	server := stubJWKS(t, "key-1", key)
+
+	tests := []struct {
+		name        string
+		token       func() string
+		expectError bool
+	}{
+		{
+			name:  "acepta token firmado con una llave del JWKS",
+			token: func() string { return signRS256(t, key, "key-1", validClaims()) },
+		},
+		{
+			name:        "rechaza token firmado con otra llave",
+			token:       func() string { return signRS256(t, otherKey, "key-1", validClaims()) },
+			expectError: true,
+		},
+		{
+			name:        "rechaza token con kid desconocido",
+			token:       func() string { return signRS256(t, key, "key-2", validClaims()) },
+			expectError: true,
+		},
+		{
+			name: "rechaza token para otra audiencia",
+			token: func() string {
+				c := validClaims()
+				c.Audience = jwt.ClaimStrings{"otra-app"}
+				return signRS256(t, key, "key-1", c)
+			},
+			expectError: true,
+		},
+		{
+			name: "rechaza token de otro emisor",
+			token: func() string {
+				c := validClaims()
+				c.Issuer = "https://evil.example.com"
+				return signRS256(t, key, "key-1", c)
+			},
+			expectError: true,
+		},
+		{
+			name: "rechaza token sin emisor",
+			token: func() string {
+				c := validClaims()
+				c.Issuer = ""
+				return signRS256(t, key, "key-1", c)
+			},
+			expectError: true,
+		},
+		{
+			name: "rechaza token expirado",
+			token: func() string {
+				c := validClaims()
+				c.ExpiresAt = jwt.NewNumericDate(time.Now().Add(-time.Minute))
+				return signRS256(t, key, "key-1", c)
+			},
+			expectError: true,
+		},
+		{
+			name: "rechaza token HS256",
+			token: func() string {
+				signed, _ := jwt.NewWithClaims(jwt.SigningMethodHS256, validClaims()).SignedString([]byte("secret"))
+				return signed
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			provider := NewJWKSProvider(JWKSConfig{JWKSURL: server.URL, Issuers: []string{testIssuer}, Audience: testAudience})
+
+			result, err := provider.Verify(context.Background(), tt.token())
+
+			if tt.expectError {
+				assert.Error(t, err)
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, "user-123", result.Subject)
+				assert.Equal(t, "test@unal.edu.co", result.Email)
+			}
+		})
+	}
+}
+
+func TestJWKSProvider_KeyCache(t *testing.T) {
+	key, _ := rsa.GenerateKey(rand.Reader, 2048)
+	rotatedKey, _ := rsa.GenerateKey(rand.Reader, 2048)
+
+	t.Run("reutiliza las llaves mientras no expire max-age", func(t *testing.T) {
+		server := stubJWKS(t, "key-1", key)
+		server.cacheControl = "public, max-age=3600, must-revalidate"
+		provider := NewJWKSProvider(JWKSConfig{JWKSURL: server.URL, Issuers: []string{testIssuer}, Audience: testAudience}).(*JWKSProvider)
+
+		for i := 0; i < 3; i++ {
+			_, err := provider.Verify(context.Background(), signRS256(t, key, "key-1", validClaims()))
+			assert.NoError(t, err)
+		}
+
+		assert.Equal(t, 1, server.fetches)
+		assert.WithinDuration(t, time.Now().Add(time.Hour), provider.expiresAt, time.Minute)
+	})
+
+	t.Run("descarga de nuevo cuando la caché expira", func(t *testing.T) {
+		server := stubJWKS(t, "key-1", key)
+		server.cacheControl = "max-age=0"
+		provider := NewJWKSProvider(JWKSConfig{JWKSURL: server.URL, Issuers: []string{testIssuer}, Audience: testAudience})
+
+		provider.Verify(context.Background(), signRS256(t, key, "key-1", validClaims()))
+		provider.Verify(context.Background(), signRS256(t, key, "key-1", validClaims()))
+
+		assert.Equal(t, 2, server.fetches)
+	})
+
+	t.Run("descarga de nuevo ante un kid nuevo tras la rotación", func(t *testing.T) {
+		server := stubJWKS(t, "key-1", key)
+		server.cacheControl = "max-age=3600"
+		provider := NewJWKSProvider(JWKSConfig{JWKSURL: server.URL, Issuers: []string{testIssuer}, Audience: testAudience}).(*JWKSProvider)
+
+		_, err := provider.Verify(context.Background(), signRS256(t, key, "key-1", validClaims()))
+		assert.NoError(t, err)
+
+		server.keys["key-2"] = rotatedKey
+		provider.fetchedAt = time.Now().Add(-2 * minRefreshInterval)
+
+		_, err = provider.Verify(context.Background(), signRS256(t, rotatedKey, "key-2", validClaims()))
+		assert.NoError(t, err)
+		assert.Equal(t, 2, server.fetches)
+	})
+
+	t.Run("no descarga en cada kid desconocido", func(t *testing.T) {
+		server := stubJWKS(t, "key-1", key)
+		server.cacheControl = "max-age=3600"
+		provider := NewJWKSProvider(JWKSConfig{JWKSURL: server.URL, Issuers: []string{testIssuer}, Audience: testAudience})
+
+		provider.Verify(context.Background(), signRS256(t, key, "key-1", validClaims()))
+		for i := 0; i < 3; i++ {
+			_, err := provider.Verify(context.Background(), signRS256(t, key, "desconocido", validClaims()))
+			assert.Error(t, err)
+		}
+
+		assert.Equal(t, 1, server.fetches)
+	})
+}
+
+func TestCacheMaxAge(t *testing.T) {
+	tests := []struct {
+		header   string
+		expected time.Duration
+	}{
+		{"public, max-age=19800, must-revalidate, no-transform", 19800 * time.Second},
+		{"max-age=60", time.Minute},
+		{"no-cache", defaultKeysTTL},
+		{"max-age=abc", defaultKeysTTL},
+		{"", defaultKeysTTL},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.header, func(t *testing.T) {
+			assert.Equal(t, tt.expected, cacheMaxAge(tt.header))
+		})
+	}
+}
+
+func TestParseIssuers(t *testing.T) {
+	assert.Equal(t, []string{"https://a.example.com", "https://b.example.com"}, ParseIssuers(" https://a.example.com, ,https://b.example.com "))
+	assert.Empty(t, ParseIssuers(""))
+}
+
+func TestJWKSConfigValidate(t *testing.T) {
+	valid := func() JWKSConfig {
+		return JWKSConfig{JWKSURL: "https://proveedor/jwks", Issuers: []string{testIssuer}, Audience: testAudience}
+	}
+
+	tests := []struct {
+		name        string
+		config      func() JWKSConfig
+		expectError bool
+	}{
+		{name: "acepta una configuración completa", config: valid},
+		{
+			name:        "rechaza sin OIDC_JWKS_URL",
+			config:      func() JWKSConfig { c := valid(); c.JWKSURL = ""; return c },
+			expectError: true,
+		},
+		{
+			name:        "rechaza sin emisores",
+			config:      func() JWKSConfig { c := valid(); c.Issuers = ParseIssuers(""); return c },
+			expectError: true,
+		},
+		{
+			name:        "rechaza un emisor vacío",
+			config:      func() JWKSConfig { c := valid(); c.Issuers = []string{""}; return c },
+			expectError: true,
+		},
+		{
+			name:        "rechaza sin OIDC_AUDIENCE",
+			config:      func() JWKSConfig { c := valid(); c.Audience = ""; return c },
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.config().Validate()
+
+			if tt.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
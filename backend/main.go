@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"log"
 	"os"
 
@@ -8,7 +9,10 @@ import (
 	"github.com/joho/godotenv"
 
 	"softpharos/cmd/app"
+	"softpharos/cmd/buildingAPI"
+	"softpharos/cmd/migrate"
 	"softpharos/internal/infra/databases"
+	"softpharos/internal/infra/databases/migrations"
 )
 
 func main() {
@@ -30,6 +34,12 @@ func main() {
 		log.Fatalf("❌ Error al hacer ping a la base de datos: %v", err)
 	}
 
+	// Subcomando "migrate": gestiona el esquema y termina sin levantar el servidor
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		runMigrate(dbClient, os.Args[2:])
+		return
+	}
+
 	// Configurar modo de Gin
 	if os.Getenv("ENV") == "production" {
 		gin.SetMode(gin.ReleaseMode)
@@ -56,6 +66,10 @@ func main() {
 	// Mapear rutas (cada dominio registra sus propias rutas)
 	app.MapUrls(router)
 
+	// Eliminar definitivamente lo que superó la retención de la papelera y
+	// los tokens que ya expiraron
+	buildingAPI.StartPurgeJob(context.Background())
+
 	// Obtener puerto
 	port := os.Getenv("PORT")
 	if port == "" {
@@ -67,3 +81,14 @@ func main() {
 		log.Fatalf("❌ Error al iniciar el servidor: %v", err)
 	}
 }
+
+func runMigrate(dbClient *databases.Client, args []string) {
+	migrator, err := migrations.New(dbClient)
+	if err != nil {
+		log.Fatalf("❌ Error al cargar las migraciones: %v", err)
+	}
+
+	if err := migrate.Run(context.Background(), migrator, args, os.Stdout); err != nil {
+		log.Fatalf("❌ %v", err)
+	}
+}
@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/core/ports/providers/identity_provider.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/core/ports/providers/identity_provider.go -destination=mocks/core/ports/providers/identity_provider_mock.go -package=providers
+//
+
+// Package providers is a generated GoMock package.
+package providers
+
+import (
+	context "context"
+	reflect "reflect"
+	identity "softpharos/internal/core/domain/identity"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIdentityProvider is a mock of IdentityProvider interface.
+type MockIdentityProvider struct {
+	ctrl     *gomock.Controller
+	recorder *MockIdentityProviderMockRecorder
+	isgomock struct{}
+}
+
+// MockIdentityProviderMockRecorder is the mock recorder for MockIdentityProvider.
+type MockIdentityProviderMockRecorder struct {
+	mock *MockIdentityProvider
+}
+
+// NewMockIdentityProvider creates a new mock instance.
+func NewMockIdentityProvider(ctrl *gomock.Controller) *MockIdentityProvider {
+	mock := &MockIdentityProvider{ctrl: ctrl}
+	mock.recorder = &MockIdentityProviderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIdentityProvider) EXPECT() *MockIdentityProviderMockRecorder {
+	return m.recorder
+}
+
+// Verify mocks base method.
+func (m *MockIdentityProvider) Verify(ctx context.Context, idToken string) (*identity.ProviderIdentity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Verify", ctx, idToken)
+	ret0, _ := ret[0].(*identity.ProviderIdentity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Verify indicates an expected call of Verify.
+func (mr *MockIdentityProviderMockRecorder) Verify(ctx, idToken any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Verify", reflect.TypeOf((*MockIdentityProvider)(nil).Verify), ctx, idToken)
+}
@@ -13,6 +13,7 @@ import (
 	context "context"
 	reflect "reflect"
 	comment "softpharos/internal/core/domain/comment"
+	query "softpharos/internal/core/domain/query"
 
 	gomock "go.uber.org/mock/gomock"
 )
@@ -70,18 +71,18 @@ func (mr *MockCommentRepositoryMockRecorder) Delete(ctx, id any) *gomock.Call {
 }
 
 // GetAll mocks base method.
-func (m *MockCommentRepository) GetAll(ctx context.Context) ([]comment.Comment, error) {
+func (m *MockCommentRepository) GetAll(ctx context.Context, params query.Params) (*query.Page[comment.Comment], error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAll", ctx)
-	ret0, _ := ret[0].([]comment.Comment)
+	ret := m.ctrl.Call(m, "GetAll", ctx, params)
+	ret0, _ := ret[0].(*query.Page[comment.Comment])
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetAll indicates an expected call of GetAll.
-func (mr *MockCommentRepositoryMockRecorder) GetAll(ctx any) *gomock.Call {
+func (mr *MockCommentRepositoryMockRecorder) GetAll(ctx, params any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockCommentRepository)(nil).GetAll), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockCommentRepository)(nil).GetAll), ctx, params)
 }
 
 // GetByID mocks base method.
@@ -114,6 +115,50 @@ func (mr *MockCommentRepositoryMockRecorder) GetByMilestoneID(ctx, milestoneID a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByMilestoneID", reflect.TypeOf((*MockCommentRepository)(nil).GetByMilestoneID), ctx, milestoneID)
 }
 
+// GetTrashedByID mocks base method.
+func (m *MockCommentRepository) GetTrashedByID(ctx context.Context, id int) (*comment.Comment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTrashedByID", ctx, id)
+	ret0, _ := ret[0].(*comment.Comment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTrashedByID indicates an expected call of GetTrashedByID.
+func (mr *MockCommentRepositoryMockRecorder) GetTrashedByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTrashedByID", reflect.TypeOf((*MockCommentRepository)(nil).GetTrashedByID), ctx, id)
+}
+
+// GetTrashedByMilestoneID mocks base method.
+func (m *MockCommentRepository) GetTrashedByMilestoneID(ctx context.Context, milestoneID int) ([]comment.Comment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTrashedByMilestoneID", ctx, milestoneID)
+	ret0, _ := ret[0].([]comment.Comment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTrashedByMilestoneID indicates an expected call of GetTrashedByMilestoneID.
+func (mr *MockCommentRepositoryMockRecorder) GetTrashedByMilestoneID(ctx, milestoneID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTrashedByMilestoneID", reflect.TypeOf((*MockCommentRepository)(nil).GetTrashedByMilestoneID), ctx, milestoneID)
+}
+
+// Restore mocks base method.
+func (m *MockCommentRepository) Restore(ctx context.Context, id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Restore indicates an expected call of Restore.
+func (mr *MockCommentRepositoryMockRecorder) Restore(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockCommentRepository)(nil).Restore), ctx, id)
+}
+
 // Update mocks base method.
 func (m *MockCommentRepository) Update(ctx context.Context, arg1 *comment.Comment) error {
 	m.ctrl.T.Helper()
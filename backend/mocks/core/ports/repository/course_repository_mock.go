@@ -0,0 +1,255 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/core/ports/repository/course_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/core/ports/repository/course_repository.go -destination=mocks/core/ports/repository/course_repository_mock.go -package=repository
+//
+
+// Package repository is a generated GoMock package.
+package repository
+
+import (
+	context "context"
+	reflect "reflect"
+	course "softpharos/internal/core/domain/course"
+	query "softpharos/internal/core/domain/query"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockCourseRepository is a mock of CourseRepository interface.
+type MockCourseRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockCourseRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockCourseRepositoryMockRecorder is the mock recorder for MockCourseRepository.
+type MockCourseRepositoryMockRecorder struct {
+	mock *MockCourseRepository
+}
+
+// NewMockCourseRepository creates a new mock instance.
+func NewMockCourseRepository(ctrl *gomock.Controller) *MockCourseRepository {
+	mock := &MockCourseRepository{ctrl: ctrl}
+	mock.recorder = &MockCourseRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCourseRepository) EXPECT() *MockCourseRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockCourseRepository) Create(ctx context.Context, arg1 *course.Course) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockCourseRepositoryMockRecorder) Create(ctx, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockCourseRepository)(nil).Create), ctx, arg1)
+}
+
+// CreateSection mocks base method.
+func (m *MockCourseRepository) CreateSection(ctx context.Context, section *course.Section) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSection", ctx, section)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateSection indicates an expected call of CreateSection.
+func (mr *MockCourseRepositoryMockRecorder) CreateSection(ctx, section any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSection", reflect.TypeOf((*MockCourseRepository)(nil).CreateSection), ctx, section)
+}
+
+// Delete mocks base method.
+func (m *MockCourseRepository) Delete(ctx context.Context, id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockCourseRepositoryMockRecorder) Delete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockCourseRepository)(nil).Delete), ctx, id)
+}
+
+// DeleteSection mocks base method.
+func (m *MockCourseRepository) DeleteSection(ctx context.Context, id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSection", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSection indicates an expected call of DeleteSection.
+func (mr *MockCourseRepositoryMockRecorder) DeleteSection(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSection", reflect.TypeOf((*MockCourseRepository)(nil).DeleteSection), ctx, id)
+}
+
+// GetAll mocks base method.
+func (m *MockCourseRepository) GetAll(ctx context.Context, params query.Params) (*query.Page[course.Course], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", ctx, params)
+	ret0, _ := ret[0].(*query.Page[course.Course])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockCourseRepositoryMockRecorder) GetAll(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockCourseRepository)(nil).GetAll), ctx, params)
+}
+
+// GetByID mocks base method.
+func (m *MockCourseRepository) GetByID(ctx context.Context, id int) (*course.Course, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*course.Course)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockCourseRepositoryMockRecorder) GetByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockCourseRepository)(nil).GetByID), ctx, id)
+}
+
+// GetSectionByID mocks base method.
+func (m *MockCourseRepository) GetSectionByID(ctx context.Context, id int) (*course.Section, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSectionByID", ctx, id)
+	ret0, _ := ret[0].(*course.Section)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSectionByID indicates an expected call of GetSectionByID.
+func (mr *MockCourseRepositoryMockRecorder) GetSectionByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSectionByID", reflect.TypeOf((*MockCourseRepository)(nil).GetSectionByID), ctx, id)
+}
+
+// GetSections mocks base method.
+func (m *MockCourseRepository) GetSections(ctx context.Context, courseID int, termID *int) ([]course.Section, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSections", ctx, courseID, termID)
+	ret0, _ := ret[0].([]course.Section)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSections indicates an expected call of GetSections.
+func (mr *MockCourseRepositoryMockRecorder) GetSections(ctx, courseID, termID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSections", reflect.TypeOf((*MockCourseRepository)(nil).GetSections), ctx, courseID, termID)
+}
+
+// Update mocks base method.
+func (m *MockCourseRepository) Update(ctx context.Context, arg1 *course.Course) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockCourseRepositoryMockRecorder) Update(ctx, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockCourseRepository)(nil).Update), ctx, arg1)
+}
+
+// MockCourseMemberRepository is a mock of CourseMemberRepository interface.
+type MockCourseMemberRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockCourseMemberRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockCourseMemberRepositoryMockRecorder is the mock recorder for MockCourseMemberRepository.
+type MockCourseMemberRepositoryMockRecorder struct {
+	mock *MockCourseMemberRepository
+}
+
+// NewMockCourseMemberRepository creates a new mock instance.
+func NewMockCourseMemberRepository(ctrl *gomock.Controller) *MockCourseMemberRepository {
+	mock := &MockCourseMemberRepository{ctrl: ctrl}
+	mock.recorder = &MockCourseMemberRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCourseMemberRepository) EXPECT() *MockCourseMemberRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockCourseMemberRepository) Create(ctx context.Context, member *course.Member) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, member)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockCourseMemberRepositoryMockRecorder) Create(ctx, member any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockCourseMemberRepository)(nil).Create), ctx, member)
+}
+
+// Delete mocks base method.
+func (m *MockCourseMemberRepository) Delete(ctx context.Context, sectionID, userID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, sectionID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockCourseMemberRepositoryMockRecorder) Delete(ctx, sectionID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockCourseMemberRepository)(nil).Delete), ctx, sectionID, userID)
+}
+
+// GetBySectionAndUser mocks base method.
+func (m *MockCourseMemberRepository) GetBySectionAndUser(ctx context.Context, sectionID, userID int) (*course.Member, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBySectionAndUser", ctx, sectionID, userID)
+	ret0, _ := ret[0].(*course.Member)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBySectionAndUser indicates an expected call of GetBySectionAndUser.
+func (mr *MockCourseMemberRepositoryMockRecorder) GetBySectionAndUser(ctx, sectionID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBySectionAndUser", reflect.TypeOf((*MockCourseMemberRepository)(nil).GetBySectionAndUser), ctx, sectionID, userID)
+}
+
+// GetBySectionID mocks base method.
+func (m *MockCourseMemberRepository) GetBySectionID(ctx context.Context, sectionID int) ([]course.Member, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBySectionID", ctx, sectionID)
+	ret0, _ := ret[0].([]course.Member)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBySectionID indicates an expected call of GetBySectionID.
+func (mr *MockCourseMemberRepositoryMockRecorder) GetBySectionID(ctx, sectionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBySectionID", reflect.TypeOf((*MockCourseMemberRepository)(nil).GetBySectionID), ctx, sectionID)
+}
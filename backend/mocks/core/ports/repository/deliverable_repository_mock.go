@@ -13,6 +13,7 @@ import (
 	context "context"
 	reflect "reflect"
 	deliverable "softpharos/internal/core/domain/deliverable"
+	query "softpharos/internal/core/domain/query"
 
 	gomock "go.uber.org/mock/gomock"
 )
@@ -70,18 +71,18 @@ func (mr *MockDeliverableRepositoryMockRecorder) Delete(ctx, id any) *gomock.Cal
 }
 
 // GetAll mocks base method.
-func (m *MockDeliverableRepository) GetAll(ctx context.Context) ([]deliverable.Deliverable, error) {
+func (m *MockDeliverableRepository) GetAll(ctx context.Context, params query.Params) (*query.Page[deliverable.Deliverable], error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAll", ctx)
-	ret0, _ := ret[0].([]deliverable.Deliverable)
+	ret := m.ctrl.Call(m, "GetAll", ctx, params)
+	ret0, _ := ret[0].(*query.Page[deliverable.Deliverable])
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetAll indicates an expected call of GetAll.
-func (mr *MockDeliverableRepositoryMockRecorder) GetAll(ctx any) *gomock.Call {
+func (mr *MockDeliverableRepositoryMockRecorder) GetAll(ctx, params any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockDeliverableRepository)(nil).GetAll), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockDeliverableRepository)(nil).GetAll), ctx, params)
 }
 
 // GetByID mocks base method.
@@ -114,6 +115,50 @@ func (mr *MockDeliverableRepositoryMockRecorder) GetByMilestoneID(ctx, milestone
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByMilestoneID", reflect.TypeOf((*MockDeliverableRepository)(nil).GetByMilestoneID), ctx, milestoneID)
 }
 
+// GetTrashedByID mocks base method.
+func (m *MockDeliverableRepository) GetTrashedByID(ctx context.Context, id int) (*deliverable.Deliverable, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTrashedByID", ctx, id)
+	ret0, _ := ret[0].(*deliverable.Deliverable)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTrashedByID indicates an expected call of GetTrashedByID.
+func (mr *MockDeliverableRepositoryMockRecorder) GetTrashedByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTrashedByID", reflect.TypeOf((*MockDeliverableRepository)(nil).GetTrashedByID), ctx, id)
+}
+
+// GetTrashedByMilestoneID mocks base method.
+func (m *MockDeliverableRepository) GetTrashedByMilestoneID(ctx context.Context, milestoneID int) ([]deliverable.Deliverable, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTrashedByMilestoneID", ctx, milestoneID)
+	ret0, _ := ret[0].([]deliverable.Deliverable)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTrashedByMilestoneID indicates an expected call of GetTrashedByMilestoneID.
+func (mr *MockDeliverableRepositoryMockRecorder) GetTrashedByMilestoneID(ctx, milestoneID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTrashedByMilestoneID", reflect.TypeOf((*MockDeliverableRepository)(nil).GetTrashedByMilestoneID), ctx, milestoneID)
+}
+
+// Restore mocks base method.
+func (m *MockDeliverableRepository) Restore(ctx context.Context, id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Restore indicates an expected call of Restore.
+func (mr *MockDeliverableRepositoryMockRecorder) Restore(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockDeliverableRepository)(nil).Restore), ctx, id)
+}
+
 // Update mocks base method.
 func (m *MockDeliverableRepository) Update(ctx context.Context, arg1 *deliverable.Deliverable) error {
 	m.ctrl.T.Helper()
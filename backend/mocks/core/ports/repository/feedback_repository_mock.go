@@ -13,6 +13,7 @@ import (
 	context "context"
 	reflect "reflect"
 	feedback "softpharos/internal/core/domain/feedback"
+	query "softpharos/internal/core/domain/query"
 
 	gomock "go.uber.org/mock/gomock"
 )
@@ -70,18 +71,18 @@ func (mr *MockFeedbackRepositoryMockRecorder) Delete(ctx, id any) *gomock.Call {
 }
 
 // GetAll mocks base method.
-func (m *MockFeedbackRepository) GetAll(ctx context.Context) ([]feedback.Feedback, error) {
+func (m *MockFeedbackRepository) GetAll(ctx context.Context, params query.Params) (*query.Page[feedback.Feedback], error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAll", ctx)
-	ret0, _ := ret[0].([]feedback.Feedback)
+	ret := m.ctrl.Call(m, "GetAll", ctx, params)
+	ret0, _ := ret[0].(*query.Page[feedback.Feedback])
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetAll indicates an expected call of GetAll.
-func (mr *MockFeedbackRepositoryMockRecorder) GetAll(ctx any) *gomock.Call {
+func (mr *MockFeedbackRepositoryMockRecorder) GetAll(ctx, params any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockFeedbackRepository)(nil).GetAll), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockFeedbackRepository)(nil).GetAll), ctx, params)
 }
 
 // GetByID mocks base method.
@@ -114,6 +115,50 @@ func (mr *MockFeedbackRepositoryMockRecorder) GetByMilestoneID(ctx, milestoneID
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByMilestoneID", reflect.TypeOf((*MockFeedbackRepository)(nil).GetByMilestoneID), ctx, milestoneID)
 }
 
+// GetTrashedByID mocks base method.
+func (m *MockFeedbackRepository) GetTrashedByID(ctx context.Context, id int) (*feedback.Feedback, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTrashedByID", ctx, id)
+	ret0, _ := ret[0].(*feedback.Feedback)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTrashedByID indicates an expected call of GetTrashedByID.
+func (mr *MockFeedbackRepositoryMockRecorder) GetTrashedByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTrashedByID", reflect.TypeOf((*MockFeedbackRepository)(nil).GetTrashedByID), ctx, id)
+}
+
+// GetTrashedByMilestoneID mocks base method.
+func (m *MockFeedbackRepository) GetTrashedByMilestoneID(ctx context.Context, milestoneID int) ([]feedback.Feedback, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTrashedByMilestoneID", ctx, milestoneID)
+	ret0, _ := ret[0].([]feedback.Feedback)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTrashedByMilestoneID indicates an expected call of GetTrashedByMilestoneID.
+func (mr *MockFeedbackRepositoryMockRecorder) GetTrashedByMilestoneID(ctx, milestoneID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTrashedByMilestoneID", reflect.TypeOf((*MockFeedbackRepository)(nil).GetTrashedByMilestoneID), ctx, milestoneID)
+}
+
+// Restore mocks base method.
+func (m *MockFeedbackRepository) Restore(ctx context.Context, id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Restore indicates an expected call of Restore.
+func (mr *MockFeedbackRepositoryMockRecorder) Restore(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockFeedbackRepository)(nil).Restore), ctx, id)
+}
+
 // Update mocks base method.
 func (m *MockFeedbackRepository) Update(ctx context.Context, arg1 *feedback.Feedback) error {
 	m.ctrl.T.Helper()
@@ -13,6 +13,9 @@ import (
 	context "context"
 	reflect "reflect"
 	milestone "softpharos/internal/core/domain/milestone"
+	milestone_detail "softpharos/internal/core/domain/milestone_detail"
+	query "softpharos/internal/core/domain/query"
+	time "time"
 
 	gomock "go.uber.org/mock/gomock"
 )
@@ -70,18 +73,18 @@ func (mr *MockMilestoneRepositoryMockRecorder) Delete(ctx, id any) *gomock.Call
 }
 
 // GetAll mocks base method.
-func (m *MockMilestoneRepository) GetAll(ctx context.Context) ([]milestone.Milestone, error) {
+func (m *MockMilestoneRepository) GetAll(ctx context.Context, params query.Params) (*query.Page[milestone.Milestone], error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAll", ctx)
-	ret0, _ := ret[0].([]milestone.Milestone)
+	ret := m.ctrl.Call(m, "GetAll", ctx, params)
+	ret0, _ := ret[0].(*query.Page[milestone.Milestone])
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetAll indicates an expected call of GetAll.
-func (mr *MockMilestoneRepositoryMockRecorder) GetAll(ctx any) *gomock.Call {
+func (mr *MockMilestoneRepositoryMockRecorder) GetAll(ctx, params any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockMilestoneRepository)(nil).GetAll), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockMilestoneRepository)(nil).GetAll), ctx, params)
 }
 
 // GetByID mocks base method.
@@ -114,6 +117,110 @@ func (mr *MockMilestoneRepositoryMockRecorder) GetByProjectID(ctx, projectID any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByProjectID", reflect.TypeOf((*MockMilestoneRepository)(nil).GetByProjectID), ctx, projectID)
 }
 
+// GetCounts mocks base method.
+func (m *MockMilestoneRepository) GetCounts(ctx context.Context, milestoneIDs []int) (map[int]*milestone.Counts, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCounts", ctx, milestoneIDs)
+	ret0, _ := ret[0].(map[int]*milestone.Counts)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCounts indicates an expected call of GetCounts.
+func (mr *MockMilestoneRepositoryMockRecorder) GetCounts(ctx, milestoneIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCounts", reflect.TypeOf((*MockMilestoneRepository)(nil).GetCounts), ctx, milestoneIDs)
+}
+
+// GetDetail mocks base method.
+func (m *MockMilestoneRepository) GetDetail(ctx context.Context, id int, includes []string) (*milestone_detail.Detail, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDetail", ctx, id, includes)
+	ret0, _ := ret[0].(*milestone_detail.Detail)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDetail indicates an expected call of GetDetail.
+func (mr *MockMilestoneRepositoryMockRecorder) GetDetail(ctx, id, includes any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDetail", reflect.TypeOf((*MockMilestoneRepository)(nil).GetDetail), ctx, id, includes)
+}
+
+// GetOverdueByCourse mocks base method.
+func (m *MockMilestoneRepository) GetOverdueByCourse(ctx context.Context, courseID int, today time.Time, params query.Params) (*query.Page[milestone.Milestone], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOverdueByCourse", ctx, courseID, today, params)
+	ret0, _ := ret[0].(*query.Page[milestone.Milestone])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOverdueByCourse indicates an expected call of GetOverdueByCourse.
+func (mr *MockMilestoneRepositoryMockRecorder) GetOverdueByCourse(ctx, courseID, today, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOverdueByCourse", reflect.TypeOf((*MockMilestoneRepository)(nil).GetOverdueByCourse), ctx, courseID, today, params)
+}
+
+// GetOverdueByProjectID mocks base method.
+func (m *MockMilestoneRepository) GetOverdueByProjectID(ctx context.Context, projectID int, today time.Time) ([]milestone.Milestone, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOverdueByProjectID", ctx, projectID, today)
+	ret0, _ := ret[0].([]milestone.Milestone)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOverdueByProjectID indicates an expected call of GetOverdueByProjectID.
+func (mr *MockMilestoneRepositoryMockRecorder) GetOverdueByProjectID(ctx, projectID, today any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOverdueByProjectID", reflect.TypeOf((*MockMilestoneRepository)(nil).GetOverdueByProjectID), ctx, projectID, today)
+}
+
+// GetTrashedByID mocks base method.
+func (m *MockMilestoneRepository) GetTrashedByID(ctx context.Context, id int) (*milestone.Milestone, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTrashedByID", ctx, id)
+	ret0, _ := ret[0].(*milestone.Milestone)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTrashedByID indicates an expected call of GetTrashedByID.
+func (mr *MockMilestoneRepositoryMockRecorder) GetTrashedByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTrashedByID", reflect.TypeOf((*MockMilestoneRepository)(nil).GetTrashedByID), ctx, id)
+}
+
+// GetTrashedByProjectID mocks base method.
+func (m *MockMilestoneRepository) GetTrashedByProjectID(ctx context.Context, projectID int) ([]milestone.Milestone, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTrashedByProjectID", ctx, projectID)
+	ret0, _ := ret[0].([]milestone.Milestone)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTrashedByProjectID indicates an expected call of GetTrashedByProjectID.
+func (mr *MockMilestoneRepositoryMockRecorder) GetTrashedByProjectID(ctx, projectID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTrashedByProjectID", reflect.TypeOf((*MockMilestoneRepository)(nil).GetTrashedByProjectID), ctx, projectID)
+}
+
+// Restore mocks base method.
+func (m *MockMilestoneRepository) Restore(ctx context.Context, id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Restore indicates an expected call of Restore.
+func (mr *MockMilestoneRepositoryMockRecorder) Restore(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockMilestoneRepository)(nil).Restore), ctx, id)
+}
+
 // Update mocks base method.
 func (m *MockMilestoneRepository) Update(ctx context.Context, arg1 *milestone.Milestone) error {
 	m.ctrl.T.Helper()
@@ -127,3 +234,18 @@ func (mr *MockMilestoneRepositoryMockRecorder) Update(ctx, arg1 any) *gomock.Cal
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockMilestoneRepository)(nil).Update), ctx, arg1)
 }
+
+// UpdateStatus mocks base method.
+func (m *MockMilestoneRepository) UpdateStatus(ctx context.Context, id int, from, to string, at time.Time) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateStatus", ctx, id, from, to, at)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateStatus indicates an expected call of UpdateStatus.
+func (mr *MockMilestoneRepositoryMockRecorder) UpdateStatus(ctx, id, from, to, at any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStatus", reflect.TypeOf((*MockMilestoneRepository)(nil).UpdateStatus), ctx, id, from, to, at)
+}
@@ -13,6 +13,7 @@ import (
 	context "context"
 	reflect "reflect"
 	project_member "softpharos/internal/core/domain/project_member"
+	query "softpharos/internal/core/domain/query"
 
 	gomock "go.uber.org/mock/gomock"
 )
@@ -41,6 +42,21 @@ func (m *MockProjectMemberRepository) EXPECT() *MockProjectMemberRepositoryMockR
 	return m.recorder
 }
 
+// CountByRole mocks base method.
+func (m *MockProjectMemberRepository) CountByRole(ctx context.Context, projectID int, role string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountByRole", ctx, projectID, role)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountByRole indicates an expected call of CountByRole.
+func (mr *MockProjectMemberRepositoryMockRecorder) CountByRole(ctx, projectID, role any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountByRole", reflect.TypeOf((*MockProjectMemberRepository)(nil).CountByRole), ctx, projectID, role)
+}
+
 // Create mocks base method.
 func (m *MockProjectMemberRepository) Create(ctx context.Context, projectMember *project_member.ProjectMember) error {
 	m.ctrl.T.Helper()
@@ -70,18 +86,18 @@ func (mr *MockProjectMemberRepositoryMockRecorder) Delete(ctx, id any) *gomock.C
 }
 
 // GetAll mocks base method.
-func (m *MockProjectMemberRepository) GetAll(ctx context.Context) ([]project_member.ProjectMember, error) {
+func (m *MockProjectMemberRepository) GetAll(ctx context.Context, params query.Params) (*query.Page[project_member.ProjectMember], error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAll", ctx)
-	ret0, _ := ret[0].([]project_member.ProjectMember)
+	ret := m.ctrl.Call(m, "GetAll", ctx, params)
+	ret0, _ := ret[0].(*query.Page[project_member.ProjectMember])
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetAll indicates an expected call of GetAll.
-func (mr *MockProjectMemberRepositoryMockRecorder) GetAll(ctx any) *gomock.Call {
+func (mr *MockProjectMemberRepositoryMockRecorder) GetAll(ctx, params any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockProjectMemberRepository)(nil).GetAll), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockProjectMemberRepository)(nil).GetAll), ctx, params)
 }
 
 // GetByID mocks base method.
@@ -99,6 +115,21 @@ func (mr *MockProjectMemberRepositoryMockRecorder) GetByID(ctx, id any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockProjectMemberRepository)(nil).GetByID), ctx, id)
 }
 
+// GetByProjectAndUser mocks base method.
+func (m *MockProjectMemberRepository) GetByProjectAndUser(ctx context.Context, projectID, userID int) (*project_member.ProjectMember, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByProjectAndUser", ctx, projectID, userID)
+	ret0, _ := ret[0].(*project_member.ProjectMember)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByProjectAndUser indicates an expected call of GetByProjectAndUser.
+func (mr *MockProjectMemberRepositoryMockRecorder) GetByProjectAndUser(ctx, projectID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByProjectAndUser", reflect.TypeOf((*MockProjectMemberRepository)(nil).GetByProjectAndUser), ctx, projectID, userID)
+}
+
 // GetByProjectID mocks base method.
 func (m *MockProjectMemberRepository) GetByProjectID(ctx context.Context, projectID int) ([]project_member.ProjectMember, error) {
 	m.ctrl.T.Helper()
@@ -114,6 +145,21 @@ func (mr *MockProjectMemberRepositoryMockRecorder) GetByProjectID(ctx, projectID
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByProjectID", reflect.TypeOf((*MockProjectMemberRepository)(nil).GetByProjectID), ctx, projectID)
 }
 
+// IsMember mocks base method.
+func (m *MockProjectMemberRepository) IsMember(ctx context.Context, projectID, userID int) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsMember", ctx, projectID, userID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsMember indicates an expected call of IsMember.
+func (mr *MockProjectMemberRepositoryMockRecorder) IsMember(ctx, projectID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsMember", reflect.TypeOf((*MockProjectMemberRepository)(nil).IsMember), ctx, projectID, userID)
+}
+
 // Update mocks base method.
 func (m *MockProjectMemberRepository) Update(ctx context.Context, projectMember *project_member.ProjectMember) error {
 	m.ctrl.T.Helper()
@@ -127,3 +173,17 @@ func (mr *MockProjectMemberRepositoryMockRecorder) Update(ctx, projectMember any
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockProjectMemberRepository)(nil).Update), ctx, projectMember)
 }
+
+// UpdateRole mocks base method.
+func (m *MockProjectMemberRepository) UpdateRole(ctx context.Context, id int, role string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRole", ctx, id, role)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateRole indicates an expected call of UpdateRole.
+func (mr *MockProjectMemberRepositoryMockRecorder) UpdateRole(ctx, id, role any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRole", reflect.TypeOf((*MockProjectMemberRepository)(nil).UpdateRole), ctx, id, role)
+}
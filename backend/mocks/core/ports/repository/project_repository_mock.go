@@ -13,6 +13,8 @@ import (
 	context "context"
 	reflect "reflect"
 	project "softpharos/internal/core/domain/project"
+	query "softpharos/internal/core/domain/query"
+	time "time"
 
 	gomock "go.uber.org/mock/gomock"
 )
@@ -70,18 +72,33 @@ func (mr *MockProjectRepositoryMockRecorder) Delete(ctx, id any) *gomock.Call {
 }
 
 // GetAll mocks base method.
-func (m *MockProjectRepository) GetAll(ctx context.Context) ([]project.Project, error) {
+func (m *MockProjectRepository) GetAll(ctx context.Context, params query.Params) (*query.Page[project.Project], error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAll", ctx)
-	ret0, _ := ret[0].([]project.Project)
+	ret := m.ctrl.Call(m, "GetAll", ctx, params)
+	ret0, _ := ret[0].(*query.Page[project.Project])
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetAll indicates an expected call of GetAll.
-func (mr *MockProjectRepositoryMockRecorder) GetAll(ctx any) *gomock.Call {
+func (mr *MockProjectRepositoryMockRecorder) GetAll(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockProjectRepository)(nil).GetAll), ctx, params)
+}
+
+// GetByCourse mocks base method.
+func (m *MockProjectRepository) GetByCourse(ctx context.Context, courseID int, params query.Params) (*query.Page[project.Project], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByCourse", ctx, courseID, params)
+	ret0, _ := ret[0].(*query.Page[project.Project])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByCourse indicates an expected call of GetByCourse.
+func (mr *MockProjectRepositoryMockRecorder) GetByCourse(ctx, courseID, params any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockProjectRepository)(nil).GetAll), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByCourse", reflect.TypeOf((*MockProjectRepository)(nil).GetByCourse), ctx, courseID, params)
 }
 
 // GetByID mocks base method.
@@ -99,6 +116,21 @@ func (mr *MockProjectRepositoryMockRecorder) GetByID(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockProjectRepository)(nil).GetByID), ctx, id)
 }
 
+// GetByMember mocks base method.
+func (m *MockProjectRepository) GetByMember(ctx context.Context, userID int, params query.Params) (*query.Page[project.MemberProject], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByMember", ctx, userID, params)
+	ret0, _ := ret[0].(*query.Page[project.MemberProject])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByMember indicates an expected call of GetByMember.
+func (mr *MockProjectRepositoryMockRecorder) GetByMember(ctx, userID, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByMember", reflect.TypeOf((*MockProjectRepository)(nil).GetByMember), ctx, userID, params)
+}
+
 // GetByOwner mocks base method.
 func (m *MockProjectRepository) GetByOwner(ctx context.Context, ownerID int) ([]project.Project, error) {
 	m.ctrl.T.Helper()
@@ -114,6 +146,79 @@ func (mr *MockProjectRepositoryMockRecorder) GetByOwner(ctx, ownerID any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByOwner", reflect.TypeOf((*MockProjectRepository)(nil).GetByOwner), ctx, ownerID)
 }
 
+// GetTrashed mocks base method.
+func (m *MockProjectRepository) GetTrashed(ctx context.Context, params query.Params) (*query.Page[project.Project], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTrashed", ctx, params)
+	ret0, _ := ret[0].(*query.Page[project.Project])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTrashed indicates an expected call of GetTrashed.
+func (mr *MockProjectRepositoryMockRecorder) GetTrashed(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTrashed", reflect.TypeOf((*MockProjectRepository)(nil).GetTrashed), ctx, params)
+}
+
+// GetTrashedByID mocks base method.
+func (m *MockProjectRepository) GetTrashedByID(ctx context.Context, id int) (*project.Project, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTrashedByID", ctx, id)
+	ret0, _ := ret[0].(*project.Project)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTrashedByID indicates an expected call of GetTrashedByID.
+func (mr *MockProjectRepositoryMockRecorder) GetTrashedByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTrashedByID", reflect.TypeOf((*MockProjectRepository)(nil).GetTrashedByID), ctx, id)
+}
+
+// GetTrashedByOwner mocks base method.
+func (m *MockProjectRepository) GetTrashedByOwner(ctx context.Context, userID int, params query.Params) (*query.Page[project.Project], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTrashedByOwner", ctx, userID, params)
+	ret0, _ := ret[0].(*query.Page[project.Project])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTrashedByOwner indicates an expected call of GetTrashedByOwner.
+func (mr *MockProjectRepositoryMockRecorder) GetTrashedByOwner(ctx, userID, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTrashedByOwner", reflect.TypeOf((*MockProjectRepository)(nil).GetTrashedByOwner), ctx, userID, params)
+}
+
+// Restore mocks base method.
+func (m *MockProjectRepository) Restore(ctx context.Context, id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Restore indicates an expected call of Restore.
+func (mr *MockProjectRepositoryMockRecorder) Restore(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockProjectRepository)(nil).Restore), ctx, id)
+}
+
+// SetArchivedAt mocks base method.
+func (m *MockProjectRepository) SetArchivedAt(ctx context.Context, id int, at *time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetArchivedAt", ctx, id, at)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetArchivedAt indicates an expected call of SetArchivedAt.
+func (mr *MockProjectRepositoryMockRecorder) SetArchivedAt(ctx, id, at any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetArchivedAt", reflect.TypeOf((*MockProjectRepository)(nil).SetArchivedAt), ctx, id, at)
+}
+
 // Update mocks base method.
 func (m *MockProjectRepository) Update(ctx context.Context, arg1 *project.Project) error {
 	m.ctrl.T.Helper()
@@ -127,3 +232,17 @@ func (mr *MockProjectRepositoryMockRecorder) Update(ctx, arg1 any) *gomock.Call
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockProjectRepository)(nil).Update), ctx, arg1)
 }
+
+// UpdateOwner mocks base method.
+func (m *MockProjectRepository) UpdateOwner(ctx context.Context, id, ownerID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateOwner", ctx, id, ownerID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateOwner indicates an expected call of UpdateOwner.
+func (mr *MockProjectRepositoryMockRecorder) UpdateOwner(ctx, id, ownerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateOwner", reflect.TypeOf((*MockProjectRepository)(nil).UpdateOwner), ctx, id, ownerID)
+}
@@ -12,6 +12,7 @@ package repository
 import (
 	context "context"
 	reflect "reflect"
+	query "softpharos/internal/core/domain/query"
 	reaction "softpharos/internal/core/domain/reaction"
 
 	gomock "go.uber.org/mock/gomock"
@@ -41,6 +42,35 @@ func (m *MockReactionRepository) EXPECT() *MockReactionRepositoryMockRecorder {
 	return m.recorder
 }
 
+// AddForUser mocks base method.
+func (m *MockReactionRepository) AddForUser(ctx context.Context, arg1 *reaction.Reaction) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddForUser", ctx, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddForUser indicates an expected call of AddForUser.
+func (mr *MockReactionRepositoryMockRecorder) AddForUser(ctx, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddForUser", reflect.TypeOf((*MockReactionRepository)(nil).AddForUser), ctx, arg1)
+}
+
+// CountByMilestone mocks base method.
+func (m *MockReactionRepository) CountByMilestone(ctx context.Context, milestoneID, userID int) (map[string]reaction.TypeCount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountByMilestone", ctx, milestoneID, userID)
+	ret0, _ := ret[0].(map[string]reaction.TypeCount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountByMilestone indicates an expected call of CountByMilestone.
+func (mr *MockReactionRepositoryMockRecorder) CountByMilestone(ctx, milestoneID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountByMilestone", reflect.TypeOf((*MockReactionRepository)(nil).CountByMilestone), ctx, milestoneID, userID)
+}
+
 // Create mocks base method.
 func (m *MockReactionRepository) Create(ctx context.Context, arg1 *reaction.Reaction) error {
 	m.ctrl.T.Helper()
@@ -70,18 +100,18 @@ func (mr *MockReactionRepositoryMockRecorder) Delete(ctx, id any) *gomock.Call {
 }
 
 // GetAll mocks base method.
-func (m *MockReactionRepository) GetAll(ctx context.Context) ([]reaction.Reaction, error) {
+func (m *MockReactionRepository) GetAll(ctx context.Context, params query.Params) (*query.Page[reaction.Reaction], error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAll", ctx)
-	ret0, _ := ret[0].([]reaction.Reaction)
+	ret := m.ctrl.Call(m, "GetAll", ctx, params)
+	ret0, _ := ret[0].(*query.Page[reaction.Reaction])
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetAll indicates an expected call of GetAll.
-func (mr *MockReactionRepositoryMockRecorder) GetAll(ctx any) *gomock.Call {
+func (mr *MockReactionRepositoryMockRecorder) GetAll(ctx, params any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockReactionRepository)(nil).GetAll), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockReactionRepository)(nil).GetAll), ctx, params)
 }
 
 // GetByID mocks base method.
@@ -114,6 +144,20 @@ func (mr *MockReactionRepositoryMockRecorder) GetByMilestoneID(ctx, milestoneID
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByMilestoneID", reflect.TypeOf((*MockReactionRepository)(nil).GetByMilestoneID), ctx, milestoneID)
 }
 
+// RemoveForUser mocks base method.
+func (m *MockReactionRepository) RemoveForUser(ctx context.Context, milestoneID, userID int, reactionType string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveForUser", ctx, milestoneID, userID, reactionType)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveForUser indicates an expected call of RemoveForUser.
+func (mr *MockReactionRepositoryMockRecorder) RemoveForUser(ctx, milestoneID, userID, reactionType any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveForUser", reflect.TypeOf((*MockReactionRepository)(nil).RemoveForUser), ctx, milestoneID, userID, reactionType)
+}
+
 // Update mocks base method.
 func (m *MockReactionRepository) Update(ctx context.Context, arg1 *reaction.Reaction) error {
 	m.ctrl.T.Helper()
@@ -12,6 +12,7 @@ package repository
 import (
 	context "context"
 	reflect "reflect"
+	query "softpharos/internal/core/domain/query"
 	role "softpharos/internal/core/domain/role"
 
 	gomock "go.uber.org/mock/gomock"
@@ -70,18 +71,18 @@ func (mr *MockRoleRepositoryMockRecorder) Delete(ctx, id any) *gomock.Call {
 }
 
 // GetAll mocks base method.
-func (m *MockRoleRepository) GetAll(ctx context.Context) ([]role.Role, error) {
+func (m *MockRoleRepository) GetAll(ctx context.Context, params query.Params) (*query.Page[role.Role], error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAll", ctx)
-	ret0, _ := ret[0].([]role.Role)
+	ret := m.ctrl.Call(m, "GetAll", ctx, params)
+	ret0, _ := ret[0].(*query.Page[role.Role])
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetAll indicates an expected call of GetAll.
-func (mr *MockRoleRepositoryMockRecorder) GetAll(ctx any) *gomock.Call {
+func (mr *MockRoleRepositoryMockRecorder) GetAll(ctx, params any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockRoleRepository)(nil).GetAll), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockRoleRepository)(nil).GetAll), ctx, params)
 }
 
 // GetByID mocks base method.
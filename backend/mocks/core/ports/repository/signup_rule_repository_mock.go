@@ -0,0 +1,130 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/core/ports/repository/signup_rule_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/core/ports/repository/signup_rule_repository.go -destination=mocks/core/ports/repository/signup_rule_repository_mock.go -package=repository
+//
+
+// Package repository is a generated GoMock package.
+package repository
+
+import (
+	context "context"
+	reflect "reflect"
+	query "softpharos/internal/core/domain/query"
+	signup_rule "softpharos/internal/core/domain/signup_rule"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockSignupRuleRepository is a mock of SignupRuleRepository interface.
+type MockSignupRuleRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockSignupRuleRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockSignupRuleRepositoryMockRecorder is the mock recorder for MockSignupRuleRepository.
+type MockSignupRuleRepositoryMockRecorder struct {
+	mock *MockSignupRuleRepository
+}
+
+// NewMockSignupRuleRepository creates a new mock instance.
+func NewMockSignupRuleRepository(ctrl *gomock.Controller) *MockSignupRuleRepository {
+	mock := &MockSignupRuleRepository{ctrl: ctrl}
+	mock.recorder = &MockSignupRuleRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSignupRuleRepository) EXPECT() *MockSignupRuleRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockSignupRuleRepository) Create(ctx context.Context, rule *signup_rule.SignupRule) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, rule)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockSignupRuleRepositoryMockRecorder) Create(ctx, rule any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockSignupRuleRepository)(nil).Create), ctx, rule)
+}
+
+// Delete mocks base method.
+func (m *MockSignupRuleRepository) Delete(ctx context.Context, id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockSignupRuleRepositoryMockRecorder) Delete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockSignupRuleRepository)(nil).Delete), ctx, id)
+}
+
+// GetAll mocks base method.
+func (m *MockSignupRuleRepository) GetAll(ctx context.Context, params query.Params) (*query.Page[signup_rule.SignupRule], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", ctx, params)
+	ret0, _ := ret[0].(*query.Page[signup_rule.SignupRule])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockSignupRuleRepositoryMockRecorder) GetAll(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockSignupRuleRepository)(nil).GetAll), ctx, params)
+}
+
+// GetByID mocks base method.
+func (m *MockSignupRuleRepository) GetByID(ctx context.Context, id int) (*signup_rule.SignupRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*signup_rule.SignupRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockSignupRuleRepositoryMockRecorder) GetByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockSignupRuleRepository)(nil).GetByID), ctx, id)
+}
+
+// GetByPatterns mocks base method.
+func (m *MockSignupRuleRepository) GetByPatterns(ctx context.Context, patterns []string) ([]signup_rule.SignupRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByPatterns", ctx, patterns)
+	ret0, _ := ret[0].([]signup_rule.SignupRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByPatterns indicates an expected call of GetByPatterns.
+func (mr *MockSignupRuleRepositoryMockRecorder) GetByPatterns(ctx, patterns any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByPatterns", reflect.TypeOf((*MockSignupRuleRepository)(nil).GetByPatterns), ctx, patterns)
+}
+
+// Update mocks base method.
+func (m *MockSignupRuleRepository) Update(ctx context.Context, rule *signup_rule.SignupRule) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, rule)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockSignupRuleRepositoryMockRecorder) Update(ctx, rule any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockSignupRuleRepository)(nil).Update), ctx, rule)
+}
@@ -0,0 +1,130 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/core/ports/repository/term_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/core/ports/repository/term_repository.go -destination=mocks/core/ports/repository/term_repository_mock.go -package=repository
+//
+
+// Package repository is a generated GoMock package.
+package repository
+
+import (
+	context "context"
+	reflect "reflect"
+	query "softpharos/internal/core/domain/query"
+	term "softpharos/internal/core/domain/term"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockTermRepository is a mock of TermRepository interface.
+type MockTermRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockTermRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockTermRepositoryMockRecorder is the mock recorder for MockTermRepository.
+type MockTermRepositoryMockRecorder struct {
+	mock *MockTermRepository
+}
+
+// NewMockTermRepository creates a new mock instance.
+func NewMockTermRepository(ctrl *gomock.Controller) *MockTermRepository {
+	mock := &MockTermRepository{ctrl: ctrl}
+	mock.recorder = &MockTermRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTermRepository) EXPECT() *MockTermRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockTermRepository) Create(ctx context.Context, arg1 *term.Term) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockTermRepositoryMockRecorder) Create(ctx, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockTermRepository)(nil).Create), ctx, arg1)
+}
+
+// Delete mocks base method.
+func (m *MockTermRepository) Delete(ctx context.Context, id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockTermRepositoryMockRecorder) Delete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockTermRepository)(nil).Delete), ctx, id)
+}
+
+// GetAll mocks base method.
+func (m *MockTermRepository) GetAll(ctx context.Context, params query.Params) (*query.Page[term.Term], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", ctx, params)
+	ret0, _ := ret[0].(*query.Page[term.Term])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockTermRepositoryMockRecorder) GetAll(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockTermRepository)(nil).GetAll), ctx, params)
+}
+
+// GetByID mocks base method.
+func (m *MockTermRepository) GetByID(ctx context.Context, id int) (*term.Term, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*term.Term)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockTermRepositoryMockRecorder) GetByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockTermRepository)(nil).GetByID), ctx, id)
+}
+
+// GetByProjects mocks base method.
+func (m *MockTermRepository) GetByProjects(ctx context.Context, projectIDs []int) (map[int]*term.Term, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByProjects", ctx, projectIDs)
+	ret0, _ := ret[0].(map[int]*term.Term)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByProjects indicates an expected call of GetByProjects.
+func (mr *MockTermRepositoryMockRecorder) GetByProjects(ctx, projectIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByProjects", reflect.TypeOf((*MockTermRepository)(nil).GetByProjects), ctx, projectIDs)
+}
+
+// Update mocks base method.
+func (m *MockTermRepository) Update(ctx context.Context, arg1 *term.Term) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockTermRepositoryMockRecorder) Update(ctx, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockTermRepository)(nil).Update), ctx, arg1)
+}
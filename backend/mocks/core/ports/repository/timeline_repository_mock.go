@@ -0,0 +1,58 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/core/ports/repository/timeline_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/core/ports/repository/timeline_repository.go -destination=mocks/core/ports/repository/timeline_repository_mock.go -package=repository
+//
+
+// Package repository is a generated GoMock package.
+package repository
+
+import (
+	context "context"
+	reflect "reflect"
+	query "softpharos/internal/core/domain/query"
+	timeline "softpharos/internal/core/domain/timeline"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockTimelineRepository is a mock of TimelineRepository interface.
+type MockTimelineRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockTimelineRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockTimelineRepositoryMockRecorder is the mock recorder for MockTimelineRepository.
+type MockTimelineRepositoryMockRecorder struct {
+	mock *MockTimelineRepository
+}
+
+// NewMockTimelineRepository creates a new mock instance.
+func NewMockTimelineRepository(ctrl *gomock.Controller) *MockTimelineRepository {
+	mock := &MockTimelineRepository{ctrl: ctrl}
+	mock.recorder = &MockTimelineRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTimelineRepository) EXPECT() *MockTimelineRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetByProjectID mocks base method.
+func (m *MockTimelineRepository) GetByProjectID(ctx context.Context, projectID int, params query.Params) (*query.Page[timeline.Event], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByProjectID", ctx, projectID, params)
+	ret0, _ := ret[0].(*query.Page[timeline.Event])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByProjectID indicates an expected call of GetByProjectID.
+func (mr *MockTimelineRepositoryMockRecorder) GetByProjectID(ctx, projectID, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByProjectID", reflect.TypeOf((*MockTimelineRepository)(nil).GetByProjectID), ctx, projectID, params)
+}
@@ -0,0 +1,154 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/core/ports/services/access_service.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/core/ports/services/access_service.go -destination=mocks/core/ports/services/access_service_mock.go -package=services
+//
+
+// Package services is a generated GoMock package.
+package services
+
+import (
+	context "context"
+	reflect "reflect"
+	project_member "softpharos/internal/core/domain/project_member"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockAccessService is a mock of AccessService interface.
+type MockAccessService struct {
+	ctrl     *gomock.Controller
+	recorder *MockAccessServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockAccessServiceMockRecorder is the mock recorder for MockAccessService.
+type MockAccessServiceMockRecorder struct {
+	mock *MockAccessService
+}
+
+// NewMockAccessService creates a new mock instance.
+func NewMockAccessService(ctrl *gomock.Controller) *MockAccessService {
+	mock := &MockAccessService{ctrl: ctrl}
+	mock.recorder = &MockAccessServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAccessService) EXPECT() *MockAccessServiceMockRecorder {
+	return m.recorder
+}
+
+// RequireAuthor mocks base method.
+func (m *MockAccessService) RequireAuthor(ctx context.Context, authorID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RequireAuthor", ctx, authorID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RequireAuthor indicates an expected call of RequireAuthor.
+func (mr *MockAccessServiceMockRecorder) RequireAuthor(ctx, authorID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequireAuthor", reflect.TypeOf((*MockAccessService)(nil).RequireAuthor), ctx, authorID)
+}
+
+// RequireMilestoneAbility mocks base method.
+func (m *MockAccessService) RequireMilestoneAbility(ctx context.Context, milestoneID int, ability project_member.Ability) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RequireMilestoneAbility", ctx, milestoneID, ability)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RequireMilestoneAbility indicates an expected call of RequireMilestoneAbility.
+func (mr *MockAccessServiceMockRecorder) RequireMilestoneAbility(ctx, milestoneID, ability any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequireMilestoneAbility", reflect.TypeOf((*MockAccessService)(nil).RequireMilestoneAbility), ctx, milestoneID, ability)
+}
+
+// RequireMilestoneMember mocks base method.
+func (m *MockAccessService) RequireMilestoneMember(ctx context.Context, milestoneID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RequireMilestoneMember", ctx, milestoneID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RequireMilestoneMember indicates an expected call of RequireMilestoneMember.
+func (mr *MockAccessServiceMockRecorder) RequireMilestoneMember(ctx, milestoneID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequireMilestoneMember", reflect.TypeOf((*MockAccessService)(nil).RequireMilestoneMember), ctx, milestoneID)
+}
+
+// RequireProjectAbility mocks base method.
+func (m *MockAccessService) RequireProjectAbility(ctx context.Context, projectID int, ability project_member.Ability) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RequireProjectAbility", ctx, projectID, ability)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RequireProjectAbility indicates an expected call of RequireProjectAbility.
+func (mr *MockAccessServiceMockRecorder) RequireProjectAbility(ctx, projectID, ability any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequireProjectAbility", reflect.TypeOf((*MockAccessService)(nil).RequireProjectAbility), ctx, projectID, ability)
+}
+
+// RequireProjectMember mocks base method.
+func (m *MockAccessService) RequireProjectMember(ctx context.Context, projectID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RequireProjectMember", ctx, projectID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RequireProjectMember indicates an expected call of RequireProjectMember.
+func (mr *MockAccessServiceMockRecorder) RequireProjectMember(ctx, projectID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequireProjectMember", reflect.TypeOf((*MockAccessService)(nil).RequireProjectMember), ctx, projectID)
+}
+
+// RequireProjectOwner mocks base method.
+func (m *MockAccessService) RequireProjectOwner(ctx context.Context, projectID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RequireProjectOwner", ctx, projectID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RequireProjectOwner indicates an expected call of RequireProjectOwner.
+func (mr *MockAccessServiceMockRecorder) RequireProjectOwner(ctx, projectID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequireProjectOwner", reflect.TypeOf((*MockAccessService)(nil).RequireProjectOwner), ctx, projectID)
+}
+
+// RequireSectionMember mocks base method.
+func (m *MockAccessService) RequireSectionMember(ctx context.Context, sectionID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RequireSectionMember", ctx, sectionID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RequireSectionMember indicates an expected call of RequireSectionMember.
+func (mr *MockAccessServiceMockRecorder) RequireSectionMember(ctx, sectionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequireSectionMember", reflect.TypeOf((*MockAccessService)(nil).RequireSectionMember), ctx, sectionID)
+}
+
+// RequireSectionProfessor mocks base method.
+func (m *MockAccessService) RequireSectionProfessor(ctx context.Context, sectionID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RequireSectionProfessor", ctx, sectionID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RequireSectionProfessor indicates an expected call of RequireSectionProfessor.
+func (mr *MockAccessServiceMockRecorder) RequireSectionProfessor(ctx, sectionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequireSectionProfessor", reflect.TypeOf((*MockAccessService)(nil).RequireSectionProfessor), ctx, sectionID)
+}
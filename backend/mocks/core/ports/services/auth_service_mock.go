@@ -12,6 +12,7 @@ package services
 import (
 	context "context"
 	reflect "reflect"
+	session "softpharos/internal/core/domain/session"
 	user "softpharos/internal/core/domain/user"
 
 	gomock "go.uber.org/mock/gomock"
@@ -41,18 +42,63 @@ func (m *MockAuthService) EXPECT() *MockAuthServiceMockRecorder {
 	return m.recorder
 }
 
-// AuthenticateWithGoogle mocks base method.
-func (m *MockAuthService) AuthenticateWithGoogle(ctx context.Context, idToken string) (*user.User, string, error) {
+// Authenticate mocks base method.
+func (m *MockAuthService) Authenticate(ctx context.Context, idToken string) (*user.User, *session.Tokens, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AuthenticateWithGoogle", ctx, idToken)
+	ret := m.ctrl.Call(m, "Authenticate", ctx, idToken)
 	ret0, _ := ret[0].(*user.User)
-	ret1, _ := ret[1].(string)
+	ret1, _ := ret[1].(*session.Tokens)
 	ret2, _ := ret[2].(error)
 	return ret0, ret1, ret2
 }
 
-// AuthenticateWithGoogle indicates an expected call of AuthenticateWithGoogle.
-func (mr *MockAuthServiceMockRecorder) AuthenticateWithGoogle(ctx, idToken any) *gomock.Call {
+// Authenticate indicates an expected call of Authenticate.
+func (mr *MockAuthServiceMockRecorder) Authenticate(ctx, idToken any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthenticateWithGoogle", reflect.TypeOf((*MockAuthService)(nil).AuthenticateWithGoogle), ctx, idToken)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Authenticate", reflect.TypeOf((*MockAuthService)(nil).Authenticate), ctx, idToken)
+}
+
+// Logout mocks base method.
+func (m *MockAuthService) Logout(ctx context.Context, refreshToken string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Logout", ctx, refreshToken)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Logout indicates an expected call of Logout.
+func (mr *MockAuthServiceMockRecorder) Logout(ctx, refreshToken any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Logout", reflect.TypeOf((*MockAuthService)(nil).Logout), ctx, refreshToken)
+}
+
+// PurgeExpiredTokens mocks base method.
+func (m *MockAuthService) PurgeExpiredTokens(ctx context.Context) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeExpiredTokens", ctx)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeExpiredTokens indicates an expected call of PurgeExpiredTokens.
+func (mr *MockAuthServiceMockRecorder) PurgeExpiredTokens(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeExpiredTokens", reflect.TypeOf((*MockAuthService)(nil).PurgeExpiredTokens), ctx)
+}
+
+// RefreshSession mocks base method.
+func (m *MockAuthService) RefreshSession(ctx context.Context, refreshToken string) (*user.User, *session.Tokens, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RefreshSession", ctx, refreshToken)
+	ret0, _ := ret[0].(*user.User)
+	ret1, _ := ret[1].(*session.Tokens)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// RefreshSession indicates an expected call of RefreshSession.
+func (mr *MockAuthServiceMockRecorder) RefreshSession(ctx, refreshToken any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshSession", reflect.TypeOf((*MockAuthService)(nil).RefreshSession), ctx, refreshToken)
 }
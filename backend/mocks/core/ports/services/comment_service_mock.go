@@ -13,6 +13,7 @@ import (
 	context "context"
 	reflect "reflect"
 	comment "softpharos/internal/core/domain/comment"
+	query "softpharos/internal/core/domain/query"
 
 	gomock "go.uber.org/mock/gomock"
 )
@@ -70,18 +71,18 @@ func (mr *MockCommentServiceMockRecorder) DeleteComment(ctx, id any) *gomock.Cal
 }
 
 // GetAllComments mocks base method.
-func (m *MockCommentService) GetAllComments(ctx context.Context) ([]comment.Comment, error) {
+func (m *MockCommentService) GetAllComments(ctx context.Context, params query.Params) (*query.Page[comment.Comment], error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAllComments", ctx)
-	ret0, _ := ret[0].([]comment.Comment)
+	ret := m.ctrl.Call(m, "GetAllComments", ctx, params)
+	ret0, _ := ret[0].(*query.Page[comment.Comment])
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetAllComments indicates an expected call of GetAllComments.
-func (mr *MockCommentServiceMockRecorder) GetAllComments(ctx any) *gomock.Call {
+func (mr *MockCommentServiceMockRecorder) GetAllComments(ctx, params any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllComments", reflect.TypeOf((*MockCommentService)(nil).GetAllComments), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllComments", reflect.TypeOf((*MockCommentService)(nil).GetAllComments), ctx, params)
 }
 
 // GetCommentByID mocks base method.
@@ -114,6 +115,35 @@ func (mr *MockCommentServiceMockRecorder) GetCommentsByMilestoneID(ctx, mileston
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCommentsByMilestoneID", reflect.TypeOf((*MockCommentService)(nil).GetCommentsByMilestoneID), ctx, milestoneID)
 }
 
+// GetTrashedCommentsByMilestoneID mocks base method.
+func (m *MockCommentService) GetTrashedCommentsByMilestoneID(ctx context.Context, milestoneID int) ([]comment.Comment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTrashedCommentsByMilestoneID", ctx, milestoneID)
+	ret0, _ := ret[0].([]comment.Comment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTrashedCommentsByMilestoneID indicates an expected call of GetTrashedCommentsByMilestoneID.
+func (mr *MockCommentServiceMockRecorder) GetTrashedCommentsByMilestoneID(ctx, milestoneID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTrashedCommentsByMilestoneID", reflect.TypeOf((*MockCommentService)(nil).GetTrashedCommentsByMilestoneID), ctx, milestoneID)
+}
+
+// RestoreComment mocks base method.
+func (m *MockCommentService) RestoreComment(ctx context.Context, id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreComment", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestoreComment indicates an expected call of RestoreComment.
+func (mr *MockCommentServiceMockRecorder) RestoreComment(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreComment", reflect.TypeOf((*MockCommentService)(nil).RestoreComment), ctx, id)
+}
+
 // UpdateComment mocks base method.
 func (m *MockCommentService) UpdateComment(ctx context.Context, arg1 *comment.Comment) error {
 	m.ctrl.T.Helper()
@@ -0,0 +1,233 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/core/ports/services/course_service.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/core/ports/services/course_service.go -destination=mocks/core/ports/services/course_service_mock.go -package=services
+//
+
+// Package services is a generated GoMock package.
+package services
+
+import (
+	context "context"
+	reflect "reflect"
+	course "softpharos/internal/core/domain/course"
+	milestone "softpharos/internal/core/domain/milestone"
+	project "softpharos/internal/core/domain/project"
+	query "softpharos/internal/core/domain/query"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockCourseService is a mock of CourseService interface.
+type MockCourseService struct {
+	ctrl     *gomock.Controller
+	recorder *MockCourseServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockCourseServiceMockRecorder is the mock recorder for MockCourseService.
+type MockCourseServiceMockRecorder struct {
+	mock *MockCourseService
+}
+
+// NewMockCourseService creates a new mock instance.
+func NewMockCourseService(ctrl *gomock.Controller) *MockCourseService {
+	mock := &MockCourseService{ctrl: ctrl}
+	mock.recorder = &MockCourseServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCourseService) EXPECT() *MockCourseServiceMockRecorder {
+	return m.recorder
+}
+
+// AddSectionMember mocks base method.
+func (m *MockCourseService) AddSectionMember(ctx context.Context, member *course.Member) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddSectionMember", ctx, member)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddSectionMember indicates an expected call of AddSectionMember.
+func (mr *MockCourseServiceMockRecorder) AddSectionMember(ctx, member any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddSectionMember", reflect.TypeOf((*MockCourseService)(nil).AddSectionMember), ctx, member)
+}
+
+// CreateCourse mocks base method.
+func (m *MockCourseService) CreateCourse(ctx context.Context, c *course.Course) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCourse", ctx, c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateCourse indicates an expected call of CreateCourse.
+func (mr *MockCourseServiceMockRecorder) CreateCourse(ctx, c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCourse", reflect.TypeOf((*MockCourseService)(nil).CreateCourse), ctx, c)
+}
+
+// CreateCourseSection mocks base method.
+func (m *MockCourseService) CreateCourseSection(ctx context.Context, section *course.Section) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCourseSection", ctx, section)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateCourseSection indicates an expected call of CreateCourseSection.
+func (mr *MockCourseServiceMockRecorder) CreateCourseSection(ctx, section any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCourseSection", reflect.TypeOf((*MockCourseService)(nil).CreateCourseSection), ctx, section)
+}
+
+// DeleteCourse mocks base method.
+func (m *MockCourseService) DeleteCourse(ctx context.Context, id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCourse", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteCourse indicates an expected call of DeleteCourse.
+func (mr *MockCourseServiceMockRecorder) DeleteCourse(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCourse", reflect.TypeOf((*MockCourseService)(nil).DeleteCourse), ctx, id)
+}
+
+// DeleteCourseSection mocks base method.
+func (m *MockCourseService) DeleteCourseSection(ctx context.Context, id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCourseSection", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteCourseSection indicates an expected call of DeleteCourseSection.
+func (mr *MockCourseServiceMockRecorder) DeleteCourseSection(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCourseSection", reflect.TypeOf((*MockCourseService)(nil).DeleteCourseSection), ctx, id)
+}
+
+// GetAllCourses mocks base method.
+func (m *MockCourseService) GetAllCourses(ctx context.Context, params query.Params) (*query.Page[course.Course], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllCourses", ctx, params)
+	ret0, _ := ret[0].(*query.Page[course.Course])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllCourses indicates an expected call of GetAllCourses.
+func (mr *MockCourseServiceMockRecorder) GetAllCourses(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllCourses", reflect.TypeOf((*MockCourseService)(nil).GetAllCourses), ctx, params)
+}
+
+// GetCourseByID mocks base method.
+func (m *MockCourseService) GetCourseByID(ctx context.Context, id int) (*course.Course, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCourseByID", ctx, id)
+	ret0, _ := ret[0].(*course.Course)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCourseByID indicates an expected call of GetCourseByID.
+func (mr *MockCourseServiceMockRecorder) GetCourseByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCourseByID", reflect.TypeOf((*MockCourseService)(nil).GetCourseByID), ctx, id)
+}
+
+// GetCourseProjects mocks base method.
+func (m *MockCourseService) GetCourseProjects(ctx context.Context, courseID int, params query.Params) (*query.Page[project.Project], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCourseProjects", ctx, courseID, params)
+	ret0, _ := ret[0].(*query.Page[project.Project])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCourseProjects indicates an expected call of GetCourseProjects.
+func (mr *MockCourseServiceMockRecorder) GetCourseProjects(ctx, courseID, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCourseProjects", reflect.TypeOf((*MockCourseService)(nil).GetCourseProjects), ctx, courseID, params)
+}
+
+// GetCourseSections mocks base method.
+func (m *MockCourseService) GetCourseSections(ctx context.Context, courseID int, termID *int) ([]course.Section, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCourseSections", ctx, courseID, termID)
+	ret0, _ := ret[0].([]course.Section)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCourseSections indicates an expected call of GetCourseSections.
+func (mr *MockCourseServiceMockRecorder) GetCourseSections(ctx, courseID, termID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCourseSections", reflect.TypeOf((*MockCourseService)(nil).GetCourseSections), ctx, courseID, termID)
+}
+
+// GetOverdueMilestones mocks base method.
+func (m *MockCourseService) GetOverdueMilestones(ctx context.Context, courseID int, params query.Params) (*query.Page[milestone.Milestone], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOverdueMilestones", ctx, courseID, params)
+	ret0, _ := ret[0].(*query.Page[milestone.Milestone])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOverdueMilestones indicates an expected call of GetOverdueMilestones.
+func (mr *MockCourseServiceMockRecorder) GetOverdueMilestones(ctx, courseID, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOverdueMilestones", reflect.TypeOf((*MockCourseService)(nil).GetOverdueMilestones), ctx, courseID, params)
+}
+
+// GetSectionMembers mocks base method.
+func (m *MockCourseService) GetSectionMembers(ctx context.Context, sectionID int) ([]course.Member, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSectionMembers", ctx, sectionID)
+	ret0, _ := ret[0].([]course.Member)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSectionMembers indicates an expected call of GetSectionMembers.
+func (mr *MockCourseServiceMockRecorder) GetSectionMembers(ctx, sectionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSectionMembers", reflect.TypeOf((*MockCourseService)(nil).GetSectionMembers), ctx, sectionID)
+}
+
+// RemoveSectionMember mocks base method.
+func (m *MockCourseService) RemoveSectionMember(ctx context.Context, sectionID, userID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveSectionMember", ctx, sectionID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveSectionMember indicates an expected call of RemoveSectionMember.
+func (mr *MockCourseServiceMockRecorder) RemoveSectionMember(ctx, sectionID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveSectionMember", reflect.TypeOf((*MockCourseService)(nil).RemoveSectionMember), ctx, sectionID, userID)
+}
+
+// UpdateCourse mocks base method.
+func (m *MockCourseService) UpdateCourse(ctx context.Context, c *course.Course) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCourse", ctx, c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateCourse indicates an expected call of UpdateCourse.
+func (mr *MockCourseServiceMockRecorder) UpdateCourse(ctx, c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCourse", reflect.TypeOf((*MockCourseService)(nil).UpdateCourse), ctx, c)
+}
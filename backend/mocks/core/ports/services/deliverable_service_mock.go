@@ -13,6 +13,7 @@ import (
 	context "context"
 	reflect "reflect"
 	deliverable "softpharos/internal/core/domain/deliverable"
+	query "softpharos/internal/core/domain/query"
 
 	gomock "go.uber.org/mock/gomock"
 )
@@ -70,18 +71,18 @@ func (mr *MockDeliverableServiceMockRecorder) DeleteDeliverable(ctx, id any) *go
 }
 
 // GetAllDeliverables mocks base method.
-func (m *MockDeliverableService) GetAllDeliverables(ctx context.Context) ([]deliverable.Deliverable, error) {
+func (m *MockDeliverableService) GetAllDeliverables(ctx context.Context, params query.Params) (*query.Page[deliverable.Deliverable], error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAllDeliverables", ctx)
-	ret0, _ := ret[0].([]deliverable.Deliverable)
+	ret := m.ctrl.Call(m, "GetAllDeliverables", ctx, params)
+	ret0, _ := ret[0].(*query.Page[deliverable.Deliverable])
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetAllDeliverables indicates an expected call of GetAllDeliverables.
-func (mr *MockDeliverableServiceMockRecorder) GetAllDeliverables(ctx any) *gomock.Call {
+func (mr *MockDeliverableServiceMockRecorder) GetAllDeliverables(ctx, params any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllDeliverables", reflect.TypeOf((*MockDeliverableService)(nil).GetAllDeliverables), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllDeliverables", reflect.TypeOf((*MockDeliverableService)(nil).GetAllDeliverables), ctx, params)
 }
 
 // GetDeliverableByID mocks base method.
@@ -114,6 +115,35 @@ func (mr *MockDeliverableServiceMockRecorder) GetDeliverablesByMilestoneID(ctx,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeliverablesByMilestoneID", reflect.TypeOf((*MockDeliverableService)(nil).GetDeliverablesByMilestoneID), ctx, milestoneID)
 }
 
+// GetTrashedDeliverablesByMilestoneID mocks base method.
+func (m *MockDeliverableService) GetTrashedDeliverablesByMilestoneID(ctx context.Context, milestoneID int) ([]deliverable.Deliverable, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTrashedDeliverablesByMilestoneID", ctx, milestoneID)
+	ret0, _ := ret[0].([]deliverable.Deliverable)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTrashedDeliverablesByMilestoneID indicates an expected call of GetTrashedDeliverablesByMilestoneID.
+func (mr *MockDeliverableServiceMockRecorder) GetTrashedDeliverablesByMilestoneID(ctx, milestoneID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTrashedDeliverablesByMilestoneID", reflect.TypeOf((*MockDeliverableService)(nil).GetTrashedDeliverablesByMilestoneID), ctx, milestoneID)
+}
+
+// RestoreDeliverable mocks base method.
+func (m *MockDeliverableService) RestoreDeliverable(ctx context.Context, id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreDeliverable", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestoreDeliverable indicates an expected call of RestoreDeliverable.
+func (mr *MockDeliverableServiceMockRecorder) RestoreDeliverable(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreDeliverable", reflect.TypeOf((*MockDeliverableService)(nil).RestoreDeliverable), ctx, id)
+}
+
 // UpdateDeliverable mocks base method.
 func (m *MockDeliverableService) UpdateDeliverable(ctx context.Context, arg1 *deliverable.Deliverable) error {
 	m.ctrl.T.Helper()
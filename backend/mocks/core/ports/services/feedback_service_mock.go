@@ -13,6 +13,7 @@ import (
 	context "context"
 	reflect "reflect"
 	feedback "softpharos/internal/core/domain/feedback"
+	query "softpharos/internal/core/domain/query"
 
 	gomock "go.uber.org/mock/gomock"
 )
@@ -70,18 +71,18 @@ func (mr *MockFeedbackServiceMockRecorder) DeleteFeedback(ctx, id any) *gomock.C
 }
 
 // GetAllFeedbacks mocks base method.
-func (m *MockFeedbackService) GetAllFeedbacks(ctx context.Context) ([]feedback.Feedback, error) {
+func (m *MockFeedbackService) GetAllFeedbacks(ctx context.Context, params query.Params) (*query.Page[feedback.Feedback], error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAllFeedbacks", ctx)
-	ret0, _ := ret[0].([]feedback.Feedback)
+	ret := m.ctrl.Call(m, "GetAllFeedbacks", ctx, params)
+	ret0, _ := ret[0].(*query.Page[feedback.Feedback])
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetAllFeedbacks indicates an expected call of GetAllFeedbacks.
-func (mr *MockFeedbackServiceMockRecorder) GetAllFeedbacks(ctx any) *gomock.Call {
+func (mr *MockFeedbackServiceMockRecorder) GetAllFeedbacks(ctx, params any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllFeedbacks", reflect.TypeOf((*MockFeedbackService)(nil).GetAllFeedbacks), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllFeedbacks", reflect.TypeOf((*MockFeedbackService)(nil).GetAllFeedbacks), ctx, params)
 }
 
 // GetFeedbackByID mocks base method.
@@ -114,6 +115,35 @@ func (mr *MockFeedbackServiceMockRecorder) GetFeedbacksByMilestoneID(ctx, milest
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFeedbacksByMilestoneID", reflect.TypeOf((*MockFeedbackService)(nil).GetFeedbacksByMilestoneID), ctx, milestoneID)
 }
 
+// GetTrashedFeedbacksByMilestoneID mocks base method.
+func (m *MockFeedbackService) GetTrashedFeedbacksByMilestoneID(ctx context.Context, milestoneID int) ([]feedback.Feedback, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTrashedFeedbacksByMilestoneID", ctx, milestoneID)
+	ret0, _ := ret[0].([]feedback.Feedback)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTrashedFeedbacksByMilestoneID indicates an expected call of GetTrashedFeedbacksByMilestoneID.
+func (mr *MockFeedbackServiceMockRecorder) GetTrashedFeedbacksByMilestoneID(ctx, milestoneID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTrashedFeedbacksByMilestoneID", reflect.TypeOf((*MockFeedbackService)(nil).GetTrashedFeedbacksByMilestoneID), ctx, milestoneID)
+}
+
+// RestoreFeedback mocks base method.
+func (m *MockFeedbackService) RestoreFeedback(ctx context.Context, id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreFeedback", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestoreFeedback indicates an expected call of RestoreFeedback.
+func (mr *MockFeedbackServiceMockRecorder) RestoreFeedback(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreFeedback", reflect.TypeOf((*MockFeedbackService)(nil).RestoreFeedback), ctx, id)
+}
+
 // UpdateFeedback mocks base method.
 func (m *MockFeedbackService) UpdateFeedback(ctx context.Context, arg1 *feedback.Feedback) error {
 	m.ctrl.T.Helper()
@@ -0,0 +1,146 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/core/ports/services/invitation_service.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/core/ports/services/invitation_service.go -destination=mocks/core/ports/services/invitation_service_mock.go -package=services
+//
+
+// Package services is a generated GoMock package.
+package services
+
+import (
+	context "context"
+	reflect "reflect"
+	invitation "softpharos/internal/core/domain/invitation"
+	project_member "softpharos/internal/core/domain/project_member"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockInvitationService is a mock of InvitationService interface.
+type MockInvitationService struct {
+	ctrl     *gomock.Controller
+	recorder *MockInvitationServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockInvitationServiceMockRecorder is the mock recorder for MockInvitationService.
+type MockInvitationServiceMockRecorder struct {
+	mock *MockInvitationService
+}
+
+// NewMockInvitationService creates a new mock instance.
+func NewMockInvitationService(ctrl *gomock.Controller) *MockInvitationService {
+	mock := &MockInvitationService{ctrl: ctrl}
+	mock.recorder = &MockInvitationServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockInvitationService) EXPECT() *MockInvitationServiceMockRecorder {
+	return m.recorder
+}
+
+// AcceptInvitation mocks base method.
+func (m *MockInvitationService) AcceptInvitation(ctx context.Context, token string) (*project_member.ProjectMember, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AcceptInvitation", ctx, token)
+	ret0, _ := ret[0].(*project_member.ProjectMember)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AcceptInvitation indicates an expected call of AcceptInvitation.
+func (mr *MockInvitationServiceMockRecorder) AcceptInvitation(ctx, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcceptInvitation", reflect.TypeOf((*MockInvitationService)(nil).AcceptInvitation), ctx, token)
+}
+
+// AcceptInvitationByID mocks base method.
+func (m *MockInvitationService) AcceptInvitationByID(ctx context.Context, id int) (*project_member.ProjectMember, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AcceptInvitationByID", ctx, id)
+	ret0, _ := ret[0].(*project_member.ProjectMember)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AcceptInvitationByID indicates an expected call of AcceptInvitationByID.
+func (mr *MockInvitationServiceMockRecorder) AcceptInvitationByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcceptInvitationByID", reflect.TypeOf((*MockInvitationService)(nil).AcceptInvitationByID), ctx, id)
+}
+
+// CreateInvitation mocks base method.
+func (m *MockInvitationService) CreateInvitation(ctx context.Context, arg1 *invitation.Invitation) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateInvitation", ctx, arg1)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateInvitation indicates an expected call of CreateInvitation.
+func (mr *MockInvitationServiceMockRecorder) CreateInvitation(ctx, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateInvitation", reflect.TypeOf((*MockInvitationService)(nil).CreateInvitation), ctx, arg1)
+}
+
+// DeclineInvitation mocks base method.
+func (m *MockInvitationService) DeclineInvitation(ctx context.Context, token string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeclineInvitation", ctx, token)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeclineInvitation indicates an expected call of DeclineInvitation.
+func (mr *MockInvitationServiceMockRecorder) DeclineInvitation(ctx, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeclineInvitation", reflect.TypeOf((*MockInvitationService)(nil).DeclineInvitation), ctx, token)
+}
+
+// DeclineInvitationByID mocks base method.
+func (m *MockInvitationService) DeclineInvitationByID(ctx context.Context, id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeclineInvitationByID", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeclineInvitationByID indicates an expected call of DeclineInvitationByID.
+func (mr *MockInvitationServiceMockRecorder) DeclineInvitationByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeclineInvitationByID", reflect.TypeOf((*MockInvitationService)(nil).DeclineInvitationByID), ctx, id)
+}
+
+// GetInvitationsByProjectID mocks base method.
+func (m *MockInvitationService) GetInvitationsByProjectID(ctx context.Context, projectID int) ([]invitation.Invitation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetInvitationsByProjectID", ctx, projectID)
+	ret0, _ := ret[0].([]invitation.Invitation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetInvitationsByProjectID indicates an expected call of GetInvitationsByProjectID.
+func (mr *MockInvitationServiceMockRecorder) GetInvitationsByProjectID(ctx, projectID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInvitationsByProjectID", reflect.TypeOf((*MockInvitationService)(nil).GetInvitationsByProjectID), ctx, projectID)
+}
+
+// GetMyInvitations mocks base method.
+func (m *MockInvitationService) GetMyInvitations(ctx context.Context) ([]invitation.Invitation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMyInvitations", ctx)
+	ret0, _ := ret[0].([]invitation.Invitation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMyInvitations indicates an expected call of GetMyInvitations.
+func (mr *MockInvitationServiceMockRecorder) GetMyInvitations(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMyInvitations", reflect.TypeOf((*MockInvitationService)(nil).GetMyInvitations), ctx)
+}
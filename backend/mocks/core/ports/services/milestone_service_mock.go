@@ -13,6 +13,8 @@ import (
 	context "context"
 	reflect "reflect"
 	milestone "softpharos/internal/core/domain/milestone"
+	milestone_detail "softpharos/internal/core/domain/milestone_detail"
+	query "softpharos/internal/core/domain/query"
 
 	gomock "go.uber.org/mock/gomock"
 )
@@ -70,18 +72,18 @@ func (mr *MockMilestoneServiceMockRecorder) DeleteMilestone(ctx, id any) *gomock
 }
 
 // GetAllMilestones mocks base method.
-func (m *MockMilestoneService) GetAllMilestones(ctx context.Context) ([]milestone.Milestone, error) {
+func (m *MockMilestoneService) GetAllMilestones(ctx context.Context, params query.Params) (*query.Page[milestone.Milestone], error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAllMilestones", ctx)
-	ret0, _ := ret[0].([]milestone.Milestone)
+	ret := m.ctrl.Call(m, "GetAllMilestones", ctx, params)
+	ret0, _ := ret[0].(*query.Page[milestone.Milestone])
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetAllMilestones indicates an expected call of GetAllMilestones.
-func (mr *MockMilestoneServiceMockRecorder) GetAllMilestones(ctx any) *gomock.Call {
+func (mr *MockMilestoneServiceMockRecorder) GetAllMilestones(ctx, params any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllMilestones", reflect.TypeOf((*MockMilestoneService)(nil).GetAllMilestones), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllMilestones", reflect.TypeOf((*MockMilestoneService)(nil).GetAllMilestones), ctx, params)
 }
 
 // GetMilestoneByID mocks base method.
@@ -99,6 +101,21 @@ func (mr *MockMilestoneServiceMockRecorder) GetMilestoneByID(ctx, id any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMilestoneByID", reflect.TypeOf((*MockMilestoneService)(nil).GetMilestoneByID), ctx, id)
 }
 
+// GetMilestoneDetail mocks base method.
+func (m *MockMilestoneService) GetMilestoneDetail(ctx context.Context, id int, includes []string) (*milestone_detail.Detail, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMilestoneDetail", ctx, id, includes)
+	ret0, _ := ret[0].(*milestone_detail.Detail)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMilestoneDetail indicates an expected call of GetMilestoneDetail.
+func (mr *MockMilestoneServiceMockRecorder) GetMilestoneDetail(ctx, id, includes any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMilestoneDetail", reflect.TypeOf((*MockMilestoneService)(nil).GetMilestoneDetail), ctx, id, includes)
+}
+
 // GetMilestonesByProjectID mocks base method.
 func (m *MockMilestoneService) GetMilestonesByProjectID(ctx context.Context, projectID int) ([]milestone.Milestone, error) {
 	m.ctrl.T.Helper()
@@ -114,6 +131,65 @@ func (mr *MockMilestoneServiceMockRecorder) GetMilestonesByProjectID(ctx, projec
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMilestonesByProjectID", reflect.TypeOf((*MockMilestoneService)(nil).GetMilestonesByProjectID), ctx, projectID)
 }
 
+// GetOverdueMilestonesByProjectID mocks base method.
+func (m *MockMilestoneService) GetOverdueMilestonesByProjectID(ctx context.Context, projectID int) ([]milestone.Milestone, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOverdueMilestonesByProjectID", ctx, projectID)
+	ret0, _ := ret[0].([]milestone.Milestone)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOverdueMilestonesByProjectID indicates an expected call of GetOverdueMilestonesByProjectID.
+func (mr *MockMilestoneServiceMockRecorder) GetOverdueMilestonesByProjectID(ctx, projectID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOverdueMilestonesByProjectID", reflect.TypeOf((*MockMilestoneService)(nil).GetOverdueMilestonesByProjectID), ctx, projectID)
+}
+
+// GetTrashedMilestonesByProjectID mocks base method.
+func (m *MockMilestoneService) GetTrashedMilestonesByProjectID(ctx context.Context, projectID int) ([]milestone.Milestone, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTrashedMilestonesByProjectID", ctx, projectID)
+	ret0, _ := ret[0].([]milestone.Milestone)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTrashedMilestonesByProjectID indicates an expected call of GetTrashedMilestonesByProjectID.
+func (mr *MockMilestoneServiceMockRecorder) GetTrashedMilestonesByProjectID(ctx, projectID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTrashedMilestonesByProjectID", reflect.TypeOf((*MockMilestoneService)(nil).GetTrashedMilestonesByProjectID), ctx, projectID)
+}
+
+// RestoreMilestone mocks base method.
+func (m *MockMilestoneService) RestoreMilestone(ctx context.Context, id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreMilestone", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestoreMilestone indicates an expected call of RestoreMilestone.
+func (mr *MockMilestoneServiceMockRecorder) RestoreMilestone(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreMilestone", reflect.TypeOf((*MockMilestoneService)(nil).RestoreMilestone), ctx, id)
+}
+
+// TransitionMilestone mocks base method.
+func (m *MockMilestoneService) TransitionMilestone(ctx context.Context, id int, status string) (*milestone.Milestone, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TransitionMilestone", ctx, id, status)
+	ret0, _ := ret[0].(*milestone.Milestone)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TransitionMilestone indicates an expected call of TransitionMilestone.
+func (mr *MockMilestoneServiceMockRecorder) TransitionMilestone(ctx, id, status any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransitionMilestone", reflect.TypeOf((*MockMilestoneService)(nil).TransitionMilestone), ctx, id, status)
+}
+
 // UpdateMilestone mocks base method.
 func (m *MockMilestoneService) UpdateMilestone(ctx context.Context, arg1 *milestone.Milestone) error {
 	m.ctrl.T.Helper()
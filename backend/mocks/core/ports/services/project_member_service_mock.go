@@ -13,6 +13,7 @@ import (
 	context "context"
 	reflect "reflect"
 	project_member "softpharos/internal/core/domain/project_member"
+	query "softpharos/internal/core/domain/query"
 
 	gomock "go.uber.org/mock/gomock"
 )
@@ -70,18 +71,18 @@ func (mr *MockProjectMemberServiceMockRecorder) DeleteProjectMember(ctx, id any)
 }
 
 // GetAllProjectMembers mocks base method.
-func (m *MockProjectMemberService) GetAllProjectMembers(ctx context.Context) ([]project_member.ProjectMember, error) {
+func (m *MockProjectMemberService) GetAllProjectMembers(ctx context.Context, params query.Params) (*query.Page[project_member.ProjectMember], error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAllProjectMembers", ctx)
-	ret0, _ := ret[0].([]project_member.ProjectMember)
+	ret := m.ctrl.Call(m, "GetAllProjectMembers", ctx, params)
+	ret0, _ := ret[0].(*query.Page[project_member.ProjectMember])
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetAllProjectMembers indicates an expected call of GetAllProjectMembers.
-func (mr *MockProjectMemberServiceMockRecorder) GetAllProjectMembers(ctx any) *gomock.Call {
+func (mr *MockProjectMemberServiceMockRecorder) GetAllProjectMembers(ctx, params any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllProjectMembers", reflect.TypeOf((*MockProjectMemberService)(nil).GetAllProjectMembers), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllProjectMembers", reflect.TypeOf((*MockProjectMemberService)(nil).GetAllProjectMembers), ctx, params)
 }
 
 // GetProjectMemberByID mocks base method.
@@ -13,6 +13,7 @@ import (
 	context "context"
 	reflect "reflect"
 	project "softpharos/internal/core/domain/project"
+	query "softpharos/internal/core/domain/query"
 
 	gomock "go.uber.org/mock/gomock"
 )
@@ -70,18 +71,33 @@ func (mr *MockProjectServiceMockRecorder) DeleteProject(ctx, id any) *gomock.Cal
 }
 
 // GetAllProjects mocks base method.
-func (m *MockProjectService) GetAllProjects(ctx context.Context) ([]project.Project, error) {
+func (m *MockProjectService) GetAllProjects(ctx context.Context, params query.Params) (*query.Page[project.Project], error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAllProjects", ctx)
-	ret0, _ := ret[0].([]project.Project)
+	ret := m.ctrl.Call(m, "GetAllProjects", ctx, params)
+	ret0, _ := ret[0].(*query.Page[project.Project])
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetAllProjects indicates an expected call of GetAllProjects.
-func (mr *MockProjectServiceMockRecorder) GetAllProjects(ctx any) *gomock.Call {
+func (mr *MockProjectServiceMockRecorder) GetAllProjects(ctx, params any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllProjects", reflect.TypeOf((*MockProjectService)(nil).GetAllProjects), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllProjects", reflect.TypeOf((*MockProjectService)(nil).GetAllProjects), ctx, params)
+}
+
+// GetMyProjects mocks base method.
+func (m *MockProjectService) GetMyProjects(ctx context.Context, params query.Params) (*query.Page[project.MemberProject], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMyProjects", ctx, params)
+	ret0, _ := ret[0].(*query.Page[project.MemberProject])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMyProjects indicates an expected call of GetMyProjects.
+func (mr *MockProjectServiceMockRecorder) GetMyProjects(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMyProjects", reflect.TypeOf((*MockProjectService)(nil).GetMyProjects), ctx, params)
 }
 
 // GetProjectByID mocks base method.
@@ -114,6 +130,63 @@ func (mr *MockProjectServiceMockRecorder) GetProjectsByOwner(ctx, ownerID any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProjectsByOwner", reflect.TypeOf((*MockProjectService)(nil).GetProjectsByOwner), ctx, ownerID)
 }
 
+// GetTrashedProjects mocks base method.
+func (m *MockProjectService) GetTrashedProjects(ctx context.Context, params query.Params) (*query.Page[project.Project], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTrashedProjects", ctx, params)
+	ret0, _ := ret[0].(*query.Page[project.Project])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTrashedProjects indicates an expected call of GetTrashedProjects.
+func (mr *MockProjectServiceMockRecorder) GetTrashedProjects(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTrashedProjects", reflect.TypeOf((*MockProjectService)(nil).GetTrashedProjects), ctx, params)
+}
+
+// RestoreProject mocks base method.
+func (m *MockProjectService) RestoreProject(ctx context.Context, id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreProject", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestoreProject indicates an expected call of RestoreProject.
+func (mr *MockProjectServiceMockRecorder) RestoreProject(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreProject", reflect.TypeOf((*MockProjectService)(nil).RestoreProject), ctx, id)
+}
+
+// SetProjectArchived mocks base method.
+func (m *MockProjectService) SetProjectArchived(ctx context.Context, id int, archived bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetProjectArchived", ctx, id, archived)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetProjectArchived indicates an expected call of SetProjectArchived.
+func (mr *MockProjectServiceMockRecorder) SetProjectArchived(ctx, id, archived any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetProjectArchived", reflect.TypeOf((*MockProjectService)(nil).SetProjectArchived), ctx, id, archived)
+}
+
+// TransferOwnership mocks base method.
+func (m *MockProjectService) TransferOwnership(ctx context.Context, projectID, newOwnerID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TransferOwnership", ctx, projectID, newOwnerID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TransferOwnership indicates an expected call of TransferOwnership.
+func (mr *MockProjectServiceMockRecorder) TransferOwnership(ctx, projectID, newOwnerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransferOwnership", reflect.TypeOf((*MockProjectService)(nil).TransferOwnership), ctx, projectID, newOwnerID)
+}
+
 // UpdateProject mocks base method.
 func (m *MockProjectService) UpdateProject(ctx context.Context, arg1 *project.Project) error {
 	m.ctrl.T.Helper()
@@ -12,6 +12,7 @@ package services
 import (
 	context "context"
 	reflect "reflect"
+	query "softpharos/internal/core/domain/query"
 	reaction "softpharos/internal/core/domain/reaction"
 
 	gomock "go.uber.org/mock/gomock"
@@ -70,18 +71,18 @@ func (mr *MockReactionServiceMockRecorder) DeleteReaction(ctx, id any) *gomock.C
 }
 
 // GetAllReactions mocks base method.
-func (m *MockReactionService) GetAllReactions(ctx context.Context) ([]reaction.Reaction, error) {
+func (m *MockReactionService) GetAllReactions(ctx context.Context, params query.Params) (*query.Page[reaction.Reaction], error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAllReactions", ctx)
-	ret0, _ := ret[0].([]reaction.Reaction)
+	ret := m.ctrl.Call(m, "GetAllReactions", ctx, params)
+	ret0, _ := ret[0].(*query.Page[reaction.Reaction])
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetAllReactions indicates an expected call of GetAllReactions.
-func (mr *MockReactionServiceMockRecorder) GetAllReactions(ctx any) *gomock.Call {
+func (mr *MockReactionServiceMockRecorder) GetAllReactions(ctx, params any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllReactions", reflect.TypeOf((*MockReactionService)(nil).GetAllReactions), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllReactions", reflect.TypeOf((*MockReactionService)(nil).GetAllReactions), ctx, params)
 }
 
 // GetReactionByID mocks base method.
@@ -99,6 +100,35 @@ func (mr *MockReactionServiceMockRecorder) GetReactionByID(ctx, id any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReactionByID", reflect.TypeOf((*MockReactionService)(nil).GetReactionByID), ctx, id)
 }
 
+// GetReactionSummary mocks base method.
+func (m *MockReactionService) GetReactionSummary(ctx context.Context, milestoneID, userID int) ([]reaction.TypeCount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReactionSummary", ctx, milestoneID, userID)
+	ret0, _ := ret[0].([]reaction.TypeCount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReactionSummary indicates an expected call of GetReactionSummary.
+func (mr *MockReactionServiceMockRecorder) GetReactionSummary(ctx, milestoneID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReactionSummary", reflect.TypeOf((*MockReactionService)(nil).GetReactionSummary), ctx, milestoneID, userID)
+}
+
+// GetReactionTypes mocks base method.
+func (m *MockReactionService) GetReactionTypes() []string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReactionTypes")
+	ret0, _ := ret[0].([]string)
+	return ret0
+}
+
+// GetReactionTypes indicates an expected call of GetReactionTypes.
+func (mr *MockReactionServiceMockRecorder) GetReactionTypes() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReactionTypes", reflect.TypeOf((*MockReactionService)(nil).GetReactionTypes))
+}
+
 // GetReactionsByMilestoneID mocks base method.
 func (m *MockReactionService) GetReactionsByMilestoneID(ctx context.Context, milestoneID int) ([]reaction.Reaction, error) {
 	m.ctrl.T.Helper()
@@ -114,6 +144,21 @@ func (mr *MockReactionServiceMockRecorder) GetReactionsByMilestoneID(ctx, milest
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReactionsByMilestoneID", reflect.TypeOf((*MockReactionService)(nil).GetReactionsByMilestoneID), ctx, milestoneID)
 }
 
+// SetReaction mocks base method.
+func (m *MockReactionService) SetReaction(ctx context.Context, milestoneID, userID int, reactionType string, reacted bool) ([]reaction.TypeCount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetReaction", ctx, milestoneID, userID, reactionType, reacted)
+	ret0, _ := ret[0].([]reaction.TypeCount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetReaction indicates an expected call of SetReaction.
+func (mr *MockReactionServiceMockRecorder) SetReaction(ctx, milestoneID, userID, reactionType, reacted any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetReaction", reflect.TypeOf((*MockReactionService)(nil).SetReaction), ctx, milestoneID, userID, reactionType, reacted)
+}
+
 // UpdateReaction mocks base method.
 func (m *MockReactionService) UpdateReaction(ctx context.Context, arg1 *reaction.Reaction) error {
 	m.ctrl.T.Helper()
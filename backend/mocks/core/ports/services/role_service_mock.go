@@ -12,6 +12,7 @@ package services
 import (
 	context "context"
 	reflect "reflect"
+	query "softpharos/internal/core/domain/query"
 	role "softpharos/internal/core/domain/role"
 
 	gomock "go.uber.org/mock/gomock"
@@ -41,19 +42,47 @@ func (m *MockRoleService) EXPECT() *MockRoleServiceMockRecorder {
 	return m.recorder
 }
 
+// CreateRole mocks base method.
+func (m *MockRoleService) CreateRole(ctx context.Context, arg1 *role.Role) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRole", ctx, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateRole indicates an expected call of CreateRole.
+func (mr *MockRoleServiceMockRecorder) CreateRole(ctx, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRole", reflect.TypeOf((*MockRoleService)(nil).CreateRole), ctx, arg1)
+}
+
+// DeleteRole mocks base method.
+func (m *MockRoleService) DeleteRole(ctx context.Context, id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRole", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteRole indicates an expected call of DeleteRole.
+func (mr *MockRoleServiceMockRecorder) DeleteRole(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRole", reflect.TypeOf((*MockRoleService)(nil).DeleteRole), ctx, id)
+}
+
 // GetAllRoles mocks base method.
-func (m *MockRoleService) GetAllRoles(ctx context.Context) ([]role.Role, error) {
+func (m *MockRoleService) GetAllRoles(ctx context.Context, params query.Params) (*query.Page[role.Role], error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAllRoles", ctx)
-	ret0, _ := ret[0].([]role.Role)
+	ret := m.ctrl.Call(m, "GetAllRoles", ctx, params)
+	ret0, _ := ret[0].(*query.Page[role.Role])
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetAllRoles indicates an expected call of GetAllRoles.
-func (mr *MockRoleServiceMockRecorder) GetAllRoles(ctx any) *gomock.Call {
+func (mr *MockRoleServiceMockRecorder) GetAllRoles(ctx, params any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllRoles", reflect.TypeOf((*MockRoleService)(nil).GetAllRoles), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllRoles", reflect.TypeOf((*MockRoleService)(nil).GetAllRoles), ctx, params)
 }
 
 // GetRoleByID mocks base method.
@@ -85,3 +114,17 @@ func (mr *MockRoleServiceMockRecorder) GetRoleByName(ctx, name any) *gomock.Call
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRoleByName", reflect.TypeOf((*MockRoleService)(nil).GetRoleByName), ctx, name)
 }
+
+// UpdateRole mocks base method.
+func (m *MockRoleService) UpdateRole(ctx context.Context, arg1 *role.Role) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRole", ctx, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateRole indicates an expected call of UpdateRole.
+func (mr *MockRoleServiceMockRecorder) UpdateRole(ctx, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRole", reflect.TypeOf((*MockRoleService)(nil).UpdateRole), ctx, arg1)
+}
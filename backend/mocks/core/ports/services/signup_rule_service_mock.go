@@ -0,0 +1,131 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/core/ports/services/signup_rule_service.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/core/ports/services/signup_rule_service.go -destination=mocks/core/ports/services/signup_rule_service_mock.go -package=services
+//
+
+// Package services is a generated GoMock package.
+package services
+
+import (
+	context "context"
+	reflect "reflect"
+	identity "softpharos/internal/core/domain/identity"
+	query "softpharos/internal/core/domain/query"
+	signup_rule "softpharos/internal/core/domain/signup_rule"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockSignupRuleService is a mock of SignupRuleService interface.
+type MockSignupRuleService struct {
+	ctrl     *gomock.Controller
+	recorder *MockSignupRuleServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockSignupRuleServiceMockRecorder is the mock recorder for MockSignupRuleService.
+type MockSignupRuleServiceMockRecorder struct {
+	mock *MockSignupRuleService
+}
+
+// NewMockSignupRuleService creates a new mock instance.
+func NewMockSignupRuleService(ctrl *gomock.Controller) *MockSignupRuleService {
+	mock := &MockSignupRuleService{ctrl: ctrl}
+	mock.recorder = &MockSignupRuleServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSignupRuleService) EXPECT() *MockSignupRuleServiceMockRecorder {
+	return m.recorder
+}
+
+// CreateSignupRule mocks base method.
+func (m *MockSignupRuleService) CreateSignupRule(ctx context.Context, rule *signup_rule.SignupRule) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSignupRule", ctx, rule)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateSignupRule indicates an expected call of CreateSignupRule.
+func (mr *MockSignupRuleServiceMockRecorder) CreateSignupRule(ctx, rule any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSignupRule", reflect.TypeOf((*MockSignupRuleService)(nil).CreateSignupRule), ctx, rule)
+}
+
+// DeleteSignupRule mocks base method.
+func (m *MockSignupRuleService) DeleteSignupRule(ctx context.Context, id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSignupRule", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSignupRule indicates an expected call of DeleteSignupRule.
+func (mr *MockSignupRuleServiceMockRecorder) DeleteSignupRule(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSignupRule", reflect.TypeOf((*MockSignupRuleService)(nil).DeleteSignupRule), ctx, id)
+}
+
+// GetAllSignupRules mocks base method.
+func (m *MockSignupRuleService) GetAllSignupRules(ctx context.Context, params query.Params) (*query.Page[signup_rule.SignupRule], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllSignupRules", ctx, params)
+	ret0, _ := ret[0].(*query.Page[signup_rule.SignupRule])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllSignupRules indicates an expected call of GetAllSignupRules.
+func (mr *MockSignupRuleServiceMockRecorder) GetAllSignupRules(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllSignupRules", reflect.TypeOf((*MockSignupRuleService)(nil).GetAllSignupRules), ctx, params)
+}
+
+// GetSignupRuleByID mocks base method.
+func (m *MockSignupRuleService) GetSignupRuleByID(ctx context.Context, id int) (*signup_rule.SignupRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSignupRuleByID", ctx, id)
+	ret0, _ := ret[0].(*signup_rule.SignupRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSignupRuleByID indicates an expected call of GetSignupRuleByID.
+func (mr *MockSignupRuleServiceMockRecorder) GetSignupRuleByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSignupRuleByID", reflect.TypeOf((*MockSignupRuleService)(nil).GetSignupRuleByID), ctx, id)
+}
+
+// MatchAccount mocks base method.
+func (m *MockSignupRuleService) MatchAccount(ctx context.Context, account *identity.ProviderIdentity) (*signup_rule.SignupRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MatchAccount", ctx, account)
+	ret0, _ := ret[0].(*signup_rule.SignupRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MatchAccount indicates an expected call of MatchAccount.
+func (mr *MockSignupRuleServiceMockRecorder) MatchAccount(ctx, account any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MatchAccount", reflect.TypeOf((*MockSignupRuleService)(nil).MatchAccount), ctx, account)
+}
+
+// UpdateSignupRule mocks base method.
+func (m *MockSignupRuleService) UpdateSignupRule(ctx context.Context, rule *signup_rule.SignupRule) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSignupRule", ctx, rule)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateSignupRule indicates an expected call of UpdateSignupRule.
+func (mr *MockSignupRuleServiceMockRecorder) UpdateSignupRule(ctx, rule any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSignupRule", reflect.TypeOf((*MockSignupRuleService)(nil).UpdateSignupRule), ctx, rule)
+}
@@ -0,0 +1,115 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/core/ports/services/term_service.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/core/ports/services/term_service.go -destination=mocks/core/ports/services/term_service_mock.go -package=services
+//
+
+// Package services is a generated GoMock package.
+package services
+
+import (
+	context "context"
+	reflect "reflect"
+	query "softpharos/internal/core/domain/query"
+	term "softpharos/internal/core/domain/term"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockTermService is a mock of TermService interface.
+type MockTermService struct {
+	ctrl     *gomock.Controller
+	recorder *MockTermServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockTermServiceMockRecorder is the mock recorder for MockTermService.
+type MockTermServiceMockRecorder struct {
+	mock *MockTermService
+}
+
+// NewMockTermService creates a new mock instance.
+func NewMockTermService(ctrl *gomock.Controller) *MockTermService {
+	mock := &MockTermService{ctrl: ctrl}
+	mock.recorder = &MockTermServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTermService) EXPECT() *MockTermServiceMockRecorder {
+	return m.recorder
+}
+
+// CreateTerm mocks base method.
+func (m *MockTermService) CreateTerm(ctx context.Context, t *term.Term) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTerm", ctx, t)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateTerm indicates an expected call of CreateTerm.
+func (mr *MockTermServiceMockRecorder) CreateTerm(ctx, t any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTerm", reflect.TypeOf((*MockTermService)(nil).CreateTerm), ctx, t)
+}
+
+// DeleteTerm mocks base method.
+func (m *MockTermService) DeleteTerm(ctx context.Context, id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTerm", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTerm indicates an expected call of DeleteTerm.
+func (mr *MockTermServiceMockRecorder) DeleteTerm(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTerm", reflect.TypeOf((*MockTermService)(nil).DeleteTerm), ctx, id)
+}
+
+// GetAllTerms mocks base method.
+func (m *MockTermService) GetAllTerms(ctx context.Context, params query.Params) (*query.Page[term.Term], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllTerms", ctx, params)
+	ret0, _ := ret[0].(*query.Page[term.Term])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllTerms indicates an expected call of GetAllTerms.
+func (mr *MockTermServiceMockRecorder) GetAllTerms(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllTerms", reflect.TypeOf((*MockTermService)(nil).GetAllTerms), ctx, params)
+}
+
+// GetTermByID mocks base method.
+func (m *MockTermService) GetTermByID(ctx context.Context, id int) (*term.Term, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTermByID", ctx, id)
+	ret0, _ := ret[0].(*term.Term)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTermByID indicates an expected call of GetTermByID.
+func (mr *MockTermServiceMockRecorder) GetTermByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTermByID", reflect.TypeOf((*MockTermService)(nil).GetTermByID), ctx, id)
+}
+
+// UpdateTerm mocks base method.
+func (m *MockTermService) UpdateTerm(ctx context.Context, t *term.Term) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTerm", ctx, t)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateTerm indicates an expected call of UpdateTerm.
+func (mr *MockTermServiceMockRecorder) UpdateTerm(ctx, t any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTerm", reflect.TypeOf((*MockTermService)(nil).UpdateTerm), ctx, t)
+}
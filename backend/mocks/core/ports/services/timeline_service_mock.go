@@ -0,0 +1,58 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/core/ports/services/timeline_service.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/core/ports/services/timeline_service.go -destination=mocks/core/ports/services/timeline_service_mock.go -package=services
+//
+
+// Package services is a generated GoMock package.
+package services
+
+import (
+	context "context"
+	reflect "reflect"
+	query "softpharos/internal/core/domain/query"
+	timeline "softpharos/internal/core/domain/timeline"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockTimelineService is a mock of TimelineService interface.
+type MockTimelineService struct {
+	ctrl     *gomock.Controller
+	recorder *MockTimelineServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockTimelineServiceMockRecorder is the mock recorder for MockTimelineService.
+type MockTimelineServiceMockRecorder struct {
+	mock *MockTimelineService
+}
+
+// NewMockTimelineService creates a new mock instance.
+func NewMockTimelineService(ctrl *gomock.Controller) *MockTimelineService {
+	mock := &MockTimelineService{ctrl: ctrl}
+	mock.recorder = &MockTimelineServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTimelineService) EXPECT() *MockTimelineServiceMockRecorder {
+	return m.recorder
+}
+
+// GetProjectTimeline mocks base method.
+func (m *MockTimelineService) GetProjectTimeline(ctx context.Context, projectID int, params query.Params) (*query.Page[timeline.Event], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProjectTimeline", ctx, projectID, params)
+	ret0, _ := ret[0].(*query.Page[timeline.Event])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProjectTimeline indicates an expected call of GetProjectTimeline.
+func (mr *MockTimelineServiceMockRecorder) GetProjectTimeline(ctx, projectID, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProjectTimeline", reflect.TypeOf((*MockTimelineService)(nil).GetProjectTimeline), ctx, projectID, params)
+}
@@ -12,6 +12,7 @@ package services
 import (
 	context "context"
 	reflect "reflect"
+	query "softpharos/internal/core/domain/query"
 	user "softpharos/internal/core/domain/user"
 
 	gomock "go.uber.org/mock/gomock"
@@ -41,6 +42,21 @@ func (m *MockUserService) EXPECT() *MockUserServiceMockRecorder {
 	return m.recorder
 }
 
+// ChangeUserRole mocks base method.
+func (m *MockUserService) ChangeUserRole(ctx context.Context, id, roleID int) (*user.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangeUserRole", ctx, id, roleID)
+	ret0, _ := ret[0].(*user.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ChangeUserRole indicates an expected call of ChangeUserRole.
+func (mr *MockUserServiceMockRecorder) ChangeUserRole(ctx, id, roleID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeUserRole", reflect.TypeOf((*MockUserService)(nil).ChangeUserRole), ctx, id, roleID)
+}
+
 // CreateUser mocks base method.
 func (m *MockUserService) CreateUser(ctx context.Context, arg1 *user.User) error {
 	m.ctrl.T.Helper()
@@ -70,18 +86,18 @@ func (mr *MockUserServiceMockRecorder) DeleteUser(ctx, id any) *gomock.Call {
 }
 
 // GetAllUsers mocks base method.
-func (m *MockUserService) GetAllUsers(ctx context.Context) ([]user.User, error) {
+func (m *MockUserService) GetAllUsers(ctx context.Context, params query.Params) (*query.Page[user.User], error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAllUsers", ctx)
-	ret0, _ := ret[0].([]user.User)
+	ret := m.ctrl.Call(m, "GetAllUsers", ctx, params)
+	ret0, _ := ret[0].(*query.Page[user.User])
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetAllUsers indicates an expected call of GetAllUsers.
-func (mr *MockUserServiceMockRecorder) GetAllUsers(ctx any) *gomock.Call {
+func (mr *MockUserServiceMockRecorder) GetAllUsers(ctx, params any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllUsers", reflect.TypeOf((*MockUserService)(nil).GetAllUsers), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllUsers", reflect.TypeOf((*MockUserService)(nil).GetAllUsers), ctx, params)
 }
 
 // GetUserByEmail mocks base method.
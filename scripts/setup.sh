@@ -36,45 +36,43 @@ until docker exec "$PG_CONTAINER" pg_isready -U "$DB_USER" -d "$DB_NAME" >/dev/n
 done
 echo "✅ PostgreSQL está listo."
 
-# --- 3. Ejecutar script SQL de inicialización (solo si la BD está vacía) ---
+# --- 3. Aplicar migraciones y seeds ---
+# El esquema se versiona con las migraciones embebidas en el binario
+# (backend/internal/infra/databases/migrations/sql). Los seeds solo se cargan
+# cuando la base estaba vacía.
 
-INIT_SQL="backend/cmd/bd/init.sql"
 SEED_SQL="backend/cmd/bd/seed.sql"
 SEED_DEV_SQL="backend/cmd/bd/seed_dev.sql"
 
-if [ -f "$INIT_SQL" ]; then
-  echo "🔍 Verificando si la base de datos ya fue inicializada..."
-  TABLE_COUNT=$(docker exec -i "$PG_CONTAINER" psql -U "$DB_USER" -d "$DB_NAME" -t -c \
-    "SELECT count(*) FROM information_schema.tables WHERE table_schema = 'public';" | tr -d '[:space:]')
+echo "🔍 Verificando si la base de datos ya fue inicializada..."
+TABLE_COUNT=$(docker exec -i "$PG_CONTAINER" psql -U "$DB_USER" -d "$DB_NAME" -t -c \
+  "SELECT count(*) FROM information_schema.tables WHERE table_schema = 'public';" | tr -d '[:space:]')
 
-  if [ "$TABLE_COUNT" = "0" ] || [ -z "$TABLE_COUNT" ]; then
-    echo "🕐 Ejecutando script SQL de inicialización..."
-    docker exec -i "$PG_CONTAINER" psql -U "$DB_USER" -d "$DB_NAME" < "$INIT_SQL"
+echo "🕐 Aplicando migraciones pendientes..."
+(cd backend && go run . migrate up)
 
-    # Ejecutar seed de datos esenciales
-    if [ -f "$SEED_SQL" ]; then
-      echo "🌱 Poblando base de datos con datos esenciales (seed.sql)..."
-      docker exec -i "$PG_CONTAINER" psql -U "$DB_USER" -d "$DB_NAME" < "$SEED_SQL"
-    fi
+if [ "$TABLE_COUNT" = "0" ] || [ -z "$TABLE_COUNT" ]; then
+  # Ejecutar seed de datos esenciales
+  if [ -f "$SEED_SQL" ]; then
+    echo "🌱 Poblando base de datos con datos esenciales (seed.sql)..."
+    docker exec -i "$PG_CONTAINER" psql -U "$DB_USER" -d "$DB_NAME" < "$SEED_SQL"
+  fi
 
-    # Ejecutar seed de desarrollo (solo si existe)
-    if [ -f "$SEED_DEV_SQL" ]; then
-      echo "🔍 ¿Deseas cargar datos de desarrollo/testing? (y/N)"
-      read -r LOAD_DEV_DATA
-      if [[ "$LOAD_DEV_DATA" =~ ^[Yy]$ ]]; then
-        echo "🌱 Poblando base de datos con datos de desarrollo (seed_dev.sql)..."
-        docker exec -i "$PG_CONTAINER" psql -U "$DB_USER" -d "$DB_NAME" < "$SEED_DEV_SQL"
-      else
-        echo "⏭️ Saltando datos de desarrollo."
-      fi
+  # Ejecutar seed de desarrollo (solo si existe)
+  if [ -f "$SEED_DEV_SQL" ]; then
+    echo "🔍 ¿Deseas cargar datos de desarrollo/testing? (y/N)"
+    read -r LOAD_DEV_DATA
+    if [[ "$LOAD_DEV_DATA" =~ ^[Yy]$ ]]; then
+      echo "🌱 Poblando base de datos con datos de desarrollo (seed_dev.sql)..."
+      docker exec -i "$PG_CONTAINER" psql -U "$DB_USER" -d "$DB_NAME" < "$SEED_DEV_SQL"
+    else
+      echo "⏭️ Saltando datos de desarrollo."
     fi
-  else
-    echo "✅ Base de datos ya inicializada. No se ejecutará init.sql."
-    echo "💡 Para poblar datos manualmente, ejecuta:"
-    echo "   docker exec -i $PG_CONTAINER psql -U $DB_USER -d $DB_NAME < $SEED_SQL"
   fi
 else
-  echo "⚠️ No se encontró el archivo $INIT_SQL"
+  echo "✅ Base de datos ya inicializada. No se cargarán los seeds."
+  echo "💡 Para poblar datos manualmente, ejecuta:"
+  echo "   docker exec -i $PG_CONTAINER psql -U $DB_USER -d $DB_NAME < $SEED_SQL"
 fi
 
 # --- 4. Backend (Go) ---